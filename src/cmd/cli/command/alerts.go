@@ -0,0 +1,106 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+func makeAlertsCmd() *cobra.Command {
+	var alertsCmd = &cobra.Command{
+		Use:     "alerts",
+		Aliases: []string{"alert"},
+		Args:    cobra.NoArgs,
+		Short:   "Manage alert rules that notify you when a deployed service starts failing",
+	}
+
+	var alertsAddCmd = &cobra.Command{
+		Use:         "add",
+		Aliases:     []string{"create"},
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "Add an alert rule for a service",
+		Example:     "  defang alerts add --service api --on crashloop,unhealthy --notify slack",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var service, _ = cmd.Flags().GetString("service")
+			var conditions, _ = cmd.Flags().GetStringSlice("on")
+			var notify, _ = cmd.Flags().GetStringSlice("notify")
+
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+
+			_, err = cli.AlertsAdd(ctx, global.Client, cli.AddAlertParams{
+				ProjectName: projectName,
+				Stack:       session.Stack.Name,
+				Service:     service,
+				Conditions:  conditions,
+				Notify:      notify,
+			})
+			return err
+		},
+	}
+	alertsAddCmd.Flags().String("service", "", "name of the service to alert on")
+	alertsAddCmd.Flags().StringSlice("on", []string{"crashloop", "unhealthy"}, fmt.Sprintf("conditions that trigger the alert; one or more of %v", cli.AllAlertConditions))
+	alertsAddCmd.Flags().StringSlice("notify", nil, "notification channels to send the alert to, eg. slack or email")
+	_ = alertsAddCmd.MarkFlagRequired("service")
+	_ = alertsAddCmd.MarkFlagRequired("notify")
+	_ = alertsAddCmd.RegisterFlagCompletionFunc("on", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cli.AllAlertConditions, cobra.ShellCompDirectiveNoFileComp
+	})
+	alertsCmd.AddCommand(alertsAddCmd)
+
+	alertsCmd.AddCommand(&cobra.Command{
+		Use:         "ls",
+		Aliases:     []string{"list"},
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "List the alert rules of the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			return cli.AlertsList(ctx, global.Client, projectName, session.Stack.Name)
+		},
+	})
+
+	alertsCmd.AddCommand(&cobra.Command{
+		Use:         "rm ID...",
+		Aliases:     []string{"delete", "del", "remove"},
+		Annotations: authNeededAlways,
+		Args:        cobra.MinimumNArgs(1),
+		Short:       "Remove one or more alert rules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			if err := cli.AlertsDelete(ctx, global.Client, projectName, session.Stack.Name, args...); err != nil {
+				return err
+			}
+			term.Info("Removed alert rule(s)", args)
+			return nil
+		},
+	})
+	return alertsCmd
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+func makeBackupCmd() *cobra.Command {
+	var backupCmd = &cobra.Command{
+		Use:     "backup",
+		Aliases: []string{"backups"},
+		Args:    cobra.NoArgs,
+		Short:   "Manage backups of volumes and managed databases",
+		Long: `Manage backups of volumes and managed databases.
+
+To back up a service on a schedule, add x-defang-backup to the service in the Compose file, eg.
+
+  x-defang-backup:
+    schedule: daily
+    retention_days: 7`,
+	}
+
+	backupCmd.AddCommand(&cobra.Command{
+		Use:         "create SERVICE",
+		Aliases:     []string{"new"},
+		Annotations: authNeededAlways,
+		Args:        cobra.ExactArgs(1),
+		Short:       "Back up the volumes or managed database of a service now",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			_, err = cli.BackupCreate(ctx, global.Client, projectName, session.Stack.Name, args[0])
+			return err
+		},
+	})
+
+	var backupListCmd = &cobra.Command{
+		Use:         "ls",
+		Aliases:     []string{"list"},
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "List the backups of the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var service, _ = cmd.Flags().GetString("service")
+
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			return cli.BackupsList(ctx, global.Client, projectName, session.Stack.Name, service)
+		},
+	}
+	backupListCmd.Flags().String("service", "", "only list the backups of this service")
+	backupCmd.AddCommand(backupListCmd)
+
+	var backupRestoreCmd = &cobra.Command{
+		Use:         "restore ID",
+		Annotations: authNeededAlways,
+		Args:        cobra.ExactArgs(1),
+		Short:       "Restore a service from a backup, overwriting its current data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var force, _ = cmd.Flags().GetBool("force")
+
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				if err := cli.Confirm(ctx, cli.ConfirmPrompt{
+					Message: "Restore backup " + args[0] + " in project " + projectName + "? The current data of the service will be overwritten.",
+					Event:   "Restore Backup Prompt Answered",
+				}); err != nil {
+					return fmt.Errorf("restore: %w", err)
+				}
+			}
+
+			if err := cli.BackupRestore(ctx, global.Client, projectName, session.Stack.Name, args[0]); err != nil {
+				return err
+			}
+			term.Info("Restoring backup", args[0])
+			return nil
+		},
+	}
+	backupRestoreCmd.Flags().Bool("force", false, "restore without confirmation")
+	backupCmd.AddCommand(backupRestoreCmd)
+
+	return backupCmd
+}
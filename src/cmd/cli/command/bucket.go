@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+var bucketCmd = &cobra.Command{
+	Use:     "bucket",
+	Aliases: []string{"buckets"},
+	Args:    cobra.NoArgs,
+	Short:   "Manage object storage buckets",
+}
+
+var bucketListCmd = &cobra.Command{
+	Use:         "ls",
+	Aliases:     []string{"list"},
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "List the managed buckets in the project",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		project, err := session.Loader.LoadProject(ctx)
+		if err != nil {
+			return err
+		}
+
+		return cli.PrintBuckets(ctx, project, session.Provider)
+	},
+}
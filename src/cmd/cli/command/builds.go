@@ -0,0 +1,76 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var imagesCmd = &cobra.Command{
+	Use:     "images",
+	Aliases: []string{"image"},
+	Args:    cobra.NoArgs,
+	Short:   "Manage the images of deployed services",
+}
+
+var imagesListCmd = &cobra.Command{
+	Use:         "ls",
+	Aliases:     []string{"list"},
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "List the images referenced by the current deployment",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		return cli.PrintDeployedImages(ctx, projectName, session.Provider)
+	},
+}
+
+var buildsCmd = &cobra.Command{
+	Use:     "builds",
+	Aliases: []string{"build"},
+	Args:    cobra.NoArgs,
+	Short:   "Manage build artifacts",
+}
+
+var buildsPruneCmd = &cobra.Command{
+	Use:         "prune",
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "Delete old uploaded build contexts",
+	Long: `Delete old uploaded build contexts from the CD bucket. Build contexts used by the current deployment of
+any project or stack, or by a deployment that can be rolled back to, are kept. Only the aws provider supports this.
+Built images are not pruned yet; --images fails instead of leaving them behind silently.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		olderThan, _ := cmd.Flags().GetDuration("older-than")
+		keepLast, _ := cmd.Flags().GetInt("keep-last")
+		images, _ := cmd.Flags().GetBool("images")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+
+		err = cli.PruneBuildContexts(ctx, global.Client, session.Provider, cli.PruneBuildsParams{
+			OlderThan: olderThan,
+			KeepLast:  keepLast,
+			Images:    images,
+		})
+		if errors.Is(err, cli.ErrBuildContextsNotSupported) || errors.Is(err, cli.ErrImagePruneNotSupported) {
+			return fmt.Errorf("provider %q: %w", session.Stack.Provider, err)
+		}
+		return err
+	},
+}
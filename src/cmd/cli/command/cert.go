@@ -27,9 +27,62 @@ var certGenerateCmd = &cobra.Command{
 			return err
 		}
 
-		if err := cli.GenerateLetsEncryptCert(ctx, project, global.Client, session.Provider); err != nil {
+		dnsProvider := cli.DNSProviderNone
+		if p, ok := cmd.Flag("dns-provider").Value.(*cli.DNSProvider); ok {
+			dnsProvider = *p
+		}
+		if err := cli.GenerateLetsEncryptCert(ctx, project, global.Client, session.Provider, dnsProvider); err != nil {
 			return err
 		}
 		return nil
 	},
 }
+
+var certStatusCmd = &cobra.Command{
+	Use:         "status",
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "Show the TLS certificate status of each domain",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		project, err := session.Loader.LoadProject(ctx)
+		if err != nil {
+			return err
+		}
+
+		return cli.PrintCertStatus(ctx, project, session.Provider)
+	},
+}
+
+var certUploadCmd = &cobra.Command{
+	Use:         "upload",
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "Upload your own TLS certificate for a domain",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		domain, _ := cmd.Flags().GetString("domain")
+		certFile, _ := cmd.Flags().GetString("cert")
+		keyFile, _ := cmd.Flags().GetString("key")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		project, err := session.Loader.LoadProject(ctx)
+		if err != nil {
+			return err
+		}
+
+		return cli.UploadCert(ctx, project, global.Client, cli.UploadCertParams{
+			Domain:   domain,
+			CertFile: certFile,
+			KeyFile:  keyFile,
+			Stack:    session.Stack.Name,
+		})
+	},
+}
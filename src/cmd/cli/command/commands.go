@@ -27,6 +27,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/stacks"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/track"
+	"github.com/DefangLabs/defang/src/pkg/types"
 	"github.com/bufbuild/connect-go"
 	"github.com/spf13/cobra"
 )
@@ -49,7 +50,14 @@ func Execute(ctx context.Context) error {
 	if err := RootCmd.ExecuteContext(ctx); err != nil {
 		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
 			term.Error("Error:", client.PrettyError(err))
+			if requestId := client.RequestIDOf(err); requestId != "" {
+				term.Info("Request ID:", requestId)
+				printDefangHint("If you contact support, please include the request ID, or attach the file created by:", "support-bundle")
+			}
 			track.Evt("CLI Error", P("err", err))
+			if diagnostics != nil {
+				diagnostics.Error(client.PrettyError(err))
+			}
 		}
 
 		if err == dryrun.ErrDryRun {
@@ -58,9 +66,9 @@ func Execute(ctx context.Context) error {
 
 		if cerr := new(cli.ComposeError); errors.As(err, &cerr) {
 			compose := "compose"
-			fileFlag := RootCmd.Flag("file")
-			if fileFlag.Changed {
-				compose += " -f " + fileFlag.Value.String()
+			composeFiles, _ := RootCmd.PersistentFlags().GetStringArray("file")
+			for _, file := range composeFiles {
+				compose += " -f " + file
 			}
 			printDefangHint("Fix the error and try again. To validate the compose file, use:", compose+" config")
 		}
@@ -69,14 +77,13 @@ func Execute(ctx context.Context) error {
 			printDefangHint("To manage sensitive service config, use:", "config")
 		}
 
-		if cerr := new(cli.CancelError); errors.As(err, &cerr) {
-			printDefangHint("Detached. The deployment will keep running.\nTo continue the logs from where you left off, do:", cerr.Error())
-		}
-
 		code := connect.CodeOf(err)
 		if code == connect.CodeUnauthenticated {
 			printDefangHint("Please use the following command to log in:", "login")
 		}
+		if code == connect.CodeResourceExhausted {
+			printDefangHint("To see the limits of your plan and what is using them, do:", "quota")
+		}
 		if code == connect.CodeFailedPrecondition && (strings.Contains(err.Error(), "EULA") || strings.Contains(err.Error(), "terms")) {
 			printDefangHint("Please use the following command to see the Defang terms of service:", "terms")
 		}
@@ -96,6 +103,9 @@ func Execute(ctx context.Context) error {
 			term.Print("\nPlease log in by running: \n\n\t gcloud auth application-default login\n\n")
 		}
 
+		if ece := new(exitCodeError); errors.As(err, ece) {
+			return ece.code
+		}
 		return ExitCode(code)
 	}
 
@@ -148,6 +158,12 @@ func SetupCommands(version string) {
 	RootCmd.PersistentFlags().StringVar(&global.Cluster, "cluster", global.Cluster, "Defang cluster to connect to")
 	RootCmd.PersistentFlags().MarkHidden("cluster") // only for Defang use
 	RootCmd.PersistentFlags().Var(&global.Tenant, "workspace", "workspace to use")
+	RootCmd.PersistentFlags().Var(&global.Tenant, "tenant", "workspace to use (alias for --workspace)")
+	RootCmd.PersistentFlags().MarkHidden("tenant")
+	RootCmd.PersistentFlags().String("impersonate", "", "user ID to act as; only for Defang admins")
+	RootCmd.PersistentFlags().MarkHidden("impersonate") // only for Defang use
+	RootCmd.PersistentFlags().String("impersonate-reason", "", "reason for impersonating, eg. a support ticket; required with --impersonate")
+	RootCmd.PersistentFlags().MarkHidden("impersonate-reason") // only for Defang use
 	RootCmd.PersistentFlags().VarP(&global.Stack.Provider, "provider", "P", fmt.Sprintf(`bring-your-own-cloud provider; one of %v`, client.AllProviders()))
 	RootCmd.RegisterFlagCompletionFunc("provider", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		var completions []cobra.Completion
@@ -160,14 +176,22 @@ func SetupCommands(version string) {
 	RootCmd.Flags().MarkDeprecated("provider", "use '--stack' to select a stack instead")
 	RootCmd.PersistentFlags().BoolVarP(&global.Verbose, "verbose", "v", global.Verbose, "verbose logging") // backwards compat: only used by tail
 	RootCmd.PersistentFlags().BoolVar(&global.Debug, "debug", global.Debug, "debug logging for troubleshooting the CLI")
-	RootCmd.PersistentFlags().BoolVar(&dryrun.DoDryRun, "dry-run", false, "dry run (don't actually change anything)")
+	RootCmd.PersistentFlags().Var(&global.Diagnostics, "diagnostics", fmt.Sprintf(`also write warnings and errors to stderr as NDJSON records; one of %v`, allDiagnosticsFormats))
+	RootCmd.PersistentFlags().BoolVar(&global.DryRun, "dry-run", global.DryRun, "dry run (don't actually change anything)")
 	RootCmd.PersistentFlags().BoolVar(&global.NonInteractive, "non-interactive", global.NonInteractive, "disable interactive prompts / no TTY")
+	RootCmd.PersistentFlags().BoolVarP(&global.Yes, "yes", "y", global.Yes, `assume "yes" for all confirmation prompts`)
+	RootCmd.PersistentFlags().DurationVar(&global.RPCTimeout, "rpc-timeout", global.RPCTimeout, "deadline for calls to the Defang API; 0 means no deadline. Long-running calls, like deployments, have none")
 	RootCmd.PersistentFlags().StringP("project-name", "p", "", "project name")
 	RootCmd.PersistentFlags().StringP("cwd", "C", "", "change directory before running the command")
 	_ = RootCmd.MarkPersistentFlagDirname("cwd")
-	RootCmd.PersistentFlags().StringArrayP("file", "f", []string{}, `compose file path(s)`)
+	RootCmd.PersistentFlags().StringArrayP("file", "f", []string{}, `compose file path(s); repeat to merge override files in order`)
 	_ = RootCmd.MarkPersistentFlagFilename("file", "yml", "yaml")
-	RootCmd.PersistentFlags().BoolVarP(&global.Json, "json", "", global.Json, "show output in JSON format")
+	RootCmd.PersistentFlags().StringArray("env-file", []string{}, "env file(s) for interpolation of the compose file, instead of .env")
+	_ = RootCmd.MarkPersistentFlagFilename("env-file")
+	RootCmd.PersistentFlags().Bool("os-env", false, "interpolate the compose file with the host environment too")
+	RootCmd.PersistentFlags().Bool("no-interpolate", false, "don't interpolate variables in the compose file; leave them for resolution from config")
+	RootCmd.PersistentFlags().BoolVarP(&global.Json, "json", "", global.Json, "show output in JSON format; same as --output=json")
+	RootCmd.PersistentFlags().VarP(&global.Output, "output", "o", fmt.Sprintf("output format; one of %v", cli.AllOutputFormats))
 	RootCmd.PersistentFlags().BoolVarP(&global.Utc, "utc", "", global.Utc, "show timestamps in UTC timezone")
 
 	// CD command
@@ -217,6 +241,16 @@ func SetupCommands(version string) {
 	// Whoami Command
 	RootCmd.AddCommand(whoamiCmd)
 
+	// Quota Command
+	RootCmd.AddCommand(quotaCmd)
+
+	// Explain Command
+	RootCmd.AddCommand(explainCmd)
+
+	// Support Bundle Command
+	supportBundleCmd.Flags().String("archive", "", "path of the archive to create; defaults to defang-support-<timestamp>.zip")
+	RootCmd.AddCommand(supportBundleCmd)
+
 	// Workspace Command
 	RootCmd.AddCommand(workspaceCmd)
 
@@ -238,6 +272,60 @@ func SetupCommands(version string) {
 	psCommand.Aliases = []string{"getServices", "ps"}
 	RootCmd.AddCommand(psCommand)
 
+	// Service Discovery Command
+	RootCmd.AddCommand(dnsCmd)
+
+	// Curl Command
+	curlCmd.Flags().StringP("request", "X", "", "HTTP method to use; defaults to GET, or POST when --data is given")
+	curlCmd.Flags().StringP("data", "d", "", "request body to send")
+	curlCmd.Flags().StringArrayP("header", "H", nil, `extra header to send, like "Content-Type: application/json"`)
+	RootCmd.AddCommand(curlCmd)
+
+	// Inspect Command
+	RootCmd.AddCommand(inspectCmd)
+
+	// Environment Command
+	envCmd.AddCommand(envListCmd)
+	RootCmd.AddCommand(envCmd)
+
+	// Pause and Resume Commands
+	pauseCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	RootCmd.AddCommand(pauseCmd)
+	resumeCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	RootCmd.AddCommand(resumeCmd)
+
+	// Restart and Redeploy Commands
+	restartCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	RootCmd.AddCommand(restartCmd)
+	redeployCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	RootCmd.AddCommand(redeployCmd)
+
+	// Rollback Command
+	rollbackCmd.Flags().String("to", "", "deployment ID (etag) to roll back to; defaults to the previous successful deployment")
+	rollbackCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	RootCmd.AddCommand(rollbackCmd)
+
+	// Maintenance Command
+	RootCmd.AddCommand(makeMaintenanceCmd())
+
+	// Wait Command
+	waitCmd.Flags().String("for", "healthy", "state to wait for; healthy, paused, or a service state like BUILD_RUNNING")
+	waitCmd.Flags().Duration("timeout", 0, "maximum time to wait, like 5m; exits with code 124 on timeout")
+	RootCmd.AddCommand(waitCmd)
+
+	// Exec Command
+	execCmd.Flags().BoolP("stdin", "i", false, "pass stdin to the command")
+	execCmd.Flags().BoolP("tty", "t", false, "allocate a TTY for the command")
+	RootCmd.AddCommand(execCmd)
+
+	// Cp Command
+	RootCmd.AddCommand(cpCmd)
+
+	// Delete Command
+	deleteCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	deleteCmd.Flags().Bool("force", false, "delete without confirmation")
+	RootCmd.AddCommand(deleteCmd)
+
 	// Version Command
 	RootCmd.AddCommand(versionCmd)
 
@@ -248,10 +336,19 @@ func SetupCommands(version string) {
 	configSetCmd.Flags().Bool("random", false, "set a secure randomly generated value for config")
 	configSetCmd.Flags().Bool("if-not-set", false, "set the config if it is not already set")
 	configSetCmd.Flags().String("env-file", "", "load config values from an .env file")
+	configSetCmd.Flags().Bool("apply", false, "restart the deployed services that use the config")
+	configSetCmd.Flags().BoolP("detach", "d", false, "with --apply, don't wait for the services to restart")
 	configSetCmd.MarkFlagFilename("env-file")
 
 	configCmd.AddCommand(configSetCmd)
 
+	configImportCmd.Flags().String("env-file", "", "")
+	_ = configImportCmd.Flags().MarkHidden("env-file") // set from the FILE argument
+	configImportCmd.Flags().Bool("if-not-set", false, "only set the configs that are not already set")
+	configImportCmd.Flags().Bool("apply", false, "restart the deployed services that use the config")
+	configImportCmd.Flags().BoolP("detach", "d", false, "with --apply, don't wait for the services to restart")
+	configCmd.AddCommand(configImportCmd)
+
 	configDeleteCmd.Flags().BoolP("name", "n", false, "name of the config(s) (backwards compat)")
 	_ = configDeleteCmd.Flags().MarkHidden("name")
 	configCmd.AddCommand(configDeleteCmd)
@@ -296,6 +393,19 @@ func SetupCommands(version string) {
 	var deploymentsCmd = makeDeploymentsCmd("deployments")
 	RootCmd.AddCommand(deploymentsCmd)
 
+	// Projects Command
+	projectsCmd := makeProjectsCmd()
+	RootCmd.AddCommand(projectsCmd)
+
+	// Alerts Command
+	RootCmd.AddCommand(makeAlertsCmd())
+
+	// Metrics Command
+	RootCmd.AddCommand(makeMetricsCmd())
+
+	// Backup Command
+	RootCmd.AddCommand(makeBackupCmd())
+
 	// MCP Command
 	mcpServerCmd.Flags().Int("auth-server", 0, "auth server port")
 	mcpServerCmd.Flags().MarkDeprecated("auth-server", "we now reach out to the auth server: https://auth.defang.io directly")
@@ -311,11 +421,39 @@ func SetupCommands(version string) {
 	mcpCmd.AddCommand(mcpSetupCmd)
 	RootCmd.AddCommand(mcpCmd)
 
+	// LSP Command
+	RootCmd.AddCommand(lspCmd)
+
 	// Cert management
 	// TODO: Add list, renew etc.
+	dnsProvider := cli.DNSProviderNone
+	certGenerateCmd.Flags().Var(&dnsProvider, "dns-provider", fmt.Sprintf("create the CNAME record to the load balancer and allow Let's Encrypt in the CAA records automatically; one of %v", cli.AllDNSProviders))
 	certCmd.AddCommand(certGenerateCmd)
+	certCmd.AddCommand(certStatusCmd)
+	certUploadCmd.Flags().String("domain", "", "domain name of the certificate")
+	certUploadCmd.Flags().String("cert", "", "path to the PEM-encoded certificate (chain)")
+	certUploadCmd.Flags().String("key", "", "path to the PEM-encoded private key")
+	_ = certUploadCmd.MarkFlagRequired("domain")
+	_ = certUploadCmd.MarkFlagRequired("cert")
+	_ = certUploadCmd.MarkFlagRequired("key")
+	certUploadCmd.MarkFlagFilename("cert", "pem", "crt")
+	certUploadCmd.MarkFlagFilename("key", "pem", "key")
+	certCmd.AddCommand(certUploadCmd)
 	RootCmd.AddCommand(certCmd)
 
+	// Bucket management
+	bucketCmd.AddCommand(bucketListCmd)
+	RootCmd.AddCommand(bucketCmd)
+
+	// Image and build artifact management
+	imagesCmd.AddCommand(imagesListCmd)
+	RootCmd.AddCommand(imagesCmd)
+	buildsPruneCmd.Flags().Duration("older-than", 7*24*time.Hour, "only delete build contexts older than this")
+	buildsPruneCmd.Flags().Int("keep-last", 5, "number of most recent build contexts to keep")
+	buildsPruneCmd.Flags().Bool("images", false, "also prune the built images; not supported by any provider yet")
+	buildsCmd.AddCommand(buildsPruneCmd)
+	RootCmd.AddCommand(buildsCmd)
+
 	stackCmd := makeStackCmd()
 	RootCmd.AddCommand(stackCmd)
 
@@ -362,8 +500,21 @@ var RootCmd = &cobra.Command{
 			}
 			return nil
 		}
+		// The language server talks to the editor over stdio and only needs local files, so don't track/connect;
+		// same for explain, which must work offline
+		if isLspCommand(cmd) || cmd == explainCmd {
+			return nil
+		}
 		var utc, _ = cmd.Flags().GetBool("utc")
 		var json, _ = cmd.Flags().GetBool("json")
+		if cmd.Flags().Changed("output") {
+			json = global.Output == cli.OutputFormatJSON
+			global.Json = json
+		} else if json {
+			global.Output = cli.OutputFormatJSON
+		} else {
+			global.Output = cli.OutputFormatText
+		}
 
 		cli.SetUTCMode(utc)
 		cli.SetJSONMode(json)
@@ -371,12 +522,45 @@ var RootCmd = &cobra.Command{
 			global.Verbose = true
 		}
 
+		// An offline dry run only reads the local files, so CI can use it without credentials; don't track/connect
+		if offline, _ := cmd.Flags().GetBool("offline"); offline {
+			term.SetDebug(global.Debug)
+			term.SetJSON(json)
+			cmd.SetContext(cli.WithOptions(cmd.Context(), cli.Options{
+				DryRun:         true,
+				NonInteractive: true,
+				Term:           term.DefaultTerm,
+			}))
+			if err := checkProjectNameFlag(cmd); err != nil {
+				return err
+			}
+			if cwd, _ := cmd.Flags().GetString("cwd"); cwd != "" {
+				return os.Chdir(cwd)
+			}
+			return nil
+		}
+
+		// Fall back to the workspace selected with "defang workspace use"
+		if !global.Tenant.IsSet() {
+			global.Tenant = types.TenantNameOrID(client.GetDefaultWorkspace())
+		}
+
 		// Create a temporary gRPC client for tracking events before login
 		track.Tracker = cli.Connect(global.Cluster, global.Tenant)
 
 		ctx := cmd.Context()
 		term.SetDebug(global.Debug)
 
+		// Pass the global flags to the CLI package explicitly, instead of relying on its package globals
+		ctx = cli.WithOptions(ctx, cli.Options{
+			DryRun:         global.DryRun,
+			NonInteractive: global.NonInteractive,
+			Yes:            global.Yes,
+			Term:           term.DefaultTerm,
+		})
+		ctx = client.WithCallTimeout(ctx, global.RPCTimeout)
+		cmd.SetContext(ctx)
+
 		// Use "defer" to track any errors that occur during the command
 		defer func() {
 			track.Cmd(cmd, "Invoked", P("args", args), P("err", err), P("non-interactive", global.NonInteractive), P("provider", global.Stack.Provider))
@@ -399,12 +583,27 @@ var RootCmd = &cobra.Command{
 			}
 		}
 
+		composeFiles, _ := cmd.Flags().GetStringArray("file")
+		setupDiagnostics(composeFiles)
+
+		if err = checkProjectNameFlag(cmd); err != nil {
+			return err
+		}
+
+		if ctx, err = setupImpersonation(ctx, cmd); err != nil {
+			return err
+		}
+		cmd.SetContext(ctx)
+
 		global.Client, err = cli.ConnectWithTenant(ctx, global.Cluster, global.Tenant)
+		unauthenticated := err != nil
 		if err != nil {
 			if connect.CodeOf(err) != connect.CodeUnauthenticated {
 				return err
 			}
 			term.Debug("Using existing token failed; continuing to allow login/ToS flow:", err)
+		} else if err = verifyImpersonation(ctx); err != nil {
+			return err
 		}
 
 		track.Tracker = global.Client // update tracker with the real client
@@ -420,11 +619,11 @@ var RootCmd = &cobra.Command{
 
 		// Check if we are correctly logged in, but only if the command needs authorization
 		if when, ok := cmd.Annotations[authNeeded]; !ok {
-			return nil
+			return verifyImpersonationIf(ctx, unauthenticated)
 		} else if when == "playground" {
 			// Only need to be logged in for Playground, ie. no explicit BYOC provider (note that stack file hasn't been loaded yet)
 			if global.Stack.Provider != client.ProviderAuto && global.Stack.Provider != client.ProviderDefang {
-				return nil
+				return verifyImpersonationIf(ctx, unauthenticated)
 			}
 		}
 
@@ -433,8 +632,10 @@ var RootCmd = &cobra.Command{
 		} else {
 			global.Client, err = login.InteractiveRequireLoginAndToS(ctx, global.Client, global.Cluster)
 		}
-
-		return err
+		if err != nil {
+			return err
+		}
+		return verifyImpersonationIf(ctx, unauthenticated)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if global.NonInteractive {
@@ -459,13 +660,17 @@ var RootCmd = &cobra.Command{
 
 func configureLoader(cmd *cobra.Command) *compose.Loader {
 	loaderFlags := newSessionLoaderOptionsForCommand(cmd)
-	return compose.NewLoader(compose.WithProjectName(loaderFlags.ProjectName), compose.WithPath(loaderFlags.ComposeFilePaths...))
+	return compose.NewLoader(loaderFlags.LoaderOptions()...)
 }
 
 func isCompletionCommand(cmd *cobra.Command) bool {
 	return cmd.Name() == cobra.ShellCompRequestCmd || (cmd.Parent() != nil && cmd.Parent().Name() == "completion")
 }
 
+func isLspCommand(cmd *cobra.Command) bool {
+	return cmd == lspCmd
+}
+
 func isUpgradeCommand(cmd *cobra.Command) bool {
 	return cmd.Name() == "upgrade"
 }
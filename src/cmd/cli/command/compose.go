@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"math"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
@@ -19,30 +21,49 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
 	"github.com/DefangLabs/defang/src/pkg/logs"
 	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/progress"
 	"github.com/DefangLabs/defang/src/pkg/session"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/surveyor"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/timeutils"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
+	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
 const DEFANG_PORTAL_HOST = "portal.defang.io"
 const SERVICE_PORTAL_URL = "https://" + DEFANG_PORTAL_HOST + "/service"
 
-func printPlaygroundPortalServiceURLs(serviceInfos []*defangv1.ServiceInfo) {
+func printPlaygroundPortalServiceURLs(ctx context.Context, serviceInfos []*defangv1.ServiceInfo) {
+	t := cli.OptionsFromContext(ctx).Term
 	// We can only show services deployed to the prod1 defang SaaS environment.
 	if global.Stack.Provider == client.ProviderDefang && global.Cluster == client.DefaultCluster {
-		term.Info("Monitor your services' status in the defang portal")
+		t.Info("Monitor your services' status in the defang portal")
 		for _, serviceInfo := range serviceInfos {
-			term.Println("   -", SERVICE_PORTAL_URL+"/"+serviceInfo.Service.Name)
+			t.Println("   -", SERVICE_PORTAL_URL+"/"+serviceInfo.Service.Name)
 		}
 	}
 }
 
 var logType = logs.LogTypeAll
+var timestampFormat = logs.TimestampRFC3339
+var logLevel logs.LogLevel
+var logFormat logs.LogFormat
+
+// useStderrForOutput keeps stdout clean for a machine-readable document by sending all other output of the context's
+// logger and prompts to stderr. It returns the writer for the document.
+func useStderrForOutput(ctx context.Context) (context.Context, io.Writer) {
+	opts := cli.OptionsFromContext(ctx)
+	stdout := opts.Term.Stdout()
+	opts.Term = opts.Term.WithStdoutToStderr()
+	if _, ok := opts.Surveyor.(*surveyor.DefaultSurveyor); ok {
+		opts.Surveyor = nil // rebound to the stdio of the new term by WithOptions
+	}
+	return cli.WithOptions(ctx, opts), stdout
+}
 
 func makeComposeUpCmd() *cobra.Command {
 	composeUpCmd := &cobra.Command{
@@ -56,14 +77,65 @@ func makeComposeUpCmd() *cobra.Command {
 
 			var build, _ = cmd.Flags().GetBool("build")
 			var force, _ = cmd.Flags().GetBool("force")
+			var noBuild, _ = cmd.Flags().GetBool("no-build")
 			var detach, _ = cmd.Flags().GetBool("detach")
+			var wait, _ = cmd.Flags().GetBool("wait")
 			var waitTimeout, _ = cmd.Flags().GetInt("wait-timeout")
+			var spot, _ = cmd.Flags().GetBool("spot")
+			var parallel, _ = cmd.Flags().GetInt("parallel")
+			var continueOnError, _ = cmd.Flags().GetBool("continue-on-error")
+			var followSymlinks, _ = cmd.Flags().GetBool("follow-symlinks")
+			var removeOrphans, _ = cmd.Flags().GetBool("remove-orphans")
+			var confirm, _ = cmd.Flags().GetBool("confirm")
+			var quiet, _ = cmd.Flags().GetBool("quiet")
+			var maxContextSize, _ = cmd.Flags().GetString("max-context-size")
+			var buildLocal, _ = cmd.Flags().GetBool("build-local")
+			var registry, _ = cmd.Flags().GetString("registry")
+
+			var maxContextBytes int64
+			if maxContextSize != "" {
+				var err error
+				if maxContextBytes, err = units.RAMInBytes(maxContextSize); err != nil || maxContextBytes <= 0 {
+					return fmt.Errorf("invalid --max-context-size %q: expected a size like 50MiB", maxContextSize)
+				}
+			}
+
+			if buildLocal {
+				if registry == "" {
+					return errors.New("--build-local requires --registry, the repository to push the images to")
+				}
+				if err := compose.ValidateRegistry(registry); err != nil {
+					return err
+				}
+			} else if registry != "" {
+				return errors.New("--registry is only used with --build-local")
+			}
+
+			parallelism := parallel
+			switch {
+			case parallel == -1:
+				parallelism = math.MaxInt
+			case parallel <= 0:
+				return fmt.Errorf("invalid --parallel %d: expected a positive number, or -1 for unlimited", parallel)
+			}
+
+			outputFormat := global.Output
+			jsonOut := cli.OptionsFromContext(ctx).Term.Stdout()
+			if outputFormat == cli.OutputFormatJSON {
+				ctx, jsonOut = useStderrForOutput(ctx)
+				cmd.SetContext(ctx)
+			}
+			t := cli.OptionsFromContext(ctx).Term
+
+			if offline, _ := cmd.Flags().GetBool("offline"); offline {
+				return renderOffline(ctx, cmd, jsonOut, outputFormat, spot)
+			}
 
 			upload := compose.UploadModeDefault
-			if force {
+			if force || build {
 				upload = compose.UploadModeForce
-			} else if build {
-				upload = compose.UploadModeDigest
+			} else if noBuild {
+				upload = compose.UploadModeNoBuild
 			}
 
 			since := time.Now()
@@ -97,11 +169,11 @@ func makeComposeUpCmd() *cobra.Command {
 				Type:    defangv1.DeploymentType_DEPLOYMENT_TYPE_ACTIVE,
 				Stack:   session.Stack.Name,
 			}); err != nil {
-				term.Debugf("ListDeployments failed: %v", err)
+				t.Debugf("ListDeployments failed: %v", err)
 			} else if accountInfo, err := session.Provider.AccountInfo(ctx); err != nil {
-				term.Debugf("AccountInfo failed: %v", err)
+				t.Debugf("AccountInfo failed: %v", err)
 			} else if len(resp.Deployments) > 0 {
-				confirmed, err := confirmDeployment(session.Loader.TargetDirectory(ctx), resp.Deployments, accountInfo, session.Provider.GetStackName())
+				confirmed, err := confirmDeployment(ctx, session.Loader.TargetDirectory(ctx), resp.Deployments, accountInfo, session.Provider.GetStackName())
 				if err != nil {
 					return err
 				}
@@ -116,27 +188,54 @@ func makeComposeUpCmd() *cobra.Command {
 					Mode:     session.Stack.Mode,
 				})
 				if err != nil {
-					term.Debug("Failed to create stack:", err)
+					t.Debug("Failed to create stack:", err)
 				}
 			}
 
 			// Show a warning for any (managed) services that we cannot monitor
-			var managedServices []string
+			var managedServices, monitoredServices []string
 			for _, service := range project.Services {
 				if !cli.CanMonitorService(&service) {
 					managedServices = append(managedServices, service.Name)
+				} else {
+					monitoredServices = append(monitoredServices, service.Name)
 				}
 			}
 			if len(managedServices) > 0 {
-				term.Warnf("Defang cannot monitor status of the following managed service(s): %v.\n   To check if the managed service is up, check the status of the service which depends on it.", managedServices)
+				t.Warnf("Defang cannot monitor status of the following managed service(s): %v.\n   To check if the managed service is up, check the status of the service which depends on it.", managedServices)
+			}
+
+			// Show the phase of each service: updated in place on a terminal, or one line per transition in CI logs
+			inPlace := t.IsTerminal() && t.StdoutCanColor() && outputFormat == cli.OutputFormatText
+			display := progress.NewDisplay(t, monitoredServices, inPlace)
+			defer display.Close()
+			// Don't report a service as healthy before the services it depends on with condition service_healthy
+			dependencies := compose.HealthyDependencies(project.Services)
+			for service, deps := range dependencies {
+				dependencies[service] = slices.DeleteFunc(deps, func(dep string) bool { return !slices.Contains(monitoredServices, dep) })
 			}
+			ctx = progress.WithReporter(ctx, progress.WithDependencies(display, dependencies))
 
 			deploy, project, err := cli.ComposeUp(ctx, global.Client, session.Provider, session.Stack, cli.ComposeUpParams{
-				Project:    project,
-				UploadMode: upload,
-				Mode:       session.Stack.Mode,
+				Project:       project,
+				UploadMode:    upload,
+				Mode:          session.Stack.Mode,
+				Spot:          spot,
+				RemoveOrphans: removeOrphans,
+				Confirm:       confirm,
+				Upload: compose.UploadOptions{
+					Parallelism:     parallelism,
+					ContinueOnError: continueOnError,
+					FollowSymlinks:  followSymlinks,
+					MaxContextSize:  maxContextBytes,
+					Registry:        registry,
+				},
 			})
 			if err != nil {
+				if ierr := new(cli.InterruptedError); errors.As(err, &ierr) {
+					printInterruptedHint(ctx, ierr.ProjectName, "", ierr.MaybeSubmitted)
+					return err
+				}
 				composeErr := err
 				debugger, err := debug.NewDebugger(ctx, global.Cluster, session.Stack)
 				if err != nil {
@@ -149,27 +248,70 @@ func makeComposeUpCmd() *cobra.Command {
 				return errors.New("no services being deployed")
 			}
 
-			printPlaygroundPortalServiceURLs(deploy.Services)
+			printPlaygroundPortalServiceURLs(ctx, deploy.Services)
 
-			if detach {
-				term.Info("Detached.")
+			if detach && !wait {
+				t.Info("Detached.")
+				if outputFormat == cli.OutputFormatJSON {
+					return cli.PrintDeploymentOutput(jsonOut, cli.NewDeploymentOutput(project, session.Stack.Name, deploy.Etag, deploy.Services))
+				}
 				return nil
 			}
 
-			// show users the current streaming logs
-			tailSource := "all services"
-			if deploy.Etag != "" {
-				tailSource = "deployment ID " + deploy.Etag
-			}
-			term.Info("Tailing logs for", tailSource, "; press Ctrl+C to detach:")
+			var serviceStates cli.ServiceStates
+			if wait {
+				t.Info("Waiting for deployment", deploy.Etag, "to finish; press Ctrl+C to detach:")
+				display.SetInPlace(inPlace && quiet) // the build output would get overwritten
+				buildCtx, cancelBuild := context.WithCancel(ctx)
+				buildDone := make(chan struct{})
+				buildLogs := &cli.BuildLogs{Quiet: quiet}
+				go func() {
+					defer close(buildDone)
+					if err := buildLogs.Stream(buildCtx, session.Provider, project.Name, deploy.Etag); err != nil && buildCtx.Err() == nil {
+						t.Debug("Failed to stream build logs:", err)
+					}
+				}()
+				serviceStates, err = cli.WaitForDeployment(ctx, project, session.Provider, deploy.Etag, time.Duration(waitTimeout)*time.Second)
+				var failed client.ErrDeploymentFailed
+				if errors.As(err, &failed) && serviceStates[failed.Service] == defangv1.ServiceState_BUILD_FAILED {
+					display.SetInPlace(false) // the build output would get overwritten
+					buildLogs.BuildFailed(ctx, failed.Service)
+					pkg.SleepWithContext(ctx, 2*time.Second) // let the last lines of the build come in
+				}
+				cancelBuild()
+				<-buildDone
+			} else {
+				// show users the current streaming logs
+				tailSource := "all services"
+				if deploy.Etag != "" {
+					tailSource = "deployment ID " + deploy.Etag
+				}
+				t.Info("Tailing logs for", tailSource, "; press Ctrl+C to detach:")
+				display.SetInPlace(false) // the logs would get overwritten
 
-			tailOptions := newTailOptionsForDeploy(session.Stack.Name, deploy.Etag, since, global.Verbose)
-			serviceStates, err := cli.TailAndMonitor(ctx, project, session.Provider, time.Duration(waitTimeout)*time.Second, tailOptions)
+				tailOptions := newTailOptionsForDeploy(session.Stack.Name, deploy.Etag, since, global.Verbose)
+				tailOptions.Builds = !quiet
+				serviceStates, err = cli.TailAndMonitor(ctx, project, session.Provider, time.Duration(waitTimeout)*time.Second, tailOptions)
+			}
+			display.Close()
 			if err != nil {
+				if ctx.Err() != nil {
+					// The deployment was submitted before the user pressed Ctrl+C, so it keeps running
+					printInterruptedHint(ctx, project.Name, deploy.Etag, true)
+					printLogsInterruptedHint(err)
+					return err
+				}
 				deploymentErr := err
+				if wait {
+					if errors.Is(deploymentErr, context.DeadlineExceeded) {
+						t.Warn(deploymentErr) // timeouts are not printed as errors
+					}
+					// Same exit codes as "defang wait", for CI scripts
+					deploymentErr = withWaitExitCode(deploymentErr)
+				}
 				debugger, err := debug.NewDebugger(ctx, global.Cluster, session.Stack)
 				if err != nil {
-					term.Warn("Failed to initialize debugger:", err)
+					t.Warn("Failed to initialize debugger:", err)
 					return deploymentErr
 				}
 				handleTailAndMonitorErr(ctx, deploymentErr, debugger, debug.DebugConfig{
@@ -193,13 +335,21 @@ func makeComposeUpCmd() *cobra.Command {
 			}
 
 			// Print the current service states of the deployment
-			err = cli.PrintServiceStatesAndEndpoints(services)
+			err = cli.PrintServiceStatesAndEndpoints(ctx, services)
 			if err != nil {
 				return err
 			}
 
-			term.Info("Done.")
-			flushWarnings()
+			// Print the names other services can use to reach each service
+			if err := cli.PrintServiceDNS(ctx, deploy.Services); err != nil {
+				return err
+			}
+
+			t.Info("Done.")
+			flushWarnings(ctx)
+			if outputFormat == cli.OutputFormatJSON {
+				return cli.PrintDeploymentOutput(jsonOut, cli.NewDeploymentOutput(project, session.Stack.Name, deploy.Etag, deploy.Services))
+			}
 			return nil
 		},
 	}
@@ -208,14 +358,49 @@ func makeComposeUpCmd() *cobra.Command {
 	composeUpCmd.Flags().Bool("tail", false, "tail the service logs after updating") // no-op, but keep for backwards compatibility
 	_ = composeUpCmd.Flags().MarkHidden("tail")
 	composeUpCmd.Flags().VarP(&global.Stack.Mode, "mode", "m", fmt.Sprintf("deployment mode; one of %v", modes.AllDeploymentModes()))
-	composeUpCmd.Flags().Bool("build", false, "build images before starting services") // docker-compose compatibility
-	composeUpCmd.Flags().Bool("wait", true, "wait for services to be running|healthy") // docker-compose compatibility
-	_ = composeUpCmd.Flags().MarkHidden("wait")
-	composeUpCmd.Flags().Int("wait-timeout", -1, "maximum duration to wait for the project to be running|healthy") // docker-compose compatibility
+	composeUpCmd.Flags().Bool("build", false, "build images before starting services; always rebuilds, even if the build context is unchanged, like --force") // docker-compose compatibility
+	composeUpCmd.Flags().Bool("no-build", false, "don't build images; every service must specify an image")                                                   // docker-compose compatibility
+	composeUpCmd.MarkFlagsMutuallyExclusive("build", "no-build")
+	composeUpCmd.MarkFlagsMutuallyExclusive("force", "no-build")
+	composeUpCmd.Flags().Bool("wait", false, "wait for services to be running|healthy instead of tailing the logs; takes precedence over --detach")            // docker-compose compatibility
+	composeUpCmd.Flags().Int("wait-timeout", -1, "maximum seconds to wait for the project to be running|healthy; with --wait, exits with code 124 on timeout") // docker-compose compatibility
+	composeUpCmd.Flags().Bool("spot", false, "use spot/preemptible capacity for services that can tolerate interruptions")
+	composeUpCmd.Flags().Int("parallel", compose.DefaultUploadOptions().Parallelism, "maximum number of build contexts to upload concurrently, -1 for unlimited")
+	composeUpCmd.Flags().Bool("remove-orphans", false, "remove deployed services that are no longer in the Compose file") // docker-compose compatibility
+	composeUpCmd.Flags().String("max-context-size", "", fmt.Sprintf("maximum compressed size of a build context, eg. 50MiB; limited by your plan (default %s)", units.BytesSize(float64(compose.ContextSizeHardLimit))))
+	composeUpCmd.Flags().Bool("follow-symlinks", false, "copy the files that symlinks outside of a build context point to, instead of failing")
+	composeUpCmd.Flags().Bool("continue-on-error", false, "when a build context fails to upload, deploy the other services and keep the deployed version of the failed ones")
+	composeUpCmd.Flags().Bool("build-local", false, "build the images with the local Docker daemon and push them to --registry, instead of uploading the build contexts")
+	composeUpCmd.Flags().String("registry", "", "repository to push the images built with --build-local to, eg. ghcr.io/org/app; the deployment must be able to pull from it")
+	composeUpCmd.Flags().BoolP("quiet", "q", false, "hide the build output, unless the build of a service fails")
+	composeUpCmd.Flags().Bool("confirm", false, "show the changes to the deployed project and ask before deploying; see also compose plan")
+	composeUpCmd.Flags().Bool("offline", false, "validate and print the deployment without connecting to Defang or the cloud provider; implies --dry-run")
 	return composeUpCmd
 }
 
-func confirmDeployment(targetDirectory string, existingDeployments []*defangv1.Deployment, accountInfo *client.AccountInfo, stackName string) (bool, error) {
+// renderOffline prints the deployment that "compose up" would submit, using only the local files and flags.
+func renderOffline(ctx context.Context, cmd *cobra.Command, out io.Writer, outputFormat cli.OutputFormat, spot bool) error {
+	project, err := configureLoader(cmd).LoadProject(ctx)
+	if err != nil {
+		return err // not handleInvalidComposeFileErr, which needs the network
+	}
+	// The provider is only used to name the services; the fabric client is never connected
+	provider := cli.NewOfflineProvider(cli.NewProvider(ctx, global.Stack.Provider, &client.GrpcClient{}, global.Stack.Name))
+	req, err := cli.RenderDeployment(ctx, provider, &global.Stack, project, global.Stack.Mode, spot)
+	if err != nil {
+		return err
+	}
+	format := cli.ConfigFormatYAML
+	if outputFormat == cli.OutputFormatJSON {
+		format = cli.ConfigFormatJSON
+	}
+	if err := cli.PrintDeployRequest(out, req, format); err != nil {
+		return err
+	}
+	return dryrun.ErrDryRun
+}
+
+func confirmDeployment(ctx context.Context, targetDirectory string, existingDeployments []*defangv1.Deployment, accountInfo *client.AccountInfo, stackName string) (bool, error) {
 	samePlace := slices.ContainsFunc(existingDeployments, func(dep *defangv1.Deployment) bool {
 		if dep.Provider != accountInfo.Provider.Value() {
 			return false
@@ -226,16 +411,14 @@ func confirmDeployment(targetDirectory string, existingDeployments []*defangv1.D
 	if samePlace {
 		return true, nil
 	}
-	printExistingDeployments(existingDeployments)
+	printExistingDeployments(ctx, existingDeployments)
 	if global.NonInteractive {
 		return true, nil
 	}
-	confirmed, err := confirmDeploymentToNewLocation()
-	if err != nil {
-		return false, err
-	}
-	if !confirmed {
+	if err := cli.Confirm(ctx, cli.ConfirmPrompt{Message: "Are you sure you want to continue?"}); errors.Is(err, cli.ErrCanceled) {
 		return false, nil
+	} else if err != nil {
+		return false, err
 	}
 	if stackName == "" {
 		stackName = stacks.DefaultBeta
@@ -246,7 +429,7 @@ func confirmDeployment(targetDirectory string, existingDeployments []*defangv1.D
 			Mode:     global.Stack.Mode,
 		})
 		if err != nil {
-			term.Debugf("Failed to create stack %v", err)
+			cli.OptionsFromContext(ctx).Term.Debugf("Failed to create stack %v", err)
 		} else {
 			stacks.PrintCreateMessage(stackName)
 		}
@@ -254,8 +437,9 @@ func confirmDeployment(targetDirectory string, existingDeployments []*defangv1.D
 	return true, nil
 }
 
-func printExistingDeployments(existingDeployments []*defangv1.Deployment) {
-	term.Info("This project was previously deployed to the following locations:")
+func printExistingDeployments(ctx context.Context, existingDeployments []*defangv1.Deployment) {
+	t := cli.OptionsFromContext(ctx).Term
+	t.Info("This project was previously deployed to the following locations:")
 	deploymentStrings := make([]string, 0, len(existingDeployments))
 	for _, dep := range existingDeployments {
 		var providerId client.ProviderID
@@ -265,25 +449,12 @@ func printExistingDeployments(existingDeployments []*defangv1.Deployment) {
 	// sort and remove duplicates
 	slices.Sort(deploymentStrings)
 	deploymentStrings = slices.Compact(deploymentStrings)
-	term.Println(strings.Join(deploymentStrings, "\n"))
-}
-
-func confirmDeploymentToNewLocation() (bool, error) {
-	var confirm bool
-	if err := survey.AskOne(&survey.Confirm{
-		Message: "Are you sure you want to continue?",
-		Default: false,
-	}, &confirm, survey.WithStdio(term.DefaultTerm.Stdio())); err != nil {
-		return false, err
-	} else if !confirm {
-		return false, nil
-	}
-	return true, nil
+	t.Println(strings.Join(deploymentStrings, "\n"))
 }
 
 func promptToCreateStack(ctx context.Context, targetDirectory string, params stacks.Parameters) error {
 	if global.NonInteractive {
-		term.Info("Consider creating a stack to manage your deployments.")
+		cli.OptionsFromContext(ctx).Term.Info("Consider creating a stack to manage your deployments.")
 		printDefangHint("To create a stack, do:", "stack new --name="+params.Name)
 		return nil
 	}
@@ -310,7 +481,7 @@ func handleComposeUpErr(ctx context.Context, debugger *debug.Debugger, project *
 	}
 
 	if connect.CodeOf(originalErr) == connect.CodeResourceExhausted && strings.Contains(originalErr.Error(), "maximum number of projects") {
-		term.Error("Error:", client.PrettyError(originalErr))
+		cli.OptionsFromContext(ctx).Term.Error("Error:", client.PrettyError(originalErr))
 		err := handleTooManyProjectsError(ctx, provider, originalErr)
 		if err != nil {
 			return originalErr
@@ -318,20 +489,54 @@ func handleComposeUpErr(ctx context.Context, debugger *debug.Debugger, project *
 		return nil
 	}
 
-	if global.NonInteractive || errors.Is(originalErr, byoc.ErrLocalPulumiStopped) {
+	if global.NonInteractive || errors.Is(originalErr, byoc.ErrLocalPulumiStopped) || errors.Is(originalErr, cli.ErrCanceled) {
 		return originalErr
 	}
 
-	term.Error("Error:", client.PrettyError(originalErr))
+	cli.OptionsFromContext(ctx).Term.Error("Error:", client.PrettyError(originalErr))
 	return debugger.DebugDeploymentError(ctx, debug.DebugConfig{
 		Project: project,
 	}, originalErr)
 }
 
+// printInterruptedHint tells the user what, if anything, was deployed before the command was interrupted,
+// and how to undo it.
+func printInterruptedHint(ctx context.Context, projectName, etag string, submitted bool) {
+	t := cli.OptionsFromContext(ctx).Term
+	if !submitted {
+		t.Info("Interrupted; nothing was deployed.")
+		return
+	}
+	if etag != "" {
+		t.Infof("Interrupted; deployment %s of project %q was already submitted.", etag, projectName)
+	} else {
+		t.Infof("Interrupted while submitting the deployment of project %q; it may still go ahead.", projectName)
+		printDefangHint("To check, do:", "deployments --project-name="+projectName)
+	}
+	printDefangHint("To roll back to the previous deployment, do:", "rollback --project-name="+projectName)
+}
+
+// printDetachedHint tells the user that the deployment keeps running after Ctrl+C, and how to continue its logs: from
+// where they left off if err is a cli.CancelError, or else with the given command.
+func printDetachedHint(err error, tailCommand string) {
+	if cerr := new(cli.CancelError); errors.As(err, &cerr) {
+		tailCommand = cerr.Error()
+	}
+	printDefangHint("Detached. The deployment will keep running.\nTo continue the logs from where you left off, do:", tailCommand)
+}
+
+// printLogsInterruptedHint tells the user how to continue the logs from where they left off, if err is
+// a cli.CancelError.
+func printLogsInterruptedHint(err error) {
+	if cerr := new(cli.CancelError); errors.As(err, &cerr) {
+		printDefangHint("Interrupted. To continue the logs from where you left off, do:", cerr.Error())
+	}
+}
+
 func handleTooManyProjectsError(ctx context.Context, provider client.Provider, originalErr error) error {
 	projectName, err := provider.RemoteProjectName(ctx)
 	if err != nil {
-		term.Warn("failed to get remote project name:", err)
+		cli.OptionsFromContext(ctx).Term.Warn("failed to get remote project name:", err)
 		return originalErr
 	}
 
@@ -343,7 +548,7 @@ func handleTooManyProjectsError(ctx context.Context, provider client.Provider, o
 
 	_, err = cli.InteractiveComposeDown(ctx, projectName, global.Client, provider)
 	if err != nil {
-		term.Warn("ComposeDown failed:", err)
+		cli.OptionsFromContext(ctx).Term.Warn("ComposeDown failed:", err)
 		printDefangHint("To deactivate a project, do:", "compose down --project-name "+projectName)
 		return originalErr
 	} else {
@@ -358,7 +563,7 @@ func handleTailAndMonitorErr(ctx context.Context, err error, debugger *debug.Deb
 	var errDeploymentFailed client.ErrDeploymentFailed
 	if errors.As(err, &errDeploymentFailed) {
 		// Tail got canceled because of deployment failure: prompt to show the debugger
-		term.Warn(errDeploymentFailed)
+		cli.OptionsFromContext(ctx).Term.Warn(errDeploymentFailed)
 		if errDeploymentFailed.Service != "" {
 			debugConfig.FailedServices = []string{errDeploymentFailed.Service}
 		}
@@ -399,23 +604,81 @@ func newTailOptionsForDeploy(stack, deployment string, since time.Time, verbose
 	}
 }
 
-func flushWarnings() {
-	if global.HasTty && term.HadWarnings() && !global.Json {
-		term.Println("\n\u26A0\uFE0F Some warnings were seen during this command:")
-		term.FlushWarnings()
+func flushWarnings(ctx context.Context) {
+	t := cli.OptionsFromContext(ctx).Term
+	if global.HasTty && t.HadWarnings() && !global.Json {
+		t.Println("\n\u26A0\uFE0F Some warnings were seen during this command:")
+		t.FlushWarnings()
 	}
 }
 
+func makeComposePlanCmd() *cobra.Command {
+	composePlanCmd := &cobra.Command{
+		Use:         "plan",
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "Show what compose up would change in the deployed project, without deploying",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var spot, _ = cmd.Flags().GetBool("spot")
+			var removeOrphans, _ = cmd.Flags().GetBool("remove-orphans")
+
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+
+			project, err := session.Loader.LoadProject(ctx)
+			if err != nil {
+				return handleInvalidComposeFileErr(ctx, err)
+			}
+
+			plan, err := cli.ComposePlan(ctx, session.Provider, session.Stack, cli.ComposeUpParams{
+				Project:       project,
+				Mode:          session.Stack.Mode,
+				Spot:          spot,
+				RemoveOrphans: removeOrphans,
+			})
+			if err != nil {
+				return err
+			}
+			return cli.PrintDeploymentPlan(ctx, cli.OptionsFromContext(ctx).Term.Stdout(), *plan, global.Output)
+		},
+	}
+	composePlanCmd.Flags().VarP(&global.Stack.Mode, "mode", "m", fmt.Sprintf("deployment mode; one of %v", modes.AllDeploymentModes()))
+	composePlanCmd.Flags().Bool("spot", false, "use spot/preemptible capacity for services that can tolerate interruptions")
+	composePlanCmd.Flags().Bool("remove-orphans", false, "remove deployed services that are no longer in the Compose file")
+	return composePlanCmd
+}
+
 func makeComposeDownCmd() *cobra.Command {
 	composeDownCmd := &cobra.Command{
-		Use:         "down",
+		Use:         "down [SERVICE...]",
 		Aliases:     []string{"rm", "remove"}, // like docker stack
 		Annotations: authNeededAlways,
-		Args:        cobra.NoArgs, // TODO: optional list of service names to remove select services
-		Short:       "Reads a Compose file and deprovisions its services",
+		Args:        cobra.ArbitraryArgs,
+		Short:       "Reads a Compose file and deprovisions its services, or only the given services",
+		Long: `Reads a Compose file and deprovisions its services, or only the given services.
+
+With --remove-orphans, the deployed services that are no longer in the Compose file are deleted too, and with
+--volumes, the volumes of the deleted services. DNS records that were created outside of Defang, like the CNAME of
+a custom domain at your DNS provider, are not deleted; remove them there.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return deleteServices(cmd, args)
+			}
+
 			var detach, _ = cmd.Flags().GetBool("detach")
 
+			ctx := cmd.Context()
+			jsonOut := cli.OptionsFromContext(ctx).Term.Stdout()
+			if global.Output == cli.OutputFormatJSON {
+				ctx, jsonOut = useStderrForOutput(ctx)
+				cmd.SetContext(ctx)
+			}
+			t := cli.OptionsFromContext(ctx).Term
+
 			session, err := newCommandSession(cmd)
 			if err != nil {
 				return err
@@ -431,60 +694,93 @@ func makeComposeDownCmd() *cobra.Command {
 				return err
 			}
 
+			if removeVolumes, _ := cmd.Flags().GetBool("volumes"); !removeVolumes {
+				warnProjectVolumes(cmd.Context(), session.Provider, projectName)
+			}
+
 			since := time.Now()
 			deployment, err := cli.ComposeDown(cmd.Context(), projectName, global.Client, session.Provider)
 			if err != nil {
 				if connect.CodeOf(err) == connect.CodeNotFound {
 					// Show a warning (not an error) if the service was not found
-					term.Warn(client.PrettyError(err))
+					t.Warn(client.PrettyError(err))
 					return nil
 				}
 				return err
 			}
 
-			term.Info("Deleted services, deployment ID", deployment)
+			t.Info("Deleted services, deployment ID", deployment)
 
 			listConfigs, err := session.Provider.ListConfig(cmd.Context(), &defangv1.ListConfigsRequest{Project: projectName})
 			if err == nil {
 				if len(listConfigs.Names) > 0 {
-					term.Warn("Stored project configs are not deleted.")
+					t.Warn("Stored project configs are not deleted.")
 				}
 			} else {
-				term.Debugf("ListConfigs failed: %v", err)
+				t.Debugf("ListConfigs failed: %v", err)
 			}
 
 			if detach {
 				printDefangHint("To track the update, do:", "tail --project-name="+projectName+" --deployment="+deployment)
-				return nil
+				return printDownOutput(jsonOut, projectName, session.Stack.Name, deployment)
 			}
 
 			tailOptions := newTailOptionsForDown(session.Stack.Name, deployment, since)
 			tailCtx := cmd.Context() // FIXME: stop Tail when the deployment task is done
 			err = cli.TailAndWaitForCD(tailCtx, session.Provider, projectName, tailOptions)
+			if tailCtx.Err() != nil {
+				// The removal was submitted before the user pressed Ctrl+C, so it keeps running
+				printDetachedHint(err, "tail --project-name="+projectName+" --deployment="+deployment)
+				return err
+			}
 			if err != nil && !errors.Is(err, io.EOF) {
 				if connect.CodeOf(err) == connect.CodePermissionDenied {
 					// If tail fails because of missing permission, we show a warning and detach. This is
 					// different than `up`, which will wait for the deployment to finish, but we don't have an
 					// ECS event subscription for `down` so we can't wait for the deployment to finish.
 					// Instead, we'll just show a warning and detach.
-					term.Warn("Unable to tail logs. Detaching.")
+					t.Warn("Unable to tail logs. Detaching.")
 					return nil
 				}
 				return err
 			}
-			term.Info("Done.")
+			t.Info("Done.")
 			if len(listConfigs.Names) > 0 {
 				printDefangHint("To delete stored project configs, run:", "config rm --project-name="+projectName+" "+strings.Join(listConfigs.Names, " "))
 			}
-			return nil
+			return printDownOutput(jsonOut, projectName, session.Stack.Name, deployment)
 		},
 	}
 	composeDownCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	composeDownCmd.Flags().Bool("force", false, "delete the given services without confirmation")
 	composeDownCmd.Flags().Bool("tail", false, "tail the service logs after deleting") // no-op, but keep for backwards compatibility
 	_ = composeDownCmd.Flags().MarkHidden("tail")
+	composeDownCmd.Flags().Bool("remove-orphans", false, "also delete deployed services that are no longer in the Compose file") // docker-compose compatibility
+	composeDownCmd.Flags().Bool("volumes", false, "also delete the volumes of the deleted services")                             // docker-compose compatibility
 	return composeDownCmd
 }
 
+// printDownOutput prints the summary of deleting services, if the output format is JSON.
+func printDownOutput(w io.Writer, projectName, stack string, etag types.ETag) error {
+	if global.Output != cli.OutputFormatJSON {
+		return nil
+	}
+	return cli.PrintDeploymentOutput(w, cli.DeploymentOutput{Project: projectName, Stack: stack, Etag: etag, Services: []cli.ServiceOutput{}})
+}
+
+// warnProjectVolumes warns that taking down the whole project also deletes its volumes, which "docker compose down"
+// only does with --volumes.
+func warnProjectVolumes(ctx context.Context, provider client.Provider, projectName string) {
+	project, err := cli.LoadDeployedProject(ctx, provider, projectName)
+	if err != nil {
+		cli.OptionsFromContext(ctx).Term.Debugf("Failed to load the deployed project: %v", err)
+		return
+	}
+	if len(project.Volumes) > 0 {
+		cli.OptionsFromContext(ctx).Term.Warnf("The volume(s) %s are deleted along with the project", strings.Join(slices.Sorted(maps.Keys(project.Volumes)), ", "))
+	}
+}
+
 func newTailOptionsForDown(stack, deployment string, since time.Time) cli.TailOptions {
 	return cli.TailOptions{
 		Stack:      stack,
@@ -507,18 +803,29 @@ func newTailOptionsForDown(stack, deployment string, since time.Time) cli.TailOp
 }
 
 func makeComposeConfigCmd() *cobra.Command {
-	return &cobra.Command{
+	var format = cli.ConfigFormatYAML
+	configCmd := &cobra.Command{
 		Use:   "config",
 		Args:  cobra.NoArgs, // TODO: takes optional list of service names
-		Short: "Reads a Compose file and shows the generated config",
+		Short: "Reads a Compose file and shows the normalized project, as it would be deployed",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
+			if global.Output == cli.OutputFormatJSON && !cmd.Flags().Changed("format") {
+				format = cli.ConfigFormatJSON
+			}
+			out := cli.OptionsFromContext(ctx).Term.Stdout()
+			if format == cli.ConfigFormatJSON {
+				ctx, out = useStderrForOutput(ctx)
+				cmd.SetContext(ctx)
+			}
+			t := cli.OptionsFromContext(ctx).Term
+
 			sessionx, err := newCommandSessionWithOpts(cmd, commandSessionOpts{
 				CheckAccountInfo: false,
 			})
 			if err != nil {
-				term.Warn("unable to load stack:", err, "- some information may not be up-to-date")
+				t.Warn("unable to load stack:", err, "- some information may not be up-to-date")
 				sessionx = &session.Session{
 					Loader:   configureLoader(cmd),
 					Provider: client.NewPlaygroundProvider(global.Client, stacks.DefaultBeta),
@@ -528,7 +835,7 @@ func makeComposeConfigCmd() *cobra.Command {
 
 			_, err = sessionx.Provider.AccountInfo(ctx)
 			if err != nil {
-				term.Warn("unable to connect to cloud provider:", err, "- some information may not be up-to-date")
+				t.Warn("unable to connect to cloud provider:", err, "- some information may not be up-to-date")
 			}
 
 			project, loadErr := sessionx.Loader.LoadProject(ctx)
@@ -537,9 +844,11 @@ func makeComposeConfigCmd() *cobra.Command {
 			}
 
 			_, _, err = cli.ComposeUp(ctx, global.Client, sessionx.Provider, sessionx.Stack, cli.ComposeUpParams{
-				Project:    project,
-				UploadMode: compose.UploadModeIgnore,
-				Mode:       modes.ModeUnspecified,
+				Project:      project,
+				UploadMode:   compose.UploadModeIgnore,
+				Mode:         modes.ModeUnspecified,
+				ConfigFormat: format,
+				ConfigOut:    out,
 			})
 			if !errors.Is(err, dryrun.ErrDryRun) {
 				return err
@@ -547,6 +856,31 @@ func makeComposeConfigCmd() *cobra.Command {
 			return nil
 		},
 	}
+	configCmd.Flags().Var(&format, "format", fmt.Sprintf("format of the project; one of %v", cli.AllConfigFormats)) // docker-compose compatibility
+	return configCmd
+}
+
+func makeComposeContextCmd() *cobra.Command {
+	contextCmd := &cobra.Command{
+		Use:   "context",
+		Args:  cobra.NoArgs,
+		Short: "Inspect the build contexts of the services",
+	}
+	contextCmd.AddCommand(&cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Args:    cobra.NoArgs,
+		Short:   "List the files that would be uploaded as the build context of each service",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			project, err := configureLoader(cmd).LoadProject(ctx)
+			if err != nil {
+				return handleInvalidComposeFileErr(ctx, err)
+			}
+			return cli.PrintBuildContextFiles(ctx, project)
+		},
+	})
+	return contextCmd
 }
 
 func makeComposePsCmd() *cobra.Command {
@@ -602,6 +936,7 @@ func makeLogsCmd() *cobra.Command {
 	}
 	setupLogsFlags(logsCmd)
 	logsCmd.Flags().Int32("limit", 100, "maximum number of log lines to show")
+	logsCmd.Flags().Int32("tail", 0, "number of log lines to show from the end of the logs, or of the --since/--until window")
 	return logsCmd
 }
 
@@ -622,13 +957,22 @@ func setupLogsFlags(cmd *cobra.Command) {
 	cmd.Flags().MarkHidden("name")
 	cmd.Flags().String("etag", "", "deployment ID (ETag) of the service")
 	cmd.Flags().MarkDeprecated("etag", "superseded by --deployment") // but keep for backwards compatibility
-	cmd.Flags().String("deployment", "", "deployment ID of the service (use 'latest' for the most recent deployment)")
+	cmd.Flags().String("deployment", "", "only show build and runtime logs of the given deployment ID (use 'latest' for the most recent deployment)")
+	cmd.Flags().Bool("current", false, "only show build and runtime logs of the current deployment; same as --deployment=latest")
 	cmd.Flags().Bool("follow", false, "follow log output; incompatible with --until") // NOTE: -f is already used by --file
 	cmd.Flags().BoolP("raw", "r", false, "show raw (unparsed) logs")
 	cmd.Flags().String("since", "", "show logs since duration or timestamp (unix or RFC3339)")
 	cmd.Flags().String("until", "", "show logs until duration or timestamp (unix or RFC3339); incompatible with --follow")
 	cmd.Flags().Var(&logType, "type", fmt.Sprintf("show logs of type; one of %v", logs.AllLogTypes))
 	cmd.Flags().String("filter", "", "only show logs containing given text; case-insensitive")
+	cmd.Flags().String("grep", "", "only show logs matching the regular expression; case-sensitive")
+	cmd.Flags().Var(&logLevel, "level", fmt.Sprintf("only show logs of at least the given level; one of %v", logs.AllLogLevels))
+	cmd.Flags().StringSlice("service", nil, "only show logs of the given service; can be repeated")
+	cmd.Flags().Var(&timestampFormat, "timestamps", fmt.Sprintf("format of the timestamps; one of %v", logs.AllTimestampFormats))
+	cmd.Flags().Bool("no-timestamps", false, "don't show the timestamps; same as --timestamps=none")
+	cmd.Flags().Bool("no-prefix", false, "don't show the deployment, service, and host of each log line")
+	cmd.Flags().Var(&logFormat, "log-format", fmt.Sprintf("format of the log output; one of %v", logs.AllLogFormats))
+	cmd.Flags().String("tz", "", `time zone of the timestamps, eg. "UTC", "America/New_York" or "+09:00" (default local)`)
 }
 
 func handleLogsCmd(cmd *cobra.Command, args []string) error {
@@ -642,10 +986,54 @@ func handleLogsCmd(cmd *cobra.Command, args []string) error {
 	var until, _ = cmd.Flags().GetString("until")
 	var follow, _ = cmd.Flags().GetBool("follow")
 	var limit, _ = cmd.Flags().GetInt32("limit")
+	var tz, _ = cmd.Flags().GetString("tz")
+	var current, _ = cmd.Flags().GetBool("current")
+	var grep, _ = cmd.Flags().GetString("grep")
+	var serviceFlags, _ = cmd.Flags().GetStringSlice("service")
+	var noTimestamps, _ = cmd.Flags().GetBool("no-timestamps")
+	var noPrefix, _ = cmd.Flags().GetBool("no-prefix")
+	var tail, _ = cmd.Flags().GetInt32("tail")
 
 	if follow && until != "" {
 		return errors.New("cannot use --follow and --until together")
 	}
+	if tail < 0 {
+		return fmt.Errorf("invalid --tail %d: expected a positive number", tail)
+	}
+	if tail > 0 && follow {
+		return errors.New("cannot use --tail and --follow together")
+	}
+	if tail > 0 && cmd.Flags().Changed("limit") {
+		return errors.New("cannot use --tail and --limit together")
+	}
+	if current && (deployment != "" || etag != "") {
+		return errors.New("cannot use --current and --deployment together")
+	}
+	if current {
+		deployment = cli.DeploymentLatest
+	}
+	if _, err := regexp.Compile(grep); err != nil {
+		return fmt.Errorf("invalid --grep: %w", err)
+	}
+	timestamps := timestampFormat
+	if noTimestamps {
+		if cmd.Flags().Changed("timestamps") {
+			return errors.New("cannot use --no-timestamps and --timestamps together")
+		}
+		timestamps = logs.TimestampNone
+	}
+	if raw && cmd.Flags().Changed("log-format") && logFormat != logs.LogFormatRaw {
+		return errors.New("cannot use --raw and --log-format together")
+	}
+	jsonFormat := global.Json || logFormat == logs.LogFormatJSON
+
+	var timeZone *time.Location
+	if tz != "" {
+		var err error
+		if timeZone, err = logs.ParseTimeZone(tz); err != nil {
+			return err
+		}
+	}
 
 	if etag != "" && deployment == "" {
 		deployment = etag
@@ -667,19 +1055,7 @@ func handleLogsCmd(cmd *cobra.Command, args []string) error {
 	}
 	untilTs = untilTs.UTC()
 
-	rangeStr := ""
-	if pkg.IsValidTime(sinceTs) {
-		rangeStr = " since " + sinceTs.Format(time.RFC3339Nano)
-	}
-	if pkg.IsValidTime(untilTs) {
-		rangeStr += " until " + untilTs.Format(time.RFC3339Nano)
-	}
-	term.Infof("Showing logs%s; press Ctrl+C to stop:", rangeStr)
-
-	services := args
-	if len(name) > 0 {
-		services = append(args, strings.Split(name, ",")...) // backwards compat
-	}
+	services := logServices(args, serviceFlags, name)
 	if logType.Has(logs.LogTypeBuild) {
 		servicesWithBuild := make([]string, 0, len(services)*2)
 		for _, service := range services {
@@ -701,38 +1077,79 @@ func handleLogsCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Handle 'latest' deployment flag
-	if deployment == "latest" {
-		resp, err := global.Client.ListDeployments(cmd.Context(), &defangv1.ListDeploymentsRequest{
-			Project: projectName,
-			Stack:   session.Stack.Name,
-			Type:    defangv1.DeploymentType_DEPLOYMENT_TYPE_ACTIVE,
-			Limit:   1,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to fetch latest deployment: %w", err)
+	if deployment != "" {
+		found, err := cli.FindDeployment(cmd.Context(), global.Client, projectName, session.Stack.Name, deployment)
+		if deployment == cli.DeploymentLatest {
+			if err != nil {
+				return fmt.Errorf("failed to fetch latest deployment: %w", err)
+			}
+			if found == nil {
+				return errors.New("no active deployments found")
+			}
+		} else if err != nil {
+			term.Debug("Failed to look up deployment:", err)
 		}
-		if len(resp.Deployments) == 0 {
-			return errors.New("no active deployments found")
+		if found != nil {
+			deployment = found.Id
+			// Start at the deployment, so the build logs are included too
+			if since == "" && found.Timestamp.IsValid() {
+				sinceTs = found.Timestamp.AsTime().UTC()
+			}
 		}
-		deployment = resp.Deployments[0].Id
+	}
+
+	rangeStr := ""
+	if deployment != "" {
+		rangeStr = " of deployment " + deployment
+	}
+	if pkg.IsValidTime(sinceTs) {
+		rangeStr += " since " + sinceTs.Format(time.RFC3339Nano)
+	}
+	if pkg.IsValidTime(untilTs) {
+		rangeStr += " until " + untilTs.Format(time.RFC3339Nano)
+	}
+	// Keep the output of the machine-readable formats clean, so it can be piped into other tools
+	machineReadable := jsonFormat || logFormat == logs.LogFormatLogfmt
+	if !machineReadable {
+		term.Infof("Showing logs%s; press Ctrl+C to stop:", rangeStr)
 	}
 
 	tailOptions := cli.TailOptions{
 		Deployment:    deployment,
 		Filter:        filter,
+		Grep:          grep,
+		Level:         logLevel,
 		LogType:       logType,
-		Raw:           raw,
+		Logfmt:        logFormat == logs.LogFormatLogfmt,
+		NoPrefix:      noPrefix,
+		Raw:           raw || logFormat == logs.LogFormatRaw,
 		Services:      services,
 		Since:         sinceTs,
 		Until:         untilTs,
 		Verbose:       verbose,
 		Follow:        follow,
+		JSON:          jsonFormat,
 		Limit:         limit,
-		PrintBookends: true,
+		PrintBookends: !machineReadable,
 		Stack:         session.Stack.Name,
+		Tail:          tail,
+		TimeZone:      timeZone,
+		Timestamps:    timestamps,
+	}
+	err = cli.Tail(cmd.Context(), session.Provider, projectName, tailOptions)
+	if cmd.Context().Err() != nil {
+		printLogsInterruptedHint(err)
+	}
+	return err
+}
+
+// logServices returns the services from the arguments, the --service flags and the deprecated --name flag
+func logServices(args, serviceFlags []string, name string) []string {
+	services := append(slices.Clone(args), serviceFlags...)
+	if len(name) > 0 {
+		services = append(services, strings.Split(name, ",")...) // backwards compat
 	}
-	return cli.Tail(cmd.Context(), session.Provider, projectName, tailOptions)
+	return services
 }
 
 func setupComposeCommand() *cobra.Command {
@@ -752,15 +1169,16 @@ services:
 	}
 	// Compose Command
 	// composeCmd.Flags().Bool("compatibility", false, "Run compose in backward compatibility mode"); TODO: Implement compose option
-	// composeCmd.Flags().String("env-file", "", "Specify an alternate environment file."); TODO: Implement compose option
-	// composeCmd.Flags().Int("parallel", -1, "Control max parallelism, -1 for unlimited (default -1)"); TODO: Implement compose option
 	// composeCmd.Flags().String("profile", "", "Specify a profile to enable"); TODO: Implement compose option
 	// composeCmd.Flags().String("project-directory", "", "Specify an alternate working directory"); TODO: Implement compose option
 	composeCmd.PersistentFlags().StringVar(&byoc.DefangPulumiBackend, "pulumi-backend", "", `specify an alternate Pulumi backend URL or "pulumi-cloud"`)
 	composeCmd.AddCommand(makeComposeUpCmd())
+	composeCmd.AddCommand(makeComposePlanCmd())
 	composeCmd.AddCommand(makeComposeConfigCmd())
 	composeCmd.AddCommand(makeComposeDownCmd())
+	composeCmd.AddCommand(makeComposeContextCmd())
 	composeCmd.AddCommand(makeComposePsCmd())
+	composeCmd.AddCommand(makeComposeRefreshEnvCmd())
 	composeCmd.AddCommand(makeLogsCmd())
 	composeLsCmd := makeDeploymentsCmd("ls")
 	composeCmd.AddCommand(composeLsCmd)
@@ -3,13 +3,24 @@ package command
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
 	"os"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/surveyor"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/DefangLabs/defang/src/protos/io/defang/v1/defangv1connect"
 	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestInitializeTailCmd(t *testing.T) {
@@ -34,7 +45,7 @@ func TestPrintPlaygroundPortalServiceURLs(t *testing.T) {
 
 	global.Stack.Provider = client.ProviderDefang
 	global.Cluster = client.DefaultCluster
-	printPlaygroundPortalServiceURLs([]*defangv1.ServiceInfo{
+	printPlaygroundPortalServiceURLs(t.Context(), []*defangv1.ServiceInfo{
 		{
 			Service: &defangv1.Service{Name: "service1"},
 		}})
@@ -79,3 +90,206 @@ func TestComposeConfig(t *testing.T) {
 		}
 	})
 }
+
+type mockDeployFabricService struct {
+	mockFabricService
+}
+
+func (m *mockDeployFabricService) CanIUse(context.Context, *connect.Request[defangv1.CanIUseRequest]) (*connect.Response[defangv1.CanIUseResponse], error) {
+	return connect.NewResponse(&defangv1.CanIUseResponse{}), nil
+}
+
+func (m *mockDeployFabricService) ListDeployments(context.Context, *connect.Request[defangv1.ListDeploymentsRequest]) (*connect.Response[defangv1.ListDeploymentsResponse], error) {
+	return connect.NewResponse(&defangv1.ListDeploymentsResponse{}), nil
+}
+
+func (m *mockDeployFabricService) GetDelegateSubdomainZone(context.Context, *connect.Request[defangv1.GetDelegateSubdomainZoneRequest]) (*connect.Response[defangv1.DelegateSubdomainZoneResponse], error) {
+	return connect.NewResponse(&defangv1.DelegateSubdomainZoneResponse{}), nil
+}
+
+func (m *mockDeployFabricService) ListSecrets(context.Context, *connect.Request[defangv1.ListConfigsRequest]) (*connect.Response[defangv1.Secrets], error) {
+	return connect.NewResponse(&defangv1.Secrets{Names: []string{"dummy"}}), nil
+}
+
+func (m *mockDeployFabricService) GetServices(context.Context, *connect.Request[defangv1.GetServicesRequest]) (*connect.Response[defangv1.GetServicesResponse], error) {
+	return connect.NewResponse(&defangv1.GetServicesResponse{}), nil
+}
+
+func (m *mockDeployFabricService) PutDeployment(context.Context, *connect.Request[defangv1.PutDeploymentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnavailable, errors.New("history unavailable")) // makes ComposeUp print a warning
+}
+
+func (m *mockDeployFabricService) Deploy(ctx context.Context, req *connect.Request[defangv1.DeployRequest]) (*connect.Response[defangv1.DeployResponse], error) {
+	return connect.NewResponse(&defangv1.DeployResponse{
+		Etag: "a1b2c3",
+		Services: []*defangv1.ServiceInfo{
+			{Service: &defangv1.Service{Name: "nginx"}, Etag: "a1b2c3", State: defangv1.ServiceState_DEPLOYMENT_PENDING},
+		},
+	}), nil
+}
+
+func TestComposeUpOutputJSON(t *testing.T) {
+	_, handler := defangv1connect.NewFabricControllerHandler(&mockDeployFabricService{})
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	t.Chdir("../../../../src/testdata/sanity")
+	t.Setenv("DEFANG_ACCESS_TOKEN", "token-123")
+
+	stdout, stderr := term.SetupTestTerm(t)
+	t.Cleanup(func() {
+		// Flags keep their values between the commands of the tests
+		flag := RootCmd.PersistentFlags().Lookup("output")
+		flag.Value.Set(flag.DefValue)
+		flag.Changed = false
+		global.Json = false
+	})
+
+	err := testCommand(t, []string{"compose", "up", "--provider=defang", "--detach", "--output=json", "--dry-run=false"}, server.URL)
+	if err != nil {
+		t.Fatalf("compose up failed: %v\n%s", err, stderr)
+	}
+	if !json.Valid(stdout.Bytes()) {
+		t.Errorf("expected stdout to be a JSON document, got:\n%s", stdout)
+	}
+	var output cli.DeploymentOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		t.Fatal(err)
+	}
+	if output.Etag != "a1b2c3" || len(output.Services) != 1 || output.Services[0].Name != "nginx" {
+		t.Errorf("unexpected deployment output: %+v", output)
+	}
+	if !strings.Contains(stderr.String(), "Unable to update deployment history") {
+		t.Errorf("expected status messages on stderr, got:\n%s", stderr)
+	}
+}
+
+type mockTailFabricService struct {
+	mockDeployFabricService
+	received chan struct{}
+}
+
+func (m *mockTailFabricService) Tail(ctx context.Context, req *connect.Request[defangv1.TailRequest], stream *connect.ServerStream[defangv1.TailResponse]) error {
+	if err := stream.Send(&defangv1.TailResponse{
+		Service: "nginx",
+		Entries: []*defangv1.LogEntry{{Message: "hello", Timestamp: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), Service: "nginx"}},
+	}); err != nil {
+		return err
+	}
+	close(m.received)
+	<-ctx.Done() // keep streaming until the client goes away
+	return ctx.Err()
+}
+
+func TestLogsInterrupted(t *testing.T) {
+	mockService := &mockTailFabricService{received: make(chan struct{})}
+	_, handler := defangv1connect.NewFabricControllerHandler(mockService)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	t.Chdir("../../../../src/testdata/sanity")
+	t.Setenv("DEFANG_ACCESS_TOKEN", "token-123")
+	t.Setenv("DEFANG_HIDE_HINTS", "")
+	hasTty := global.HasTty
+	t.Cleanup(func() { global.HasTty = hasTty })
+	global.HasTty = true
+
+	stdout, stderr := term.SetupTestTerm(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	go func() {
+		<-mockService.received
+		time.Sleep(100 * time.Millisecond) // let the client print the entry
+		cancel()                           // as if the user pressed Ctrl+C
+	}()
+	RootCmd.SetArgs([]string{"logs", "--provider=defang", "--follow", "--since=2024-01-01T00:00:00Z", "--cluster", strings.TrimPrefix(server.URL, "http://")})
+	err := RootCmd.ExecuteContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v\n%s", err, stderr)
+	}
+	if cerr := new(cli.CancelError); !errors.As(err, &cerr) {
+		t.Fatalf("expected a cli.CancelError, got: %T", err)
+	}
+	const want = "--since=2024-01-02T03:04:05Z"
+	if got := stdout.String(); !strings.Contains(got, "To continue the logs from where you left off") || !strings.Contains(got, want) {
+		t.Errorf("expected a hint to continue the logs %s, got:\n%s", want, got)
+	}
+	if strings.Contains(stdout.String(), "Detached") {
+		t.Errorf("expected no deployment hint for logs, got:\n%s", stdout)
+	}
+}
+
+type mockDownFabricService struct {
+	mockTailFabricService
+}
+
+func (m *mockDownFabricService) Destroy(context.Context, *connect.Request[defangv1.DestroyRequest]) (*connect.Response[defangv1.DestroyResponse], error) {
+	return connect.NewResponse(&defangv1.DestroyResponse{Etag: "d1e2f3"}), nil
+}
+
+func TestComposeDownInterrupted(t *testing.T) {
+	mockService := &mockDownFabricService{mockTailFabricService{received: make(chan struct{})}}
+	_, handler := defangv1connect.NewFabricControllerHandler(mockService)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	t.Chdir("../../../../src/testdata/sanity")
+	t.Setenv("DEFANG_ACCESS_TOKEN", "token-123")
+	t.Setenv("DEFANG_HIDE_HINTS", "")
+	hasTty := global.HasTty
+	t.Cleanup(func() { global.HasTty = hasTty })
+	global.HasTty = true
+
+	stdout, stderr := term.SetupTestTerm(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	go func() {
+		<-mockService.received
+		time.Sleep(100 * time.Millisecond) // let the client print the entry
+		cancel()                           // as if the user pressed Ctrl+C
+	}()
+	args := []string{"compose", "down", "--provider=defang", "--project-name=sanity", "--dry-run=false", "--cluster", strings.TrimPrefix(server.URL, "http://")}
+	if downCmd, _, err := RootCmd.Find(args); err == nil {
+		downCmd.SetContext(ctx) // or it keeps the context of an earlier test
+	}
+	RootCmd.SetArgs(args)
+	if err := RootCmd.ExecuteContext(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatalf("compose down failed: %v\n%s", err, stderr)
+	}
+	if got := stdout.String(); !strings.Contains(got, "Detached. The deployment will keep running.") || !strings.Contains(got, "To continue the logs from where you left off") {
+		t.Errorf("expected a hint that the removal keeps running, got:\n%s", got)
+	}
+}
+
+func TestLogServices(t *testing.T) {
+	args := make([]string, 1, 4) // with room to spare, so appending could overwrite it
+	args[0] = "app"
+	services := logServices(args, []string{"worker"}, "db,cache")
+	if !slices.Equal(services, []string{"app", "worker", "db", "cache"}) {
+		t.Errorf("logServices() = %v", services)
+	}
+	if !slices.Equal(args, []string{"app"}) || args[:2][1] != "" {
+		t.Errorf("logServices() modified the arguments: %v", args[:2])
+	}
+}
+
+func TestUseStderrForOutput(t *testing.T) {
+	stdout, stderr := term.SetupTestTerm(t)
+	original := surveyor.NewDefaultSurveyor()
+	ctx := cli.WithOptions(t.Context(), cli.Options{Term: term.DefaultTerm, Surveyor: original})
+
+	ctx, w := useStderrForOutput(ctx)
+	opts := cli.OptionsFromContext(ctx)
+	if opts.Surveyor == original {
+		t.Error("expected the prompts to be rebound to the new term")
+	}
+	opts.Term.Info("status")
+	w.Write([]byte("{}"))
+
+	if stdout.String() != "{}" {
+		t.Errorf("expected only the document on stdout, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "status") {
+		t.Errorf("expected the status on stderr, got %q", stderr.String())
+	}
+	if term.DefaultTerm.Stdout() != stdout {
+		t.Error("expected the default term to be left alone")
+	}
+}
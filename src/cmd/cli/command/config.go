@@ -1,6 +1,7 @@
 package command
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +13,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/session"
 	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
@@ -159,14 +161,22 @@ var configSetCmd = &cobra.Command{
 			}
 
 			var value string
-			err := survey.AskOne(sensitivePrompt, &value, survey.WithStdio(term.DefaultTerm.Stdio()))
+			err := cli.Ask(cmd.Context(), sensitivePrompt, &value)
 			if err != nil {
 				return err
 			}
 			envMap = map[string]string{name: value}
 		}
 
+		if !ifNotSet && global.Interactive() && !global.Yes {
+			// Ask before overwriting existing values, because those cannot be retrieved later
+			if err := confirmConfigOverwrite(cmd.Context(), session.Provider, projectName, envMap); err != nil {
+				return err
+			}
+		}
+
 		var errs []error
+		var updated []string
 		for name, value := range envMap {
 			didSet, err := cli.ConfigSet(cmd.Context(), projectName, session.Provider, name, value, cli.ConfigSetOptions{
 				IfNotSet: ifNotSet,
@@ -177,16 +187,69 @@ var configSetCmd = &cobra.Command{
 				term.Info("Config", name, "is already set; skipping due to --if-not-set flag")
 			} else {
 				term.Info("Updated value for", name)
+				updated = append(updated, name)
 			}
 		}
 
 		term.Infof("Successfully set %d config value(s)", len(envMap)-len(errs))
 
-		printDefangHint("To update the deployed values, do:", "compose up")
+		if apply, _ := cmd.Flags().GetBool("apply"); apply {
+			if err := errors.Join(errs...); err != nil {
+				return err
+			}
+			if len(updated) == 0 {
+				return nil
+			}
+			return applyConfig(cmd, session, projectName, updated)
+		}
+
+		if len(updated) > 0 {
+			printDefangHint("To restart the deployed services with the updated values, do:", "compose refresh-env "+strings.Join(updated, " "))
+		}
 		return errors.Join(errs...)
 	},
 }
 
+var configImportCmd = &cobra.Command{
+	Use:         "import FILE [CONFIG...]",
+	Annotations: authNeededForPlayground,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Adds or updates the config values from an .env file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Same as "config create --env-file FILE [CONFIG...]"
+		if err := cmd.Flags().Set("env-file", args[0]); err != nil {
+			return err
+		}
+		return configSetCmd.RunE(cmd, args[1:])
+	},
+}
+
+// confirmConfigOverwrite asks to confirm each config in envMap that is already set, and removes the ones the
+// user declined from envMap.
+func confirmConfigOverwrite(ctx context.Context, provider client.Provider, projectName string, envMap map[string]string) error {
+	existing, err := provider.ListConfig(ctx, &defangv1.ListConfigsRequest{Project: projectName})
+	if err != nil {
+		return err
+	}
+	for _, name := range existing.Names {
+		if _, ok := envMap[name]; !ok {
+			continue
+		}
+		err := cli.Confirm(ctx, cli.ConfirmPrompt{
+			Message:          fmt.Sprintf("Config %q is already set; overwrite it?", name),
+			Event:            "Overwrite Config Prompt Answered",
+			IfNonInteractive: true,
+		})
+		if errors.Is(err, cli.ErrCanceled) {
+			term.Info("Skipping config", name)
+			delete(envMap, name)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var configDeleteCmd = &cobra.Command{
 	Use:         "rm CONFIG...", // like Docker
 	Annotations: authNeededForPlayground,
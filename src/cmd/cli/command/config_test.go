@@ -132,6 +132,15 @@ func TestConfigSetFlagConflicts(t *testing.T) {
 			name: "valid use of --env-file with specific configs",
 			args: []string{"config", "set", "--env-file=" + envFilePath, "TEST_KEY", "ANOTHER_KEY", "--provider=defang", "--project-name=app"},
 		},
+		{
+			name: "valid use of import",
+			args: []string{"config", "import", envFilePath, "--provider=defang", "--project-name=app"},
+		},
+		{
+			name:        "import with config not in file",
+			args:        []string{"config", "import", envFilePath, "NONEXISTENT_KEY", "--provider=defang", "--project-name=app"},
+			expectedErr: `config "NONEXISTENT_KEY" not found in env file`,
+		},
 		{
 			name: "no = in KEY=VALUE format; interactive mode",
 			args: []string{"config", "set", "KEY1", "--provider=defang", "--project-name=app"},
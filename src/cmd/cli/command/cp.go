@@ -0,0 +1,46 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var cpCmd = &cobra.Command{
+	Use:         "cp SRC DEST",
+	Annotations: authNeededAlways,
+	Args:        cobra.ExactArgs(2),
+	Short:       "Copy files or directories to or from a running container of a service",
+	Long: `Copy files or directories to or from a running container of a service, like "kubectl cp".
+
+Exactly one of SRC and DEST must be in a container, written as SERVICE:PATH. Like
+cp, SRC is copied into DEST if DEST is a directory. The container must have sh
+and tar.`,
+	Example: `  defang cp ./seed.sql db:/tmp/seed.sql
+  defang cp ./seed.sql db:/tmp
+  defang cp app:/var/log/app ./logs`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		srcService, src := cli.ParseCopyPath(args[0])
+		dstService, dst := cli.ParseCopyPath(args[1])
+		if (srcService == "") == (dstService == "") {
+			return errors.New("exactly one of SRC and DEST must be a path in a service, like SERVICE:PATH")
+		}
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		if srcService != "" {
+			return cli.CopyFromService(ctx, global.Client, projectName, session.Stack.Name, srcService, src, dst)
+		}
+		return cli.CopyToService(ctx, global.Client, projectName, session.Stack.Name, dstService, src, dst)
+	},
+}
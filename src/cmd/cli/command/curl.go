@@ -0,0 +1,42 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var curlCmd = &cobra.Command{
+	Use:         "curl SERVICE [PATH]",
+	Annotations: authNeededAlways,
+	Args:        cobra.RangeArgs(1, 2),
+	Short:       "Send an HTTP request to the deployed endpoint of a service",
+	Long:        "Send an HTTP request to the deployed endpoint of a service. A private service is reached from inside its own container, which must have curl.",
+	Example:     "  defang curl api /healthz",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		method, _ := cmd.Flags().GetString("request")
+		data, _ := cmd.Flags().GetString("data")
+		headers, _ := cmd.Flags().GetStringArray("header")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		params := cli.CurlParams{
+			Service: args[0],
+			Method:  method,
+			Data:    data,
+			Headers: headers,
+		}
+		if len(args) > 1 {
+			params.Path = args[1]
+		}
+		return cli.Curl(ctx, global.Client, projectName, session.Stack.Name, session.Provider, params)
+	},
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:         "delete SERVICE...",
+	Aliases:     []string{"del"},
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Remove services from the deployed project without affecting the other services",
+	RunE:        deleteServices,
+}
+
+// deleteServices removes the services in args from the deployed project, after confirming with the user.
+func deleteServices(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	jsonOut := cli.OptionsFromContext(ctx).Term.Stdout()
+	if global.Output == cli.OutputFormatJSON {
+		ctx, jsonOut = useStderrForOutput(ctx)
+		cmd.SetContext(ctx)
+	}
+	t := cli.OptionsFromContext(ctx).Term
+	var detach, _ = cmd.Flags().GetBool("detach")
+	var force, _ = cmd.Flags().GetBool("force")
+	var removeOrphans, _ = cmd.Flags().GetBool("remove-orphans")
+	var removeVolumes, _ = cmd.Flags().GetBool("volumes")
+
+	session, err := newCommandSession(cmd)
+	if err != nil {
+		return err
+	}
+	projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+	if err != nil {
+		return err
+	}
+	project, err := cli.LoadDeployedProject(ctx, session.Provider, projectName)
+	if err != nil {
+		return err
+	}
+
+	if removeOrphans {
+		orphans, err := findOrphans(ctx, session.Loader, project)
+		if err != nil {
+			return err
+		}
+		for _, orphan := range orphans {
+			if !slices.Contains(args, orphan) {
+				args = append(args, orphan)
+			}
+		}
+	}
+
+	if dependents := compose.DependentServices(project, args...); len(dependents) > 0 {
+		t.Warnf("The following services reference %s and might stop working: %s", strings.Join(args, ", "), strings.Join(dependents, ", "))
+	}
+	if !force {
+		message := "Delete service(s) " + strings.Join(args, ", ") + " from project " + projectName + "?"
+		if removeVolumes {
+			message = "Delete service(s) " + strings.Join(args, ", ") + " and their volumes from project " + projectName + "?"
+		}
+		if err := cli.Confirm(ctx, cli.ConfirmPrompt{
+			Message: message,
+			Event:   "Delete Services Prompt Answered",
+		}); err != nil {
+			return fmt.Errorf("delete: %w", err)
+		}
+	}
+
+	resp, err := cli.DeleteServices(ctx, global.Client, session.Provider, session.Stack, project, args, removeVolumes)
+	if err != nil {
+		return err
+	}
+
+	t.Info("Deleting service(s)", args, "in deployment", resp.Etag)
+	if detach {
+		t.Info("Detached.")
+	} else {
+		if err := cli.WaitForCdTaskExit(ctx, session.Provider); err != nil {
+			return err
+		}
+		t.Info("Done.")
+	}
+	if global.Output == cli.OutputFormatJSON {
+		// The summary lists the services that remain in the deployment
+		return cli.PrintDeploymentOutput(jsonOut, cli.NewDeploymentOutput(project, session.Stack.Name, resp.Etag, resp.Services))
+	}
+	return nil
+}
+
+// findOrphans returns the services of the deployed project that are no longer in the Compose file.
+func findOrphans(ctx context.Context, loader client.Loader, deployed *compose.Project) ([]string, error) {
+	project, err := loader.LoadProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orphans := compose.OrphanedServices(deployed, project)
+	if len(orphans) > 0 {
+		cli.OptionsFromContext(ctx).Term.Info("Removing orphaned service(s):", strings.Join(orphans, ", "))
+	}
+	return orphans, nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+type DiagnosticsFormat string
+
+const (
+	// DiagnosticsNone shows warnings and errors as text only.
+	DiagnosticsNone DiagnosticsFormat = "none"
+	// DiagnosticsJSON also writes warnings and errors to stderr as newline-delimited JSON, for editor integrations.
+	DiagnosticsJSON DiagnosticsFormat = "json"
+)
+
+var allDiagnosticsFormats = []DiagnosticsFormat{
+	DiagnosticsNone,
+	DiagnosticsJSON,
+}
+
+func (d DiagnosticsFormat) String() string {
+	return string(d)
+}
+
+func (d *DiagnosticsFormat) Set(value string) error {
+	for _, format := range allDiagnosticsFormats {
+		if format.String() == value {
+			*d = format
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid diagnostics format: %q, not one of %v", value, allDiagnosticsFormats)
+}
+
+func (d DiagnosticsFormat) Type() string {
+	return "diagnostics-format"
+}
+
+var diagnostics *compose.DiagnosticsWriter
+
+// setupDiagnostics starts reporting all warnings as diagnostics if requested; errors are reported by Execute.
+func setupDiagnostics(composeFiles []string) {
+	if global.Diagnostics != DiagnosticsJSON {
+		return
+	}
+	diagnostics = compose.NewDiagnosticsWriter(os.Stderr, composeFiles)
+	term.SetWarningHook(diagnostics.Warning)
+}
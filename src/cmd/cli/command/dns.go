@@ -0,0 +1,27 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var dnsCmd = &cobra.Command{
+	Use:         "dns",
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "Show the hostnames other services can use to reach each service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		return cli.PrintProjectServiceDNS(ctx, projectName, session.Provider)
+	},
+}
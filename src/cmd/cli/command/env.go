@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Args:  cobra.NoArgs,
+	Short: "Inspect the environment of deployed services",
+}
+
+var envListCmd = &cobra.Command{
+	Use:         "ls SERVICE",
+	Aliases:     []string{"list"},
+	Annotations: authNeededAlways,
+	Args:        cobra.ExactArgs(1),
+	Short:       "List the environment variables applied to a deployed service and where they come from",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		return cli.PrintDeployedEnv(ctx, projectName, args[0], session.Provider)
+	},
+}
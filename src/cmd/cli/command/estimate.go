@@ -1,6 +1,7 @@
 package command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -30,7 +31,7 @@ func makeEstimateCmd() *cobra.Command {
 			}
 
 			if global.Stack.Provider == client.ProviderAuto {
-				providerID, err := interactiveSelectProvider([]client.ProviderID{
+				providerID, err := interactiveSelectProvider(ctx, []client.ProviderID{
 					client.ProviderAWS,
 					client.ProviderGCP,
 				})
@@ -74,7 +75,7 @@ var providerDescription = map[client.ProviderID]string{
 	client.ProviderGCP:    "Deploy to Google Cloud Platform using gcloud Application Default Credentials.",
 }
 
-func interactiveSelectProvider(providers []client.ProviderID) (client.ProviderID, error) {
+func interactiveSelectProvider(ctx context.Context, providers []client.ProviderID) (client.ProviderID, error) {
 	if len(providers) < 2 {
 		panic("interactiveSelectProvider called with less than 2 providers")
 	}
@@ -91,7 +92,7 @@ func interactiveSelectProvider(providers []client.ProviderID) (client.ProviderID
 		defaultOption = client.ProviderGCP.String()
 	}
 	var optionValue string
-	if err := survey.AskOne(&survey.Select{
+	if err := cli.Ask(ctx, &survey.Select{
 		Default: defaultOption,
 		Message: "Choose a cloud provider:",
 		Options: options,
@@ -99,7 +100,7 @@ func interactiveSelectProvider(providers []client.ProviderID) (client.ProviderID
 		Description: func(value string, i int) string {
 			return providerDescription[client.ProviderID(value)]
 		},
-	}, &optionValue, survey.WithStdio(term.DefaultTerm.Stdio())); err != nil {
+	}, &optionValue); err != nil {
 		return "", fmt.Errorf("failed to select provider: %w", err)
 	}
 	track.Evt("ProviderSelected", P("provider", optionValue))
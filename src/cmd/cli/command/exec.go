@@ -0,0 +1,46 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var execCmd = &cobra.Command{
+	Use:         "exec SERVICE [-- COMMAND [ARG...]]",
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Run a command in a running container of a service",
+	Long: `Run a command in a running container of a service, like "kubectl exec".
+
+Without a command, the default shell of the container is started. The exit code
+of the command is the exit code of the CLI.`,
+	Example: `  defang exec -it app -- sh
+  defang exec app -- ls -l /data`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		var stdin, _ = cmd.Flags().GetBool("stdin")
+		var tty, _ = cmd.Flags().GetBool("tty")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		err = cli.Exec(ctx, global.Client, projectName, session.Stack.Name, args[0], cli.ExecOptions{
+			Command: args[1:],
+			Stdin:   stdin,
+			TTY:     tty,
+		})
+		if exitErr := new(cli.ExecExitError); errors.As(err, exitErr) {
+			return exitCodeError{err, ExitCode(exitErr.Code)}
+		}
+		return err
+	},
+}
@@ -7,3 +7,13 @@ type ExitCode int
 func (e ExitCode) Error() string {
 	return fmt.Sprintf("exit code %d", e)
 }
+
+// exitCodeError makes the CLI exit with a specific code, for commands that are used in scripts.
+type exitCodeError struct {
+	error
+	code ExitCode
+}
+
+func (e exitCodeError) Unwrap() error {
+	return e.error
+}
@@ -0,0 +1,26 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+var explainCmd = &cobra.Command{
+	Use:     "explain CODE",
+	Args:    cobra.ExactArgs(1),
+	Example: "  defang explain DFG1002",
+	Short:   "Explain a warning or error code, like DFG1002",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info, ok := compose.Explain(args[0])
+		if !ok {
+			return fmt.Errorf("unknown code %q; codes look like DFG1002", args[0])
+		}
+		term.Printc(term.BrightCyan, string(info.Code)+": "+info.Title+"\n\n")
+		term.Println(info.Explanation)
+		term.Println("\nSee", info.Code.URL())
+		return nil
+	},
+}
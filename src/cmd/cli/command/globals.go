@@ -3,8 +3,10 @@ package command
 import (
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/DefangLabs/defang/src/pkg"
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/modes"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
@@ -67,15 +69,20 @@ type GlobalConfig struct {
 	Cluster        string
 	ColorMode      ColorMode
 	Debug          bool
+	Diagnostics    DiagnosticsFormat
+	DryRun         bool // don't actually change anything
 	HasTty         bool
 	HideUpdate     bool
 	Json           bool
-	ModelID        string // only for debug/generate; Pro users
+	Output         cli.OutputFormat // "json" is the same as Json
+	ModelID        string           // only for debug/generate; Pro users
 	NonInteractive bool
+	RPCTimeout     time.Duration // default deadline for calls to the Fabric controller; 0 means no deadline
 	Stack          stacks.Parameters
 	Tenant         types.TenantNameOrID // workspace
 	Utc            bool
 	Verbose        bool
+	Yes            bool // assume "yes" for all confirmation prompts
 }
 
 func (global *GlobalConfig) Interactive() bool {
@@ -115,12 +122,26 @@ func NewGlobalConfig() *GlobalConfig {
 		}
 	}
 
+	diagnostics := DiagnosticsNone
+	if fromEnv, ok := os.LookupEnv("DEFANG_DIAGNOSTICS"); ok {
+		err := diagnostics.Set(fromEnv)
+		if err != nil {
+			term.Debugf("invalid DEFANG_DIAGNOSTICS value: %v", err)
+		}
+	}
+
 	json := pkg.GetenvBool("DEFANG_JSON")
+	output := cli.OutputFormatText
+	if json {
+		output = cli.OutputFormatJSON
+	}
 	hastty := term.IsTerminal() && !pkg.GetenvBool("CI")
 
 	tenant := types.TenantNameOrID("")
 	if fromEnv, ok := os.LookupEnv("DEFANG_WORKSPACE"); ok {
 		tenant = types.TenantNameOrID(fromEnv)
+	} else if fromEnv, ok := os.LookupEnv("DEFANG_TENANT"); ok {
+		tenant = types.TenantNameOrID(fromEnv)
 	} else if fromEnv, ok := os.LookupEnv("DEFANG_ORG"); ok {
 		tenant = types.TenantNameOrID(fromEnv)
 		term.Warn("DEFANG_ORG is deprecated; use DEFANG_WORKSPACE instead")
@@ -130,10 +151,13 @@ func NewGlobalConfig() *GlobalConfig {
 		ColorMode:      color,
 		Cluster:        pkg.Getenv("DEFANG_FABRIC", client.DefangFabric),
 		Debug:          pkg.GetenvBool("DEFANG_DEBUG"),
+		Diagnostics:    diagnostics,
 		HasTty:         hastty,
 		HideUpdate:     pkg.GetenvBool("DEFANG_HIDE_UPDATE"),
 		Json:           json,
+		Output:         output,
 		NonInteractive: !hastty,
+		RPCTimeout:     client.LoadClientTimeouts().Call, // from DEFANG_RPC_TIMEOUT
 		Stack: stacks.Parameters{
 			Name:     pkg.Getenv("DEFANG_STACK", ""),
 			Provider: provider,
@@ -142,6 +166,7 @@ func NewGlobalConfig() *GlobalConfig {
 		},
 		Verbose: pkg.GetenvBool("DEFANG_VERBOSE"),
 		Tenant:  tenant,
+		Yes:     pkg.GetenvBool("DEFANG_YES"),
 	}
 }
 
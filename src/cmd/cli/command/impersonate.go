@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/DefangLabs/defang/src/pkg/auth"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+// Commands that deal with credentials must never run as another user
+var noImpersonationCommands = []string{"login", "logout", "token"}
+
+// setupImpersonation handles the --impersonate flag, which is only for Defang support staff. The impersonation is
+// carried by the returned context, so only the Fabric calls made with it act as the other user.
+func setupImpersonation(ctx context.Context, cmd *cobra.Command) (context.Context, error) {
+	userID, _ := cmd.Flags().GetString("impersonate")
+	if userID == "" {
+		return ctx, nil
+	}
+	if slices.Contains(noImpersonationCommands, cmd.Name()) {
+		return ctx, fmt.Errorf("cannot use --impersonate with %q", cmd.Name())
+	}
+	reason, _ := cmd.Flags().GetString("impersonate-reason")
+	impersonation, err := auth.NewImpersonation(userID, reason)
+	if err != nil {
+		return ctx, err
+	}
+	term.Warnf("Impersonating user %q; every request is audited with reason %q", impersonation.UserID, impersonation.Reason)
+	return auth.WithImpersonation(ctx, impersonation), nil
+}
+
+// verifyImpersonation makes sure the Fabric granted the impersonation, so we don't silently act as ourselves.
+func verifyImpersonation(ctx context.Context) error {
+	impersonation := auth.ImpersonationFromContext(ctx)
+	if impersonation == nil {
+		return nil
+	}
+	resp, err := global.Client.WhoAmI(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.UserId != impersonation.UserID {
+		return errors.New("impersonation was not granted; only Defang admins can impersonate users")
+	}
+	return nil
+}
+
+// verifyImpersonationIf verifies the impersonation if that failed before, because the existing token was not valid.
+// Without a login, WhoAmI fails again, so the command never runs as the caller while --impersonate is set.
+func verifyImpersonationIf(ctx context.Context, unverified bool) error {
+	if !unverified {
+		return nil
+	}
+	return verifyImpersonation(ctx)
+}
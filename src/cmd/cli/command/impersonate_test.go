@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/DefangLabs/defang/src/protos/io/defang/v1/defangv1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// expiredTokenFabricService rejects the existing token once, like before a login, and then acts as the caller.
+type expiredTokenFabricService struct {
+	mockFabricService
+	whoAmICalls int
+}
+
+func (m *expiredTokenFabricService) WhoAmI(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[defangv1.WhoAmIResponse], error) {
+	m.whoAmICalls++
+	if m.whoAmICalls == 1 {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("token expired"))
+	}
+	return connect.NewResponse(&defangv1.WhoAmIResponse{Tenant: "default", UserId: "caller"}), nil
+}
+
+func TestImpersonationAfterLogin(t *testing.T) {
+	term.SetupTestTerm(t)
+	mockService := &expiredTokenFabricService{}
+	_, handler := defangv1connect.NewFabricControllerHandler(mockService)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	t.Setenv("DEFANG_ACCESS_TOKEN", "token-123")
+
+	oldGlobal := global
+	t.Cleanup(func() {
+		global = oldGlobal
+		// Flags keep their values between the commands of the tests
+		for _, name := range []string{"impersonate", "impersonate-reason", "non-interactive"} {
+			flag := RootCmd.PersistentFlags().Lookup(name)
+			flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+	})
+	global.Stack.Name = "" // don't load stack files
+
+	err := testCommand(t, []string{"whoami", "--non-interactive", "--impersonate", "user-2", "--impersonate-reason", "ticket-1"}, server.URL)
+	if err == nil || !strings.Contains(err.Error(), "impersonation was not granted") {
+		t.Errorf("expected the impersonation to be verified after the login, got %v", err)
+	}
+	if mockService.whoAmICalls != 2 {
+		t.Errorf("expected WhoAmI to be called again after the login, got %d calls", mockService.whoAmICalls)
+	}
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"os"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+var inspectCmd = &cobra.Command{
+	Use:         "inspect SERVICE",
+	Annotations: authNeededAlways,
+	Args:        cobra.ExactArgs(1),
+	Short:       "Show everything known about a deployed service as JSON",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		inspection, err := cli.InspectService(ctx, projectName, args[0], session.Provider)
+		if err != nil {
+			return err
+		}
+		return cli.PrintServiceInspection(os.Stdout, inspection)
+	},
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"os"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/lsp"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Args:  cobra.NoArgs,
+	Short: "Start a language server for Compose files",
+	Long: `Start a language server on stdin/stdout that validates Compose files as you edit them.
+
+The server publishes the same warnings and errors as "defang compose config" as diagnostics,
+and shows documentation for the x-defang extensions on hover. Configure your editor to run
+"defang lsp" for YAML files named compose.yaml or docker-compose.yaml.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Stdout is reserved for the protocol, so any other output goes to stderr
+		stderrTerm := term.NewTerm(os.Stdin, os.Stderr, os.Stderr)
+		stderrTerm.SetDebug(global.Debug)
+		ctx := cli.WithOptions(cmd.Context(), cli.Options{NonInteractive: true, Term: stderrTerm})
+
+		version := cmd.Root().Version // avoid circular dependency with RootCmd
+		return lsp.NewServer(os.Stdin, os.Stdout, version).Serve(ctx)
+	},
+}
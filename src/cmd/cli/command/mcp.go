@@ -1,11 +1,13 @@
 package command
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/DefangLabs/defang/src/pkg/agent/tools"
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/mcp"
 	"github.com/DefangLabs/defang/src/pkg/term"
@@ -58,7 +60,11 @@ var mcpServerCmd = &cobra.Command{
 
 		// Start the server
 		term.Println("Starting Defang MCP server")
-		if err := server.ServeStdio(s); err != nil {
+		// The tools run with the options of the server, which logs to the log file, since stdout is the protocol
+		opts := cli.Options{DryRun: global.DryRun, NonInteractive: true, Term: term.DefaultTerm}
+		if err := server.ServeStdio(s, server.WithStdioContextFunc(func(ctx context.Context) context.Context {
+			return cli.WithOptions(ctx, opts)
+		})); err != nil {
 			return err
 		}
 
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"errors"
+	"os"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/spf13/cobra"
+)
+
+func makeMetricsCmd() *cobra.Command {
+	var metricsCmd = &cobra.Command{
+		Use:     "metrics",
+		Aliases: []string{"metric"},
+		Args:    cobra.NoArgs,
+		Short:   "Access the Prometheus-compatible metrics of your services",
+	}
+
+	metricsCmd.AddCommand(&cobra.Command{
+		Use:         "endpoint",
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "Print the scrape URL and token for the metrics of the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			_, err = cli.MetricsEndpoint(ctx, global.Client, projectName, session.Stack.Name)
+			return err
+		},
+	})
+
+	var metricsExportCmd = &cobra.Command{
+		Use:         "export",
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "Forward the metrics of the project to a Prometheus remote-write endpoint",
+		Example: `  DEFANG_REMOTE_WRITE_TOKEN=… defang metrics export --remote-write https://prometheus.example.com/api/v1/write
+  defang metrics export --stop`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var remoteWrite, _ = cmd.Flags().GetString("remote-write")
+			var stop, _ = cmd.Flags().GetBool("stop")
+
+			if stop == (remoteWrite != "") {
+				return errors.New("specify either --remote-write or --stop")
+			}
+
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+
+			return cli.MetricsExport(ctx, global.Client, cli.MetricsExportParams{
+				ProjectName:    projectName,
+				Stack:          session.Stack.Name,
+				RemoteWriteURL: remoteWrite,
+				BearerToken:    os.Getenv("DEFANG_REMOTE_WRITE_TOKEN"), // not a flag, to keep it out of the shell history
+			})
+		},
+	}
+	metricsExportCmd.Flags().String("remote-write", "", "Prometheus remote-write URL to forward the metrics to; set DEFANG_REMOTE_WRITE_TOKEN for bearer authentication")
+	metricsExportCmd.Flags().Bool("stop", false, "stop forwarding the metrics")
+	metricsCmd.AddCommand(metricsExportCmd)
+
+	return metricsCmd
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/spf13/cobra"
+)
+
+type redeployFunc func(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error)
+
+func makeRedeployRunE(redeploy redeployFunc, verb string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		var detach, _ = cmd.Flags().GetBool("detach")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		resp, err := redeploy(ctx, global.Client, session.Provider, session.Stack, projectName, args)
+		if err != nil {
+			return err
+		}
+
+		term.Info(verb, "service(s)", args, "in deployment", resp.Etag)
+		if detach {
+			term.Info("Detached.")
+			return nil
+		}
+		if err := cli.WaitForCdTaskExit(ctx, session.Provider); err != nil {
+			return err
+		}
+		term.Info("Done.")
+		return nil
+	}
+}
+
+var pauseCmd = &cobra.Command{
+	Use:         "pause SERVICE...",
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Scale services to zero replicas without removing them; use resume to bring them back",
+	RunE:        makeRedeployRunE(cli.PauseServices, "Pausing"),
+}
+
+var resumeCmd = &cobra.Command{
+	Use:         "resume SERVICE...",
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Scale paused services back to their configured replicas",
+	RunE:        makeRedeployRunE(cli.ResumeServices, "Resuming"),
+}
+
+var restartCmd = &cobra.Command{
+	Use:         "restart SERVICE...",
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Replace the containers of deployed services, without changing or rebuilding them",
+	RunE:        makeRedeployRunE(cli.RestartServices, "Restarting"),
+}
+
+var redeployCmd = &cobra.Command{
+	Use:         "redeploy SERVICE...",
+	Annotations: authNeededAlways,
+	Args:        cobra.MinimumNArgs(1),
+	Short:       "Restart deployed services with a fresh pull of their images, eg. for a \"latest\" tag",
+	RunE:        makeRedeployRunE(cli.RedeployServices, "Redeploying"),
+}
+
+func makeMaintenanceCmd() *cobra.Command {
+	var maintenanceCmd = &cobra.Command{
+		Use:   "maintenance",
+		Args:  cobra.NoArgs,
+		Short: "Serve a maintenance page at the ingress of services, without changing the deployment",
+	}
+
+	var maintenanceOnCmd = &cobra.Command{
+		Use:         "on SERVICE...",
+		Annotations: authNeededAlways,
+		Args:        cobra.MinimumNArgs(1),
+		Short:       "Serve a maintenance page instead of the services; run again to change the message",
+		Example:     `  defang maintenance on web --message "Back at 9:00 UTC"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var message, _ = cmd.Flags().GetString("message")
+			enable := func(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+				return cli.EnableMaintenance(ctx, fabric, provider, stack, projectName, serviceNames, message)
+			}
+			return makeRedeployRunE(enable, "Enabling maintenance mode for")(cmd, args)
+		},
+	}
+	maintenanceOnCmd.Flags().String("message", "", "message to show on the maintenance page")
+	maintenanceOnCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	maintenanceCmd.AddCommand(maintenanceOnCmd)
+
+	var maintenanceOffCmd = &cobra.Command{
+		Use:         "off SERVICE...",
+		Annotations: authNeededAlways,
+		Args:        cobra.MinimumNArgs(1),
+		Short:       "Restore the traffic to services in maintenance mode",
+		RunE:        makeRedeployRunE(cli.DisableMaintenance, "Disabling maintenance mode for"),
+	}
+	maintenanceOffCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	maintenanceCmd.AddCommand(maintenanceOffCmd)
+
+	return maintenanceCmd
+}
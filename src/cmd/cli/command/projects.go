@@ -0,0 +1,66 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+func makeProjectsCmd() *cobra.Command {
+	listProjects := func(cmd *cobra.Command, args []string) error {
+		return cli.ProjectsList(cmd.Context(), global.Client, global.Stack.Name)
+	}
+
+	var projectsCmd = &cobra.Command{
+		Use:         "projects",
+		Aliases:     []string{"project", "proj"},
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "List the deployed projects and select the active project",
+		RunE:        listProjects,
+	}
+	projectsCmd.AddCommand(&cobra.Command{
+		Use:         "ls",
+		Aliases:     []string{"list"},
+		Annotations: authNeededAlways,
+		Args:        cobra.NoArgs,
+		Short:       "List all deployed projects with their service count and last deploy time",
+		RunE:        listProjects,
+	})
+
+	var projectsUseCmd = &cobra.Command{
+		Use:         "use PROJECT",
+		Aliases:     []string{"switch", "select"},
+		Annotations: authNeededAlways,
+		Args:        cobra.MaximumNArgs(1),
+		Short:       "Set the active project for commands run outside of a project directory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var clear, _ = cmd.Flags().GetBool("clear")
+			if clear {
+				if len(args) > 0 {
+					return errors.New("cannot specify a project with --clear")
+				}
+				if err := client.SetActiveProject(""); err != nil {
+					return err
+				}
+				term.Info("Cleared the active project")
+				return nil
+			}
+			if len(args) == 0 {
+				return errors.New("missing project name; use \"defang projects\" to list the deployed projects")
+			}
+
+			if err := cli.UseProject(cmd.Context(), global.Client, args[0]); err != nil {
+				return err
+			}
+			term.Infof("Now using project %q; a Compose file or --project-name takes precedence", args[0])
+			return nil
+		},
+	}
+	projectsUseCmd.Flags().Bool("clear", false, "clear the active project")
+	projectsCmd.AddCommand(projectsUseCmd)
+	return projectsCmd
+}
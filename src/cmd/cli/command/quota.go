@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+var quotaCmd = &cobra.Command{
+	Use:         "quota",
+	Aliases:     []string{"quotas", "usage"},
+	Args:        cobra.NoArgs,
+	Short:       "Show the limits of your plan and how much of each is in use",
+	Annotations: authNeededAlways,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.Quotas(cmd.Context(), global.Client)
+	},
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/session"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+// applyConfig restarts the deployed services that use the given configs, or any config if none are given.
+func applyConfig(cmd *cobra.Command, session *session.Session, projectName string, configNames []string) error {
+	ctx := cmd.Context()
+	var detach, _ = cmd.Flags().GetBool("detach")
+
+	resp, serviceNames, err := cli.RefreshConfig(ctx, global.Client, session.Provider, session.Stack, projectName, configNames)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		term.Info("No deployed services use the config; nothing to restart")
+		return nil
+	}
+
+	term.Info("Restarting service(s)", serviceNames, "in deployment", resp.Etag)
+	if detach {
+		term.Info("Detached.")
+		return nil
+	}
+	if err := cli.WaitForCdTaskExit(ctx, session.Provider); err != nil {
+		return err
+	}
+	term.Info("Done.")
+	return nil
+}
+
+func makeComposeRefreshEnvCmd() *cobra.Command {
+	refreshEnvCmd := &cobra.Command{
+		Use:         "refresh-env [CONFIG...]",
+		Annotations: authNeededAlways,
+		Args:        cobra.ArbitraryArgs,
+		Short:       "Restart the deployed services that use the given configs, or any config, to pick up updated values",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := newCommandSession(cmd)
+			if err != nil {
+				return err
+			}
+			projectName, err := client.LoadProjectNameWithFallback(cmd.Context(), session.Loader, session.Provider)
+			if err != nil {
+				return err
+			}
+			return applyConfig(cmd, session, projectName, args)
+		},
+	}
+	refreshEnvCmd.Flags().BoolP("detach", "d", false, "run in detached mode")
+	return refreshEnvCmd
+}
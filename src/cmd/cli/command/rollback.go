@@ -0,0 +1,51 @@
+package command
+
+import (
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:         "rollback",
+	Annotations: authNeededAlways,
+	Args:        cobra.NoArgs,
+	Short:       "Redeploy the services of an earlier deployment, without needing its Compose file",
+	Long: `Redeploy the services of an earlier deployment, without needing its Compose file.
+
+By default, this rolls back to the most recent successful deployment before the current one. Use "defang deployments
+--all" to find the ID of another deployment. Services that were added after that deployment are kept; use "defang
+delete" to remove them.`,
+	Example: "  defang rollback --to a1b2c3d4e5f6",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		var to, _ = cmd.Flags().GetString("to")
+		var detach, _ = cmd.Flags().GetBool("detach")
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		resp, etag, err := cli.Rollback(ctx, global.Client, session.Provider, session.Stack, projectName, to)
+		if err != nil {
+			return err
+		}
+
+		term.Info("Rolling back to deployment", etag, "in new deployment", resp.Etag)
+		if detach {
+			term.Info("Detached.")
+			return nil
+		}
+		if err := cli.WaitForCdTaskExit(ctx, session.Provider); err != nil {
+			return err
+		}
+		term.Info("Done.")
+		return nil
+	},
+}
@@ -8,14 +8,13 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	"github.com/DefangLabs/defang/src/pkg/debug"
 	"github.com/DefangLabs/defang/src/pkg/session"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
 	"github.com/DefangLabs/defang/src/pkg/term"
-	"github.com/DefangLabs/defang/src/pkg/track"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	"github.com/spf13/cobra"
 )
@@ -55,27 +54,16 @@ func newCommandSessionWithOpts(cmd *cobra.Command, opts commandSessionOpts) (*se
 func newSessionLoaderOptionsForCommand(cmd *cobra.Command) session.SessionLoaderOptions {
 	configPaths, _ := cmd.Flags().GetStringArray("file")
 	projectName, _ := cmd.Flags().GetString("project-name")
+	envFiles, _ := cmd.Flags().GetStringArray("env-file")
+	osEnv, _ := cmd.Flags().GetBool("os-env")
+	noInterpolate, _ := cmd.Flags().GetBool("no-interpolate")
 
-	// Avoid common mistakes
-	if projectName != "" {
-		var maybeProvider client.ProviderID
-		if maybeProvider.Set(projectName) == nil && !cmd.Flag("provider").Changed {
-			// using -p with a provider name instead of -P
-			term.Warnf("Project name %q looks like a provider name; did you mean to use -P=%s instead of -p?", projectName, projectName)
-			doubleCheckProjectName(projectName)
-		} else if strings.HasPrefix(projectName, "roject-name") {
-			// -project-name= instead of --project-name
-			term.Warn("Did you mean to use --project-name instead of -project-name?")
-			doubleCheckProjectName(projectName)
-		} else if strings.HasPrefix(projectName, "rovider") {
-			// -provider= instead of --provider
-			term.Warn("Did you mean to use --provider instead of -provider?")
-			doubleCheckProjectName(projectName)
-		}
-	}
 	return session.SessionLoaderOptions{
 		ComposeFilePaths: configPaths,
 		ProjectName:      projectName,
+		EnvFiles:         envFiles,
+		OsEnv:            osEnv,
+		NoInterpolate:    noInterpolate,
 		GetStackOpts: stacks.GetStackOpts{
 			Interactive: !global.NonInteractive,
 			Default:     global.Stack,
@@ -83,18 +71,30 @@ func newSessionLoaderOptionsForCommand(cmd *cobra.Command) session.SessionLoader
 	}
 }
 
-func doubleCheckProjectName(projectName string) {
-	if global.NonInteractive {
-		return
-	}
-	var confirm bool
-	err := survey.AskOne(&survey.Confirm{
-		Message: "Continue with project: " + projectName + "?",
-	}, &confirm, survey.WithStdio(term.DefaultTerm.Stdio()))
-	track.Evt("ProjectNameConfirm", P("project", projectName), P("confirm", confirm), P("err", err))
-	if err == nil && !confirm {
-		os.Exit(1)
-	}
+// checkProjectNameFlag warns about common mistakes with the --project-name flag and asks to continue.
+func checkProjectNameFlag(cmd *cobra.Command) error {
+	projectName, _ := cmd.Flags().GetString("project-name")
+	if projectName == "" {
+		return nil
+	}
+	var maybeProvider client.ProviderID
+	if maybeProvider.Set(projectName) == nil && !cmd.Flag("provider").Changed {
+		// using -p with a provider name instead of -P
+		term.Warnf("Project name %q looks like a provider name; did you mean to use -P=%s instead of -p?", projectName, projectName)
+	} else if strings.HasPrefix(projectName, "roject-name") {
+		// -project-name= instead of --project-name
+		term.Warn("Did you mean to use --project-name instead of -project-name?")
+	} else if strings.HasPrefix(projectName, "rovider") {
+		// -provider= instead of --provider
+		term.Warn("Did you mean to use --provider instead of -provider?")
+	} else {
+		return nil
+	}
+	return cli.Confirm(cmd.Context(), cli.ConfirmPrompt{
+		Message:          "Continue with project: " + projectName + "?",
+		Event:            "ProjectNameConfirm",
+		IfNonInteractive: true,
+	})
 }
 
 func newStackManagerForLoader(ctx context.Context, loader *compose.Loader) (session.StacksManager, error) {
@@ -107,7 +107,7 @@ func newStackManagerForLoader(ctx context.Context, loader *compose.Loader) (sess
 		if !errors.Is(err, types.ErrComposeFileNotFound) {
 			return nil, handleInvalidComposeFileErr(ctx, err)
 		}
-		term.Debugf("Could not determine project name: %v", err)
+		cli.OptionsFromContext(ctx).Term.Debugf("Could not determine project name: %v", err)
 	}
 	sm, err := stacks.NewManager(global.Client, targetDirectory, projectName, ec)
 	if err != nil {
@@ -148,8 +148,8 @@ func handleInvalidComposeFileErr(ctx context.Context, err error) error {
 		return err
 	}
 
-	term.Error("Cannot load project:", err)
-	project, err := compose.NewLoader().CreateProjectForDebug()
+	cli.OptionsFromContext(ctx).Term.Error("Cannot load project:", err)
+	project, err := compose.NewLoader().CreateProjectForDebug(ctx)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -111,3 +115,44 @@ func copyDir(src string, dst string) error {
 	}
 	return nil
 }
+
+type declineSurveyor struct {
+	asked int
+}
+
+func (s *declineSurveyor) AskOne(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	s.asked++
+	*response.(*bool) = false
+	return nil
+}
+
+func TestCheckProjectNameFlag(t *testing.T) {
+	newCmd := func(projectName string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("project-name", projectName, "")
+		cmd.Flags().String("provider", "", "")
+		return cmd
+	}
+
+	t.Run("no mistake", func(t *testing.T) {
+		surveyor := &declineSurveyor{}
+		cmd := newCmd("app")
+		cmd.SetContext(cli.WithOptions(t.Context(), cli.Options{Surveyor: surveyor}))
+		assert.NoError(t, checkProjectNameFlag(cmd))
+		assert.Zero(t, surveyor.asked)
+	})
+
+	t.Run("non-interactive", func(t *testing.T) {
+		term.SetupTestTerm(t)
+		cmd := newCmd("aws")
+		cmd.SetContext(cli.WithOptions(t.Context(), cli.Options{NonInteractive: true}))
+		assert.NoError(t, checkProjectNameFlag(cmd), "only warns without a TTY")
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		term.SetupTestTerm(t)
+		cmd := newCmd("roject-name=app")
+		cmd.SetContext(cli.WithOptions(t.Context(), cli.Options{Surveyor: &declineSurveyor{}}))
+		assert.ErrorIs(t, checkProjectNameFlag(cmd), cli.ErrCanceled, "returns instead of exiting")
+	})
+}
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+var supportBundleCmd = &cobra.Command{
+	Use:   "support-bundle",
+	Args:  cobra.NoArgs,
+	Short: "Create an archive with logs, the compose project, and recent request IDs to share with support",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		archive, _ := cmd.Flags().GetString("archive")
+		if archive == "" {
+			archive = fmt.Sprintf("defang-support-%s.zip", time.Now().UTC().Format("20060102T150405Z"))
+		}
+
+		// The compose project is optional, since the problem might be that it doesn't load
+		project, err := configureLoader(cmd).LoadProject(ctx)
+		if err != nil {
+			term.Debug("Not including the compose project:", err)
+		}
+
+		if err := cli.SupportBundle(ctx, global.Client, cli.SupportBundleParams{
+			Output:     archive,
+			CliVersion: GetCurrentVersion(),
+			Project:    project,
+		}); err != nil {
+			return err
+		}
+		term.Infof("Created %s; please review it before sharing it with support", archive)
+		return nil
+	},
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/protos/io/defang/v1/defangv1connect"
+)
+
+func TestSupportBundleOutput(t *testing.T) {
+	_, handler := defangv1connect.NewFabricControllerHandler(&mockFabricService{})
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	t.Setenv("DEFANG_ACCESS_TOKEN", "token-123")
+	term.SetupTestTerm(t)
+	t.Chdir("../../../../src/testdata/sanity")
+	t.Cleanup(func() {
+		// Flags keep their values between the commands of the tests
+		supportBundleCmd.Flags().Set("archive", "")
+		for _, name := range []string{"json", "output"} {
+			flag := RootCmd.PersistentFlags().Lookup(name)
+			flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+		global.Json = false
+		global.Output = cli.OutputFormatText
+		cli.SetJSONMode(false)
+	})
+
+	archive := filepath.Join(t.TempDir(), "bundle.zip")
+	if err := testCommand(t, []string{"support-bundle", "--archive", archive, "-o", "json"}, server.URL); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(archive); err != nil {
+		t.Errorf("expected the archive to be created: %v", err)
+	}
+	// The archive path has its own flag, so -o is the output format
+	if global.Output != cli.OutputFormatJSON {
+		t.Errorf("expected the output format to be JSON, got %q", global.Output)
+	}
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of "defang wait", so scripts can tell why it stopped waiting
+const (
+	waitExitFailed   ExitCode = 1   // a service failed to build or deploy
+	waitExitNotFound ExitCode = 3   // a service is not part of the deployed project
+	waitExitTimeout  ExitCode = 124 // same as the timeout(1) command
+)
+
+var waitCmd = &cobra.Command{
+	Use:         "wait [SERVICE...]",
+	Annotations: authNeededAlways,
+	Args:        cobra.ArbitraryArgs,
+	Short:       "Wait until the deployed services reach a state, like healthy; for use in scripts",
+	Example:     "  defang wait --for healthy api worker --timeout 5m && ./smoke-test.sh",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		var waitFor, _ = cmd.Flags().GetString("for")
+		var timeout, _ = cmd.Flags().GetDuration("timeout")
+
+		state, err := cli.ParseWaitState(waitFor)
+		if err != nil {
+			return err
+		}
+
+		session, err := newCommandSession(cmd)
+		if err != nil {
+			return err
+		}
+		projectName, err := client.LoadProjectNameWithFallback(ctx, session.Loader, session.Provider)
+		if err != nil {
+			return err
+		}
+
+		_, err = cli.WaitForServices(ctx, session.Provider, cli.WaitParams{
+			ProjectName: projectName,
+			Services:    args,
+			State:       state,
+			Timeout:     timeout,
+		})
+		if err != nil {
+			return withWaitExitCode(err)
+		}
+		term.Info("Done.")
+		return nil
+	},
+}
+
+func withWaitExitCode(err error) error {
+	switch {
+	case errors.As(err, new(client.ErrDeploymentFailed)):
+		return exitCodeError{err, waitExitFailed}
+	case errors.As(err, new(cli.ErrServiceNotFound)):
+		return exitCodeError{err, waitExitNotFound}
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitCodeError{err, waitExitTimeout}
+	}
+	return err
+}
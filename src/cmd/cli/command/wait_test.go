@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+)
+
+func TestWithWaitExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ExitCode
+	}{
+		{client.ErrDeploymentFailed{Service: "app", Message: "crashed"}, waitExitFailed},
+		{cli.ErrServiceNotFound{ProjectName: "project", Service: "app"}, waitExitNotFound},
+		{fmt.Errorf("wait-timeout of 1m0s exceeded: %w", context.DeadlineExceeded), waitExitTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			var ece exitCodeError
+			if err := withWaitExitCode(tt.err); !errors.As(err, &ece) || ece.code != tt.want {
+				t.Errorf("withWaitExitCode() = %v, want exit code %d", err, tt.want)
+			}
+		})
+	}
+
+	if err := errors.New("other"); withWaitExitCode(err) != err {
+		t.Error("expected other errors to be returned as is")
+	}
+}
@@ -2,12 +2,14 @@ package command
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/auth"
 	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/pkg/types"
 	"github.com/spf13/cobra"
 )
 
@@ -40,6 +42,43 @@ func ListWorkspaces(cmd *cobra.Command, args []string) error {
 	return term.Table(rows, headers...)
 }
 
+func UseWorkspace(cmd *cobra.Command, args []string) error {
+	if clear, _ := cmd.Flags().GetBool("clear"); clear {
+		if len(args) > 0 {
+			return errors.New("cannot specify a workspace with --clear")
+		}
+		if err := client.SetDefaultWorkspace(""); err != nil {
+			return err
+		}
+		term.Info("Cleared the default workspace")
+		return nil
+	}
+	if len(args) == 0 {
+		return errors.New("missing workspace name or ID")
+	}
+
+	token := client.GetExistingToken(global.Cluster)
+	if token == "" {
+		return errors.New("no access token found; please log in with `defang login`")
+	}
+
+	info, err := auth.FetchUserInfo(cmd.Context(), token)
+	if err != nil {
+		return err
+	}
+
+	workspace := info.FindWorkspaceInfo(types.TenantNameOrID(args[0]))
+	if workspace == nil {
+		return fmt.Errorf("workspace %q not found; use `defang workspace ls` to list available workspaces", args[0])
+	}
+
+	if err := client.SetDefaultWorkspace(workspace.Name); err != nil {
+		return err
+	}
+	term.Infof("Using workspace %q by default; override with --workspace or DEFANG_WORKSPACE", workspace.Name)
+	return nil
+}
+
 var workspaceCmd = &cobra.Command{
 	Use:         "workspace",
 	Aliases:     []string{"workspaces", "ws"},
@@ -58,8 +97,19 @@ var workspaceListCmd = &cobra.Command{
 	RunE:        ListWorkspaces,
 }
 
+var workspaceUseCmd = &cobra.Command{
+	Use:         "use [WORKSPACE]",
+	Aliases:     []string{"select", "switch"},
+	Args:        cobra.MaximumNArgs(1),
+	Annotations: authNeededAlways,
+	Short:       "Set the default workspace for subsequent commands",
+	RunE:        UseWorkspace,
+}
+
 func init() {
 	workspaceCmd.Flags().Bool("json", pkg.GetenvBool("DEFANG_JSON"), "print output in JSON format")
 	workspaceListCmd.Flags().Bool("json", pkg.GetenvBool("DEFANG_JSON"), "print output in JSON format")
 	workspaceCmd.AddCommand(workspaceListCmd)
+	workspaceUseCmd.Flags().Bool("clear", false, "clear the default workspace")
+	workspaceCmd.AddCommand(workspaceUseCmd)
 }
@@ -7,8 +7,11 @@ import (
 	"os"
 	"os/signal"
 	"runtime/debug"
+	"syscall"
+	"time"
 
 	"github.com/DefangLabs/defang/src/cmd/cli/command"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/logs"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/track"
@@ -29,17 +32,40 @@ func main() {
 	}
 
 	// Handle Ctrl+C so we can exit gracefully
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			select {
+			case <-done:
+				return // not interrupted; we're exiting anyway
+			default:
+			}
+			stop() // restore the default handler, so a second Ctrl+C exits immediately
+			term.Warn("Interrupted; cleaning up. Press Ctrl+C again to quit immediately.")
+		case <-done:
+		}
+	}()
+
+	// Keep a log of the recent runs, incl. debug messages, for the support bundle
+	if logFile, err := client.OpenCLILog(); err == nil {
+		defer logFile.Close()
+		term.SetLog(logFile)
+		term.Debugf("Defang CLI %s started at %s", version, time.Now().UTC().Format(time.RFC3339))
+	}
 
 	slog.SetDefault(logs.NewTermLogger(term.DefaultTerm))
 	command.SetupCommands(version)
 	err := command.Execute(ctx)
+	close(done)
 	if ctx.Err() != nil {
 		// The context was cancelled by the Interrupt signal handler
 		track.Evt("User Interrupted", track.P("version", version))
 	}
 	stop()
 	track.FlushAllTracking()
+	client.FlushRequests()
 
 	if err != nil {
 		// If the error is a command.ExitCode, use its value as the exit code
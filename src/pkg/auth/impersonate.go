@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	ImpersonateHeader       = "X-Defang-Impersonate-User"
+	ImpersonateReasonHeader = "X-Defang-Impersonate-Reason"
+)
+
+// Impersonation lets support staff act as another user, eg. to reproduce an issue. The Fabric only honors it
+// for admins and records the reason with every request in the audit log.
+type Impersonation struct {
+	UserID string
+	Reason string
+}
+
+// NewImpersonation returns the impersonation of the given user; a reason is required.
+func NewImpersonation(userID, reason string) (*Impersonation, error) {
+	userID, reason = strings.TrimSpace(userID), strings.TrimSpace(reason)
+	if userID == "" {
+		return nil, errors.New("missing user ID to impersonate")
+	}
+	if reason == "" {
+		return nil, errors.New("impersonation requires a reason, eg. a support ticket")
+	}
+	return &Impersonation{UserID: userID, Reason: reason}, nil
+}
+
+type impersonationKey struct{}
+
+// WithImpersonation returns a context that makes the Fabric calls made with it on behalf of the impersonated user.
+// Other requests, like tracking events, don't use the context and are never impersonated.
+func WithImpersonation(ctx context.Context, impersonation *Impersonation) context.Context {
+	return context.WithValue(ctx, impersonationKey{}, impersonation)
+}
+
+// ImpersonationFromContext returns the impersonation of the context, or nil if not impersonating.
+func ImpersonationFromContext(ctx context.Context) *Impersonation {
+	impersonation, _ := ctx.Value(impersonationKey{}).(*Impersonation)
+	return impersonation
+}
+
+func setImpersonationHeaders(ctx context.Context, header http.Header) {
+	if impersonation := ImpersonationFromContext(ctx); impersonation != nil {
+		header.Set(ImpersonateHeader, impersonation.UserID)
+		header.Set(ImpersonateReasonHeader, impersonation.Reason)
+	}
+}
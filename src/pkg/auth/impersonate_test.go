@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestNewImpersonation(t *testing.T) {
+	if _, err := NewImpersonation("user-1", " "); err == nil {
+		t.Error("expected an error without a reason")
+	}
+	if _, err := NewImpersonation("", "ticket 123"); err == nil {
+		t.Error("expected an error without a user ID")
+	}
+	impersonation, err := NewImpersonation(" user-1 ", "ticket 123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if impersonation.UserID != "user-1" || impersonation.Reason != "ticket 123" {
+		t.Errorf("unexpected impersonation: %+v", impersonation)
+	}
+}
+
+func TestImpersonationHeaders(t *testing.T) {
+	impersonation, err := NewImpersonation("user-1", "ticket 123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewAuthInterceptor("token", "").WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, nil
+	})
+
+	t.Run("impersonating", func(t *testing.T) {
+		req := connect.NewRequest(&emptypb.Empty{})
+		if _, err := unary(WithImpersonation(t.Context(), impersonation), req); err != nil {
+			t.Fatal(err)
+		}
+		if got := req.Header().Get(ImpersonateHeader); got != "user-1" {
+			t.Errorf("expected %s header %q, got %q", ImpersonateHeader, "user-1", got)
+		}
+		if got := req.Header().Get(ImpersonateReasonHeader); got != "ticket 123" {
+			t.Errorf("expected %s header %q, got %q", ImpersonateReasonHeader, "ticket 123", got)
+		}
+	})
+
+	t.Run("other context", func(t *testing.T) {
+		// eg. tracking events, which use their own context
+		req := connect.NewRequest(&emptypb.Empty{})
+		if _, err := unary(t.Context(), req); err != nil {
+			t.Fatal(err)
+		}
+		if got := req.Header().Get(ImpersonateHeader); got != "" {
+			t.Errorf("expected no %s header, got %q", ImpersonateHeader, got)
+		}
+	})
+}
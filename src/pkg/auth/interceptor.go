@@ -27,6 +27,7 @@ func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		if a.requestedTenant.IsSet() {
 			req.Header().Set(TenantHeader, string(a.requestedTenant))
 		}
+		setImpersonationHeaders(ctx, req.Header())
 		return next(ctx, req)
 	}
 }
@@ -39,6 +40,7 @@ func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 		if a.requestedTenant.IsSet() {
 			conn.RequestHeader().Set(TenantHeader, string(a.requestedTenant))
 		}
+		setImpersonationHeaders(ctx, conn.RequestHeader())
 		return conn
 	}
 }
@@ -2,6 +2,9 @@ package cert
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"net"
 	"net/http"
 	"time"
@@ -33,6 +36,32 @@ func CheckTLSCert(ctx context.Context, domain string) error {
 	return nil
 }
 
+// GetTLSCert returns the verified leaf certificate served for the domain, bypassing any cached DNS.
+func GetTLSCert(ctx context.Context, domain string) (*x509.Certificate, error) {
+	resolver := dns.RootResolver{}
+	ips, err := resolver.LookupIPAddr(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, errors.New("no IP address found for " + domain)
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 10 * time.Second},
+		Config:    &tls.Config{ServerName: domain},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ips[0].String(), "443"))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("no TLS certificate presented for " + domain)
+	}
+	return certs[0], nil
+}
+
 func getFixedIPTransport(ip string) *http.Transport {
 	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+var alertConditions = map[string]defangv1.AlertCondition{
+	"crashloop":         defangv1.AlertCondition_ALERT_CONDITION_CRASHLOOP,
+	"unhealthy":         defangv1.AlertCondition_ALERT_CONDITION_UNHEALTHY,
+	"deployment-failed": defangv1.AlertCondition_ALERT_CONDITION_DEPLOYMENT_FAILED,
+}
+
+// AllAlertConditions are the names accepted by ParseAlertConditions.
+var AllAlertConditions = []string{"crashloop", "unhealthy", "deployment-failed"}
+
+// ParseAlertConditions converts condition names like "crashloop" into AlertCondition values, removing duplicates.
+func ParseAlertConditions(names []string) ([]defangv1.AlertCondition, error) {
+	var conditions []defangv1.AlertCondition
+	for _, name := range names {
+		condition, ok := alertConditions[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("invalid alert condition %q; must be one of %v", name, AllAlertConditions)
+		}
+		if !slices.Contains(conditions, condition) {
+			conditions = append(conditions, condition)
+		}
+	}
+	if len(conditions) == 0 {
+		return nil, fmt.Errorf("missing alert condition; must be one or more of %v", AllAlertConditions)
+	}
+	return conditions, nil
+}
+
+func alertConditionName(condition defangv1.AlertCondition) string {
+	for name, c := range alertConditions {
+		if c == condition {
+			return name
+		}
+	}
+	return strings.ToLower(strings.TrimPrefix(condition.String(), "ALERT_CONDITION_"))
+}
+
+type AddAlertParams struct {
+	ProjectName string
+	Stack       string
+	Service     string
+	Conditions  []string
+	Notify      []string
+}
+
+// AlertsAdd creates an alert rule that notifies the given channels when the service matches any of the conditions.
+func AlertsAdd(ctx context.Context, fabric client.FabricClient, params AddAlertParams) (*defangv1.AlertRule, error) {
+	opts := OptionsFromContext(ctx)
+	if params.Service == "" {
+		return nil, errors.New("missing service name; use --service to specify the service to alert on")
+	}
+	conditions, err := ParseAlertConditions(params.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	var notify []string
+	for _, channel := range params.Notify {
+		channel = strings.ToLower(strings.TrimSpace(channel))
+		if channel == "" || strings.ContainsAny(channel, " \t") {
+			return nil, fmt.Errorf("invalid notification channel %q", channel)
+		}
+		if !slices.Contains(notify, channel) {
+			notify = append(notify, channel)
+		}
+	}
+	if len(notify) == 0 {
+		return nil, errors.New("missing notification channel; use --notify to specify where to send alerts, eg. slack")
+	}
+
+	opts.Term.Debugf("Adding alert rule for service %q in project %q", params.Service, params.ProjectName)
+
+	if opts.DryRun {
+		return nil, dryrun.ErrDryRun
+	}
+
+	rule, err := fabric.CreateAlertRule(ctx, &defangv1.CreateAlertRuleRequest{
+		Project:    params.ProjectName,
+		Stack:      params.Stack,
+		Service:    params.Service,
+		Conditions: conditions,
+		Notify:     notify,
+	})
+	if err != nil {
+		return nil, err
+	}
+	opts.Term.Infof("Added alert rule %s: notify %s when service %q is %s", rule.Id, strings.Join(rule.Notify, ", "), rule.Service, formatAlertConditions(rule.Conditions, " or "))
+	return rule, nil
+}
+
+type AlertRuleLineItem struct {
+	Id         string
+	Service    string
+	Conditions string
+	Notify     string
+	CreatedAt  string
+}
+
+// AlertsList prints the alert rules of the project.
+func AlertsList(ctx context.Context, fabric client.FabricClient, projectName, stack string) error {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Listing alert rules in project %q", projectName)
+
+	resp, err := fabric.ListAlertRules(ctx, &defangv1.ListAlertRulesRequest{Project: projectName, Stack: stack})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Rules) == 0 {
+		_, err := opts.Term.Warn("No alert rules found; use \"defang alerts add\" to add one")
+		return err
+	}
+
+	items := make([]AlertRuleLineItem, len(resp.Rules))
+	for i, rule := range resp.Rules {
+		items[i] = AlertRuleLineItem{
+			Id:         rule.Id,
+			Service:    rule.Service,
+			Conditions: formatAlertConditions(rule.Conditions, ","),
+			Notify:     strings.Join(rule.Notify, ","),
+		}
+		if rule.CreatedAt != nil {
+			items[i].CreatedAt = rule.CreatedAt.AsTime().Local().Format(time.RFC3339)
+		}
+	}
+	slices.SortStableFunc(items, func(a, b AlertRuleLineItem) int {
+		return strings.Compare(a.Service, b.Service)
+	})
+
+	return opts.Term.Table(items, "Id", "Service", "Conditions", "Notify", "CreatedAt")
+}
+
+// AlertsDelete removes the alert rules with the given IDs.
+func AlertsDelete(ctx context.Context, fabric client.FabricClient, projectName, stack string, ids ...string) error {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Deleting alert rules %v in project %q", ids, projectName)
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	return fabric.DeleteAlertRules(ctx, &defangv1.DeleteAlertRulesRequest{Project: projectName, Stack: stack, Ids: ids})
+}
+
+func formatAlertConditions(conditions []defangv1.AlertCondition, sep string) string {
+	names := make([]string, len(conditions))
+	for i, c := range conditions {
+		names[i] = alertConditionName(c)
+	}
+	return strings.Join(names, sep)
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+func TestParseAlertConditions(t *testing.T) {
+	tests := []struct {
+		names   []string
+		want    []defangv1.AlertCondition
+		wantErr string
+	}{
+		{names: []string{"crashloop"}, want: []defangv1.AlertCondition{defangv1.AlertCondition_ALERT_CONDITION_CRASHLOOP}},
+		{names: []string{"Unhealthy", " crashloop ", "unhealthy"}, want: []defangv1.AlertCondition{defangv1.AlertCondition_ALERT_CONDITION_UNHEALTHY, defangv1.AlertCondition_ALERT_CONDITION_CRASHLOOP}},
+		{names: []string{"deployment-failed"}, want: []defangv1.AlertCondition{defangv1.AlertCondition_ALERT_CONDITION_DEPLOYMENT_FAILED}},
+		{names: []string{"oom"}, wantErr: `invalid alert condition "oom"`},
+		{names: nil, wantErr: "missing alert condition"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.names, ","), func(t *testing.T) {
+			got, err := ParseAlertConditions(tt.names)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseAlertConditions() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAlertConditions() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseAlertConditions() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("ParseAlertConditions()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+type mockAlertsFabricClient struct {
+	client.MockFabricClient
+	req *defangv1.CreateAlertRuleRequest
+}
+
+func (m *mockAlertsFabricClient) CreateAlertRule(ctx context.Context, req *defangv1.CreateAlertRuleRequest) (*defangv1.AlertRule, error) {
+	m.req = req
+	return m.MockFabricClient.CreateAlertRule(ctx, req)
+}
+
+func TestAlertsAdd(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+	fabric := &mockAlertsFabricClient{}
+
+	rule, err := AlertsAdd(t.Context(), fabric, AddAlertParams{
+		ProjectName: "test",
+		Stack:       "beta",
+		Service:     "api",
+		Conditions:  []string{"crashloop", "unhealthy"},
+		Notify:      []string{"Slack", "slack"},
+	})
+	if err != nil {
+		t.Fatalf("AlertsAdd() error = %v", err)
+	}
+	if rule.Id != "alert-1" {
+		t.Errorf("AlertsAdd() rule ID = %q, want %q", rule.Id, "alert-1")
+	}
+	if fabric.req.Project != "test" || fabric.req.Stack != "beta" || fabric.req.Service != "api" {
+		t.Errorf("unexpected request: %v", fabric.req)
+	}
+	if len(fabric.req.Notify) != 1 || fabric.req.Notify[0] != "slack" {
+		t.Errorf("expected notify [slack], got %v", fabric.req.Notify)
+	}
+	if !strings.Contains(stdout.String(), `when service "api" is crashloop or unhealthy`) {
+		t.Errorf("unexpected output: %s", stdout.String())
+	}
+
+	t.Run("missing notify", func(t *testing.T) {
+		_, err := AlertsAdd(t.Context(), fabric, AddAlertParams{Service: "api", Conditions: []string{"crashloop"}})
+		if err == nil || !strings.Contains(err.Error(), "missing notification channel") {
+			t.Errorf("AlertsAdd() error = %v, want missing notification channel", err)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctx := WithOptions(t.Context(), Options{DryRun: true})
+
+		fabric := &mockAlertsFabricClient{}
+		_, err := AlertsAdd(ctx, fabric, AddAlertParams{Service: "api", Conditions: []string{"crashloop"}, Notify: []string{"email"}})
+		if err != dryrun.ErrDryRun {
+			t.Fatalf("Expected dryrun.ErrDryRun, got %v", err)
+		}
+		if fabric.req != nil {
+			t.Error("CreateAlertRule should not be called in dry-run mode")
+		}
+	})
+}
+
+func TestAlertsList(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	if err := AlertsList(t.Context(), client.MockFabricClient{}, "test", ""); err != nil {
+		t.Fatalf("AlertsList() error = %v", err)
+	}
+
+	for _, expected := range []string{"ID", "CONDITIONS", "NOTIFY", "alert-1", "api", "crashloop,unhealthy", "slack"} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, stdout.String())
+		}
+	}
+}
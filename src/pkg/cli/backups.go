@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// BackupCreate starts an on-demand backup of the volumes or managed store of the service.
+func BackupCreate(ctx context.Context, fabric client.FabricClient, projectName, stack, service string) (*defangv1.Backup, error) {
+	opts := OptionsFromContext(ctx)
+	if service == "" {
+		return nil, errors.New("missing service name")
+	}
+	opts.Term.Debugf("Creating backup of service %q in project %q", service, projectName)
+
+	if opts.DryRun {
+		return nil, dryrun.ErrDryRun
+	}
+
+	backup, err := fabric.CreateBackup(ctx, &defangv1.CreateBackupRequest{Project: projectName, Stack: stack, Service: service})
+	if err != nil {
+		return nil, err
+	}
+	opts.Term.Infof("Creating backup %s of service %q", backup.Id, backup.Service)
+	return backup, nil
+}
+
+type BackupLineItem struct {
+	Id        string
+	Service   string
+	State     string
+	Size      string
+	Scheduled bool
+	CreatedAt string
+}
+
+// BackupsList prints the backups of the project, newest first, optionally only those of the given service.
+func BackupsList(ctx context.Context, fabric client.FabricClient, projectName, stack, service string) error {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Listing backups in project %q", projectName)
+
+	resp, err := fabric.ListBackups(ctx, &defangv1.ListBackupsRequest{Project: projectName, Stack: stack, Service: service})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Backups) == 0 {
+		_, err := opts.Term.Warn("No backups found; use \"defang backup create\" to create one, or add x-defang-backup to schedule them")
+		return err
+	}
+
+	backups := slices.Clone(resp.Backups)
+	slices.SortStableFunc(backups, func(a, b *defangv1.Backup) int {
+		return b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime())
+	})
+
+	items := make([]BackupLineItem, len(backups))
+	for i, backup := range backups {
+		items[i] = BackupLineItem{
+			Id:        backup.Id,
+			Service:   backup.Service,
+			State:     strings.ToLower(strings.TrimPrefix(backup.State.String(), "BACKUP_STATE_")),
+			Scheduled: backup.Scheduled,
+		}
+		if backup.SizeBytes > 0 {
+			items[i].Size = fmt.Sprintf("%.1f MiB", float64(backup.SizeBytes)/compose.MiB)
+		}
+		if backup.CreatedAt != nil {
+			items[i].CreatedAt = backup.CreatedAt.AsTime().Local().Format(time.RFC3339)
+		}
+	}
+
+	return opts.Term.Table(items, "Id", "Service", "State", "Size", "Scheduled", "CreatedAt")
+}
+
+// BackupRestore restores the volumes or managed store of a service from the backup with the given ID,
+// overwriting its current data.
+func BackupRestore(ctx context.Context, fabric client.FabricClient, projectName, stack, id string) error {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Restoring backup %q in project %q", id, projectName)
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	return fabric.RestoreBackup(ctx, &defangv1.RestoreBackupRequest{Project: projectName, Stack: stack, Id: id})
+}
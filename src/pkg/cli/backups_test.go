@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type mockBackupsFabricClient struct {
+	client.MockFabricClient
+	restoreReq *defangv1.RestoreBackupRequest
+}
+
+func (m *mockBackupsFabricClient) RestoreBackup(ctx context.Context, req *defangv1.RestoreBackupRequest) error {
+	m.restoreReq = req
+	return nil
+}
+
+func TestBackupCreate(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	backup, err := BackupCreate(t.Context(), client.MockFabricClient{}, "test", "beta", "db")
+	if err != nil {
+		t.Fatalf("BackupCreate() error = %v", err)
+	}
+	if backup.Id != "backup-1" || backup.Service != "db" || backup.Stack != "beta" {
+		t.Errorf("unexpected backup: %v", backup)
+	}
+	if !strings.Contains(stdout.String(), `Creating backup backup-1 of service "db"`) {
+		t.Errorf("unexpected output: %s", stdout.String())
+	}
+
+	t.Run("missing service", func(t *testing.T) {
+		_, err := BackupCreate(t.Context(), client.MockFabricClient{}, "test", "", "")
+		if err == nil || !strings.Contains(err.Error(), "missing service name") {
+			t.Errorf("BackupCreate() error = %v, want missing service name", err)
+		}
+	})
+}
+
+func TestBackupsList(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	if err := BackupsList(t.Context(), client.MockFabricClient{}, "test", "", ""); err != nil {
+		t.Fatalf("BackupsList() error = %v", err)
+	}
+
+	for _, expected := range []string{"ID", "SCHEDULED", "backup-1", "db", "completed", "5.0 MiB", "true"} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, stdout.String())
+		}
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	fabric := &mockBackupsFabricClient{}
+	if err := BackupRestore(t.Context(), fabric, "test", "beta", "backup-1"); err != nil {
+		t.Fatalf("BackupRestore() error = %v", err)
+	}
+	if fabric.restoreReq.Id != "backup-1" || fabric.restoreReq.Project != "test" || fabric.restoreReq.Stack != "beta" {
+		t.Errorf("unexpected request: %v", fabric.restoreReq)
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		ctx := WithOptions(t.Context(), Options{DryRun: true})
+
+		fabric := &mockBackupsFabricClient{}
+		if err := BackupRestore(ctx, fabric, "test", "", "backup-1"); err != dryrun.ErrDryRun {
+			t.Fatalf("Expected dryrun.ErrDryRun, got %v", err)
+		}
+		if fabric.restoreReq != nil {
+			t.Error("RestoreBackup should not be called in dry-run mode")
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type BucketLineItem struct {
+	Bucket     string
+	Public     bool
+	Versioning bool
+	Deployment string
+	State      string
+	UsedBy     string
+}
+
+var ErrNoBuckets = errors.New("no managed buckets found; add x-defang-bucket to a service to create one")
+
+// GetBuckets returns the managed buckets declared in the project, along with their deployment state.
+func GetBuckets(ctx context.Context, project *compose.Project, provider client.Provider) ([]BucketLineItem, error) {
+	opts := OptionsFromContext(ctx)
+	var buckets []BucketLineItem
+	for _, svccfg := range project.Services {
+		bucket := compose.GetBucket(&svccfg)
+		if bucket == nil {
+			continue
+		}
+		item := BucketLineItem{Bucket: svccfg.Name, State: "NOT_DEPLOYED", Public: bucket.Public, Versioning: bucket.Versioning}
+		var usedBy []string
+		for _, dependency := range project.Services {
+			if _, ok := dependency.DependsOn[svccfg.Name]; ok {
+				usedBy = append(usedBy, dependency.Name)
+			}
+		}
+		slices.Sort(usedBy)
+		item.UsedBy = strings.Join(usedBy, ",")
+		buckets = append(buckets, item)
+	}
+	if len(buckets) == 0 {
+		return nil, ErrNoBuckets
+	}
+	slices.SortFunc(buckets, func(a, b BucketLineItem) int {
+		return strings.Compare(a.Bucket, b.Bucket)
+	})
+
+	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: project.Name})
+	if err != nil {
+		opts.Term.Debugf("GetServices failed: %v", err)
+		return buckets, nil // show the declared buckets anyway
+	}
+	for _, serviceInfo := range servicesResponse.Services {
+		for i := range buckets {
+			if buckets[i].Bucket == serviceInfo.Service.Name {
+				buckets[i].Deployment = serviceInfo.Etag
+				buckets[i].State = serviceInfo.State.String()
+			}
+		}
+	}
+	return buckets, nil
+}
+
+func PrintBuckets(ctx context.Context, project *compose.Project, provider client.Provider) error {
+	opts := OptionsFromContext(ctx)
+	buckets, err := GetBuckets(ctx, project, provider)
+	if err != nil {
+		return err
+	}
+	return opts.Term.Table(buckets, "Bucket", "Public", "Versioning", "State", "Deployment", "UsedBy")
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+type mockBucketProvider struct {
+	client.MockProvider
+}
+
+func (mockBucketProvider) GetServices(context.Context, *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return &defangv1.GetServicesResponse{Services: []*defangv1.ServiceInfo{
+		{Service: &defangv1.Service{Name: "uploads"}, Etag: "a1b2c3", State: defangv1.ServiceState_DEPLOYMENT_COMPLETED},
+	}}, nil
+}
+
+func TestGetBuckets(t *testing.T) {
+	t.Run("no buckets", func(t *testing.T) {
+		project := &compose.Project{Name: "test", Services: compose.Services{"app": {Name: "app", Image: "app"}}}
+		_, err := GetBuckets(t.Context(), project, mockBucketProvider{})
+		if !errors.Is(err, ErrNoBuckets) {
+			t.Fatalf("expected ErrNoBuckets, got %v", err)
+		}
+	})
+
+	t.Run("buckets with dependents", func(t *testing.T) {
+		project := &compose.Project{Name: "test", Services: compose.Services{
+			"uploads": {Name: "uploads", Extensions: map[string]any{"x-defang-bucket": map[string]any{"public": true}}},
+			"backups": {Name: "backups", Extensions: map[string]any{"x-defang-bucket": true}},
+			"web":     {Name: "web", DependsOn: composeTypes.DependsOnConfig{"uploads": {}}},
+			"worker":  {Name: "worker", DependsOn: composeTypes.DependsOnConfig{"uploads": {}, "backups": {}}},
+		}}
+		buckets, err := GetBuckets(t.Context(), project, mockBucketProvider{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []BucketLineItem{
+			{Bucket: "backups", UsedBy: "worker", State: "NOT_DEPLOYED"},
+			{Bucket: "uploads", Public: true, UsedBy: "web,worker", State: "DEPLOYMENT_COMPLETED", Deployment: "a1b2c3"},
+		}
+		if len(buckets) != len(expected) {
+			t.Fatalf("expected %d buckets, got %d", len(expected), len(buckets))
+		}
+		for i := range expected {
+			if buckets[i] != expected[i] {
+				t.Errorf("expected %+v, got %+v", expected[i], buckets[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+var ErrBuildContextsNotSupported = errors.New("managing build contexts is not supported by this provider")
+
+// ErrImagePruneNotSupported is returned when pruning built images is requested. The CD doesn't record which image
+// each deployment uses, so no provider can tell which images are still needed; the registry's lifecycle policy
+// expires them instead.
+var ErrImagePruneNotSupported = errors.New("pruning built images is not supported by this provider; they are expired by the container registry's lifecycle policy")
+
+type ImageLineItem struct {
+	Service    string
+	Image      string
+	Digest     string
+	Built      bool
+	Deployment string
+}
+
+// GetDeployedImages returns the images referenced by the last deployment of the project.
+func GetDeployedImages(ctx context.Context, projectName string, provider client.Provider) ([]ImageLineItem, error) {
+	projUpdate, err := provider.GetProjectUpdate(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	if projUpdate == nil || len(projUpdate.Compose) == 0 {
+		return nil, fmt.Errorf("project %q has not been deployed", projectName)
+	}
+	project, err := compose.LoadFromContent(ctx, projUpdate.Compose, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []ImageLineItem
+	for _, svccfg := range project.Services {
+		if svccfg.Image == "" && svccfg.Build == nil {
+			continue // managed service
+		}
+		image, digest, _ := strings.Cut(svccfg.Image, "@")
+		images = append(images, ImageLineItem{
+			Service:    svccfg.Name,
+			Image:      image,
+			Digest:     digest,
+			Built:      svccfg.Build != nil,
+			Deployment: projUpdate.Etag,
+		})
+	}
+	slices.SortFunc(images, func(a, b ImageLineItem) int {
+		return strings.Compare(a.Service, b.Service)
+	})
+	return images, nil
+}
+
+func PrintDeployedImages(ctx context.Context, projectName string, provider client.Provider) error {
+	opts := OptionsFromContext(ctx)
+	images, err := GetDeployedImages(ctx, projectName, provider)
+	if err != nil {
+		return err
+	}
+	return opts.Term.Table(images, "Service", "Image", "Digest", "Built", "Deployment")
+}
+
+type PruneBuildsParams struct {
+	OlderThan time.Duration // 0 means any age
+	KeepLast  int
+	Images    bool // also prune built images; see ErrImagePruneNotSupported
+}
+
+type BuildContextLineItem struct {
+	Name         string
+	Size         int64
+	LastModified string
+}
+
+// PruneBuildContexts deletes old uploaded build contexts, except the most recent ones and those that are still needed.
+// The build contexts are shared by all projects and stacks in the CD bucket, so it keeps those used by the current
+// deployment of any project or stack, and those of earlier deployments that could be rolled back to. Nothing is
+// deleted if any of the deployments can't be checked.
+func PruneBuildContexts(ctx context.Context, fabric client.FabricClient, provider client.Provider, params PruneBuildsParams) error {
+	opts := OptionsFromContext(ctx)
+	if params.Images {
+		return ErrImagePruneNotSupported
+	}
+	manager, ok := provider.(client.BuildContextManager)
+	if !ok {
+		return ErrBuildContextsNotSupported
+	}
+	buildContexts, err := manager.ListBuildContexts(ctx)
+	if err != nil {
+		return err
+	}
+	inUse, err := getBuildContextsInUse(ctx, fabric, manager)
+	if err != nil {
+		return err
+	}
+
+	prune := selectBuildContextsToPrune(buildContexts, inUse, params.OlderThan, params.KeepLast, time.Now())
+	if len(prune) == 0 {
+		opts.Term.Info("No build contexts to prune")
+		return nil
+	}
+
+	items := make([]BuildContextLineItem, len(prune))
+	names := make([]string, len(prune))
+	var total int64
+	for i, bc := range prune {
+		items[i] = BuildContextLineItem{Name: bc.Name, Size: bc.Size, LastModified: bc.LastModified.Format(time.RFC3339)}
+		names[i] = bc.Name
+		total += bc.Size
+	}
+	if err := opts.Term.Table(items, "Name", "Size", "LastModified"); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+	if err := manager.DeleteBuildContexts(ctx, names); err != nil {
+		return err
+	}
+	opts.Term.Infof("Deleted %d build context(s), %d bytes", len(prune), total)
+	return nil
+}
+
+// getBuildContextsInUse returns the names of the build contexts referenced by the current deployment of every project
+// and stack, by the deployments in their history that Rollback could redeploy, and by the locally kept revisions.
+func getBuildContextsInUse(ctx context.Context, fabric client.FabricClient, manager client.BuildContextManager) ([]string, error) {
+	projects, err := manager.ListDeployedProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, deployed := range projects {
+		names = append(names, getBuildContextNames(ctx, deployed.Compose, deployed.Project)...)
+
+		// Same history as Rollback uses to find the deployment to roll back to
+		resp, err := fabric.ListDeployments(ctx, &defangv1.ListDeploymentsRequest{
+			Type:    defangv1.DeploymentType_DEPLOYMENT_TYPE_HISTORY,
+			Project: deployed.Project,
+			Stack:   deployed.Stack,
+			Limit:   100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range resp.Deployments {
+			names = append(names, getBuildContextNames(ctx, d.Compose, deployed.Project)...)
+		}
+	}
+
+	// The local revisions are used when the controller doesn't have the Compose file of a deployment
+	err = filepath.WalkDir(revisionsDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == revisionsDir && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll // no revisions kept yet
+			}
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ".yaml") {
+			return nil
+		}
+		composeFile, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		projectName := filepath.Base(filepath.Dir(filepath.Dir(path))) // revisions/<project>/<stack>/<etag>.yaml
+		names = append(names, getBuildContextNames(ctx, composeFile, projectName)...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the local revisions: %w", err)
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// getBuildContextNames returns the names of the build contexts referenced by the Compose file.
+func getBuildContextNames(ctx context.Context, composeFile []byte, projectName string) []string {
+	if len(composeFile) == 0 {
+		return nil
+	}
+	project, err := compose.LoadFromContent(ctx, composeFile, projectName)
+	if err != nil {
+		OptionsFromContext(ctx).Term.Debugf("Failed to load the Compose file of project %q: %v", projectName, err)
+		return nil
+	}
+	var names []string
+	for _, svccfg := range project.Services {
+		if svccfg.Build == nil {
+			continue
+		}
+		if u, err := url.Parse(svccfg.Build.Context); err == nil && u.Path != "" {
+			names = append(names, path.Base(u.Path))
+		}
+	}
+	return names
+}
+
+func selectBuildContextsToPrune(buildContexts []client.BuildContext, inUse []string, olderThan time.Duration, keepLast int, now time.Time) []client.BuildContext {
+	buildContexts = slices.Clone(buildContexts)
+	slices.SortFunc(buildContexts, func(a, b client.BuildContext) int {
+		return b.LastModified.Compare(a.LastModified) // newest first
+	})
+
+	var prune []client.BuildContext
+	for i, bc := range buildContexts {
+		if i < keepLast || slices.Contains(inUse, bc.Name) {
+			continue
+		}
+		if olderThan > 0 && now.Sub(bc.LastModified) < olderThan {
+			continue
+		}
+		prune = append(prune, bc)
+	}
+	return prune
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectBuildContextsToPrune(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	buildContexts := []client.BuildContext{
+		{Name: "a.tar.gz", LastModified: now.Add(-40 * 24 * time.Hour)},
+		{Name: "b.tar.gz", LastModified: now.Add(-1 * time.Hour)},
+		{Name: "c.tar.gz", LastModified: now.Add(-20 * 24 * time.Hour)},
+		{Name: "d.tar.gz", LastModified: now.Add(-50 * 24 * time.Hour)},
+	}
+	names := func(bcs []client.BuildContext) []string {
+		var names []string
+		for _, bc := range bcs {
+			names = append(names, bc.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name      string
+		inUse     []string
+		olderThan time.Duration
+		keepLast  int
+		want      []string
+	}{
+		{"all", nil, 0, 0, []string{"b.tar.gz", "c.tar.gz", "a.tar.gz", "d.tar.gz"}},
+		{"keep last", nil, 0, 2, []string{"a.tar.gz", "d.tar.gz"}},
+		{"older than", nil, 30 * 24 * time.Hour, 0, []string{"a.tar.gz", "d.tar.gz"}},
+		{"in use", []string{"d.tar.gz"}, 30 * 24 * time.Hour, 0, []string{"a.tar.gz"}},
+		{"keep all", nil, 0, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectBuildContextsToPrune(buildContexts, tt.inUse, tt.olderThan, tt.keepLast, now)
+			assert.Equal(t, tt.want, names(got))
+		})
+	}
+}
+
+func TestGetDeployedImages(t *testing.T) {
+	provider := mockEnvProvider{compose: []byte(`
+services:
+  api:
+    build:
+      context: https://bucket.s3.amazonaws.com/uploads/sha256-abc.tar.gz
+    image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api@sha256:0123
+  db:
+    image: postgres:16
+  cache:
+    x-defang-redis: true
+`)}
+
+	images, err := GetDeployedImages(t.Context(), "app", provider)
+	require.NoError(t, err)
+	expected := []ImageLineItem{
+		{Service: "api", Image: "123456789012.dkr.ecr.us-west-2.amazonaws.com/api", Digest: "sha256:0123", Built: true},
+		{Service: "db", Image: "postgres:16"},
+	}
+	assert.Equal(t, expected, images)
+}
+
+type mockBuildContextProvider struct {
+	client.MockProvider
+	projects []client.DeployedProject
+}
+
+func (m mockBuildContextProvider) ListBuildContexts(context.Context) ([]client.BuildContext, error) {
+	return nil, nil
+}
+
+func (m mockBuildContextProvider) DeleteBuildContexts(context.Context, []string) error {
+	return nil
+}
+
+func (m mockBuildContextProvider) ListDeployedProjects(context.Context) ([]client.DeployedProject, error) {
+	return m.projects, nil
+}
+
+func TestGetBuildContextsInUse(t *testing.T) {
+	oldDir := revisionsDir
+	t.Cleanup(func() { revisionsDir = oldDir })
+	revisionsDir = t.TempDir()
+
+	composeWithContext := func(name string) string {
+		return "services:\n  api:\n    build:\n      context: https://bucket.s3.amazonaws.com/uploads/" + name + "\n"
+	}
+	provider := mockBuildContextProvider{projects: []client.DeployedProject{
+		{Project: "app", Stack: "beta", Compose: []byte(composeWithContext("app.tar.gz"))},
+		{Project: "other", Stack: "beta", Compose: []byte(composeWithContext("other.tar.gz"))},
+		{Project: "down", Stack: "beta"}, // no Compose file
+	}}
+	fabric := mockRollbackFabricClient{deployments: []*defangv1.Deployment{
+		testDeployment("previous", 2, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, composeWithContext("previous.tar.gz")),
+		testDeployment("old", 3, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, ""),
+	}}
+	require.NoError(t, saveRevision("app", "beta", "local", []byte(composeWithContext("local.tar.gz"))))
+
+	inUse, err := getBuildContextsInUse(t.Context(), fabric, provider)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.tar.gz", "local.tar.gz", "other.tar.gz", "previous.tar.gz"}, inUse)
+}
+
+func TestGetBuildContextsInUseRevisionsError(t *testing.T) {
+	oldDir := revisionsDir
+	t.Cleanup(func() { revisionsDir = oldDir })
+	provider := mockBuildContextProvider{}
+	fabric := mockRollbackFabricClient{}
+
+	t.Run("no revisions", func(t *testing.T) {
+		revisionsDir = filepath.Join(t.TempDir(), "missing")
+		_, err := getBuildContextsInUse(t.Context(), fabric, provider)
+		assert.NoError(t, err)
+	})
+
+	t.Run("unreadable revision", func(t *testing.T) {
+		revisionsDir = t.TempDir()
+		stackDir := filepath.Join(revisionsDir, "app", "beta")
+		require.NoError(t, os.MkdirAll(stackDir, 0700))
+		require.NoError(t, os.Symlink(filepath.Join(stackDir, "missing"), filepath.Join(stackDir, "broken.yaml")))
+		_, err := getBuildContextsInUse(t.Context(), fabric, provider)
+		assert.Error(t, err, "an incomplete keep-set must not be used to prune")
+	})
+}
+
+func TestPruneImagesNotSupported(t *testing.T) {
+	err := PruneBuildContexts(t.Context(), mockRollbackFabricClient{}, mockBuildContextProvider{}, PruneBuildsParams{Images: true})
+	assert.ErrorIs(t, err, ErrImagePruneNotSupported)
+}
@@ -14,19 +14,19 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/state"
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
 	"github.com/DefangLabs/defang/src/pkg/logs"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
 )
 
 func CdCommand(ctx context.Context, projectName string, provider client.Provider, fabric client.FabricClient, command client.CdCommand) (types.ETag, error) {
+	opts := OptionsFromContext(ctx)
 	if projectName == "" { // projectName is empty for "list --remote"
-		term.Infof("Running CD command %q", command)
+		opts.Term.Infof("Running CD command %q", command)
 	} else {
-		term.Infof("Running CD command %q in project %q", command, projectName)
+		opts.Term.Infof("Running CD command %q in project %q", command, projectName)
 	}
-	if dryrun.DoDryRun {
+	if opts.DryRun {
 		return "", dryrun.ErrDryRun
 	}
 
@@ -49,7 +49,7 @@ func CdCommand(ctx context.Context, projectName string, provider client.Provider
 	case client.CdCommandDown, client.CdCommandDestroy:
 		err := deleteSubdomain(ctx, projectName, provider, fabric)
 		if err != nil {
-			term.Warn("Unable to update deployment history; deployment will proceed anyway.")
+			opts.Term.Warn("Unable to update deployment history; deployment will proceed anyway.")
 			break
 		}
 		// Update deployment table to mark deployment as destroyed only after successful deletion of the subdomain
@@ -64,8 +64,8 @@ func CdCommand(ctx context.Context, projectName string, provider client.Provider
 			EventsUrl:   eventsUrl,
 		})
 		if err != nil {
-			term.Debug("Failed to record deployment:", err)
-			term.Warn("Unable to update deployment history; deployment will proceed anyway.")
+			opts.Term.Debug("Failed to record deployment:", err)
+			opts.Term.Warn("Unable to update deployment history; deployment will proceed anyway.")
 		}
 	}
 	return etag, nil
@@ -79,9 +79,10 @@ func deleteSubdomain(ctx context.Context, projectName string, provider client.Pr
 	})
 	if err != nil {
 		// This can fail when the project was deployed from a different workspace than the current one
-		term.Debug("DeleteSubdomainZone failed:", err)
+		opts := OptionsFromContext(ctx)
+		opts.Term.Debug("DeleteSubdomainZone failed:", err)
 		if connect.CodeOf(err) == connect.CodeNotFound {
-			term.Warn("Subdomain not found; did you mean to destroy a different project or stack?")
+			opts.Term.Warn("Subdomain not found; did you mean to destroy a different project or stack?")
 		}
 		return err
 	}
@@ -120,7 +121,7 @@ func TailAndWaitForCD(ctx context.Context, provider client.Provider, projectName
 	// blocking call to tail
 	var tailErr error
 	if err := streamLogs(ctx, provider, projectName, tailOptions, logEntryPrintHandler); err != nil {
-		term.Debug("Tail stopped with", err, errors.Unwrap(err))
+		OptionsFromContext(ctx).Term.Debug("Tail stopped with", err, errors.Unwrap(err))
 		if !errors.Is(err, context.Canceled) {
 			tailErr = err
 		}
@@ -135,8 +136,9 @@ func SplitProjectStack(name string) (projectName string, stackName string) {
 }
 
 func CdListFromStorage(ctx context.Context, provider client.Provider, allRegions bool) error {
-	term.Debug("Running CD list")
-	if dryrun.DoDryRun {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debug("Running CD list")
+	if opts.DryRun {
 		return dryrun.ErrDryRun
 	}
 
@@ -161,10 +163,10 @@ func CdListFromStorage(ctx context.Context, provider client.Provider, allRegions
 		if allRegions {
 			accountInfo.Region = ""
 		}
-		term.Printf("No projects found in %v\n", accountInfo)
+		opts.Term.Printf("No projects found in %v\n", accountInfo)
 	}
 
-	return term.Table(stacks, "Project", "Stack", "Workspace", "Region")
+	return opts.Term.Table(stacks, "Project", "Stack", "Workspace", "Region")
 }
 
 func GetStatesAndEventsUploadUrls(ctx context.Context, projectName string, provider client.Provider, fabric client.FabricClient) (statesUrl string, eventsUrl string, err error) {
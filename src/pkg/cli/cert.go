@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,7 +18,6 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	"github.com/DefangLabs/defang/src/pkg/dns"
 	"github.com/DefangLabs/defang/src/pkg/spinner"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
 )
@@ -69,15 +69,21 @@ var (
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			term.Debugf("Redirecting from %v to %v", via[len(via)-1].URL, req.URL)
+			OptionsFromContext(req.Context()).Term.Debugf("Redirecting from %v to %v", via[len(via)-1].URL, req.URL)
 			return nil
 		},
 	}
 	httpRetryDelayBase = 5 * time.Second
 )
 
-func GenerateLetsEncryptCert(ctx context.Context, project *compose.Project, client client.FabricClient, provider client.Provider) error {
-	term.Debugf("Generating TLS cert for project %q", project.Name)
+func GenerateLetsEncryptCert(ctx context.Context, project *compose.Project, client client.FabricClient, provider client.Provider, dnsProvider DNSProvider) error {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Generating TLS cert for project %q", project.Name)
+
+	records, err := newDNSRecordManager(ctx, dnsProvider)
+	if err != nil {
+		return err
+	}
 
 	services, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: project.Name})
 	if err != nil {
@@ -89,29 +95,37 @@ func GenerateLetsEncryptCert(ctx context.Context, project *compose.Project, clie
 		if service, ok := project.Services[serviceInfo.Service.Name]; ok && service.DomainName != "" && serviceInfo.ZoneId == "" {
 			cnt++
 			targets := getDomainTargets(serviceInfo, service)
-			domains := []string{service.DomainName}
-			if defaultNetwork := service.Networks["default"]; defaultNetwork != nil {
-				domains = append(domains, defaultNetwork.Aliases...)
-			}
-			term.Debugf("Found service %v with domains %v and targets %v", service.Name, domains, targets)
+			domains := getServiceDomains(service)
+			opts.Term.Debugf("Found service %v with domains %v and targets %v", service.Name, domains, targets)
 			for _, domain := range domains {
-				generateCert(ctx, domain, targets, client)
+				generateCert(ctx, domain, targets, client, records)
 			}
 		}
 	}
 	if cnt == 0 {
-		term.Infof("No `domainname` found in compose file; no HTTPS cert generation needed")
+		opts.Term.Infof("No `domainname` found in compose file; no HTTPS cert generation needed")
 	}
 
 	return nil
 }
 
+func getServiceDomains(service compose.ServiceConfig) []string {
+	domains := compose.GetDomainNames(&service)
+	if defaultNetwork := service.Networks["default"]; defaultNetwork != nil {
+		domains = append(domains, defaultNetwork.Aliases...)
+	}
+	return domains
+}
+
 func getDomainTargets(serviceInfo *defangv1.ServiceInfo, service compose.ServiceConfig) []string {
 	// Only use the ALB for aws cert gen to avoid defang domain in the middle
 	if serviceInfo.LbDnsName != "" {
 		return []string{serviceInfo.LbDnsName}
 	} else {
-		targets := []string{serviceInfo.PublicFqdn}
+		var targets []string
+		if serviceInfo.PublicFqdn != "" {
+			targets = append(targets, serviceInfo.PublicFqdn)
+		}
 		for i, endpoint := range serviceInfo.Endpoints {
 			if service.Ports[i].Mode == compose.Mode_INGRESS {
 				targets = append(targets, endpoint)
@@ -120,43 +134,70 @@ func getDomainTargets(serviceInfo *defangv1.ServiceInfo, service compose.Service
 		return targets
 	}
 }
-func generateCert(ctx context.Context, domain string, targets []string, client client.FabricClient) {
-	term.Infof("Checking DNS setup for %v", domain)
+
+func generateCert(ctx context.Context, domain string, targets []string, client client.FabricClient, records dnsRecordManager) {
+	opts := OptionsFromContext(ctx)
+	if len(targets) == 0 {
+		opts.Term.Warnf("Skipping TLS cert generation for %v: the service has no public endpoint to point the domain to", domain)
+		return
+	}
+	opts.Term.Infof("Checking DNS setup for %v", domain)
+	if records != nil {
+		createDNSRecords(ctx, PublicCertChecker{}, domain, targets, records)
+	}
 	if err := waitForCNAME(ctx, domain, targets, client); err != nil {
-		term.Errorf("Error waiting for CNAME: %v", err)
+		opts.Term.Errorf("Error waiting for CNAME: %v", err)
 		return
 	}
 
-	term.Infof("%v DNS is properly configured!", domain)
+	opts.Term.Infof("%v DNS is properly configured!", domain)
 	if err := cert.CheckTLSCert(ctx, domain); err == nil {
-		term.Infof("TLS cert for %v is already ready", domain)
+		opts.Term.Infof("TLS cert for %v is already ready", domain)
 		return
 	}
 	if err := pkg.SleepWithContext(ctx, 5*time.Second); err != nil { // slight delay to ensure DNS to propagate
-		term.Errorf("Error waiting for DNS propagation: %v", err)
+		opts.Term.Errorf("Error waiting for DNS propagation: %v", err)
 		return
 	}
-	term.Infof("Triggering cert generation for %v", domain)
+	opts.Term.Infof("Triggering cert generation for %v", domain)
 	if err := triggerCertGeneration(ctx, domain); err != nil {
-		term.Errorf("Error triggering cert generation, please try again")
+		opts.Term.Errorf("Error triggering cert generation, please try again")
 		return
 	}
 
-	term.Infof("Waiting for TLS cert to be online for %v, this could take a few minutes", domain)
+	opts.Term.Infof("Waiting for TLS cert to be online for %v, this could take a few minutes", domain)
 	if err := waitForTLS(ctx, domain); err != nil {
-		term.Errorf("Error waiting for TLS to be online: %v", err)
+		opts.Term.Errorf("Error waiting for TLS to be online: %v", err)
 		// FIXME: Add more info on how to debug, possibly provided by the server side to avoid client type detection here
 		return
 	}
 
-	term.Infof("TLS cert for %v is ready\n", domain)
+	opts.Term.Infof("TLS cert for %v is ready\n", domain)
+}
+
+// createDNSRecords creates the CNAME record that routes the domain to the load balancer, unless the domain already
+// resolves to it, and authorizes Let's Encrypt in the CAA records, so the certificate can be issued.
+func createDNSRecords(ctx context.Context, checker CertChecker, domain string, targets []string, records dnsRecordManager) {
+	opts := OptionsFromContext(ctx)
+	if !checker.CheckDomainDNSReady(ctx, domain, slices.Clone(targets)) {
+		opts.Term.Infof("Creating CNAME record %v -> %v", domain, targets[0])
+		if err := records.UpsertCNAMERecord(ctx, domain, targets[0]); err != nil {
+			opts.Term.Warnf("Failed to create CNAME record for %v: %v", domain, err)
+		}
+	}
+	if name, err := records.AllowCAAIssuer(ctx, domain, letsEncryptCAA); err != nil {
+		opts.Term.Warnf("Failed to check the CAA records for %v: %v", domain, err)
+	} else if name != "" {
+		opts.Term.Infof("Added a CAA record to %v to allow Let's Encrypt to issue the certificate for %v", name, domain)
+	}
 }
 
 func triggerCertGeneration(ctx context.Context, domain string) error {
-	doSpinner := term.StdoutCanColor() && term.IsTerminal()
+	opts := OptionsFromContext(ctx)
+	doSpinner := opts.Term.StdoutCanColor() && opts.Term.IsTerminal()
 	if doSpinner {
-		term.HideCursor()
-		defer term.ShowCursor()
+		opts.Term.HideCursor()
+		defer opts.Term.ShowCursor()
 
 		spin := spinner.New()
 		cancelSpinner := spin.Start(ctx)
@@ -165,22 +206,23 @@ func triggerCertGeneration(ctx context.Context, domain string) error {
 	// Our own retry logic uses the root resolver to prevent cached DNS and retry on all non-200 errors
 	if err := getWithRetries(ctx, fmt.Sprintf("http://%v", domain), 5); err != nil { // Retry incase of DNS error
 		// Ignore possible tls error as cert attachment may take time
-		term.Debugf("Error triggering cert generation: %v", err)
+		opts.Term.Debugf("Error triggering cert generation: %v", err)
 		return err
 	}
 	return nil
 }
 
 func waitForTLS(ctx context.Context, domain string) error {
+	opts := OptionsFromContext(ctx)
 	ticker := time.NewTicker(3 * time.Second)
 	defer ticker.Stop()
 	timeout, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
-	doSpinner := term.StdoutCanColor() && term.IsTerminal()
+	doSpinner := opts.Term.StdoutCanColor() && opts.Term.IsTerminal()
 	if doSpinner {
-		term.HideCursor()
-		defer term.ShowCursor()
+		opts.Term.HideCursor()
+		defer opts.Term.ShowCursor()
 
 		spin := spinner.New()
 		cancelSpinner := spin.Start(ctx)
@@ -194,13 +236,14 @@ func waitForTLS(ctx context.Context, domain string) error {
 			if err := cert.CheckTLSCert(timeout, domain); err == nil {
 				return nil
 			} else {
-				term.Debugf("Error checking TLS cert for %v: %v", domain, err)
+				opts.Term.Debugf("Error checking TLS cert for %v: %v", domain, err)
 			}
 		}
 	}
 }
 
 func waitForCNAME(ctx context.Context, domain string, targets []string, client client.FabricClient) error {
+	opts := OptionsFromContext(ctx)
 	for i, target := range targets {
 		targets[i] = dns.Normalize(target)
 	}
@@ -210,10 +253,10 @@ func waitForCNAME(ctx context.Context, domain string, targets []string, client c
 
 	serverSideVerified := false
 	serverVerifyRpcFailure := 0
-	doSpinner := term.StdoutCanColor() && term.IsTerminal()
+	doSpinner := opts.Term.StdoutCanColor() && opts.Term.IsTerminal()
 	if doSpinner {
-		term.HideCursor()
-		defer term.ShowCursor()
+		opts.Term.HideCursor()
+		defer opts.Term.ShowCursor()
 
 		spin := spinner.New()
 		cancelSpinner := spin.Start(ctx)
@@ -223,24 +266,24 @@ func waitForCNAME(ctx context.Context, domain string, targets []string, client c
 	verifyDNS := func() error {
 		if !serverSideVerified && serverVerifyRpcFailure < 3 {
 			if err := client.VerifyDNSSetup(ctx, &defangv1.VerifyDNSSetupRequest{Domain: domain, Targets: targets}); err == nil {
-				term.Debugf("Server side DNS verification for %v successful", domain)
+				opts.Term.Debugf("Server side DNS verification for %v successful", domain)
 				serverSideVerified = true
 			} else {
 				if cerr := new(connect.Error); errors.As(err, &cerr) && cerr.Code() == connect.CodeFailedPrecondition {
-					term.Debugf("Server side DNS verification negative result: %v", cerr.Message())
+					opts.Term.Debugf("Server side DNS verification negative result: %v", cerr.Message())
 				} else {
-					term.Debugf("Server side DNS verification request for %v failed: %v", domain, err)
+					opts.Term.Debugf("Server side DNS verification request for %v failed: %v", domain, err)
 					serverVerifyRpcFailure++
 				}
 			}
 			if serverVerifyRpcFailure >= 3 {
-				term.Warnf("Server side DNS verification for %v failed multiple times, skipping server side DNS verification.", domain)
+				opts.Term.Warnf("Server side DNS verification for %v failed multiple times, skipping server side DNS verification.", domain)
 			}
 		}
 		if serverSideVerified || serverVerifyRpcFailure >= 3 {
 			locallyVerified := dns.CheckDomainDNSReady(ctx, domain, targets)
 			if serverSideVerified && !locallyVerified {
-				term.Warnf("DNS settings for %v are verified, but changes may take a few minutes to propagate due to caching.", domain)
+				opts.Term.Warnf("DNS settings for %v are verified, but changes may take a few minutes to propagate due to caching.", domain)
 				return nil
 			}
 			if locallyVerified {
@@ -253,9 +296,9 @@ func waitForCNAME(ctx context.Context, domain string, targets []string, client c
 	if err := verifyDNS(); err == nil {
 		return nil
 	}
-	term.Infof("Configure a CNAME or ALIAS record for the domain name: %v", domain)
-	term.Printf("  %v  -> %v\n", domain, strings.Join(targets, " or "))
-	term.Infof("Awaiting DNS record setup and propagation... This may take a while.")
+	opts.Term.Infof("Configure a CNAME or ALIAS record for the domain name: %v", domain)
+	opts.Term.Printf("  %v  -> %v\n", domain, strings.Join(targets, " or "))
+	opts.Term.Infof("Awaiting DNS record setup and propagation... This may take a while.")
 
 	for {
 		select {
@@ -270,6 +313,7 @@ func waitForCNAME(ctx context.Context, domain string, targets []string, client c
 }
 
 func getWithRetries(ctx context.Context, url string, tries int) error {
+	opts := OptionsFromContext(ctx)
 	var errs []error
 	for i := range make([]struct{}, tries) {
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -284,18 +328,18 @@ func getWithRetries(ctx context.Context, url string, tries int) error {
 				return nil
 			}
 			if resp != nil && resp.Request != nil && resp.Request.URL.Scheme == "https" {
-				term.Debugf("cert gen request success, received redirect to %v", resp.Request.URL)
+				opts.Term.Debugf("cert gen request success, received redirect to %v", resp.Request.URL)
 				return nil // redirect to https indicate a successful cert generation
 			}
 			if err == nil {
 				err = fmt.Errorf("HTTP: %v", resp.StatusCode)
 			}
 		} else if cve := new(tls.CertificateVerificationError); errors.As(err, &cve) {
-			term.Debugf("cert gen request success, received tls error: %v", cve)
+			opts.Term.Debugf("cert gen request success, received tls error: %v", cve)
 			return nil // tls error indicate a successful cert gen trigger, as it has to be redirected to https
 		}
 
-		term.Debugf("Error fetching %v: %v, tries left %v", url, err, tries-i-1)
+		opts.Term.Debugf("Error fetching %v: %v, tries left %v", url, err, tries-i-1)
 		errs = append(errs, err)
 
 		delay := httpRetryDelayBase << i // Simple exponential backoff
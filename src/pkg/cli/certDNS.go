@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/clouds/aws"
+	"github.com/DefangLabs/defang/src/pkg/clouds/cloudflare"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+)
+
+// DNSProvider is the DNS service used to automatically create the records that a domain needs for its certificate:
+// the CNAME record that routes the domain to the load balancer, through which Let's Encrypt validates the domain over
+// HTTP, and a CAA record that authorizes Let's Encrypt, if the zone restricts the CAs that can issue certificates.
+type DNSProvider string
+
+const (
+	DNSProviderNone       DNSProvider = ""
+	DNSProviderRoute53    DNSProvider = "route53"
+	DNSProviderCloudflare DNSProvider = "cloudflare"
+)
+
+var AllDNSProviders = []DNSProvider{DNSProviderRoute53, DNSProviderCloudflare}
+
+func (d DNSProvider) String() string {
+	return string(d)
+}
+
+func (d *DNSProvider) Set(s string) error {
+	provider := DNSProvider(strings.ToLower(s))
+	for _, p := range AllDNSProviders {
+		if p == provider {
+			*d = provider
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid DNS provider: %q, not one of %v", s, AllDNSProviders)
+}
+
+func (d DNSProvider) Type() string {
+	return "dns-provider"
+}
+
+// letsEncryptCAA is the CA domain of Let's Encrypt in CAA records
+const letsEncryptCAA = "letsencrypt.org"
+
+// dnsRecordManager creates the DNS records of a domain in the DNS service of the user.
+type dnsRecordManager interface {
+	// UpsertCNAMERecord creates or updates the CNAME record for the domain pointing to the target
+	UpsertCNAMERecord(ctx context.Context, domain, target string) error
+	// AllowCAAIssuer authorizes the CA in the CAA records that apply to the domain, and returns the name of the updated
+	// record, or "" if the CA was already authorized
+	AllowCAAIssuer(ctx context.Context, domain, issuer string) (string, error)
+}
+
+type route53RecordManager struct {
+	r53 aws.Route53API
+}
+
+func (r route53RecordManager) UpsertCNAMERecord(ctx context.Context, domain, target string) error {
+	return aws.UpsertCNAMERecord(ctx, domain, target, r.r53)
+}
+
+func (r route53RecordManager) AllowCAAIssuer(ctx context.Context, domain, issuer string) (string, error) {
+	return aws.AllowCAAIssuer(ctx, domain, issuer, r.r53)
+}
+
+func newDNSRecordManager(ctx context.Context, dnsProvider DNSProvider) (dnsRecordManager, error) {
+	switch dnsProvider {
+	case DNSProviderNone:
+		return nil, nil
+	case DNSProviderRoute53:
+		cfg, err := aws.LoadDefaultConfig(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Region == "" {
+			cfg.Region = "us-east-1" // Route53 is a global service
+		}
+		return route53RecordManager{r53: route53.NewFromConfig(cfg)}, nil
+	case DNSProviderCloudflare:
+		cf, err := cloudflare.NewClientFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		return cf, nil
+	default:
+		return nil, fmt.Errorf("unsupported DNS provider: %q", dnsProvider)
+	}
+}
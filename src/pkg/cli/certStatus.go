@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cert"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/dns"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+const (
+	CertStatusIssued     = "ISSUED"
+	CertStatusRenewalDue = "RENEWAL_DUE"
+	CertStatusExpired    = "EXPIRED"
+	CertStatusPending    = "PENDING"
+	CertStatusPendingDNS = "PENDING_DNS"
+)
+
+type CertLineItem struct {
+	Domain  string
+	Service string
+	DNS     string
+	Status  string
+	Issuer  string
+	Expires string
+}
+
+// CertChecker looks up the DNS records and the TLS certificate that are served for a domain.
+type CertChecker interface {
+	GetTLSCert(ctx context.Context, domain string) (*x509.Certificate, error)
+	CheckDomainDNSReady(ctx context.Context, domain string, targets []string) bool
+}
+
+// PublicCertChecker checks the domain on the public internet, bypassing any cached DNS.
+type PublicCertChecker struct{}
+
+func (PublicCertChecker) GetTLSCert(ctx context.Context, domain string) (*x509.Certificate, error) {
+	return cert.GetTLSCert(ctx, domain)
+}
+
+func (PublicCertChecker) CheckDomainDNSReady(ctx context.Context, domain string, targets []string) bool {
+	return dns.CheckDomainDNSReady(ctx, domain, targets)
+}
+
+// GetCertStatus returns the issuance and renewal state of the TLS certificate for each custom domain in the project.
+func GetCertStatus(ctx context.Context, project *compose.Project, provider client.Provider, checker CertChecker) ([]CertLineItem, error) {
+	services, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: project.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []CertLineItem
+	for _, serviceInfo := range services.Services {
+		service, ok := project.Services[serviceInfo.Service.Name]
+		if !ok || service.DomainName == "" {
+			continue
+		}
+		targets := getDomainTargets(serviceInfo, service)
+		for _, domain := range getServiceDomains(service) {
+			certs = append(certs, getCertLineItem(ctx, checker, domain, service.Name, serviceInfo.ZoneId != "", targets))
+		}
+	}
+	slices.SortFunc(certs, func(a, b CertLineItem) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+	return certs, nil
+}
+
+func getCertLineItem(ctx context.Context, checker CertChecker, domain, service string, managed bool, targets []string) CertLineItem {
+	opts := OptionsFromContext(ctx)
+	item := CertLineItem{Domain: domain, Service: service}
+	switch {
+	case managed:
+		item.DNS = "MANAGED" // DNS and cert validation are handled by the provider in the delegated zone
+	case checker.CheckDomainDNSReady(ctx, domain, slices.Clone(targets)):
+		item.DNS = "OK"
+	default:
+		item.DNS = "PENDING"
+	}
+
+	leaf, err := checker.GetTLSCert(ctx, domain)
+	if err != nil {
+		opts.Term.Debugf("Error getting TLS cert for %v: %v", domain, err)
+		var cie x509.CertificateInvalidError
+		switch {
+		case errors.As(err, &cie) && cie.Reason == x509.Expired:
+			item.Status = CertStatusExpired
+		case item.DNS == "PENDING":
+			item.Status = CertStatusPendingDNS
+		default:
+			item.Status = CertStatusPending
+		}
+		return item
+	}
+
+	item.Issuer = leaf.Issuer.CommonName
+	if len(leaf.Issuer.Organization) > 0 {
+		item.Issuer = leaf.Issuer.Organization[0]
+	}
+	item.Expires = leaf.NotAfter.Format(time.DateOnly)
+	if time.Until(leaf.NotAfter) < certExpiryWarning {
+		item.Status = CertStatusRenewalDue
+	} else {
+		item.Status = CertStatusIssued
+	}
+	return item
+}
+
+func PrintCertStatus(ctx context.Context, project *compose.Project, provider client.Provider) error {
+	opts := OptionsFromContext(ctx)
+	certs, err := GetCertStatus(ctx, project, provider, PublicCertChecker{})
+	if err != nil {
+		return err
+	}
+	if len(certs) == 0 {
+		opts.Term.Infof("No `domainname` found in compose file; no HTTPS cert needed")
+		return nil
+	}
+	return opts.Term.Table(certs, "Domain", "Service", "DNS", "Status", "Issuer", "Expires")
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+type mockCertStatusProvider struct {
+	client.Provider
+}
+
+func (mockCertStatusProvider) GetServices(ctx context.Context, req *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return &defangv1.GetServicesResponse{
+		Project: req.Project,
+		Services: []*defangv1.ServiceInfo{
+			{Service: &defangv1.Service{Name: "api"}, LbDnsName: "lb.example.net"},
+			{Service: &defangv1.Service{Name: "web"}, LbDnsName: "lb.example.net", ZoneId: "Z123"},
+			{Service: &defangv1.Service{Name: "worker"}},
+		},
+	}, nil
+}
+
+type mockCertChecker struct {
+	certs      map[string]*x509.Certificate
+	errs       map[string]error
+	pendingDNS []string
+}
+
+func (m mockCertChecker) GetTLSCert(ctx context.Context, domain string) (*x509.Certificate, error) {
+	if cert, ok := m.certs[domain]; ok {
+		return cert, nil
+	}
+	if err, ok := m.errs[domain]; ok {
+		return nil, err
+	}
+	return nil, errors.New("tls: handshake failure")
+}
+
+func (m mockCertChecker) CheckDomainDNSReady(ctx context.Context, domain string, targets []string) bool {
+	return !slices.Contains(m.pendingDNS, domain)
+}
+
+func TestGetCertStatus(t *testing.T) {
+	project := &compose.Project{
+		Name: "test",
+		Services: composeTypes.Services{
+			"api": {
+				Name:       "api",
+				DomainName: "api.example.com",
+				Networks: map[string]*composeTypes.ServiceNetworkConfig{
+					"default": {Aliases: []string{"old.example.com", "new.example.com", "soon.example.com"}},
+				},
+			},
+			"web":    {Name: "web", DomainName: "www.example.com"},
+			"worker": {Name: "worker"},
+		},
+	}
+
+	notAfter := time.Now().Add(60 * 24 * time.Hour)
+	checker := mockCertChecker{
+		certs: map[string]*x509.Certificate{
+			"api.example.com":  {Issuer: pkix.Name{Organization: []string{"Let's Encrypt"}, CommonName: "R11"}, NotAfter: notAfter},
+			"www.example.com":  {Issuer: pkix.Name{Organization: []string{"Let's Encrypt"}, CommonName: "R11"}, NotAfter: notAfter},
+			"soon.example.com": {Issuer: pkix.Name{CommonName: "R11"}, NotAfter: time.Now().Add(24 * time.Hour)},
+		},
+		errs: map[string]error{
+			"old.example.com": x509.CertificateInvalidError{Reason: x509.Expired},
+		},
+		pendingDNS: []string{"new.example.com"},
+	}
+
+	certs, err := GetCertStatus(t.Context(), project, mockCertStatusProvider{}, checker)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expires := notAfter.Format(time.DateOnly)
+	expected := []CertLineItem{
+		{Domain: "api.example.com", Service: "api", DNS: "OK", Status: CertStatusIssued, Issuer: "Let's Encrypt", Expires: expires},
+		{Domain: "new.example.com", Service: "api", DNS: "PENDING", Status: CertStatusPendingDNS},
+		{Domain: "old.example.com", Service: "api", DNS: "OK", Status: CertStatusExpired},
+		{Domain: "soon.example.com", Service: "api", DNS: "OK", Status: CertStatusRenewalDue, Issuer: "R11", Expires: time.Now().Add(24 * time.Hour).Format(time.DateOnly)},
+		{Domain: "www.example.com", Service: "web", DNS: "MANAGED", Status: CertStatusIssued, Issuer: "Let's Encrypt", Expires: expires},
+	}
+	if len(certs) != len(expected) {
+		t.Fatalf("expected %d certs, got %d: %+v", len(expected), len(certs), certs)
+	}
+	for i, cert := range certs {
+		if cert != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], cert)
+		}
+	}
+}
+
+func TestDNSProviderSet(t *testing.T) {
+	var p DNSProvider
+	if err := p.Set("Route53"); err != nil || p != DNSProviderRoute53 {
+		t.Errorf("expected route53, got %q, %v", p, err)
+	}
+	if err := p.Set("cloudflare"); err != nil || p != DNSProviderCloudflare {
+		t.Errorf("expected cloudflare, got %q, %v", p, err)
+	}
+	if err := p.Set("godaddy"); err == nil {
+		t.Error("expected error for unsupported DNS provider")
+	}
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/dns"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+const certExpiryWarning = 30 * 24 * time.Hour
+
+type UploadCertParams struct {
+	Domain   string
+	CertFile string
+	KeyFile  string
+	Stack    string
+}
+
+// UploadCert uploads a user-provided TLS certificate and binds it to the ingress services for the domain.
+func UploadCert(ctx context.Context, project *compose.Project, fabric client.FabricClient, params UploadCertParams) error {
+	opts := OptionsFromContext(ctx)
+	domain := dns.Normalize(params.Domain)
+	if domain == "" {
+		return errors.New("missing domain name; use --domain to specify the domain of the certificate")
+	}
+
+	certPEM, err := os.ReadFile(params.CertFile)
+	if err != nil {
+		return fmt.Errorf("failed to read certificate: %w", err)
+	}
+	keyPEM, err := os.ReadFile(params.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	leaf, err := parseCertificate(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+	if err := leaf.VerifyHostname(domain); err != nil {
+		return fmt.Errorf("certificate is not valid for %q: %w", domain, err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return fmt.Errorf("certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+	if time.Until(leaf.NotAfter) < certExpiryWarning {
+		opts.Term.Warnf("certificate for %q expires on %s", domain, leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	services := getIngressServicesForDomain(project, domain)
+	if len(services) == 0 {
+		opts.Term.Warnf("no ingress service in project %q uses domain %q; the certificate will be bound on the next deployment", project.Name, domain)
+	}
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	err = fabric.PutCertificate(ctx, &defangv1.PutCertificateRequest{
+		Project:        project.Name,
+		Stack:          params.Stack,
+		Domain:         domain,
+		CertificatePem: certPEM,
+		PrivateKeyPem:  keyPEM,
+		Services:       services,
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.Term.Infof("Uploaded certificate for %q (expires %s)", domain, leaf.NotAfter.Format(time.DateOnly))
+	if len(services) > 0 {
+		opts.Term.Info("Bound certificate to service(s):", strings.Join(services, ", "))
+	}
+	return nil
+}
+
+func parseCertificate(certPEM, keyPEM []byte) (*x509.Certificate, error) {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate or private key: %w", err)
+	}
+	leaf := pair.Leaf
+	if leaf == nil {
+		if leaf, err = x509.ParseCertificate(pair.Certificate[0]); err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+	}
+	return leaf, nil
+}
+
+// getIngressServicesForDomain returns the ingress services that use the domain. Other services are not bound, even
+// if the certificate is valid for their domain too, eg. with a wildcard certificate.
+func getIngressServicesForDomain(project *compose.Project, domain string) []string {
+	var services []string
+	for _, service := range project.Services {
+		if service.DomainName == "" || !slices.ContainsFunc(service.Ports, func(port composeTypes.ServicePortConfig) bool {
+			return port.Mode == compose.Mode_INGRESS
+		}) {
+			continue
+		}
+		if slices.Contains(compose.GetDomainNames(&service), domain) {
+			services = append(services, service.Name)
+		}
+	}
+	slices.Sort(services)
+	return services
+}
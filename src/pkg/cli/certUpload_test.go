@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPutCertificateClient struct {
+	client.MockFabricClient
+	req *defangv1.PutCertificateRequest
+}
+
+func (m *mockPutCertificateClient) PutCertificate(ctx context.Context, req *defangv1.PutCertificateRequest) error {
+	m.req = req
+	return nil
+}
+
+func writeTestCert(t *testing.T, notAfter time.Time, dnsNames ...string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func TestUploadCert(t *testing.T) {
+	project := &compose.Project{
+		Name: "test",
+		Services: composeTypes.Services{
+			"web":    {Name: "web", DomainName: "app.example.com", Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS}}},
+			"api":    {Name: "api", DomainName: "api.example.com", Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS}}},
+			"worker": {Name: "worker", DomainName: "app.example.com"},
+		},
+	}
+
+	t.Run("binds matching ingress services", func(t *testing.T) {
+		certFile, keyFile := writeTestCert(t, time.Now().Add(90*24*time.Hour), "app.example.com")
+		fabric := &mockPutCertificateClient{}
+		err := UploadCert(t.Context(), project, fabric, UploadCertParams{Domain: "App.Example.com.", CertFile: certFile, KeyFile: keyFile, Stack: "beta"})
+		require.NoError(t, err)
+		assert.Equal(t, "app.example.com", fabric.req.Domain)
+		assert.Equal(t, "beta", fabric.req.Stack)
+		assert.Equal(t, []string{"web"}, fabric.req.Services)
+	})
+
+	t.Run("wildcard certificate", func(t *testing.T) {
+		certFile, keyFile := writeTestCert(t, time.Now().Add(90*24*time.Hour), "*.example.com")
+		fabric := &mockPutCertificateClient{}
+		err := UploadCert(t.Context(), project, fabric, UploadCertParams{Domain: "api.example.com", CertFile: certFile, KeyFile: keyFile})
+		require.NoError(t, err)
+		assert.Equal(t, "api.example.com", fabric.req.Domain)
+		assert.Equal(t, []string{"api"}, fabric.req.Services, "only the service using --domain should be bound")
+	})
+
+	t.Run("wildcard domain", func(t *testing.T) {
+		wildcard := &compose.Project{
+			Name: "test",
+			Services: composeTypes.Services{
+				"web": {Name: "web", DomainName: "*.example.com", Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS}}},
+				"api": {Name: "api", DomainName: "api.example.com", Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS}}},
+			},
+		}
+		certFile, keyFile := writeTestCert(t, time.Now().Add(90*24*time.Hour), "*.example.com")
+		fabric := &mockPutCertificateClient{}
+		err := UploadCert(t.Context(), wildcard, fabric, UploadCertParams{Domain: "*.example.com", CertFile: certFile, KeyFile: keyFile})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"web"}, fabric.req.Services)
+	})
+
+	t.Run("domain mismatch", func(t *testing.T) {
+		certFile, keyFile := writeTestCert(t, time.Now().Add(90*24*time.Hour), "other.example.org")
+		err := UploadCert(t.Context(), project, &mockPutCertificateClient{}, UploadCertParams{Domain: "app.example.com", CertFile: certFile, KeyFile: keyFile})
+		assert.ErrorContains(t, err, `certificate is not valid for "app.example.com"`)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		certFile, keyFile := writeTestCert(t, time.Now().Add(-time.Minute), "app.example.com")
+		err := UploadCert(t.Context(), project, &mockPutCertificateClient{}, UploadCertParams{Domain: "app.example.com", CertFile: certFile, KeyFile: keyFile})
+		assert.ErrorContains(t, err, "certificate expired")
+	})
+
+	t.Run("key mismatch", func(t *testing.T) {
+		certFile, _ := writeTestCert(t, time.Now().Add(90*24*time.Hour), "app.example.com")
+		_, keyFile := writeTestCert(t, time.Now().Add(90*24*time.Hour), "app.example.com")
+		err := UploadCert(t.Context(), project, &mockPutCertificateClient{}, UploadCertParams{Domain: "app.example.com", CertFile: certFile, KeyFile: keyFile})
+		assert.ErrorContains(t, err, "invalid certificate or private key")
+	})
+}
@@ -16,7 +16,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	composetypes "github.com/compose-spec/compose-go/v2/types"
 )
@@ -295,6 +297,12 @@ func TestGetDomainTargets(t *testing.T) {
 			},
 			expected: []string{"app.defang.app", "8080--app.defang.app"},
 		},
+		{
+			name:        "no public fqdn nor lb dns name",
+			serviceInfo: &defangv1.ServiceInfo{},
+			service:     compose.ServiceConfig{},
+			expected:    nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -311,3 +319,48 @@ func TestGetDomainTargets(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateCertWithoutTargets(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	records := &mockDNSRecordManager{cnames: map[string]string{}}
+	generateCert(t.Context(), "app.example.com", nil, client.MockFabricClient{}, records)
+	if len(records.cnames) != 0 || len(records.caa) != 0 {
+		t.Errorf("unexpected DNS records: %v, %v", records.cnames, records.caa)
+	}
+
+	if !strings.Contains(stdout.String(), "Skipping TLS cert generation for app.example.com") {
+		t.Errorf("expected a warning, got %q", stdout.String())
+	}
+}
+
+type mockDNSRecordManager struct {
+	cnames map[string]string
+	caa    []string
+}
+
+func (m *mockDNSRecordManager) UpsertCNAMERecord(ctx context.Context, domain, target string) error {
+	m.cnames[domain] = target
+	return nil
+}
+
+func (m *mockDNSRecordManager) AllowCAAIssuer(ctx context.Context, domain, issuer string) (string, error) {
+	m.caa = append(m.caa, domain+" "+issuer)
+	return "example.com", nil
+}
+
+func TestCreateDNSRecords(t *testing.T) {
+	term.SetupTestTerm(t)
+	checker := mockCertChecker{pendingDNS: []string{"new.example.com"}}
+	records := &mockDNSRecordManager{cnames: map[string]string{}}
+
+	createDNSRecords(t.Context(), checker, "new.example.com", []string{"lb.example.net"}, records)
+	createDNSRecords(t.Context(), checker, "api.example.com", []string{"lb.example.net"}, records)
+
+	if len(records.cnames) != 1 || records.cnames["new.example.com"] != "lb.example.net" {
+		t.Errorf("expected only the CNAME of the pending domain to be created, got %v", records.cnames)
+	}
+	if !slices.Equal(records.caa, []string{"new.example.com letsencrypt.org", "api.example.com letsencrypt.org"}) {
+		t.Errorf("expected Let's Encrypt to be authorized for both domains, got %v", records.caa)
+	}
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/clouds/aws/ecs/cfn"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/ptr"
+	"google.golang.org/protobuf/proto"
+)
+
+func (b *ByocAws) ListBuildContexts(ctx context.Context) ([]client.BuildContext, error) {
+	if err := b.driver.FillOutputs(ctx); err != nil {
+		var cfnErr *cfn.ErrStackNotFoundException
+		if errors.As(err, &cfnErr) {
+			return nil, nil // no CD bucket = no uploads yet
+		}
+		return nil, AnnotateAwsError(err)
+	}
+
+	uploads, err := b.driver.ListUploads(ctx)
+	if err != nil {
+		return nil, AnnotateAwsError(err)
+	}
+	contexts := make([]client.BuildContext, len(uploads))
+	for i, upload := range uploads {
+		contexts[i] = client.BuildContext(upload)
+	}
+	return contexts, nil
+}
+
+func (b *ByocAws) DeleteBuildContexts(ctx context.Context, names []string) error {
+	if err := b.driver.FillOutputs(ctx); err != nil {
+		return AnnotateAwsError(err)
+	}
+	return AnnotateAwsError(b.driver.DeleteUploads(ctx, names))
+}
+
+func (b *ByocAws) BuildContextExists(ctx context.Context, url string) (bool, error) {
+	if err := b.driver.FillOutputs(ctx); err != nil {
+		return false, AnnotateAwsError(err)
+	}
+	exists, err := b.driver.UploadExists(ctx, url)
+	return exists, AnnotateAwsError(err)
+}
+
+func (b *ByocAws) UploadMultipart(ctx context.Context, req *defangv1.UploadURLRequest, body io.Reader) (*defangv1.UploadURLResponse, error) {
+	if err := b.SetUpCD(ctx); err != nil {
+		return nil, err
+	}
+
+	url, err := b.driver.UploadMultipart(ctx, req.Digest, body, func(part int32, err error) {
+		term.Warnf("Retrying part %d of the upload: %v", part, AnnotateAwsError(err))
+	})
+	if err != nil {
+		return nil, AnnotateAwsError(err)
+	}
+	return &defangv1.UploadURLResponse{Url: url}, nil
+}
+
+func (b *ByocAws) ListDeployedProjects(ctx context.Context) ([]client.DeployedProject, error) {
+	if err := b.driver.FillOutputs(ctx); err != nil {
+		var cfnErr *cfn.ErrStackNotFoundException
+		if errors.As(err, &cfnErr) {
+			return nil, nil // no CD bucket = no deployments yet
+		}
+		return nil, AnnotateAwsError(err)
+	}
+
+	cfg, err := b.driver.LoadConfig(ctx)
+	if err != nil {
+		return nil, AnnotateAwsError(err)
+	}
+
+	bucketName := b.bucketName()
+	s3Client := s3.NewFromConfig(cfg)
+	var projects []client.DeployedProject
+	paginator := s3.NewListObjectsV2Paginator(s3Client, &s3.ListObjectsV2Input{
+		Bucket: &bucketName,
+		Prefix: ptr.String("projects/"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, AnnotateAwsError(err)
+		}
+		for _, obj := range page.Contents {
+			// Same layout as GetProjectUpdatePath: projects/<project>/<stack>/project.pb
+			parts := strings.Split(ptr.ToString(obj.Key), "/")
+			if len(parts) != 4 || parts[3] != "project.pb" {
+				continue
+			}
+			getObjectOutput, err := s3Client.GetObject(ctx, &s3.GetObjectInput{
+				Bucket: &bucketName,
+				Key:    obj.Key,
+			})
+			if err != nil {
+				return nil, AnnotateAwsError(err)
+			}
+			pbBytes, err := io.ReadAll(getObjectOutput.Body)
+			getObjectOutput.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			var projUpdate defangv1.ProjectUpdate
+			if err := proto.Unmarshal(pbBytes, &projUpdate); err != nil {
+				return nil, err
+			}
+			projects = append(projects, client.DeployedProject{Project: parts[1], Stack: parts[2], Compose: projUpdate.Compose})
+		}
+	}
+	return projects, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"iter"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
@@ -215,6 +216,16 @@ func (b *ByocAws) deploy(ctx context.Context, req *client.DeployRequest, cmd str
 		return nil, err
 	}
 
+	network, err := compose.GetNetworkConfig(project)
+	if err != nil {
+		return nil, err
+	}
+	if network != nil {
+		if err := validateNetworkConfig(network); err != nil {
+			return nil, err
+		}
+	}
+
 	etag := types.NewEtag()
 	serviceInfos, err := b.GetServiceInfos(ctx, project.Name, req.DelegateDomain, etag, project.Services)
 	if err != nil {
@@ -264,6 +275,7 @@ func (b *ByocAws) deploy(ctx context.Context, req *client.DeployRequest, cmd str
 		project:         project.Name,
 		statesUrl:       req.StatesUrl,
 		eventsUrl:       req.EventsUrl,
+		network:         network,
 	}
 
 	if b.needDockerHubCreds {
@@ -331,7 +343,7 @@ func (b *ByocAws) FixupServices(ctx context.Context, project *composeTypes.Proje
 }
 
 func (b *ByocAws) checkRequiresDockerHubToken(ctx context.Context, project *composeTypes.Project) error {
-	images, err := compose.FindAllBaseImages(project)
+	images, err := compose.FindAllBaseImages(ctx, project)
 	if err != nil {
 		return err
 	}
@@ -524,6 +536,8 @@ type cdCommand struct {
 
 	statesUrl string
 	eventsUrl string
+
+	network *compose.NetworkConfig
 }
 
 func (b *ByocAws) runCdCommand(ctx context.Context, cmd cdCommand) (ecs.TaskArn, error) {
@@ -545,6 +559,9 @@ func (b *ByocAws) runCdCommand(ctx context.Context, cmd cdCommand) (ecs.TaskArn,
 	}
 
 	env["DEFANG_MODE"] = strings.ToLower(cmd.mode.String())
+	if cmd.network != nil {
+		maps.Copy(env, cmd.network.Environment())
+	}
 	if cmd.dockerHubUsername != "" && cmd.dockerHubAccessToken != "" {
 		arn, err := b.putDockerHubSecret(ctx, cmd.project, cmd.dockerHubUsername, cmd.dockerHubAccessToken)
 		if err != nil {
@@ -864,6 +881,13 @@ func (b *ByocAws) getLogGroupInputs(etag types.ETag, projectName, service, filte
 }
 
 func (b *ByocAws) UpdateServiceInfo(ctx context.Context, si *defangv1.ServiceInfo, projectName, delegateDomain string, service composeTypes.ServiceConfig) error {
+	if iamRole := compose.GetIamRole(&service); iamRole != "" {
+		if !compose.IsAwsRoleArn(iamRole) {
+			return fmt.Errorf("x-defang-iam-role %q is not an IAM role ARN", iamRole)
+		}
+		si.IamRole = iamRole // the task role; CD will make sure ECS can assume it
+	}
+
 	if service.DomainName == "" {
 		return nil
 	}
@@ -32,6 +32,11 @@ type r53Mock struct {
 	delegationSets []types.DelegationSet
 }
 
+func (r r53Mock) ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+	// TODO: implement if needed
+	return nil, nil
+}
+
 func (r r53Mock) DeleteReusableDelegationSet(ctx context.Context, params *route53.DeleteReusableDelegationSetInput, optFns ...func(*route53.Options)) (*route53.DeleteReusableDelegationSetOutput, error) {
 	// TODO: implement if needed
 	return nil, nil
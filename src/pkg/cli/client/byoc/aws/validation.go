@@ -3,6 +3,8 @@ package aws
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -89,3 +91,26 @@ func validateGPUResources(ctx context.Context, project *composeTypes.Project) er
 
 	return nil
 }
+
+var (
+	vpcIdRegex           = regexp.MustCompile(`^vpc-[0-9a-f]{8,17}$`)
+	subnetIdRegex        = regexp.MustCompile(`^subnet-[0-9a-f]{8,17}$`)
+	securityGroupIdRegex = regexp.MustCompile(`^sg-[0-9a-f]{8,17}$`)
+)
+
+func validateNetworkConfig(network *compose.NetworkConfig) error {
+	if !vpcIdRegex.MatchString(network.Vpc) {
+		return fmt.Errorf("x-defang-network: invalid VPC ID %q", network.Vpc)
+	}
+	for _, subnet := range network.PrivateSubnets {
+		if !subnetIdRegex.MatchString(subnet) {
+			return fmt.Errorf("x-defang-network: invalid subnet ID %q", subnet)
+		}
+	}
+	for _, sg := range network.SecurityGroups {
+		if !securityGroupIdRegex.MatchString(sg) {
+			return fmt.Errorf("x-defang-network: invalid security group ID %q", sg)
+		}
+	}
+	return nil
+}
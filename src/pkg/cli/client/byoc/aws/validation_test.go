@@ -7,6 +7,7 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	"github.com/DefangLabs/defang/src/pkg/clouds/aws"
 	"github.com/DefangLabs/defang/src/pkg/clouds/aws/ecs/cfn"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
@@ -198,3 +199,43 @@ services:
 		}
 	})
 }
+
+func TestValidateNetworkConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		network compose.NetworkConfig
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			network: compose.NetworkConfig{Vpc: "vpc-0a1b2c3d", PrivateSubnets: []string{"subnet-0a1b2c3d", "subnet-1a2b3c4d5e6f7a8b9"}, SecurityGroups: []string{"sg-0a1b2c3d"}},
+		},
+		{
+			name:    "invalid vpc",
+			network: compose.NetworkConfig{Vpc: "my-vpc"},
+			wantErr: `x-defang-network: invalid VPC ID "my-vpc"`,
+		},
+		{
+			name:    "invalid subnet",
+			network: compose.NetworkConfig{Vpc: "vpc-0a1b2c3d", PrivateSubnets: []string{"sg-0a1b2c3d"}},
+			wantErr: `x-defang-network: invalid subnet ID "sg-0a1b2c3d"`,
+		},
+		{
+			name:    "invalid security group",
+			network: compose.NetworkConfig{Vpc: "vpc-0a1b2c3d", SecurityGroups: []string{"default"}},
+			wantErr: `x-defang-network: invalid security group ID "default"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetworkConfig(&tt.network)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			} else if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
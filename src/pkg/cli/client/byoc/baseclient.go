@@ -201,11 +201,14 @@ func (b *ByocBaseClient) update(ctx context.Context, projectName, delegateDomain
 	healthCheckPath, _ := compose.GetHealthCheckPathAndPort(service.HealthCheck)
 	si := &defangv1.ServiceInfo{
 		AllowScaling:    b.AllowScaling,
+		Autoscaling:     compose.GetAutoscaling(&service),
+		BackupPolicy:    compose.GetBackupPolicy(&service),
 		Domainname:      service.DomainName,
 		Etag:            types.NewEtag(), // TODO: could be hash for dedup/idempotency
 		Project:         projectName,     // was: tenant
 		Service:         &defangv1.Service{Name: service.Name},
 		HealthcheckPath: healthCheckPath,
+		Ingress:         compose.GetIngress(&service),
 	}
 
 	hasHost := false
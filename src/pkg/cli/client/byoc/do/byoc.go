@@ -33,6 +33,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -142,6 +143,10 @@ func (b *ByocDo) deploy(ctx context.Context, req *client.DeployRequest, cmd stri
 		return nil, err
 	}
 
+	if _, ok := project.Extensions["x-defang-network"]; ok {
+		return nil, errors.New("x-defang-network is not supported by DigitalOcean")
+	}
+
 	if err := b.SetUpCD(ctx); err != nil {
 		return nil, err
 	}
@@ -315,6 +320,13 @@ func (b *ByocDo) DeleteConfig(ctx context.Context, secrets *defangv1.Secrets) er
 	return err
 }
 
+func (b *ByocDo) UpdateServiceInfo(ctx context.Context, si *defangv1.ServiceInfo, projectName, delegateDomain string, service composeTypes.ServiceConfig) error {
+	if compose.GetIamRole(&service) != "" {
+		return errors.New("x-defang-iam-role is not supported by DigitalOcean")
+	}
+	return nil
+}
+
 func (b *ByocDo) GetService(ctx context.Context, s *defangv1.GetRequest) (*defangv1.ServiceInfo, error) {
 	//Dumps endpoint and tag. Reads the protobuff for that service. Combines with info from get app.
 	//Only used in Tail
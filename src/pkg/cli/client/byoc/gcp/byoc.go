@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"iter"
+	"maps"
 	"os"
 	"path"
 	"strings"
@@ -27,6 +28,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
 	"go.yaml.in/yaml/v4"
 	gcpdns "google.golang.org/api/dns/v1"
 	"google.golang.org/api/googleapi"
@@ -377,6 +379,7 @@ type cdCommand struct {
 	project        string
 	statesUrl      string
 	eventsUrl      string
+	network        *compose.NetworkConfig
 }
 
 type CloudBuildStep struct {
@@ -434,6 +437,10 @@ func (b *ByocGcp) runCdCommand(ctx context.Context, cmd cdCommand) error {
 		env["DEFANG_ETAG"] = cmd.etag
 	}
 
+	if cmd.network != nil {
+		maps.Copy(env, cmd.network.Environment())
+	}
+
 	if os.Getenv("DEFANG_PULUMI_DIR") != "" {
 		debugEnv := []string{"REGION=" + b.driver.GetRegion()}
 		if gcpProject := os.Getenv("GCP_PROJECT_ID"); gcpProject != "" {
@@ -512,6 +519,14 @@ func (b *ByocGcp) deploy(ctx context.Context, req *client.DeployRequest, command
 		return nil, err
 	}
 
+	network, err := compose.GetNetworkConfig(project)
+	if err != nil {
+		return nil, err
+	}
+	if network != nil && len(network.SecurityGroups) > 0 {
+		return nil, errors.New("x-defang-network: security groups are not supported on GCP; use VPC firewall rules instead")
+	}
+
 	// FIXME: Get cd image tag for the project
 
 	if err := b.SetUpCD(ctx); err != nil {
@@ -565,6 +580,7 @@ func (b *ByocGcp) deploy(ctx context.Context, req *client.DeployRequest, command
 		project:        project.Name,
 		statesUrl:      req.StatesUrl,
 		eventsUrl:      req.EventsUrl,
+		network:        network,
 	}
 	if err := b.runCdCommand(ctx, cdCmd); err != nil {
 		return nil, err
@@ -619,6 +635,16 @@ func (b *ByocGcp) getLogStream(ctx context.Context, gcpLogsClient GcpLogsClient,
 	return logStream
 }
 
+func (b *ByocGcp) UpdateServiceInfo(ctx context.Context, si *defangv1.ServiceInfo, projectName, delegateDomain string, service composeTypes.ServiceConfig) error {
+	if iamRole := compose.GetIamRole(&service); iamRole != "" {
+		if !compose.IsGcpServiceAccount(iamRole) {
+			return fmt.Errorf("x-defang-iam-role %q is not a service account email", iamRole)
+		}
+		si.IamRole = iamRole // the service account the Cloud Run service runs as
+	}
+	return nil
+}
+
 func (b *ByocGcp) GetService(ctx context.Context, req *defangv1.GetRequest) (*defangv1.ServiceInfo, error) {
 	all, err := b.GetServices(ctx, &defangv1.GetServicesRequest{Project: req.Project})
 	if err != nil {
@@ -12,25 +12,37 @@ type FabricClient interface {
 	AgreeToS(context.Context) error
 	CanIUse(context.Context, *defangv1.CanIUseRequest) (*defangv1.CanIUseResponse, error)
 	CheckLoginAndToS(context.Context) error
+	CreateAlertRule(context.Context, *defangv1.CreateAlertRuleRequest) (*defangv1.AlertRule, error)
+	CreateBackup(context.Context, *defangv1.CreateBackupRequest) (*defangv1.Backup, error)
 	CreateDelegateSubdomainZone(context.Context, *defangv1.DelegateSubdomainZoneRequest) (*defangv1.DelegateSubdomainZoneResponse, error)
 	CreateUploadURL(context.Context, *defangv1.UploadURLRequest) (*defangv1.UploadURLResponse, error)
 	Debug(context.Context, *defangv1.DebugRequest) (*defangv1.DebugResponse, error)
+	DeleteAlertRules(context.Context, *defangv1.DeleteAlertRulesRequest) error
 	DeleteSubdomainZone(context.Context, *defangv1.DeleteSubdomainZoneRequest) error
 	Estimate(context.Context, *defangv1.EstimateRequest) (*defangv1.EstimateResponse, error)
+	Exec(context.Context) ExecStream
 	GenerateCompose(context.Context, *defangv1.GenerateComposeRequest) (*defangv1.GenerateComposeResponse, error)
 	GenerateFiles(context.Context, *defangv1.GenerateFilesRequest) (*defangv1.GenerateFilesResponse, error)
 	GetDefaultStack(context.Context, *defangv1.GetDefaultStackRequest) (*defangv1.GetStackResponse, error)
 	GetDelegateSubdomainZone(context.Context, *defangv1.GetDelegateSubdomainZoneRequest) (*defangv1.DelegateSubdomainZoneResponse, error)
 	GetFabricClient() defangv1connect.FabricControllerClient
+	GetMetricsEndpoint(context.Context, *defangv1.GetMetricsEndpointRequest) (*defangv1.MetricsEndpoint, error)
 	GetPlaygroundProjectDomain(context.Context) (*defangv1.GetPlaygroundProjectDomainResponse, error)
+	GetQuotas(context.Context) (*defangv1.GetQuotasResponse, error)
 	GetRequestedTenant() types.TenantNameOrID
 	GetTenantName() types.TenantLabel
 	GetVersions(context.Context) (*defangv1.Version, error)
+	ListAlertRules(context.Context, *defangv1.ListAlertRulesRequest) (*defangv1.ListAlertRulesResponse, error)
+	ListBackups(context.Context, *defangv1.ListBackupsRequest) (*defangv1.ListBackupsResponse, error)
 	ListDeployments(context.Context, *defangv1.ListDeploymentsRequest) (*defangv1.ListDeploymentsResponse, error)
+	ListProjects(context.Context, *defangv1.ListProjectsRequest) (*defangv1.ListProjectsResponse, error)
 	ListStacks(context.Context, *defangv1.ListStacksRequest) (*defangv1.ListStacksResponse, error)
 	Preview(context.Context, *defangv1.PreviewRequest) (*defangv1.PreviewResponse, error)
+	PutCertificate(context.Context, *defangv1.PutCertificateRequest) error
 	PutDeployment(context.Context, *defangv1.PutDeploymentRequest) error
+	PutMetricsExport(context.Context, *defangv1.PutMetricsExportRequest) error
 	PutStack(context.Context, *defangv1.PutStackRequest) error
+	RestoreBackup(context.Context, *defangv1.RestoreBackupRequest) error
 	RevokeToken(context.Context) error
 	SetOptions(context.Context, *defangv1.SetOptionsRequest) error
 	Token(context.Context, *defangv1.TokenRequest) (*defangv1.TokenResponse, error)
@@ -39,6 +51,14 @@ type FabricClient interface {
 	WhoAmI(context.Context) (*defangv1.WhoAmIResponse, error)
 }
 
+// ExecStream is the client side of the bidirectional stream of an Exec call.
+type ExecStream interface {
+	Send(*defangv1.ExecRequest) error
+	Receive() (*defangv1.ExecResponse, error)
+	CloseRequest() error
+	CloseResponse() error
+}
+
 type Property struct {
 	Name  string
 	Value any
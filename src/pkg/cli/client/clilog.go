@@ -0,0 +1,20 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// CLILogPath is the log of the recent runs of the CLI, incl. debug messages, which is included in the support bundle.
+var CLILogPath = filepath.Join(StateDir, "defang.log")
+
+const maxCLILogSize = 1024 * 1024 // when exceeded, the oldest half is dropped
+
+// OpenCLILog opens the CLI log for appending.
+func OpenCLILog() (*os.File, error) {
+	trimLog(CLILogPath, maxCLILogSize)
+	if err := os.MkdirAll(filepath.Dir(CLILogPath), 0700); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(CLILogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+}
@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"runtime"
 	"strings"
 	"time"
@@ -31,14 +30,16 @@ func NewGrpcClient(host, accessToken string, requestedTenant types.TenantNameOrI
 	}
 	baseUrl += host
 	// Debug(" - Connecting to", baseUrl)
+	timeouts := LoadClientTimeouts()
 	fabricClient := defangv1connect.NewFabricControllerClient(
-		http.DefaultClient,
+		timeouts.NewHTTPClient(),
 		baseUrl,
 		connect.WithGRPC(),
 		connect.WithInterceptors(
 			grpcLogger{"fabricClient"},
 			auth.NewAuthInterceptor(accessToken, requestedTenant),
-			Retrier{},
+			Retrier{LoadRetryPolicy()},
+			deadlineInterceptor{timeouts}, // innermost, so each retry gets its own deadline
 		),
 	)
 
@@ -91,6 +92,50 @@ func (g GrpcClient) PutStack(ctx context.Context, req *defangv1.PutStackRequest)
 	return err
 }
 
+func (g GrpcClient) PutCertificate(ctx context.Context, req *defangv1.PutCertificateRequest) error {
+	_, err := g.client.PutCertificate(ctx, connect.NewRequest(req))
+	return err
+}
+
+func (g GrpcClient) CreateAlertRule(ctx context.Context, req *defangv1.CreateAlertRuleRequest) (*defangv1.AlertRule, error) {
+	return getMsg(g.client.CreateAlertRule(ctx, connect.NewRequest(req)))
+}
+
+func (g GrpcClient) ListAlertRules(ctx context.Context, req *defangv1.ListAlertRulesRequest) (*defangv1.ListAlertRulesResponse, error) {
+	return getMsg(g.client.ListAlertRules(ctx, connect.NewRequest(req)))
+}
+
+func (g GrpcClient) DeleteAlertRules(ctx context.Context, req *defangv1.DeleteAlertRulesRequest) error {
+	_, err := g.client.DeleteAlertRules(ctx, connect.NewRequest(req))
+	return err
+}
+
+func (g GrpcClient) GetMetricsEndpoint(ctx context.Context, req *defangv1.GetMetricsEndpointRequest) (*defangv1.MetricsEndpoint, error) {
+	return getMsg(g.client.GetMetricsEndpoint(ctx, connect.NewRequest(req)))
+}
+
+func (g GrpcClient) PutMetricsExport(ctx context.Context, req *defangv1.PutMetricsExportRequest) error {
+	_, err := g.client.PutMetricsExport(ctx, connect.NewRequest(req))
+	return err
+}
+
+func (g GrpcClient) CreateBackup(ctx context.Context, req *defangv1.CreateBackupRequest) (*defangv1.Backup, error) {
+	return getMsg(g.client.CreateBackup(ctx, connect.NewRequest(req)))
+}
+
+func (g GrpcClient) ListBackups(ctx context.Context, req *defangv1.ListBackupsRequest) (*defangv1.ListBackupsResponse, error) {
+	return getMsg(g.client.ListBackups(ctx, connect.NewRequest(req)))
+}
+
+func (g GrpcClient) RestoreBackup(ctx context.Context, req *defangv1.RestoreBackupRequest) error {
+	_, err := g.client.RestoreBackup(ctx, connect.NewRequest(req))
+	return err
+}
+
+func (g GrpcClient) Exec(ctx context.Context) ExecStream {
+	return g.client.Exec(ctx)
+}
+
 func (g GrpcClient) PutDeployment(ctx context.Context, req *defangv1.PutDeploymentRequest) error {
 	_, err := g.client.PutDeployment(ctx, connect.NewRequest(req))
 	return err
@@ -104,6 +149,10 @@ func (g GrpcClient) ListDeployments(ctx context.Context, req *defangv1.ListDeplo
 	return getMsg(g.client.ListDeployments(ctx, connect.NewRequest(req)))
 }
 
+func (g GrpcClient) ListProjects(ctx context.Context, req *defangv1.ListProjectsRequest) (*defangv1.ListProjectsResponse, error) {
+	return getMsg(g.client.ListProjects(ctx, connect.NewRequest(req)))
+}
+
 func (g GrpcClient) GenerateFiles(ctx context.Context, req *defangv1.GenerateFilesRequest) (*defangv1.GenerateFilesResponse, error) {
 	return getMsg(g.client.GenerateFiles(ctx, connect.NewRequest(req)))
 }
@@ -112,6 +161,10 @@ func (g GrpcClient) WhoAmI(ctx context.Context) (*defangv1.WhoAmIResponse, error
 	return getMsg(g.client.WhoAmI(ctx, &connect.Request[emptypb.Empty]{}))
 }
 
+func (g GrpcClient) GetQuotas(ctx context.Context) (*defangv1.GetQuotasResponse, error) {
+	return getMsg(g.client.GetQuotas(ctx, &connect.Request[emptypb.Empty]{}))
+}
+
 func (g GrpcClient) CreateDelegateSubdomainZone(ctx context.Context, req *defangv1.DelegateSubdomainZoneRequest) (*defangv1.DelegateSubdomainZoneResponse, error) {
 	return getMsg(g.client.DelegateSubdomainZone(ctx, connect.NewRequest(req)))
 }
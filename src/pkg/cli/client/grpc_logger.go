@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/term"
@@ -19,7 +20,7 @@ func (g grpcLogger) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 		// Add a request ID to the context
 		requestId := pkg.RandomID()
-		req.Header().Add("X-Request-Id", requestId)
+		req.Header().Add(RequestIDHeader, requestId)
 
 		// Get the request type name
 		reqType := req.Spec().Procedure
@@ -36,7 +37,15 @@ func (g grpcLogger) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		}
 
 		term.Debug(g.prefix, requestId, reqType, string(payload))
-		return next(ctx, req)
+		resp, err := next(ctx, req)
+		recordRequest(requestId, reqType, err)
+
+		// Attach the request ID to the error, so it can be printed; see RequestIDOf
+		var cerr *connect.Error
+		if errors.As(err, &cerr) && cerr.Meta().Get(RequestIDHeader) == "" {
+			cerr.Meta().Set(RequestIDHeader, requestId)
+		}
+		return resp, err
 	}
 }
 
@@ -46,12 +55,13 @@ func (g grpcLogger) WrapStreamingClient(next connect.StreamingClientFunc) connec
 
 		// Add a request ID to the context
 		requestId := pkg.RandomID()
-		conn.RequestHeader().Add("X-Request-Id", requestId)
+		conn.RequestHeader().Add(RequestIDHeader, requestId)
 
 		// Get the request type name
 		reqType := spec.Procedure
 
 		term.Debug(g.prefix, requestId, reqType, "streaming connection established")
+		recordRequest(requestId, reqType, nil)
 		return conn
 	}
 }
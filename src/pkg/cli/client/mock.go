@@ -3,12 +3,14 @@ package client
 import (
 	"context"
 	"errors"
+	"io"
 	"iter"
 	"net/http"
 	"net/url"
 	"path"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/DefangLabs/defang/src/pkg/dns"
 	"github.com/DefangLabs/defang/src/pkg/types"
@@ -105,6 +107,47 @@ func (m *MockServerStream[T]) Err() error {
 	return m.Error
 }
 
+// MockExecStream mocks an ExecStream: it records the requests that are sent and
+// returns the responses in order, followed by io.EOF.
+type MockExecStream struct {
+	Reqs  []*defangv1.ExecRequest
+	Resps []*defangv1.ExecResponse
+	index int
+	mu    sync.Mutex
+}
+
+func (m *MockExecStream) Send(req *defangv1.ExecRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Reqs = append(m.Reqs, req)
+	return nil
+}
+
+func (m *MockExecStream) Receive() (*defangv1.ExecResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.index >= len(m.Resps) {
+		return nil, io.EOF
+	}
+	m.index++
+	return m.Resps[m.index-1], nil
+}
+
+func (m *MockExecStream) CloseRequest() error {
+	return nil
+}
+
+func (m *MockExecStream) CloseResponse() error {
+	return nil
+}
+
+// Requests returns a copy of the requests that were sent so far.
+func (m *MockExecStream) Requests() []*defangv1.ExecRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*defangv1.ExecRequest(nil), m.Reqs...)
+}
+
 // MockWaitStream is a mock implementation of the ServerStream interface that
 // returns messages and errors from channels. It blocks until the channels are
 // closed or an error is received. It is used for testing purposes.
@@ -217,6 +260,102 @@ func (m MockFabricClient) PutStack(ctx context.Context, req *defangv1.PutStackRe
 	return nil
 }
 
+func (m MockFabricClient) PutCertificate(ctx context.Context, req *defangv1.PutCertificateRequest) error {
+	return nil
+}
+
+func (m MockFabricClient) CreateAlertRule(ctx context.Context, req *defangv1.CreateAlertRuleRequest) (*defangv1.AlertRule, error) {
+	return &defangv1.AlertRule{
+		Id:         "alert-1",
+		Project:    req.Project,
+		Stack:      req.Stack,
+		Service:    req.Service,
+		Conditions: req.Conditions,
+		Notify:     req.Notify,
+		CreatedAt:  timestamppb.Now(),
+	}, nil
+}
+
+func (m MockFabricClient) ListAlertRules(ctx context.Context, req *defangv1.ListAlertRulesRequest) (*defangv1.ListAlertRulesResponse, error) {
+	return &defangv1.ListAlertRulesResponse{
+		Rules: []*defangv1.AlertRule{
+			{
+				Id:         "alert-1",
+				Project:    req.Project,
+				Stack:      req.Stack,
+				Service:    "api",
+				Conditions: []defangv1.AlertCondition{defangv1.AlertCondition_ALERT_CONDITION_CRASHLOOP, defangv1.AlertCondition_ALERT_CONDITION_UNHEALTHY},
+				Notify:     []string{"slack"},
+				CreatedAt:  timestamppb.Now(),
+			},
+		},
+	}, nil
+}
+
+func (m MockFabricClient) DeleteAlertRules(ctx context.Context, req *defangv1.DeleteAlertRulesRequest) error {
+	return nil
+}
+
+func (m MockFabricClient) GetMetricsEndpoint(ctx context.Context, req *defangv1.GetMetricsEndpointRequest) (*defangv1.MetricsEndpoint, error) {
+	return &defangv1.MetricsEndpoint{
+		Url:       "https://metrics.defang.io/v1/" + req.Project + "/metrics",
+		Token:     "metrics-token",
+		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	}, nil
+}
+
+func (m MockFabricClient) PutMetricsExport(ctx context.Context, req *defangv1.PutMetricsExportRequest) error {
+	return nil
+}
+
+func (m MockFabricClient) CreateBackup(ctx context.Context, req *defangv1.CreateBackupRequest) (*defangv1.Backup, error) {
+	return &defangv1.Backup{
+		Id:        "backup-1",
+		Project:   req.Project,
+		Stack:     req.Stack,
+		Service:   req.Service,
+		State:     defangv1.BackupState_BACKUP_STATE_PENDING,
+		CreatedAt: timestamppb.Now(),
+	}, nil
+}
+
+func (m MockFabricClient) ListBackups(ctx context.Context, req *defangv1.ListBackupsRequest) (*defangv1.ListBackupsResponse, error) {
+	return &defangv1.ListBackupsResponse{
+		Backups: []*defangv1.Backup{
+			{
+				Id:        "backup-1",
+				Project:   req.Project,
+				Stack:     req.Stack,
+				Service:   "db",
+				State:     defangv1.BackupState_BACKUP_STATE_COMPLETED,
+				SizeBytes: 5 << 20,
+				Scheduled: true,
+				CreatedAt: timestamppb.Now(),
+			},
+		},
+	}, nil
+}
+
+func (m MockFabricClient) RestoreBackup(ctx context.Context, req *defangv1.RestoreBackupRequest) error {
+	return nil
+}
+
+func (m MockFabricClient) Exec(ctx context.Context) ExecStream {
+	return &MockExecStream{Resps: []*defangv1.ExecResponse{{Stdout: []byte("hello\n")}, {Exited: true}}}
+}
+
+func (m MockFabricClient) GetQuotas(ctx context.Context) (*defangv1.GetQuotasResponse, error) {
+	return &defangv1.GetQuotasResponse{
+		Tier: defangv1.SubscriptionTier_PERSONAL,
+		Quotas: []*defangv1.Quota{
+			{Name: "services", Limit: 10, Used: 9},
+			{Name: "cpus", Unit: "vCPU", Limit: 4, Used: 1.5},
+			{Name: "memory", Unit: "MiB", Limit: 8192, Used: 2048},
+			{Name: "build-minutes", Unit: "minutes", Limit: 0, Used: 42},
+		},
+	}, nil
+}
+
 func (m MockFabricClient) PutDeployment(ctx context.Context, req *defangv1.PutDeploymentRequest) error {
 	return nil
 }
@@ -237,6 +376,21 @@ func (m MockFabricClient) ListDeployments(ctx context.Context, req *defangv1.Lis
 	}, nil
 }
 
+func (m MockFabricClient) ListProjects(ctx context.Context, req *defangv1.ListProjectsRequest) (*defangv1.ListProjectsResponse, error) {
+	return &defangv1.ListProjectsResponse{
+		Projects: []*defangv1.Project{
+			{
+				Name:           "test",
+				ServiceCount:   2,
+				LastDeployedAt: timestamppb.Now(),
+				Stack:          "beta",
+				Provider:       defangv1.Provider_AWS,
+				Region:         "us-test-2",
+			},
+		},
+	}, nil
+}
+
 func (m MockFabricClient) CreateUploadURL(ctx context.Context, req *defangv1.UploadURLRequest) (*defangv1.UploadURLResponse, error) {
 	name := req.Digest
 	if req.Filename != "" {
@@ -281,6 +435,6 @@ func (m MockLoader) TargetDirectory(context.Context) string {
 	return "."
 }
 
-func (m MockLoader) CreateProjectForDebug() (*composeTypes.Project, error) {
+func (m MockLoader) CreateProjectForDebug(context.Context) (*composeTypes.Project, error) {
 	return &m.Project, m.Error
 }
@@ -24,6 +24,21 @@ type PlaygroundProvider struct {
 
 var _ Provider = (*PlaygroundProvider)(nil)
 
+// IsPlayground returns whether the provider is the Playground, also when it is wrapped by a provider with an Unwrap
+// method, like the offline provider.
+func IsPlayground(provider Provider) bool {
+	for {
+		switch p := provider.(type) {
+		case *PlaygroundProvider:
+			return true
+		case interface{ Unwrap() Provider }:
+			provider = p.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
 func NewPlaygroundProvider(fabricClient FabricClient, stack string) *PlaygroundProvider {
 	return &PlaygroundProvider{
 		FabricClient: fabricClient,
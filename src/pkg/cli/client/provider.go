@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"iter"
 	"time"
 
@@ -93,11 +94,44 @@ type Provider interface {
 	TearDownCD(context.Context) error
 }
 
+type BuildContext struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// DeployedProject is the current deployment of a project and stack in the CD bucket.
+type DeployedProject struct {
+	Project string
+	Stack   string
+	Compose []byte
+}
+
+// BuildContextManager is implemented by providers that can list and delete the uploaded build contexts. The build
+// contexts are shared by all projects and stacks in the CD bucket, so ListDeployedProjects returns all of them.
+type BuildContextManager interface {
+	ListBuildContexts(context.Context) ([]BuildContext, error)
+	DeleteBuildContexts(context.Context, []string) error
+	ListDeployedProjects(context.Context) ([]DeployedProject, error)
+}
+
+// BuildContextChecker is implemented by providers that can check whether an uploaded build context still exists,
+// so it can be reused instead of uploaded again.
+type BuildContextChecker interface {
+	BuildContextExists(ctx context.Context, url string) (bool, error)
+}
+
+// MultipartUploader is implemented by providers that can upload a large build context in parts, which are retried on
+// their own, so a network blip doesn't restart the whole upload. The URL of the response has no query.
+type MultipartUploader interface {
+	UploadMultipart(ctx context.Context, req *defangv1.UploadURLRequest, body io.Reader) (*defangv1.UploadURLResponse, error)
+}
+
 type Loader interface {
 	LoadProject(context.Context) (*composeTypes.Project, error)
 	LoadProjectName(context.Context) (string, bool, error) // true = name from loaded project
 	TargetDirectory(context.Context) string
-	CreateProjectForDebug() (*composeTypes.Project, error)
+	CreateProjectForDebug(context.Context) (*composeTypes.Project, error)
 }
 
 type RetryDelayer struct {
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+)
+
+// RequestIDHeader carries the ID that is generated for every call to the Fabric controller. The controller logs
+// it, so support can find the call; we print it when a call fails.
+const RequestIDHeader = "X-Request-Id"
+
+// RequestsLogPath is the file with the IDs of recent calls, which is included in the support bundle.
+var RequestsLogPath = filepath.Join(StateDir, "requests.log")
+
+const maxRequestsLogSize = 64 * 1024 // when exceeded, the oldest half is dropped
+
+var (
+	requestsLogMu sync.Mutex // guards the file
+	pendingMu     sync.Mutex
+	pending       []string // lines that are not written to the file yet
+)
+
+// recordRequest adds the ID, procedure, and outcome of a call to the requests log. The line is written in the
+// background, so the call is not slowed down by disk I/O, and errors are ignored, because this must never fail the
+// call itself.
+func recordRequest(requestId, procedure string, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = connect.CodeOf(err).String()
+	}
+	line := fmt.Sprintf("%s %s %s %s\n", time.Now().UTC().Format(time.RFC3339), requestId, procedure, outcome)
+
+	pendingMu.Lock()
+	pending = append(pending, line)
+	first := len(pending) == 1
+	pendingMu.Unlock()
+	if first {
+		go FlushRequests() // the lines recorded in the meantime are written in the same batch
+	}
+}
+
+// FlushRequests writes the recorded requests to the requests log; call it before exiting.
+func FlushRequests() {
+	requestsLogMu.Lock()
+	defer requestsLogMu.Unlock()
+
+	pendingMu.Lock()
+	lines := pending
+	pending = nil
+	pendingMu.Unlock()
+	if len(lines) == 0 {
+		return
+	}
+
+	trimLog(RequestsLogPath, maxRequestsLogSize)
+	os.MkdirAll(filepath.Dir(RequestsLogPath), 0700)
+	if f, err := os.OpenFile(RequestsLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600); err == nil {
+		f.WriteString(strings.Join(lines, ""))
+		f.Close()
+	}
+}
+
+// trimLog drops the oldest half of the log file if it's bigger than maxSize.
+func trimLog(path string, maxSize int64) {
+	if info, err := os.Stat(path); err == nil && info.Size() > maxSize {
+		if data, err := os.ReadFile(path); err == nil {
+			data = data[len(data)/2:]
+			data = data[bytes.IndexByte(data, '\n')+1:] // drop the partial line
+			os.WriteFile(path, data, 0600)
+		}
+	}
+}
+
+// RecentRequests returns up to n of the most recent lines of the requests log, oldest first.
+func RecentRequests(n int) ([]string, error) {
+	FlushRequests()
+	requestsLogMu.Lock()
+	data, err := os.ReadFile(RequestsLogPath)
+	requestsLogMu.Unlock()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, nil
+	}
+	return lines[max(0, len(lines)-n):], nil
+}
+
+// RequestIDOf returns the ID of the failed call if err came from the Fabric controller, or "" otherwise.
+func RequestIDOf(err error) string {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return cerr.Meta().Get(RequestIDHeader)
+	}
+	return ""
+}
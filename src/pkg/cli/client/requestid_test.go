@@ -0,0 +1,51 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+)
+
+func TestRecentRequests(t *testing.T) {
+	defer func(path string) { RequestsLogPath = path }(RequestsLogPath)
+	RequestsLogPath = filepath.Join(t.TempDir(), "requests.log")
+
+	requests, err := RecentRequests(10)
+	if err != nil || len(requests) != 0 {
+		t.Fatalf("expected no requests, got %v, %v", requests, err)
+	}
+
+	for i := range 3 {
+		recordRequest(fmt.Sprint("id", i), "/io.defang.v1.FabricController/WhoAmI", nil)
+	}
+	recordRequest("id3", "/io.defang.v1.FabricController/Deploy", connect.NewError(connect.CodeNotFound, errors.New("not found")))
+
+	requests, err = RecentRequests(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+	if !strings.Contains(requests[0], " id2 ") || !strings.HasSuffix(requests[0], " ok") {
+		t.Errorf("unexpected request line %q", requests[0])
+	}
+	if !strings.Contains(requests[1], " id3 ") || !strings.HasSuffix(requests[1], " not_found") {
+		t.Errorf("unexpected request line %q", requests[1])
+	}
+}
+
+func TestRequestIDOf(t *testing.T) {
+	if id := RequestIDOf(errors.New("other")); id != "" {
+		t.Errorf("expected no request ID, got %q", id)
+	}
+	cerr := connect.NewError(connect.CodeInternal, errors.New("boom"))
+	cerr.Meta().Set(RequestIDHeader, "abc")
+	if id := RequestIDOf(fmt.Errorf("wrapped: %w", cerr)); id != "abc" {
+		t.Errorf("expected request ID abc, got %q", id)
+	}
+}
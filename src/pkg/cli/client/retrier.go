@@ -2,20 +2,106 @@ package client
 
 import (
 	"context"
+	"errors"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/protos/io/defang/v1/defangv1connect"
 	"github.com/bufbuild/connect-go"
 )
 
-type Retrier struct{}
+// RetryPolicy configures how calls to the Fabric controller are retried on transient errors. Only idempotent calls
+// are retried; other calls, like Deploy, are sent once, because the server might have handled them already.
+type RetryPolicy struct {
+	MaxAttempts    int           // total number of attempts of idempotent calls, including the first
+	InitialBackoff time.Duration // delay before the first retry; doubles with each retry
+	MaxBackoff     time.Duration // upper bound of the delay between retries
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+}
+
+// retryableProcedures are calls that are safe to retry, but not marked as idempotent in the proto.
+var retryableProcedures = []string{
+	defangv1connect.FabricControllerCreateUploadURLProcedure, // returns a new URL each time
+	defangv1connect.FabricControllerTailProcedure,
+}
+
+// LoadRetryPolicy returns the default retry policy, overridden by these environment variables:
+//
+//	DEFANG_RPC_MAX_ATTEMPTS   attempts of idempotent calls, eg. "3"; "1" disables retries
+//	DEFANG_RPC_BACKOFF        delay before the first retry, eg. "1s"
+//	DEFANG_RPC_MAX_BACKOFF    upper bound of the delay between retries, eg. "30s"
+func LoadRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy
+	if value := os.Getenv("DEFANG_RPC_MAX_ATTEMPTS"); value != "" {
+		if attempts, err := strconv.Atoi(value); err != nil || attempts < 1 {
+			term.Warnf("ignoring invalid DEFANG_RPC_MAX_ATTEMPTS=%q; expected a positive number", value)
+		} else {
+			policy.MaxAttempts = attempts
+		}
+	}
+	getenvDuration("DEFANG_RPC_BACKOFF", &policy.InitialBackoff)
+	getenvDuration("DEFANG_RPC_MAX_BACKOFF", &policy.MaxBackoff)
+	return policy
+}
+
+// backoff returns the delay before the given retry, starting at 1, with jitter so clients don't retry in lockstep.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, p.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1) // between 50% and 100% of the delay
+}
+
+// isIdempotent reports whether the call can safely be repeated.
+func isIdempotent(spec connect.Spec) bool {
+	return spec.IdempotencyLevel != connect.IdempotencyUnknown || slices.Contains(retryableProcedures, spec.Procedure)
+}
+
+// isRetryable reports whether the error is transient, like the server being unavailable or a reset connection.
+func isRetryable(err error) bool {
+	return connect.CodeOf(err) == connect.CodeUnavailable || errors.Is(err, syscall.ECONNRESET)
+}
+
+// Retrier is an interceptor that retries calls on transient errors, with exponential backoff and jitter. The zero
+// value uses the DefaultRetryPolicy.
+type Retrier struct {
+	Policy RetryPolicy
+}
+
+func (r Retrier) policy() RetryPolicy {
+	if r.Policy.MaxAttempts == 0 {
+		return DefaultRetryPolicy
+	}
+	return r.Policy
+}
 
-func (Retrier) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+func (r Retrier) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !isIdempotent(req.Spec()) {
+			// Unavailable can also mean the connection dropped after the server accepted the call
+			return next(ctx, req)
+		}
+		policy := r.policy()
 		res, err := next(ctx, req)
-		if connect.CodeOf(err) == connect.CodeUnavailable {
-			// Retry once after a 1 second sleep
-			if err := pkg.SleepWithContext(ctx, 1*time.Second); err != nil {
+		for attempt := 1; attempt < policy.MaxAttempts && isRetryable(err); attempt++ {
+			term.Debugf("Retrying %s after error: %v", req.Spec().Procedure, err)
+			if err := pkg.SleepWithContext(ctx, policy.backoff(attempt)); err != nil {
 				return nil, err
 			}
 			res, err = next(ctx, req)
@@ -23,10 +109,72 @@ func (Retrier) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		return res, err
 	}
 }
-func (Retrier) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
-	return next // TODO: wrap this to handle streaming rpcs like Tail
+
+func (r Retrier) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		policy := r.policy()
+		if spec.StreamType != connect.StreamTypeServer || !isIdempotent(spec) {
+			return next(ctx, spec)
+		}
+		return &retryingStream{StreamingClientConn: next(ctx, spec), ctx: ctx, spec: spec, next: next, policy: policy}
+	}
 }
 
 func (Retrier) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return next
 }
+
+// retryingStream reopens a server stream, like Tail, when it fails before the first message was received. Once
+// messages were received, the caller must reconnect itself, since it knows where to continue.
+type retryingStream struct {
+	connect.StreamingClientConn
+	ctx      context.Context
+	spec     connect.Spec
+	next     connect.StreamingClientFunc
+	policy   RetryPolicy
+	sent     []any
+	closed   bool
+	received bool
+}
+
+func (s *retryingStream) Send(msg any) error {
+	s.sent = append(s.sent, msg)
+	return s.StreamingClientConn.Send(msg)
+}
+
+func (s *retryingStream) CloseRequest() error {
+	s.closed = true
+	return s.StreamingClientConn.CloseRequest()
+}
+
+func (s *retryingStream) Receive(msg any) error {
+	err := s.StreamingClientConn.Receive(msg)
+	for attempt := 1; !s.received && attempt < s.policy.MaxAttempts && isRetryable(err); attempt++ {
+		term.Debugf("Retrying %s after error: %v", s.spec.Procedure, err)
+		if err := pkg.SleepWithContext(s.ctx, s.policy.backoff(attempt)); err != nil {
+			return err
+		}
+		s.StreamingClientConn.CloseResponse()
+		s.StreamingClientConn = s.next(s.ctx, s.spec)
+		if err = s.resend(); err == nil {
+			err = s.StreamingClientConn.Receive(msg)
+		}
+	}
+	if err == nil {
+		s.received = true
+	}
+	return err
+}
+
+// resend sends the request messages again on a new stream.
+func (s *retryingStream) resend() error {
+	for _, msg := range s.sent {
+		if err := s.StreamingClientConn.Send(msg); err != nil {
+			return err
+		}
+	}
+	if s.closed {
+		return s.StreamingClientConn.CloseRequest()
+	}
+	return nil
+}
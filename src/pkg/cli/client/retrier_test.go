@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/DefangLabs/defang/src/protos/io/defang/v1/defangv1connect"
@@ -14,6 +15,12 @@ import (
 type grpcMockHandler struct {
 	defangv1connect.UnimplementedFabricControllerHandler
 	tries int
+	fails int // number of calls that fail before succeeding; all fail if 0
+}
+
+func (g *grpcMockHandler) fail() bool {
+	g.tries++
+	return g.fails == 0 || g.tries <= g.fails
 }
 
 func (g *grpcMockHandler) Deploy(context.Context, *connect.Request[defangv1.DeployRequest]) (*connect.Response[defangv1.DeployResponse], error) {
@@ -21,10 +28,31 @@ func (g *grpcMockHandler) Deploy(context.Context, *connect.Request[defangv1.Depl
 	return nil, connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
 }
 
-// func (g *grpcMockHandler) Tail(ctx context.Context, r *connect.Request[defangv1.TailRequest], s *connect.ServerStream[defangv1.TailResponse]) error {
-// 	g.tries++
-// 	return connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
-// }
+func (g *grpcMockHandler) GetServices(context.Context, *connect.Request[defangv1.GetServicesRequest]) (*connect.Response[defangv1.GetServicesResponse], error) {
+	if g.fail() {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+	}
+	return connect.NewResponse(&defangv1.GetServicesResponse{Project: "app"}), nil
+}
+
+func (g *grpcMockHandler) CreateUploadURL(context.Context, *connect.Request[defangv1.UploadURLRequest]) (*connect.Response[defangv1.UploadURLResponse], error) {
+	if g.fail() {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+	}
+	return connect.NewResponse(&defangv1.UploadURLResponse{Url: "https://example.com/upload"}), nil
+}
+
+func (g *grpcMockHandler) Tail(ctx context.Context, r *connect.Request[defangv1.TailRequest], s *connect.ServerStream[defangv1.TailResponse]) error {
+	if g.fail() {
+		return connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+	}
+	return s.Send(&defangv1.TailResponse{Service: r.Msg.Services[0]})
+}
+
+func (g *grpcMockHandler) Delete(context.Context, *connect.Request[defangv1.DeleteRequest]) (*connect.Response[defangv1.DeleteResponse], error) {
+	g.tries++
+	return nil, connect.NewError(connect.CodeInternal, errors.New("internal"))
+}
 
 func TestRetrier(t *testing.T) {
 	fabricServer := &grpcMockHandler{}
@@ -39,7 +67,95 @@ func TestRetrier(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error")
 	}
-	if fabricServer.tries != 2 {
-		t.Fatalf("expected 2 tries, got %d", fabricServer.tries)
+	if fabricServer.tries != 1 {
+		t.Fatalf("expected 1 try, got %d", fabricServer.tries)
+	}
+}
+
+func TestRetrierPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	newClient := func(fabricServer *grpcMockHandler) defangv1connect.FabricControllerClient {
+		_, handler := defangv1connect.NewFabricControllerHandler(fabricServer)
+		server := httptest.NewTLSServer(handler)
+		t.Cleanup(server.Close)
+		return defangv1connect.NewFabricControllerClient(server.Client(), server.URL, connect.WithGRPC(), connect.WithInterceptors(Retrier{policy}))
+	}
+
+	t.Run("idempotent call succeeds after retries", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{fails: 2}
+		resp, err := newClient(fabricServer).GetServices(t.Context(), connect.NewRequest(&defangv1.GetServicesRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Msg.Project != "app" || fabricServer.tries != 3 {
+			t.Errorf("expected success after 3 tries, got %d", fabricServer.tries)
+		}
+	})
+
+	t.Run("idempotent call gives up after max attempts", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{}
+		_, err := newClient(fabricServer).GetServices(t.Context(), connect.NewRequest(&defangv1.GetServicesRequest{}))
+		if connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Fatalf("expected unavailable, got %v", err)
+		}
+		if fabricServer.tries != 3 {
+			t.Errorf("expected 3 tries, got %d", fabricServer.tries)
+		}
+	})
+
+	t.Run("upload URL is retried", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{fails: 1}
+		if _, err := newClient(fabricServer).CreateUploadURL(t.Context(), connect.NewRequest(&defangv1.UploadURLRequest{})); err != nil {
+			t.Fatal(err)
+		}
+		if fabricServer.tries != 2 {
+			t.Errorf("expected 2 tries, got %d", fabricServer.tries)
+		}
+	})
+
+	t.Run("non-idempotent call is not retried", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{}
+		_, err := newClient(fabricServer).Deploy(t.Context(), connect.NewRequest(&defangv1.DeployRequest{}))
+		if connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Fatalf("expected unavailable, got %v", err)
+		}
+		if fabricServer.tries != 1 {
+			t.Errorf("expected 1 try, got %d", fabricServer.tries)
+		}
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{}
+		if _, err := newClient(fabricServer).Delete(t.Context(), connect.NewRequest(&defangv1.DeleteRequest{})); err == nil {
+			t.Fatal("expected error")
+		}
+		if fabricServer.tries != 1 {
+			t.Errorf("expected 1 try, got %d", fabricServer.tries)
+		}
+	})
+
+	t.Run("stream is reopened before the first message", func(t *testing.T) {
+		fabricServer := &grpcMockHandler{fails: 2}
+		stream, err := newClient(fabricServer).Tail(t.Context(), connect.NewRequest(&defangv1.TailRequest{Services: []string{"web"}}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer stream.Close()
+		if !stream.Receive() {
+			t.Fatalf("expected a message, got %v", stream.Err())
+		}
+		if stream.Msg().Service != "web" || fabricServer.tries != 3 {
+			t.Errorf("expected a message after 3 tries, got %d", fabricServer.tries)
+		}
+	})
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		retry := i + 1
+		if got := policy.backoff(retry); got < want/2 || got > want {
+			t.Errorf("retry %d: expected between %v and %v, got %v", retry, want/2, want, got)
+		}
 	}
 }
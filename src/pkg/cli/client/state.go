@@ -20,6 +20,8 @@ var (
 type State struct {
 	AnonID          string
 	TermsAcceptedAt time.Time
+	ActiveProject   string `json:",omitempty"` // set with "defang projects use"
+	Workspace       string `json:",omitempty"` // set with "defang workspace use"
 }
 
 func initState(path string) State {
@@ -63,3 +65,29 @@ func AcceptTerms() error {
 func TermsAccepted() bool {
 	return state.termsAccepted()
 }
+
+// GetActiveProject returns the project selected with SetActiveProject, if any.
+func GetActiveProject() string {
+	return initState(statePath).ActiveProject
+}
+
+// SetActiveProject persists the project to use when no project name is given and no Compose file is found;
+// an empty name clears the active project.
+func SetActiveProject(projectName string) error {
+	state = initState(statePath)
+	state.ActiveProject = projectName
+	return state.write(statePath)
+}
+
+// GetDefaultWorkspace returns the workspace selected with SetDefaultWorkspace, if any.
+func GetDefaultWorkspace() string {
+	return initState(statePath).Workspace
+}
+
+// SetDefaultWorkspace persists the workspace (tenant) to use when none is given with --workspace or
+// DEFANG_WORKSPACE; an empty name clears it.
+func SetDefaultWorkspace(workspace string) error {
+	state = initState(statePath)
+	state.Workspace = workspace
+	return state.write(statePath)
+}
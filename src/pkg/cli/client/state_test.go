@@ -55,3 +55,56 @@ func TestTerms(t *testing.T) {
 		t.Errorf("TermsAccepted() returned true, expected false")
 	}
 }
+
+func TestActiveProject(t *testing.T) {
+	origStateDir, origStatePath := StateDir, statePath
+	t.Cleanup(func() { StateDir, statePath = origStateDir, origStatePath })
+	StateDir = t.TempDir()
+	statePath = filepath.Join(StateDir, "state.json")
+
+	if project := GetActiveProject(); project != "" {
+		t.Errorf("GetActiveProject() = %q, expected empty", project)
+	}
+	if err := SetActiveProject("myproject"); err != nil {
+		t.Fatalf("SetActiveProject() returned error: %v", err)
+	}
+	if project := GetActiveProject(); project != "myproject" {
+		t.Errorf("GetActiveProject() = %q, expected %q", project, "myproject")
+	}
+	anonID := GetAnonID()
+	if err := SetActiveProject(""); err != nil {
+		t.Fatalf("SetActiveProject() returned error: %v", err)
+	}
+	if project := GetActiveProject(); project != "" {
+		t.Errorf("GetActiveProject() = %q after clearing, expected empty", project)
+	}
+	if GetAnonID() != anonID {
+		t.Errorf("SetActiveProject() changed the AnonID")
+	}
+}
+
+func TestDefaultWorkspace(t *testing.T) {
+	origStateDir, origStatePath := StateDir, statePath
+	t.Cleanup(func() { StateDir, statePath = origStateDir, origStatePath })
+	StateDir = t.TempDir()
+	statePath = filepath.Join(StateDir, "state.json")
+
+	if err := SetActiveProject("myproject"); err != nil {
+		t.Fatalf("SetActiveProject() returned error: %v", err)
+	}
+	if err := SetDefaultWorkspace("acme"); err != nil {
+		t.Fatalf("SetDefaultWorkspace() returned error: %v", err)
+	}
+	if workspace := GetDefaultWorkspace(); workspace != "acme" {
+		t.Errorf("GetDefaultWorkspace() = %q, expected %q", workspace, "acme")
+	}
+	if project := GetActiveProject(); project != "myproject" {
+		t.Errorf("SetDefaultWorkspace() changed the active project to %q", project)
+	}
+	if err := SetDefaultWorkspace(""); err != nil {
+		t.Fatalf("SetDefaultWorkspace() returned error: %v", err)
+	}
+	if workspace := GetDefaultWorkspace(); workspace != "" {
+		t.Errorf("GetDefaultWorkspace() = %q after clearing, expected empty", workspace)
+	}
+}
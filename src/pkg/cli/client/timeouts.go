@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"maps"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/bufbuild/connect-go"
+)
+
+// ClientTimeouts configures the deadlines of calls to the Fabric controller and the keep-alive of its connections.
+// Streaming calls, like Tail and Subscribe, never get a deadline; the keep-alive detects dropped connections instead.
+type ClientTimeouts struct {
+	Call             time.Duration            // deadline for unary calls without one; 0 means no deadline
+	Calls            map[string]time.Duration // per-call deadlines by method name, eg. "Deploy"; overrides Call
+	KeepAlive        time.Duration            // ping idle connections after this duration; 0 disables keep-alive
+	KeepAliveTimeout time.Duration            // close the connection if a ping is not answered within this duration
+}
+
+var DefaultClientTimeouts = ClientTimeouts{
+	Call: 2 * time.Minute,
+	Calls: map[string]time.Duration{
+		// Long-running calls get no deadline, since they can take longer than any sensible default
+		"Debug":           0, // AI calls
+		"GenerateCompose": 0,
+		"GenerateFiles":   0,
+		"Delete":          0, // Playground deployments
+		"Deploy":          0,
+		"Destroy":         0,
+		"Estimate":        0,
+		"Preview":         0,
+	},
+	KeepAlive:        30 * time.Second,
+	KeepAliveTimeout: 15 * time.Second,
+}
+
+// LoadClientTimeouts returns the default timeouts, overridden by these environment variables:
+//
+//	DEFANG_RPC_TIMEOUT        deadline for calls, eg. "5m"; "0" means no deadline
+//	DEFANG_RPC_TIMEOUTS       per-call deadlines, eg. "Deploy=30m,Debug=5m"
+//	DEFANG_KEEPALIVE          idle time before pinging the connection; "0" disables keep-alive
+//	DEFANG_KEEPALIVE_TIMEOUT  time to wait for a ping response before closing the connection
+func LoadClientTimeouts() ClientTimeouts {
+	timeouts := DefaultClientTimeouts
+	timeouts.Calls = maps.Clone(DefaultClientTimeouts.Calls)
+
+	getenvDuration("DEFANG_RPC_TIMEOUT", &timeouts.Call)
+	getenvDuration("DEFANG_KEEPALIVE", &timeouts.KeepAlive)
+	getenvDuration("DEFANG_KEEPALIVE_TIMEOUT", &timeouts.KeepAliveTimeout)
+	if calls := os.Getenv("DEFANG_RPC_TIMEOUTS"); calls != "" {
+		for _, call := range strings.Split(calls, ",") {
+			method, value, _ := strings.Cut(strings.TrimSpace(call), "=")
+			timeout, err := time.ParseDuration(value)
+			if method == "" || err != nil || timeout < 0 {
+				term.Warnf("ignoring invalid DEFANG_RPC_TIMEOUTS entry %q; expected METHOD=DURATION", call)
+				continue
+			}
+			timeouts.Calls[method] = timeout
+		}
+	}
+	return timeouts
+}
+
+func getenvDuration(key string, dst *time.Duration) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		term.Warnf("ignoring invalid %s=%q; expected a duration like \"30s\"", key, value)
+		return
+	}
+	*dst = d
+}
+
+// CallTimeout returns the deadline for the given procedure, eg. "/io.defang.v1.FabricController/Deploy".
+func (t ClientTimeouts) CallTimeout(procedure string) time.Duration {
+	if timeout, ok := t.Calls[path.Base(procedure)]; ok {
+		return timeout
+	}
+	return t.Call
+}
+
+type callTimeoutKey struct{}
+
+// WithCallTimeout returns a context that overrides the default deadline of unary calls, eg. from the --rpc-timeout
+// flag. Per-call deadlines, like the ones for long-running calls, still take precedence.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, timeout)
+}
+
+func (t ClientTimeouts) contextCallTimeout(ctx context.Context, procedure string) time.Duration {
+	if timeout, ok := ctx.Value(callTimeoutKey{}).(time.Duration); ok {
+		t.Call = timeout
+	}
+	return t.CallTimeout(procedure)
+}
+
+// NewHTTPClient returns an HTTP client for the Fabric controller with keep-alive enabled.
+func (t ClientTimeouts) NewHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: -1} // negative disables TCP keep-alive
+	if t.KeepAlive > 0 {
+		dialer.KeepAlive = t.KeepAlive
+		// Pings keep idle gRPC streams, like Tail, from being dropped by proxies and NAT gateways
+		transport.HTTP2 = &http.HTTP2Config{SendPingTimeout: t.KeepAlive, PingTimeout: t.KeepAliveTimeout}
+	}
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Transport: transport}
+}
+
+// deadlineInterceptor applies the configured deadline to unary calls that don't have one already.
+type deadlineInterceptor struct {
+	timeouts ClientTimeouts
+}
+
+func (d deadlineInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			if timeout := d.timeouts.contextCallTimeout(ctx, req.Spec().Procedure); timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (deadlineInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next // long-running streams, like Tail, rely on the keep-alive instead
+}
+
+func (deadlineInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
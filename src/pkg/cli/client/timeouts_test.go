@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestLoadClientTimeouts(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		timeouts := LoadClientTimeouts()
+		if got := timeouts.CallTimeout("/io.defang.v1.FabricController/GetServices"); got != 2*time.Minute {
+			t.Errorf("expected default call timeout, got %v", got)
+		}
+		for _, method := range []string{"Deploy", "Delete", "Destroy", "Preview", "Debug"} {
+			if got := timeouts.CallTimeout("/io.defang.v1.FabricController/" + method); got != 0 {
+				t.Errorf("expected no deadline for long-running %s, got %v", method, got)
+			}
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("DEFANG_RPC_TIMEOUT", "0")
+		t.Setenv("DEFANG_RPC_TIMEOUTS", "Deploy=30m, Debug=bad, GetServices=5s")
+		t.Setenv("DEFANG_KEEPALIVE", "-1s")
+		t.Setenv("DEFANG_KEEPALIVE_TIMEOUT", "20s")
+		timeouts := LoadClientTimeouts()
+		if timeouts.Call != 0 {
+			t.Errorf("expected no default deadline, got %v", timeouts.Call)
+		}
+		if got := timeouts.CallTimeout("/io.defang.v1.FabricController/Deploy"); got != 30*time.Minute {
+			t.Errorf("expected Deploy timeout of 30m, got %v", got)
+		}
+		if got := timeouts.CallTimeout("/io.defang.v1.FabricController/Debug"); got != 0 {
+			t.Errorf("expected invalid Debug timeout to be ignored, got %v", got)
+		}
+		if got := timeouts.CallTimeout("/io.defang.v1.FabricController/GetServices"); got != 5*time.Second {
+			t.Errorf("expected GetServices timeout of 5s, got %v", got)
+		}
+		if timeouts.KeepAlive != DefaultClientTimeouts.KeepAlive || timeouts.KeepAliveTimeout != 20*time.Second {
+			t.Errorf("unexpected keep-alive settings: %v, %v", timeouts.KeepAlive, timeouts.KeepAliveTimeout)
+		}
+		if DefaultClientTimeouts.Calls["Deploy"] != 0 {
+			t.Error("expected the defaults to be unmodified")
+		}
+	})
+}
+
+type specRequest struct {
+	connect.AnyRequest
+	spec connect.Spec
+}
+
+func (r specRequest) Spec() connect.Spec {
+	return r.spec
+}
+
+func TestDeadlineInterceptor(t *testing.T) {
+	interceptor := deadlineInterceptor{ClientTimeouts{Call: time.Minute, Calls: map[string]time.Duration{"Debug": 0}}}
+	var deadline time.Time
+	var hasDeadline bool
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		deadline, hasDeadline = ctx.Deadline()
+		return nil, nil
+	})
+
+	tests := []struct {
+		procedure string
+		ctx       context.Context
+		want      time.Duration
+	}{
+		{"/io.defang.v1.FabricController/GetServices", t.Context(), time.Minute},
+		{"/io.defang.v1.FabricController/Debug", t.Context(), 0},
+		{"/io.defang.v1.FabricController/GetServices", WithCallTimeout(t.Context(), 10*time.Second), 10 * time.Second},
+		{"/io.defang.v1.FabricController/GetServices", WithCallTimeout(t.Context(), 0), 0},
+		{"/io.defang.v1.FabricController/Debug", WithCallTimeout(t.Context(), 10*time.Second), 0},
+		{"/io.defang.v1.FabricController/GetServices", func() context.Context {
+			ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+			t.Cleanup(cancel)
+			return ctx
+		}(), time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			req := specRequest{connect.NewRequest(&emptypb.Empty{}), connect.Spec{Procedure: tt.procedure}}
+			if _, err := unary(tt.ctx, req); err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == 0 {
+				if hasDeadline {
+					t.Errorf("expected no deadline, got %v", time.Until(deadline))
+				}
+				return
+			}
+			if !hasDeadline || time.Until(deadline) > tt.want || time.Until(deadline) < tt.want-5*time.Second {
+				t.Errorf("expected a deadline of about %v, got %v", tt.want, time.Until(deadline))
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"os/exec"
+	"strings"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
@@ -32,12 +34,31 @@ func MarshalPretty(root string, data proto.Message) ([]byte, error) {
 	return yaml.Marshal(raw)
 }
 
-func PrintObject(root string, data proto.Message) error {
+func PrintObject(ctx context.Context, root string, data proto.Message) error {
+	t := OptionsFromContext(ctx).Term
+	if t.DoJSON() {
+		return printObjectJSON(t, root, data)
+	}
 	bytes, err := MarshalPretty(root, data)
 	if err != nil {
 		return err
 	}
-	term.Println(string(bytes))
+	t.Println(string(bytes))
+	return nil
+}
+
+func printObjectJSON(t *term.Term, root string, data proto.Message) error {
+	bytes, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if root != "" {
+		bytes, err = json.MarshalIndent(map[string]json.RawMessage{root: bytes}, "", "  ")
+		if err != nil {
+			return err
+		}
+	}
+	t.Println(string(bytes))
 	return nil
 }
 
@@ -49,9 +70,12 @@ type putDeploymentParams struct {
 	StatesUrl    string
 	EventsUrl    string
 	ServiceInfos []*defangv1.ServiceInfo
+	Compose      []byte // the deployed Compose file, for rollbacks
+	GitCommit    string // the commit of the project, if known
 }
 
 func putDeploymentAndStack(ctx context.Context, provider client.Provider, fabric client.FabricClient, stack *stacks.Parameters, req putDeploymentParams) error {
+	opts := OptionsFromContext(ctx)
 	accountInfo, err := provider.AccountInfo(ctx)
 	if err != nil {
 		return err
@@ -84,12 +108,15 @@ func putDeploymentAndStack(ctx context.Context, provider client.Provider, fabric
 
 	origin := getDeploymentOriginFromEnvironment()
 	originMetadata := getDeploymentOriginMetadataFromEnvironment()
+	if req.GitCommit != "" {
+		originMetadata["GIT_COMMIT"] = req.GitCommit
+	}
 	if len(originMetadata) > 0 {
 		originMetadataBytes, err := json.Marshal(originMetadata)
 		if err != nil {
 			return err
 		}
-		term.Debugf("Deployment origin metadata: %s", string(originMetadataBytes))
+		opts.Term.Debugf("Deployment origin metadata: %s", string(originMetadataBytes))
 	}
 
 	return fabric.PutDeployment(ctx, &defangv1.PutDeploymentRequest{
@@ -110,6 +137,7 @@ func putDeploymentAndStack(ctx context.Context, provider client.Provider, fabric
 			Origin:            origin,
 			OriginMetadata:    originMetadata,
 			Services:          req.ServiceInfos,
+			Compose:           req.Compose,
 		},
 	})
 }
@@ -135,6 +163,7 @@ func getDeploymentOriginMetadataFromEnvironment() map[string]string {
 		metadata["GITHUB_REPOSITORY"] = os.Getenv("GITHUB_REPOSITORY")
 		metadata["GITHUB_RUN_ID"] = os.Getenv("GITHUB_RUN_ID")
 		metadata["GITHUB_REF_NAME"] = os.Getenv("GITHUB_REF_NAME")
+		metadata["GITHUB_ACTOR"] = os.Getenv("GITHUB_ACTOR")
 		metadata["GIT_AUTHOR_NAME"] = os.Getenv("GIT_AUTHOR_NAME")
 		metadata["GIT_AUTHOR_EMAIL"] = os.Getenv("GIT_AUTHOR_EMAIL")
 		metadata["GIT_COMMITTER_NAME"] = os.Getenv("GIT_COMMITTER_NAME")
@@ -146,6 +175,7 @@ func getDeploymentOriginMetadataFromEnvironment() map[string]string {
 		metadata["CI_PROJECT_PATH"] = os.Getenv("CI_PROJECT_PATH")
 		metadata["CI_JOB_ID"] = os.Getenv("CI_JOB_ID")
 		metadata["CI_COMMIT_REF_NAME"] = os.Getenv("CI_COMMIT_REF_NAME")
+		metadata["GITLAB_USER_LOGIN"] = os.Getenv("GITLAB_USER_LOGIN")
 		metadata["GIT_AUTHOR_NAME"] = os.Getenv("GIT_AUTHOR_NAME")
 		metadata["GIT_AUTHOR_EMAIL"] = os.Getenv("GIT_AUTHOR_EMAIL")
 		metadata["GIT_COMMITTER_NAME"] = os.Getenv("GIT_COMMITTER_NAME")
@@ -154,3 +184,21 @@ func getDeploymentOriginMetadataFromEnvironment() map[string]string {
 
 	return metadata
 }
+
+// getGitCommit returns the commit of the project, from the CI environment or else the Git repository of the working
+// dir. Returns an empty string if it's unknown.
+func getGitCommit(ctx context.Context, workingDir string) string {
+	for _, key := range []string{"GITHUB_SHA", "CI_COMMIT_SHA"} {
+		if sha := os.Getenv(key); sha != "" {
+			return sha
+		}
+	}
+	if workingDir == "" {
+		return "" // not loaded from disk, like the deployed Compose file
+	}
+	out, err := exec.CommandContext(ctx, "git", "-C", workingDir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "" // not a Git repository, or Git is not installed
+	}
+	return strings.TrimSpace(string(out))
+}
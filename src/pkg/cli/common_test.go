@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+func TestPrintObjectJSON(t *testing.T) {
+	defaultTerm := term.DefaultTerm
+	t.Cleanup(func() {
+		term.DefaultTerm = defaultTerm
+	})
+
+	var stdout, stderr bytes.Buffer
+	term.DefaultTerm = term.NewTerm(os.Stdin, &stdout, &stderr)
+	term.SetJSON(true)
+
+	if err := PrintObject(t.Context(), "secrets", &defangv1.Secrets{Names: []string{"A"}, Project: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	const expected = "{\n  \"secrets\": {\n    \"names\": [\n      \"A\"\n    ],\n    \"project\": \"p\"\n  }\n}\n"
+	if got := stdout.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestGetGitCommit(t *testing.T) {
+	t.Setenv("GITHUB_SHA", "")
+	t.Setenv("CI_COMMIT_SHA", "")
+	if commit := getGitCommit(t.Context(), t.TempDir()); commit != "" {
+		t.Errorf("expected no commit outside of a Git repository, got %q", commit)
+	}
+
+	t.Setenv("CI_COMMIT_SHA", "0123456789abcdef")
+	if commit := getGitCommit(t.Context(), ""); commit != "0123456789abcdef" {
+		t.Errorf("expected the commit of the CI environment, got %q", commit)
+	}
+}
@@ -0,0 +1,99 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+const autoscalingSyntax = `x-defang-autoscaling must be a boolean or object {"min_replicas": int, "max_replicas": int, "cpu_percent": int, "requests_per_second": int, "queue_depth": int}`
+
+// ParseAutoscaling parses the x-defang-autoscaling extension into an autoscaling policy.
+// Returns nil if autoscaling is disabled. A zero value means "use the provider default".
+func ParseAutoscaling(autoscaling any) (*defangv1.Autoscaling, error) {
+	switch autoscaling := autoscaling.(type) {
+	case nil:
+		return &defangv1.Autoscaling{}, nil
+	case bool:
+		if !autoscaling {
+			return nil, nil
+		}
+		return &defangv1.Autoscaling{}, nil
+	case string:
+		enabled, err := strconv.ParseBool(autoscaling)
+		if err != nil {
+			return nil, errors.New(autoscalingSyntax)
+		}
+		return ParseAutoscaling(enabled)
+	case map[string]any:
+		policy := &defangv1.Autoscaling{}
+		for _, field := range []struct {
+			key string
+			dst *uint32
+		}{
+			{"min_replicas", &policy.MinReplicas},
+			{"max_replicas", &policy.MaxReplicas},
+			{"cpu_percent", &policy.TargetCpuPercent},
+			{"requests_per_second", &policy.TargetRequestsPerSecond},
+			{"queue_depth", &policy.TargetQueueDepth},
+		} {
+			if val, ok := autoscaling[field.key]; ok {
+				n, err := toUint32(val)
+				if err != nil {
+					return nil, fmt.Errorf("x-defang-autoscaling: '%s' %w", field.key, err)
+				}
+				*field.dst = n
+			}
+		}
+		if policy.MaxReplicas > 0 && policy.MinReplicas > policy.MaxReplicas {
+			return nil, fmt.Errorf("x-defang-autoscaling: 'min_replicas' (%d) must not be greater than 'max_replicas' (%d)", policy.MinReplicas, policy.MaxReplicas)
+		}
+		// Zero means "not set" in the policy, so an explicit 0 is checked against the original value
+		if _, ok := autoscaling["cpu_percent"]; ok && (policy.TargetCpuPercent <= 0 || policy.TargetCpuPercent > 100) {
+			return nil, fmt.Errorf("x-defang-autoscaling: 'cpu_percent' must be between 1 and 100, got %d", policy.TargetCpuPercent)
+		}
+		return policy, nil
+	default:
+		return nil, errors.New(autoscalingSyntax)
+	}
+}
+
+// GetAutoscaling returns the autoscaling policy of the service, or nil if it's not autoscaled.
+func GetAutoscaling(service *types.ServiceConfig) *defangv1.Autoscaling {
+	autoscalingVal, ok := service.Extensions["x-defang-autoscaling"]
+	if !ok {
+		return nil
+	}
+	policy, _ := ParseAutoscaling(autoscalingVal) // already validated
+	return policy
+}
+
+func toUint32(val any) (uint32, error) {
+	var n float64
+	switch val := val.(type) {
+	case int:
+		n = float64(val)
+	case int64:
+		n = float64(val)
+	case uint64:
+		n = float64(val)
+	case float64:
+		n = val
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0, errors.New("must be a non-negative integer")
+		}
+		n = f
+	default:
+		return 0, errors.New("must be a non-negative integer")
+	}
+	if n < 0 || n != math.Trunc(n) || n > math.MaxUint32 {
+		return 0, errors.New("must be a non-negative integer")
+	}
+	return uint32(n), nil
+}
@@ -0,0 +1,50 @@
+package compose
+
+import (
+	"testing"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseAutoscaling(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    *defangv1.Autoscaling
+		wantErr string
+	}{
+		{"true", true, &defangv1.Autoscaling{}, ""},
+		{"false", false, nil, ""},
+		{"string", "true", &defangv1.Autoscaling{}, ""},
+		{"null", nil, &defangv1.Autoscaling{}, ""},
+		{
+			name:  "policy",
+			value: map[string]any{"min_replicas": 2, "max_replicas": uint64(10), "cpu_percent": 70, "requests_per_second": float64(100), "queue_depth": "5"},
+			want:  &defangv1.Autoscaling{MinReplicas: 2, MaxReplicas: 10, TargetCpuPercent: 70, TargetRequestsPerSecond: 100, TargetQueueDepth: 5},
+		},
+		{"min > max", map[string]any{"min_replicas": 5, "max_replicas": 2}, nil, "x-defang-autoscaling: 'min_replicas' (5) must not be greater than 'max_replicas' (2)"},
+		{"cpu 0", map[string]any{"cpu_percent": 0}, nil, "x-defang-autoscaling: 'cpu_percent' must be between 1 and 100, got 0"},
+		{"cpu > 100", map[string]any{"cpu_percent": 150}, nil, "x-defang-autoscaling: 'cpu_percent' must be between 1 and 100, got 150"},
+		{"negative", map[string]any{"max_replicas": -1}, nil, "x-defang-autoscaling: 'max_replicas' must be a non-negative integer"},
+		{"fraction", map[string]any{"queue_depth": 1.5}, nil, "x-defang-autoscaling: 'queue_depth' must be a non-negative integer"},
+		{"invalid", 42, nil, autoscalingSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAutoscaling(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+const backupSyntax = `x-defang-backup must be a boolean or object {"schedule": "hourly"|"daily"|"weekly"|cron, "retention_days": int}`
+
+// ParseBackupPolicy parses the x-defang-backup extension into a backup policy.
+// Returns nil if backups are disabled. A zero value means "use the provider default".
+func ParseBackupPolicy(backup any) (*defangv1.BackupPolicy, error) {
+	switch backup := backup.(type) {
+	case nil:
+		return &defangv1.BackupPolicy{}, nil
+	case bool:
+		if !backup {
+			return nil, nil
+		}
+		return &defangv1.BackupPolicy{}, nil
+	case string:
+		enabled, err := strconv.ParseBool(backup)
+		if err != nil {
+			return nil, errors.New(backupSyntax)
+		}
+		return ParseBackupPolicy(enabled)
+	case map[string]any:
+		policy := &defangv1.BackupPolicy{}
+		for key, val := range backup {
+			switch key {
+			case "schedule":
+				schedule, ok := val.(string)
+				if !ok || !isValidBackupSchedule(schedule) {
+					return nil, fmt.Errorf("x-defang-backup: 'schedule' must be hourly, daily, weekly, or a cron expression like \"0 3 * * *\"")
+				}
+				policy.Schedule = strings.TrimSpace(schedule)
+			case "retention_days":
+				n, err := toUint32(val)
+				if err != nil {
+					return nil, fmt.Errorf("x-defang-backup: 'retention_days' %w", err)
+				}
+				policy.RetentionDays = n
+			default:
+				return nil, errors.New(backupSyntax)
+			}
+		}
+		return policy, nil
+	default:
+		return nil, errors.New(backupSyntax)
+	}
+}
+
+// GetBackupPolicy returns the backup policy of the service, or nil if it has no scheduled backups.
+func GetBackupPolicy(service *types.ServiceConfig) *defangv1.BackupPolicy {
+	backupVal, ok := service.Extensions["x-defang-backup"]
+	if !ok {
+		return nil
+	}
+	policy, _ := ParseBackupPolicy(backupVal) // already validated
+	return policy
+}
+
+// HasBackupableState returns true if the service has state that can be backed up,
+// ie. it is a managed store or has persistent volumes.
+func HasBackupableState(service *types.ServiceConfig) bool {
+	return service.Extensions["x-defang-postgres"] != nil ||
+		service.Extensions["x-defang-redis"] != nil ||
+		service.Extensions["x-defang-mongodb"] != nil ||
+		hasPersistentVolume(service)
+}
+
+func isValidBackupSchedule(schedule string) bool {
+	switch schedule = strings.TrimSpace(schedule); schedule {
+	case "hourly", "daily", "weekly":
+		return true
+	}
+	return len(strings.Fields(schedule)) == 5 // minute hour day-of-month month day-of-week
+}
@@ -0,0 +1,94 @@
+package compose
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseBackupPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    *defangv1.BackupPolicy
+		wantErr string
+	}{
+		{"true", true, &defangv1.BackupPolicy{}, ""},
+		{"false", false, nil, ""},
+		{"string", "true", &defangv1.BackupPolicy{}, ""},
+		{"null", nil, &defangv1.BackupPolicy{}, ""},
+		{"daily", map[string]any{"schedule": "daily", "retention_days": 7}, &defangv1.BackupPolicy{Schedule: "daily", RetentionDays: 7}, ""},
+		{"cron", map[string]any{"schedule": "0 3 * * *"}, &defangv1.BackupPolicy{Schedule: "0 3 * * *"}, ""},
+		{"bad schedule", map[string]any{"schedule": "monthly"}, nil, "x-defang-backup: 'schedule' must be hourly, daily, weekly, or a cron expression like \"0 3 * * *\""},
+		{"negative", map[string]any{"retention_days": -1}, nil, "x-defang-backup: 'retention_days' must be a non-negative integer"},
+		{"unknown key", map[string]any{"keep": 3}, nil, backupSyntax},
+		{"invalid", 42, nil, backupSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBackupPolicy(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateBackup(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	tests := []struct {
+		name    string
+		service composeTypes.ServiceConfig
+		wantErr string
+	}{
+		{
+			name:    "managed postgres",
+			service: composeTypes.ServiceConfig{Name: "db", Image: "postgres:16", Extensions: composeTypes.Extensions{"x-defang-postgres": true, "x-defang-backup": true}},
+		},
+		{
+			name: "volume",
+			service: composeTypes.ServiceConfig{Name: "data", Image: "minio/minio", Extensions: composeTypes.Extensions{"x-defang-backup": map[string]any{"schedule": "hourly"}},
+				Volumes: []composeTypes.ServiceVolumeConfig{{Type: "volume", Source: "data", Target: "/data"}}},
+		},
+		{
+			name:    "stateless",
+			service: composeTypes.ServiceConfig{Name: "web", Image: "nginx", Extensions: composeTypes.Extensions{"x-defang-backup": true}},
+			wantErr: `service "web": x-defang-backup requires a managed store or volumes`,
+		},
+		{
+			name:    "disabled",
+			service: composeTypes.ServiceConfig{Name: "web", Image: "nginx", Extensions: composeTypes.Extensions{"x-defang-backup": false}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := &composeTypes.Project{Name: "app", Services: composeTypes.Services{tt.service.Name: tt.service}, Volumes: composeTypes.Volumes{"data": {}}}
+			err := ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderAuto)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateProject(ctx) error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateProject(ctx) error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
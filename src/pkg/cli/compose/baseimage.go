@@ -1,19 +1,19 @@
 package compose
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 
-	"github.com/DefangLabs/defang/src/pkg/term"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
 	"github.com/moby/buildkit/frontend/dockerfile/instructions"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 )
 
-func FindAllBaseImages(project *composeTypes.Project) ([]string, error) {
+func FindAllBaseImages(ctx context.Context, project *composeTypes.Project) ([]string, error) {
 	baseImages := make(map[string]struct{})
 	for _, service := range project.Services {
 		if service.Build != nil && service.Build.Context != "" {
@@ -25,7 +25,7 @@ func FindAllBaseImages(project *composeTypes.Project) ([]string, error) {
 			images, err := extractDockerfileBaseImages(dockerfileFullPath)
 			if err != nil {
 				if os.IsNotExist(err) {
-					term.Debugf("service %q: dockerfile %q does not exist; skipping", service.Name, dockerfileFullPath)
+					termFromContext(ctx).Debugf("service %q: dockerfile %q does not exist; skipping", service.Name, dockerfileFullPath)
 					continue
 				}
 				return nil, err
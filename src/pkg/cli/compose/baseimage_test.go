@@ -15,7 +15,7 @@ func TestFindAllBaseImages(t *testing.T) {
 		t.Fatalf("Failed to load base-image compose.yaml: %v", err)
 	}
 
-	images, err := FindAllBaseImages(project)
+	images, err := FindAllBaseImages(t.Context(), project)
 	if err != nil {
 		t.Fatalf("Received unexpected error: %v", err)
 	}
@@ -0,0 +1,70 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+const bucketSyntax = `x-defang-bucket must be a boolean or object {"public": bool, "versioning": bool}`
+
+// BucketOptions are the settings of a managed object storage bucket.
+type BucketOptions struct {
+	Public     bool
+	Versioning bool
+}
+
+// ParseBucket parses the x-defang-bucket extension into the bucket options.
+// Returns nil if the bucket is disabled, like with `x-defang-bucket: false`.
+func ParseBucket(bucket any) (*BucketOptions, error) {
+	switch bucket := bucket.(type) {
+	case nil:
+		return &BucketOptions{}, nil
+	case bool:
+		if !bucket {
+			return nil, nil
+		}
+		return &BucketOptions{}, nil
+	case string:
+		enabled, err := strconv.ParseBool(bucket)
+		if err != nil {
+			return nil, errors.New(bucketSyntax)
+		}
+		return ParseBucket(enabled)
+	case map[string]any:
+		options := &BucketOptions{}
+		for _, field := range []struct {
+			key string
+			dst *bool
+		}{
+			{"public", &options.Public},
+			{"versioning", &options.Versioning},
+		} {
+			if val, ok := bucket[field.key]; ok {
+				b, ok := val.(bool)
+				if !ok {
+					return nil, fmt.Errorf("x-defang-bucket: '%s' must be a boolean", field.key)
+				}
+				*field.dst = b
+			}
+		}
+		return options, nil
+	default:
+		return nil, errors.New(bucketSyntax)
+	}
+}
+
+// GetBucket returns the options of the managed bucket of the service, or nil if the service is not a bucket.
+func GetBucket(service *types.ServiceConfig) *BucketOptions {
+	bucketVal, ok := service.Extensions["x-defang-bucket"]
+	if !ok {
+		return nil
+	}
+	options, err := ParseBucket(bucketVal)
+	if err != nil {
+		return &BucketOptions{} // still a bucket; the error is reported by ValidateProject
+	}
+	return options
+}
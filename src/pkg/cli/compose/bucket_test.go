@@ -0,0 +1,68 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBucket(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    *BucketOptions
+		wantErr string
+	}{
+		{"true", true, &BucketOptions{}, ""},
+		{"false", false, nil, ""},
+		{"null", nil, &BucketOptions{}, ""},
+		{"string", "false", nil, ""},
+		{"options", map[string]any{"public": true, "versioning": false}, &BucketOptions{Public: true}, ""},
+		{"invalid string", "yes", nil, bucketSyntax},
+		{"invalid option", map[string]any{"public": "yes"}, nil, "x-defang-bucket: 'public' must be a boolean"},
+		{"invalid", 42, nil, bucketSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBucket(tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBucketDisabled(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	newProject := func(bucket any) *composeTypes.Project {
+		return &composeTypes.Project{
+			Name: "app",
+			Services: composeTypes.Services{
+				"storage": {Name: "storage", Image: "minio/minio", Extensions: map[string]any{"x-defang-bucket": bucket}},
+				"web":     {Name: "web", Image: "nginx", DependsOn: composeTypes.DependsOnConfig{"storage": {Condition: composeTypes.ServiceConditionStarted}}},
+			},
+		}
+	}
+
+	t.Run("false", func(t *testing.T) {
+		project := newProject(false)
+		assert.NoError(t, FixupServices(t.Context(), &client.MockProvider{}, project, UploadModeIgnore))
+		assert.Equal(t, "minio/minio", project.Services["storage"].Image, "a disabled bucket is a regular service")
+		assert.Empty(t, project.Services["web"].Environment, "no bucket env vars for a disabled bucket")
+		storage := project.Services["storage"]
+		assert.True(t, IsComputeService(&storage))
+	})
+
+	t.Run("invalid string", func(t *testing.T) {
+		project := newProject("yes")
+		assert.ErrorContains(t, ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderAuto), bucketSyntax)
+	})
+}
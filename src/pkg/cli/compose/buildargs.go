@@ -0,0 +1,88 @@
+package compose
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"go.yaml.in/yaml/v4"
+)
+
+// bareBuildArgs returns, for each service, the build args without a value in the Compose files, like "args: [VERSION]".
+func bareBuildArgs(configPaths []string) map[string][]string {
+	bare := make(map[string][]string)
+	for _, path := range configPaths {
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			continue // the loader reports it
+		}
+		var file struct {
+			Services map[string]struct {
+				Build any `yaml:"build"`
+			} `yaml:"services"`
+		}
+		if err := yaml.Unmarshal(bytes, &file); err != nil {
+			continue
+		}
+		for name, service := range file.Services {
+			build, ok := service.Build.(map[string]any)
+			if !ok {
+				continue // short syntax, without args
+			}
+			switch args := build["args"].(type) {
+			case []any:
+				for _, arg := range args {
+					if s, ok := arg.(string); ok && !strings.Contains(s, "=") {
+						bare[name] = append(bare[name], s)
+					}
+				}
+			case map[string]any:
+				for key, value := range args {
+					if value == nil {
+						bare[name] = append(bare[name], key)
+					}
+				}
+			}
+		}
+	}
+	return bare
+}
+
+// resolveBareBuildArgs adds the host environment variables named by bare build args to the project environment,
+// so the args get their value from the host like with Docker Compose. Unlike a ${VAR}, which is only interpolated
+// from the host environment with --os-env, a bare build arg is an explicit opt-in for that variable.
+func resolveBareBuildArgs(ctx context.Context, projOpts *cli.ProjectOptions) map[string][]string {
+	bare := bareBuildArgs(projOpts.ConfigPaths)
+	for _, args := range bare {
+		for _, arg := range args {
+			if _, ok := projOpts.Environment[arg]; ok {
+				continue // from the .env file or --os-env
+			}
+			if value, ok := os.LookupEnv(arg); ok {
+				termFromContext(ctx).Debugf("Using build argument %q from the host environment", arg)
+				projOpts.Environment[arg] = value
+			}
+		}
+	}
+	return bare
+}
+
+// warnUnsetBuildArgs warns about the bare build args that were left out, because they're not set anywhere.
+func warnUnsetBuildArgs(ctx context.Context, project *Project, bare map[string][]string) {
+	for name, args := range bare {
+		svccfg, ok := project.Services[name]
+		if !ok || svccfg.Build == nil {
+			continue
+		}
+		unset := slices.DeleteFunc(slices.Clone(args), func(arg string) bool {
+			_, ok := svccfg.Build.Args[arg]
+			return ok
+		})
+		if len(unset) > 0 {
+			slices.Sort(unset)
+			warnf(ctx, CodeUnsetVariable, "service %q: skipping unset build argument %q", name, unset)
+		}
+	}
+}
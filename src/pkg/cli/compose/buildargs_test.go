@@ -0,0 +1,73 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBareBuildArgs(t *testing.T) {
+	dir := t.TempDir()
+	composeFile := filepath.Join(dir, "compose.yaml")
+	err := os.WriteFile(composeFile, []byte(`
+services:
+  list:
+    build:
+      context: .
+      args:
+        - VERSION
+        - MODE=prod
+  map:
+    build:
+      context: .
+      args:
+        VERSION:
+        MODE: prod
+  short:
+    build: .
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string][]string{
+		"list": {"VERSION"},
+		"map":  {"VERSION"},
+	}, bareBuildArgs([]string{composeFile}))
+}
+
+func TestLoadBareBuildArgs(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "compose.yaml"), []byte(`
+services:
+  app:
+    build:
+      context: .
+      args:
+        - FROM_HOST
+        - FROM_DOTENV
+        - UNSET
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte("FROM_DOTENV=dotenv\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, _ := term.SetupTestTerm(t)
+	t.Setenv("FROM_HOST", "host")
+	t.Setenv("FROM_DOTENV", "host") // .env wins
+
+	project, err := NewLoader(WithPath(filepath.Join(dir, "compose.yaml"))).LoadProject(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := project.Services["app"].Build.Args
+	assert.Equal(t, "host", *args["FROM_HOST"])
+	assert.Equal(t, "dotenv", *args["FROM_DOTENV"])
+	assert.NotContains(t, args, "UNSET")
+	assert.Contains(t, stdout.String(), `skipping unset build argument ["UNSET"]`)
+}
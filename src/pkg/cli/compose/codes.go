@@ -0,0 +1,219 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Code is a stable identifier of a validation or conversion warning or error, like DFG1002. Each code has an
+// explanation in the catalog below, which "defang explain" prints without network access.
+type Code string
+
+const (
+	CodeUnsupportedPortMode     Code = "DFG1001"
+	CodeMissingHealthcheck      Code = "DFG1002"
+	CodeUnsupportedDirective    Code = "DFG1003"
+	CodeUnsupportedExtension    Code = "DFG1004"
+	CodeMissingMemory           Code = "DFG1005"
+	CodeInvalidPort             Code = "DFG1006"
+	CodePublishedPortIgnored    Code = "DFG1007"
+	CodeInvalidHealthcheck      Code = "DFG1008"
+	CodeInvalidBuild            Code = "DFG1009"
+	CodeUnsupportedSecret       Code = "DFG1010"
+	CodeSensitiveEnvironment    Code = "DFG1011"
+	CodeInvalidResources        Code = "DFG1012"
+	CodeInvalidExtension        Code = "DFG1013"
+	CodeExtensionNoEffect       Code = "DFG1014"
+	CodeSpotStateful            Code = "DFG1015"
+	CodeBackupWithoutState      Code = "DFG1016"
+	CodeHighAvailability        Code = "DFG1017"
+	CodeInternalDomainname      Code = "DFG1018"
+	CodeIngressConflict         Code = "DFG1019"
+	CodeMaintenanceNoIngress    Code = "DFG1020"
+	CodeBucketBuildOrPorts      Code = "DFG1021"
+	CodeManagedImageMismatch    Code = "DFG1022"
+	CodeStatefulImage           Code = "DFG1023"
+	CodeNameConflict            Code = "DFG1024"
+	CodeDefaultPortMode         Code = "DFG1025"
+	CodeInternalPort            Code = "DFG1026"
+	CodePlaygroundUnsupported   Code = "DFG1027"
+	CodeConfigOverride          Code = "DFG1028"
+	CodeSecretsAsEnvironment    Code = "DFG1029"
+	CodeUnsetVariable           Code = "DFG1030"
+	CodeIgnoredLimit            Code = "DFG1031"
+	CodeCapability              Code = "DFG1032"
+	CodeImageNotRebuilt         Code = "DFG1033"
+	CodeNameTooLong             Code = "DFG1034"
+	CodeSingleSubnet            Code = "DFG1035"
+	CodeImageRequiredNoBuild    Code = "DFG1036"
+	CodeUnsupportedSecurityOpts Code = "DFG1037"
+	CodeInvalidDependency       Code = "DFG1038"
+	CodeInvalidVolume           Code = "DFG1039"
+	CodeRouteOverlap            Code = "DFG1040"
+	CodeMissingConfig           Code = "DFG1041"
+	CodeUnsupportedInstance     Code = "DFG1042"
+	CodeMissingDockerignore     Code = "DFG1043"
+	CodeLargeBuildContext       Code = "DFG1044"
+	CodeIgnoredEnvironment      Code = "DFG1045"
+	CodeUploadFailed            Code = "DFG1046"
+	CodePublicDNSReference      Code = "DFG1047"
+	CodeDockerfileWarning       Code = "DFG1048"
+)
+
+type CodeInfo struct {
+	Code        Code
+	Title       string
+	Explanation string
+}
+
+var codeCatalog = []CodeInfo{
+	{CodeUnsupportedPortMode, "Unsupported port mode",
+		"The 'mode' of a port must be 'ingress' (exposed through the load balancer), 'host' (exposed directly on the container), or 'private' (only reachable by other services)."},
+	{CodeMissingHealthcheck, "Ingress port without healthcheck",
+		"The load balancer only sends traffic to healthy containers. Without a 'healthcheck', it sends GET / over HTTP/1.1 and expects a 2xx response, which fails for apps without such a route. Add a healthcheck, like [\"CMD\", \"curl\", \"-f\", \"http://localhost:8080/health\"]; gRPC services need a gRPC probe, like grpc_health_probe."},
+	{CodeUnsupportedDirective, "Unsupported compose directive",
+		"The directive has no equivalent in the cloud deployment. Depending on the directive, it is ignored with a warning or fails the deployment. Remove it, or move it to a compose override file that is only used locally."},
+	{CodeUnsupportedExtension, "Unsupported compose extension",
+		"Extensions that start with 'x-defang-' are interpreted by Defang; this one is unknown and is ignored. Check the spelling, or upgrade the CLI if the extension is new."},
+	{CodeMissingMemory, "Missing memory reservation",
+		"Without deploy.resources.reservations.memory, the provider picks a default which might be too small for the service, causing it to be killed when it runs out of memory. Specify the memory the service needs, eg. '512M'."},
+	{CodeInvalidPort, "Invalid port",
+		"The 'target' must be between 1 and 32767; 'host_ip' is not supported; 'protocol' must be one of tcp, udp, http, http2, or grpc; and 'app_protocol' must be one of http, http2, or grpc."},
+	{CodePublishedPortIgnored, "Published port ignored",
+		"Containers are reached on their 'target' port, so 'published' must either equal 'target' or be a range that includes it. Otherwise it is ignored."},
+	{CodeInvalidHealthcheck, "Invalid healthcheck",
+		"The 'test' must be [\"CMD\", command, args...] or [\"CMD-SHELL\", command line], which needs a shell in the image; the HEALTHCHECK of the image is not used. The 'timeout' and 'interval' must be whole seconds and the timeout must be smaller than the interval. A gRPC ingress port needs a gRPC probe, because an HTTP check cannot talk to a gRPC server."},
+	{CodeInvalidBuild, "Invalid build",
+		"The build 'context' must be a valid path and the 'dockerfile' must be a relative path inside the build context."},
+	{CodeUnsupportedSecret, "Unsupported secret",
+		"Secrets are stored with 'defang config' and must be marked 'external: true' in the top-level secrets section; the 'file', 'environment', and 'content' options are not supported. The name must be alphanumeric or _ and cannot be overridden."},
+	{CodeSensitiveEnvironment, "Sensitive environment value",
+		"The value looks like a password, key, or token. Values in the compose file end up in source control and in the deployment; use 'defang config set' to store the value securely and leave it empty in the compose file."},
+	{CodeInvalidResources, "Invalid resources",
+		"The 'cpus', 'shm_size', and 'replicas' must not be negative, limits must not be lower than reservations, and the in-memory file systems of shm_size and tmpfs must fit in the memory reservation."},
+	{CodeInvalidExtension, "Invalid x-defang extension",
+		"The value of the extension does not have the expected syntax; the message shows what is expected."},
+	{CodeExtensionNoEffect, "Extension has no effect",
+		"The extension is valid, but does nothing with the rest of the service definition, like x-defang-gpu without a GPU device reservation or x-defang-ingress without ingress ports."},
+	{CodeSpotStateful, "Spot capacity for a stateful service",
+		"Spot instances can be stopped at any time. A stateful service with a single replica would lose its data, so it cannot use x-defang-spot; add replicas or remove the extension."},
+	{CodeBackupWithoutState, "Backup without state",
+		"x-defang-backup only applies to managed stores, like x-defang-postgres, and to services with volumes."},
+	{CodeHighAvailability, "Single replica in high-availability mode",
+		"High-availability mode spreads replicas over availability zones, which requires at least 2 replicas or x-defang-autoscaling."},
+	{CodeInternalDomainname, "Domain name for an internal service",
+		"A service that is only on internal networks cannot be reached from the internet, so it cannot have a 'domainname'."},
+	{CodeIngressConflict, "Conflicting x-defang-ingress settings",
+		"Some x-defang-ingress settings depend on each other, like 'grpc_web' requiring a gRPC port, 'domains' requiring a 'domainname', and HSTS and 'auth' requiring HTTPS redirects."},
+	{CodeMaintenanceNoIngress, "Maintenance mode without ingress",
+		"The maintenance page is served by the load balancer, so x-defang-maintenance requires ingress ports."},
+	{CodeBucketBuildOrPorts, "Bucket with build or ports",
+		"A managed bucket is not a container, so it cannot have 'build' or 'ports'."},
+	{CodeManagedImageMismatch, "Unexpected image for managed service",
+		"A managed store, like x-defang-postgres, replaces the container with a cloud service of the same kind; the image is only used to run it locally. Use the matching image, so the local and cloud behavior match."},
+	{CodeStatefulImage, "Stateful container",
+		"Containers can be replaced at any time, which loses the data on their file system. Use a managed service, like x-defang-postgres, for databases, or mount a named volume for the data."},
+	{CodeNameConflict, "Service name conflict",
+		"Service names are normalized for DNS names and cloud resources, by lowercasing them and replacing special characters. Two services that normalize to the same name would conflict."},
+	{CodeDefaultPortMode, "Default port mode",
+		"Without a 'mode', TCP ports are exposed through the load balancer ('ingress') and UDP ports directly ('host'). Specify the mode to silence this warning."},
+	{CodeInternalPort, "Port on internal network",
+		"A service that is only on internal networks cannot be exposed through the public load balancer, so its ports use 'private' mode."},
+	{CodePlaygroundUnsupported, "Not supported in the Playground",
+		"The Playground is a shared environment with a limited feature set. Deploy to your own cloud account to use this feature; see https://s.defang.io/byoc."},
+	{CodeConfigOverride, "Environment overridden by config",
+		"A config value set with 'defang config' takes precedence over the value in the compose file."},
+	{CodeSecretsAsEnvironment, "Secrets as environment variables",
+		"Secrets are exposed to the service as environment variables, not as files in /run/secrets. The variable is named after the 'target', like DB_PASSWORD or /run/secrets/DB_PASSWORD, or else after the secret. Read them from the environment, or list them under 'environment' instead."},
+	{CodeUnsetVariable, "Unset variable",
+		"A build argument or environment variable without a value was skipped."},
+	{CodeIgnoredLimit, "Ignored limit",
+		"Swap, negative OOM scores, and disabling the OOM killer are not supported by the platform, so these settings are ignored."},
+	{CodeCapability, "Capability conflict",
+		"A capability that is in both cap_add and cap_drop is dropped; only a few capabilities can be added at all."},
+	{CodeImageNotRebuilt, "Image not rebuilt",
+		"The service has both 'image' and 'build', so the published image is used. Pass --build to build the image and publish it."},
+	{CodeNameTooLong, "Name too long",
+		"Some providers limit the length of resource names. Use a shorter name, or add 'x-defang-long-names: truncate' to the project to shorten names automatically."},
+	{CodeSingleSubnet, "Single private subnet",
+		"A single subnet is in a single availability zone, which does not survive an outage of that zone. Specify subnets in at least 2 availability zones."},
+	{CodeImageRequiredNoBuild, "Image required without build",
+		"With --no-build, every service must have an 'image' to deploy."},
+	{CodeUnsupportedSecurityOpts, "Unsupported security option",
+		"Containers cannot run privileged and only the no-new-privileges security option is supported."},
+	{CodeInvalidVolume, "Unsupported volume",
+		"Named volumes become persistent volumes of the platform, and anonymous volumes and tmpfs mounts use ephemeral storage, which is lost when the container is replaced. Bind mounts are not supported, because the files on this machine are not available in the cloud; COPY them into the image instead. The platform creates the volumes, so 'external' and volume drivers are not supported."},
+	{CodeInvalidDependency, "Invalid depends_on",
+		"Services are deployed in the order of their 'depends_on', so a dependency must be a service in the project and the dependencies cannot have a cycle. The conditions service_started and service_healthy are supported; service_healthy waits for the healthcheck of the dependency, or only for it to run if it has none."},
+	{CodeRouteOverlap, "Overlapping routes",
+		"The load balancer routes requests by domain name and path, so two services with the same 'domainname' cannot both receive the same paths, nor nested prefixes like /api/* and /api/v1/*. The catch-all path is the fallback, so it doesn't overlap with more specific paths. Use the 'paths' of x-defang-ingress to route distinct paths to each service."},
+	{CodeMissingConfig, "Missing config",
+		"The service references a config value that has not been set. Set it with 'defang config set NAME', or pass --config to prompt for it; see https://s.defang.io/config."},
+	{CodeUnsupportedInstance, "Unsupported instance type or GPU",
+		"The x-defang-instance-type family or x-defang-gpu class is not available from the provider. The message lists the supported values; use one of those, or remove the extension to let the provider pick."},
+	{CodeMissingDockerignore, "Missing .dockerignore",
+		"Without a .dockerignore file, the whole directory is uploaded as the build context. A default one was created, which excludes common caches and version control; add it to source control and extend it as needed."},
+	{CodeLargeBuildContext, "Large build context",
+		"The build context is uploaded for every deployment, so a large one makes deployments slow. Use --debug to see which files are included and exclude caches and build artifacts with .dockerignore."},
+	{CodeIgnoredEnvironment, "Environment variable ignored",
+		"Variables in the compose file are interpolated from the .env file, not from the shell environment, so the deployment doesn't depend on the machine it was made from. Add the variable to .env, pass --os-env to use the shell environment, or set it with 'defang config'."},
+	{CodeUploadFailed, "Build context upload failed",
+		"The build context of the service could not be uploaded, so the service is not deployed; the other services are. Fix the error and deploy again."},
+	{CodePublicDNSReference, "Public DNS reference not replaced",
+		"References to the public host name of a service are replaced with its public DNS name, which is only known when logged in. Run 'defang login' and try again."},
+	{CodeDockerfileWarning, "Dockerfile warnings",
+		"The Dockerfile has warnings from the BuildKit checks, like a missing or mismatched stage name. They don't fail the deployment, but might cause the build to behave differently than expected."},
+}
+
+// URL returns the short link to the documentation of the code.
+func (c Code) URL() string {
+	return "https://s.defang.io/" + strings.ToLower(string(c))
+}
+
+// Explain returns the catalog entry of the code, like "DFG1002"; the code is case-insensitive.
+func Explain(code string) (CodeInfo, bool) {
+	for _, info := range codeCatalog {
+		if strings.EqualFold(string(info.Code), code) {
+			return info, true
+		}
+	}
+	return CodeInfo{}, false
+}
+
+var codeInMessage = regexp.MustCompile(`\((DFG\d{4}):`)
+
+// CodeOf returns the code in a warning or error message, or "" if there is none.
+func CodeOf(msg string) Code {
+	if match := codeInMessage.FindStringSubmatch(msg); match != nil {
+		return Code(match[1])
+	}
+	return ""
+}
+
+func withCode(code Code, msg string) string {
+	return fmt.Sprintf("%s (%s: %s)", msg, code, code.URL())
+}
+
+// CodedError is a validation or conversion error with a Code; the message includes the code and its link.
+type CodedError struct {
+	Code Code
+	Err  error
+}
+
+func (e *CodedError) Error() string {
+	return withCode(e.Code, e.Err.Error())
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+func errorf(code Code, format string, a ...any) error {
+	return &CodedError{Code: code, Err: fmt.Errorf(format, a...)}
+}
+
+func warnf(ctx context.Context, code Code, format string, a ...any) {
+	termFromContext(ctx).Warn(withCode(code, fmt.Sprintf(format, a...)))
+}
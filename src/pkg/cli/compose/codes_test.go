@@ -0,0 +1,50 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestCodeCatalog(t *testing.T) {
+	validCode := regexp.MustCompile(`^DFG\d{4}$`)
+	seen := make(map[Code]bool)
+	for _, info := range codeCatalog {
+		if !validCode.MatchString(string(info.Code)) {
+			t.Errorf("invalid code %q", info.Code)
+		}
+		if seen[info.Code] {
+			t.Errorf("duplicate code %q", info.Code)
+		}
+		seen[info.Code] = true
+		if info.Title == "" || info.Explanation == "" {
+			t.Errorf("code %q is missing a title or explanation", info.Code)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	info, ok := Explain("dfg1002")
+	if !ok || info.Code != CodeMissingHealthcheck {
+		t.Errorf("expected %s, got %v", CodeMissingHealthcheck, info)
+	}
+	if _, ok := Explain("DFG9999"); ok {
+		t.Error("expected unknown code")
+	}
+}
+
+func TestCodedError(t *testing.T) {
+	err := fmt.Errorf("service %q: %w", "web", errorf(CodeUnsupportedPortMode, "port %d: 'mode' not one of [host ingress private]: %v", 80, "bad"))
+	const expected = `service "web": port 80: 'mode' not one of [host ingress private]: bad (DFG1001: https://s.defang.io/dfg1001)`
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+	var cerr *CodedError
+	if !errors.As(err, &cerr) || cerr.Code != CodeUnsupportedPortMode {
+		t.Errorf("expected a CodedError with %s, got %v", CodeUnsupportedPortMode, err)
+	}
+	if code := CodeOf(err.Error()); code != CodeUnsupportedPortMode {
+		t.Errorf("expected CodeOf to return %s, got %q", CodeUnsupportedPortMode, code)
+	}
+}
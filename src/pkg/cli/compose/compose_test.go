@@ -3,6 +3,7 @@ package compose
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/DefangLabs/defang/src/pkg/term"
@@ -164,6 +165,24 @@ func TestLoadProject(t *testing.T) {
 		if len(p.Services) != 1 {
 			t.Errorf("LoadProject() failed: expected 1 services, got %d", len(p.Services))
 		}
+		if filepath.Base(p.WorkingDir) != "alttestproj" {
+			t.Errorf("LoadProject() failed: expected working dir alttestproj, got %q", p.WorkingDir)
+		}
+		if context := p.Services["dfnx"].Build.Context; context != p.WorkingDir {
+			t.Errorf("LoadProject() failed: expected build context %q, got %q", p.WorkingDir, context)
+		}
+	})
+
+	t.Run("debug project from a sub directory", func(t *testing.T) {
+		t.Chdir("../../../testdata/alttestproj/subdir/subdir2")
+
+		project, err := NewLoader().CreateProjectForDebug(t.Context())
+		if err != nil {
+			t.Fatalf("CreateProjectForDebug() failed: %v", err)
+		}
+		if filepath.Base(project.WorkingDir) != "alttestproj" {
+			t.Errorf("CreateProjectForDebug() failed: expected working dir alttestproj, got %q", project.WorkingDir)
+		}
 	})
 
 	t.Run("load alternative compose file", func(t *testing.T) {
@@ -0,0 +1,52 @@
+package compose
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// The x-defang-config-revision extension changes when the config of a service is updated, which makes the CD
+// roll out new tasks for only that service, so they pick up the new values.
+const configRevisionExtension = "x-defang-config-revision"
+
+var configReferenceRegex = regexp.MustCompile(`\$\{?([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// ServicesUsingConfig returns the names of the services whose environment uses any of the given configs, either
+// as an environment variable without a value or by interpolation. With no config names, any config counts.
+func ServicesUsingConfig(project *composeTypes.Project, configNames ...string) []string {
+	uses := func(name string) bool {
+		return len(configNames) == 0 || slices.Contains(configNames, name)
+	}
+
+	var services []string
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		for key, value := range project.Services[name].Environment {
+			if value == nil {
+				if uses(key) {
+					services = append(services, name)
+					break
+				}
+				continue
+			}
+			if slices.ContainsFunc(configReferenceRegex.FindAllStringSubmatch(*value, -1), func(match []string) bool {
+				return uses(match[1])
+			}) {
+				services = append(services, name)
+				break
+			}
+		}
+	}
+	return services
+}
+
+// SetConfigRevision marks the service with the given config revision, so it gets restarted on the next deployment.
+func SetConfigRevision(service *composeTypes.ServiceConfig, revision string) {
+	service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+	if service.Extensions == nil {
+		service.Extensions = make(composeTypes.Extensions)
+	}
+	service.Extensions[configRevisionExtension] = revision
+}
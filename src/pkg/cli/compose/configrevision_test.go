@@ -0,0 +1,50 @@
+package compose
+
+import (
+	"slices"
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestServicesUsingConfig(t *testing.T) {
+	dbUrl := "postgres://app:${DB_PASSWORD}@db:5432/app"
+	plain := "production"
+	project := &composeTypes.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"web":    {Name: "web", Environment: composeTypes.MappingWithEquals{"API_KEY": nil, "NODE_ENV": &plain}},
+			"worker": {Name: "worker", Environment: composeTypes.MappingWithEquals{"DATABASE_URL": &dbUrl}},
+			"static": {Name: "static", Environment: composeTypes.MappingWithEquals{"NODE_ENV": &plain}},
+		},
+	}
+
+	tests := []struct {
+		configs []string
+		want    []string
+	}{
+		{nil, []string{"web", "worker"}},
+		{[]string{"API_KEY"}, []string{"web"}},
+		{[]string{"DB_PASSWORD"}, []string{"worker"}},
+		{[]string{"API_KEY", "DB_PASSWORD"}, []string{"web", "worker"}},
+		{[]string{"NODE_ENV"}, nil}, // has a value, so not from config
+		{[]string{"DB"}, nil},
+	}
+	for _, tt := range tests {
+		if got := ServicesUsingConfig(project, tt.configs...); !slices.Equal(got, tt.want) {
+			t.Errorf("ServicesUsingConfig(%v) = %v, want %v", tt.configs, got, tt.want)
+		}
+	}
+}
+
+func TestSetConfigRevision(t *testing.T) {
+	extensions := composeTypes.Extensions{"x-defang-llm": true}
+	service := composeTypes.ServiceConfig{Name: "web", Extensions: extensions}
+	SetConfigRevision(&service, "rev1")
+	if service.Extensions[configRevisionExtension] != "rev1" || service.Extensions["x-defang-llm"] != true {
+		t.Errorf("unexpected extensions: %v", service.Extensions)
+	}
+	if _, ok := extensions[configRevisionExtension]; ok {
+		t.Error("expected the original extensions to be unchanged")
+	}
+}
@@ -0,0 +1,22 @@
+package compose
+
+import "context"
+
+// ConfirmFunc asks the user to confirm an action; it returns an error if the action should not go ahead.
+type ConfirmFunc func(ctx context.Context, message string) error
+
+type confirmKey struct{}
+
+// WithConfirm returns a context that carries the function used to confirm actions like uploading a large build
+// context. This package can't prompt by itself, because the prompt settings live in the cli package.
+func WithConfirm(ctx context.Context, confirm ConfirmFunc) context.Context {
+	return context.WithValue(ctx, confirmKey{}, confirm)
+}
+
+// confirm calls the ConfirmFunc of the context; without one, the action goes ahead.
+func confirm(ctx context.Context, message string) error {
+	if confirm, ok := ctx.Value(confirmKey{}).(ConfirmFunc); ok {
+		return confirm(ctx, message)
+	}
+	return nil
+}
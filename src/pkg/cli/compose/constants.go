@@ -2,6 +2,9 @@ package compose
 
 const Mode_INGRESS = "ingress"
 const Mode_HOST = "host"
+const Mode_PRIVATE = "private" // alias for "host"; only reachable by other services in the project
 
 const Protocol_TCP = "tcp"
 const Protocol_UDP = "udp"
+const Protocol_HTTP2 = "http2"
+const Protocol_GRPC = "grpc"
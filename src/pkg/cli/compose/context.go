@@ -4,21 +4,25 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"cmp"
 	"compress/gzip"
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/http"
-	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/pkg/progress"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/compose-spec/compose-go/v2/types"
 	"github.com/docker/go-units"
@@ -29,10 +33,10 @@ import (
 /**
  * UploadMode determines how the build context tarball is handled when building images:
  *
- * ServiceConfig | default   | --force   | --build   | --build --force |
- * --------------+-----------+-----------+-----------+-----------------+
- * build         | Digest    | Force     | Digest    | Force           |
- * image + build | Ignore    | Force     | Digest    | Force           |
+ * ServiceConfig | default   | --force   | --build   | --no-build |
+ * --------------+-----------+-----------+-----------+------------+
+ * build         | Digest    | Force     | Force     | error      |
+ * image + build | Ignore    | Force     | Force     | Ignore     |
  */
 type UploadMode int
 
@@ -43,6 +47,7 @@ const (
 	UploadModeIgnore                     // dry-run: don't upload the tarball, just return the path
 	UploadModePreview                    // preview: like dry-run but does start the preview command
 	UploadModeEstimate                   // cost estimation: like preview, but skips the tarball
+	UploadModeNoBuild                    // --no-build: never build; every service must have an image, static files are still uploaded
 )
 
 const (
@@ -98,9 +103,16 @@ var (
 
 type WriterFactory interface {
 	CreateHeader(info fs.FileInfo, slashPath string) (io.Writer, error)
+	// CreateLink adds a symlink to target, or a hardlink to the earlier entry target; errors.ErrUnsupported means
+	// the archive can't store this kind of link and the file should be copied instead.
+	CreateLink(info fs.FileInfo, slashPath, target string, hard bool) error
 	Close() error
 }
 
+func isExecutable(mode fs.FileMode) bool {
+	return mode&0111 != 0
+}
+
 type tarFactory struct {
 	*tar.Writer
 	gzipWriter io.WriteCloser
@@ -125,11 +137,27 @@ func (tw *tarFactory) CreateHeader(info fs.FileInfo, slashPath string) (io.Write
 	header.Uid = 0
 	header.Gname = ""
 	header.Uname = ""
+	header.Mode = int64(archiveMode(info.Mode()).Perm())
 	header.Name = slashPath
 	err = tw.WriteHeader(header)
 	return tw.Writer, err
 }
 
+func (tw *tarFactory) CreateLink(info fs.FileInfo, slashPath, target string, hard bool) error {
+	header := &tar.Header{
+		Typeflag: tar.TypeSymlink,
+		Name:     slashPath,
+		Linkname: target,
+		Mode:     int64(archiveMode(info.Mode()).Perm()),
+		ModTime:  time.Unix(sourceDateEpoch, 0), // reproducible
+		Format:   tar.FormatPAX,
+	}
+	if hard {
+		header.Typeflag = tar.TypeLink
+	}
+	return tw.WriteHeader(header)
+}
+
 func (tw *tarFactory) Close() error {
 	// Close the tar and gzip writers before returning the buffer
 	err := tw.Writer.Close()
@@ -155,7 +183,7 @@ func (zw *zipFactory) CreateHeader(info fs.FileInfo, slashPath string) (io.Write
 		Name:   slashPath,
 		Method: zip.Deflate,
 	}
-	header.SetMode(info.Mode()) // sets CreatorVersion and ExternalAttrs
+	header.SetMode(archiveMode(info.Mode())) // sets CreatorVersion and ExternalAttrs
 
 	// Make reproducible
 	header.Modified = time.Unix(sourceDateEpoch, 0)
@@ -175,6 +203,24 @@ func (zw *zipFactory) CreateHeader(info fs.FileInfo, slashPath string) (io.Write
 	return writer, err
 }
 
+func (zw *zipFactory) CreateLink(info fs.FileInfo, slashPath, target string, hard bool) error {
+	if hard {
+		return errors.ErrUnsupported // zip has no hardlinks
+	}
+	header := &zip.FileHeader{
+		Name:     slashPath,
+		Method:   zip.Store,
+		Modified: time.Unix(sourceDateEpoch, 0), // reproducible
+	}
+	header.SetMode(fs.ModeSymlink | 0777)
+	w, err := zw.Writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, target) // like Info-ZIP, the content of a symlink is its target
+	return err
+}
+
 func (zw *zipFactory) Close() error {
 	// Close the zip writer before returning the buffer
 	err := zw.Writer.Close()
@@ -193,6 +239,9 @@ func parseContextLimit(limit string, def int64) int64 {
 
 var (
 	ContextSizeHardLimit = parseContextLimit(os.Getenv("DEFANG_BUILD_CONTEXT_LIMIT"), DefaultContextSizeHardLimit)
+
+	// multipartUploadMinSize is the size above which an archive is uploaded in parts, if the provider supports it
+	multipartUploadMinSize int64 = 16 * MiB
 )
 
 func getRemoteBuildContext(ctx context.Context, provider client.Provider, projectName, service string, build *types.BuildConfig, upload UploadMode) (string, error) {
@@ -210,6 +259,16 @@ func getRemoteBuildContext(ctx context.Context, provider client.Provider, projec
 		archiveType = ArchiveTypeGzip
 	}
 
+	executables, err := GetExecutables(build)
+	if err != nil {
+		return "", fmt.Errorf("service %q: %w", service, err) // already checked in ValidateProject
+	}
+
+	return getRemoteArchive(ctx, provider, projectName, service, root, build.Dockerfile, archiveType, writeIgnoreFileYes, upload, executables)
+}
+
+func getRemoteArchive(ctx context.Context, provider client.Provider, projectName, service, root, dockerfile string, archiveType ArchiveType, writeIgnore writeIgnoreFile, upload UploadMode, executables Executables) (string, error) {
+	t := termFromContext(ctx)
 	switch upload {
 	case UploadModeIgnore:
 		// `compose config`, ie. dry-run: don't upload the archive, just return the path as-is
@@ -219,53 +278,144 @@ func getRemoteBuildContext(ctx context.Context, provider client.Provider, projec
 		return fmt.Sprintf("s3://cd-preview/%s%s", service, archiveType.Extension), nil
 	}
 
-	term.Info("Packaging the project files for", service, "at", root)
-	buffer, err := createArchive(ctx, build.Context, build.Dockerfile, archiveType)
+	if !progress.Report(ctx, service, progress.PhaseCompressing) {
+		t.Info("Packaging the project files for", service, "at", root)
+	}
+	// Instead of buffering the archive in memory, we create it twice: once to get its size and digest, which are
+	// needed before the upload can start, and once more while streaming it to the upload URL.
+	sha := sha256.New()
+	size, err := writeArchive(ctx, sha, root, dockerfile, archiveType, writeIgnore, executables, false)
 	if err != nil {
 		return "", err
 	}
+	sum := sha.Sum(nil)
 
 	var digest string
 	switch upload {
-	case UploadModeDefault, UploadModeDigest:
+	case UploadModeDefault, UploadModeDigest, UploadModeNoBuild:
+		// With --no-build, only static files get here; they are uploaded, but not built into an image
 		// Calculate the digest of the tarball and pass it to the fabric controller (to avoid building the same image twice)
-		digest = calcDigest(buffer.Bytes())
-		term.Debugf("Digest for %q: %s", service, digest)
+		digest = formatDigest(sum)
+		t.Debugf("Digest for %q: %s", service, digest)
 	case UploadModePreview:
 		// For preview, we invoke the CD "preview" command, which will want a valid (S3) URL for diff, even though it won't be used
-		digest = calcDigest(buffer.Bytes())
+		digest = formatDigest(sum)
 		return fmt.Sprintf("s3://cd-preview/%s%s", digest, archiveType.Extension), nil
 	case UploadModeForce:
 		// Force: empty digest = always upload the tarball (to a random URL), triggering a new build
 	default:
-		panic("unexpected UploadMode value")
+		return "", fmt.Errorf("unexpected upload mode %v", upload)
+	}
+
+	if digest != "" {
+		// Skip the upload if the same build context was uploaded before and is still there
+		if url, ok := cachedUpload(ctx, provider, projectName, digest+archiveType.Extension); ok {
+			t.Info("Reusing the uploaded project files for", service)
+			return url, nil
+		}
 	}
 
-	term.Info("Uploading the project files for", service)
-	return uploadArchive(ctx, provider, projectName, buffer, archiveType, digest)
+	if size > ContextSizeSoftLimit {
+		if err := confirm(ctx, fmt.Sprintf("The build context for %q is %s; upload it anyway?", service, units.BytesSize(float64(size)))); err != nil {
+			return "", err
+		}
+	}
+
+	if !progress.Report(ctx, service, progress.PhaseUploading) {
+		t.Info("Uploading the project files for", service)
+	}
+	body := func() (io.Reader, error) {
+		return progress.NewReader(ctx, service, &lazyReader{open: func() io.ReadCloser {
+			return streamArchive(ctx, root, dockerfile, archiveType, executables, size, sum)
+		}}, size), nil
+	}
+	url, err := uploadArchive(ctx, provider, projectName, body, size, archiveType, digest)
+	if err == nil && digest != "" {
+		cacheUpload(ctx, provider, projectName, digest+archiveType.Extension, url)
+	}
+	return url, err
 }
 
 func calcDigest(data []byte) string {
 	sha := sha256.Sum256(data)
-	return "sha256-" + base64.StdEncoding.EncodeToString(sha[:]) // same as Nix
+	return formatDigest(sha[:])
 }
 
-func uploadArchive(ctx context.Context, provider client.Provider, projectName string, body io.Reader, archiveType ArchiveType, digest string) (string, error) {
-	// Upload the archive to the fabric controller storage; TODO: use a streaming API
-	ureq := &defangv1.UploadURLRequest{Digest: digest + archiveType.Extension, Project: projectName}
-	res, err := provider.CreateUploadURL(ctx, ureq)
-	if err != nil {
-		return "", err
+func formatDigest(sum []byte) string {
+	return "sha256-" + base64.StdEncoding.EncodeToString(sum) // same as Nix
+}
+
+var errContextChanged = errors.New("the build context changed while uploading; please try again")
+
+// streamArchive writes the archive to a pipe, so it can be uploaded without keeping it in memory. The size and
+// checksum of the archive are compared to those of the first pass, because the digest was derived from them.
+func streamArchive(ctx context.Context, root, dockerfile string, archiveType ArchiveType, executables Executables, size int64, sum []byte) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		sha := sha256.New()
+		n, err := writeArchive(ctx, io.MultiWriter(pw, sha), root, dockerfile, archiveType, writeIgnoreFileNo, executables, true)
+		if err == nil && (n != size || !bytes.Equal(sha.Sum(nil), sum)) {
+			err = errContextChanged
+		}
+		pw.CloseWithError(err) // nil: the reader gets io.EOF
+	}()
+	return pr
+}
+
+// lazyReader calls open on the first Read, so a body that is never read doesn't create an archive.
+type lazyReader struct {
+	open func() io.ReadCloser
+	rc   io.ReadCloser
+}
+
+func (lr *lazyReader) Read(p []byte) (int, error) {
+	if lr.rc == nil {
+		lr.rc = lr.open()
 	}
+	return lr.rc.Read(p)
+}
 
-	// Do an HTTP PUT to the generated URL
-	resp, err := http.Put(ctx, res.Url, string(archiveType.MimeType), body)
-	if err != nil {
-		return "", err
+func (lr *lazyReader) Close() error {
+	if lr.rc == nil {
+		return nil
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("HTTP PUT failed with status code %v", resp.Status)
+	return lr.rc.Close()
+}
+
+func uploadArchive(ctx context.Context, provider client.Provider, projectName string, body func() (io.Reader, error), size int64, archiveType ArchiveType, digest string) (string, error) {
+	ureq := &defangv1.UploadURLRequest{Digest: digest + archiveType.Extension, Project: projectName}
+
+	var res *defangv1.UploadURLResponse
+	if uploader, ok := provider.(client.MultipartUploader); ok && size > multipartUploadMinSize {
+		// Upload a large archive in parts, so a network blip only retries the current part
+		r, err := body()
+		if err != nil {
+			return "", err
+		}
+		res, err = uploader.UploadMultipart(ctx, ureq, r)
+		if c, ok := r.(io.Closer); ok {
+			c.Close()
+		}
+		if err != nil {
+			return "", err
+		}
+	} else {
+		// Upload the archive to the fabric controller storage
+		var err error
+		res, err = provider.CreateUploadURL(ctx, ureq)
+		if err != nil {
+			return "", err
+		}
+
+		// Do an HTTP PUT to the generated URL; the body is streamed, once for each attempt
+		resp, err := http.PutStream(ctx, res.Url, string(archiveType.MimeType), size, body)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != 200 {
+			return "", fmt.Errorf("HTTP PUT failed with status code %v", resp.Status)
+		}
 	}
 
 	url := http.RemoveQueryParam(res.Url)
@@ -291,22 +441,22 @@ func (cr contextAwareReader) Read(p []byte) (n int, err error) {
 }
 
 // tryReadIgnoreFile attempts to read the specified ignore file.
-func tryReadIgnoreFile(cwd, ignorefile string) io.ReadCloser {
+func tryReadIgnoreFile(ctx context.Context, cwd, ignorefile string) io.ReadCloser {
 	path := filepath.Join(cwd, ignorefile)
 	reader, err := os.Open(path)
 	if err != nil {
 		return nil
 	}
-	term.Debug("Reading .dockerignore file from", ignorefile)
+	termFromContext(ctx).Debug("Reading .dockerignore file from", ignorefile)
 	return reader
 }
 
 // writeDefaultIgnoreFile writes a default
 // .dockerignore file to the specified directory.
 // Returns the filename of the written file and an error.
-func writeDefaultIgnoreFile(cwd string, dockerignore string) (string, error) {
+func writeDefaultIgnoreFile(ctx context.Context, cwd string, dockerignore string) (string, error) {
 	path := filepath.Join(cwd, dockerignore)
-	term.Debug("Writing .dockerignore file to", path)
+	termFromContext(ctx).Debug("Writing .dockerignore file to", path)
 
 	err := os.WriteFile(path, []byte(defaultDockerIgnore), 0644)
 	if err != nil {
@@ -319,15 +469,15 @@ func writeDefaultIgnoreFile(cwd string, dockerignore string) (string, error) {
 // getDockerIgnorePatterns attempts to read the ignore file
 // for the specified Dockerfile and returns the patterns and
 // the name of the ignore file.
-func getDockerIgnorePatterns(root, dockerfile string) ([]string, string, error) {
+func getDockerIgnorePatterns(ctx context.Context, root, dockerfile string) ([]string, string, error) {
 	// Check for Dockerfile-specific ignore file
 	// Attempt to read Dockerfile-specific ignore file
 	dockerignore := dockerfile + dotdockerignore
-	reader := tryReadIgnoreFile(root, dockerignore)
+	reader := tryReadIgnoreFile(ctx, root, dockerignore)
 	if reader == nil {
 		// Fallback to .dockerignore
 		dockerignore = dotdockerignore
-		reader = tryReadIgnoreFile(root, dockerignore)
+		reader = tryReadIgnoreFile(ctx, root, dockerignore)
 		if reader == nil {
 			// No .dockerignore file found; read from defaults
 			dockerignore = ""
@@ -345,8 +495,49 @@ func getDockerIgnorePatterns(root, dockerfile string) ([]string, string, error)
 	return patterns, dockerignore, nil
 }
 
-func WalkContextFolder(root, dockerfile string, fn func(path string, de os.DirEntry, slashPath string) error) error {
-	return walkContextFolder(root, dockerfile, writeIgnoreFileNo, fn)
+func WalkContextFolder(ctx context.Context, root, dockerfile string, fn func(path string, de os.DirEntry, slashPath string) error) error {
+	return walkContextFolder(ctx, root, dockerfile, writeIgnoreFileNo, fn)
+}
+
+type ContextFile struct {
+	Path string // relative to the context root, with forward slashes
+	Size int64
+}
+
+// ListContextFiles returns the files that would be included in the build context, without creating an archive.
+// Symlinks are listed like writeArchive stores them with --follow-symlinks: a link inside of the context without
+// content, and a link to a file outside of it with the size of that file.
+func ListContextFiles(ctx context.Context, root, dockerfile string) ([]ContextFile, error) {
+	var files []ContextFile
+	err := walkContextFolder(ctx, root, dockerfile, writeIgnoreFileNo, func(path string, de os.DirEntry, slashPath string) error {
+		if de.Type()&fs.ModeSymlink != 0 {
+			target, err := contextSymlink(root, path, true)
+			if err != nil {
+				return fmt.Errorf("%s: %w", slashPath, err)
+			}
+			if target != "" {
+				files = append(files, ContextFile{Path: slashPath})
+				return nil
+			}
+			if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+				files = append(files, ContextFile{Path: slashPath, Size: info.Size()})
+			}
+			return nil
+		}
+		if !de.Type().IsRegular() {
+			return nil
+		}
+		info, err := de.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, ContextFile{Path: slashPath, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
 }
 
 type writeIgnoreFile bool
@@ -354,24 +545,25 @@ type writeIgnoreFile bool
 const writeIgnoreFileNo writeIgnoreFile = false
 const writeIgnoreFileYes writeIgnoreFile = true
 
-func walkContextFolder(root, dockerfile string, writeIgnore writeIgnoreFile, fn func(path string, de os.DirEntry, slashPath string) error) error {
+func walkContextFolder(ctx context.Context, root, dockerfile string, writeIgnore writeIgnoreFile, fn func(path string, de os.DirEntry, slashPath string) error) error {
 	if dockerfile == "" {
 		dockerfile = "Dockerfile"
 	} else {
-		dockerfile = filepath.Clean(dockerfile)
+		dockerfile = filepath.Clean(dockerfile) // also converts forward slashes on Windows
 	}
+	root = longPath(root)
 
 	// Get the ignore patterns from the .dockerignore file
-	patterns, dockerignore, err := getDockerIgnorePatterns(root, dockerfile)
+	patterns, dockerignore, err := getDockerIgnorePatterns(ctx, root, dockerfile)
 	if err != nil {
 		return err
 	}
 
 	if dockerignore == "" && writeIgnore {
 		// Generate a default .dockerignore file if none exists (to be included in the context)
-		term.Warn("No .dockerignore file found; creating default .dockerignore; you may add this to source control (git)")
+		warnf(ctx, CodeMissingDockerignore, "No .dockerignore file found; creating default .dockerignore; you may add this to source control (git)")
 		var err error
-		dockerignore, err = writeDefaultIgnoreFile(root, dotdockerignore)
+		dockerignore, err = writeDefaultIgnoreFile(ctx, root, dotdockerignore)
 		if err != nil {
 			return fmt.Errorf("failed to write default .dockerignore file: %w", err)
 		}
@@ -412,10 +604,14 @@ func walkContextFolder(root, dockerfile string, writeIgnore writeIgnoreFile, fn
 				return err
 			}
 			if ignore {
-				term.Debug("Ignoring", relPath) // TODO: avoid printing in this function
 				if de.IsDir() {
+					if reincludesUnder(pm, slashPath) || isParentDir(relPath, dockerfile) || isParentDir(relPath, dockerignore) {
+						return nil // traverse, but don't include the directory itself
+					}
+					termFromContext(ctx).Debug("Ignoring", relPath) // TODO: avoid printing in this function
 					return filepath.SkipDir
 				}
+				termFromContext(ctx).Debug("Ignoring", relPath)
 				return nil
 			}
 		}
@@ -429,11 +625,201 @@ func walkContextFolder(root, dockerfile string, writeIgnore writeIgnoreFile, fn
 	return nil
 }
 
-func createArchive(ctx context.Context, root string, dockerfile string, contentType ArchiveType) (*bytes.Buffer, error) {
-	fileCount := 0
+// reincludesUnder returns true if an exception pattern like "!node_modules/.keep" or "!**/*.keep" could match a path
+// inside the ignored directory, in which case we can't skip the directory. Like "docker build", the pattern is
+// compared per path component, where "**" matches any number of components.
+func reincludesUnder(pm *patternmatcher.PatternMatcher, slashDir string) bool {
+	if !pm.Exclusions() {
+		return false
+	}
+	dirParts := strings.Split(slashDir, "/")
+	for _, pattern := range pm.Patterns() {
+		if !pattern.Exclusion() {
+			continue
+		}
+		if couldMatchUnder(strings.Split(filepath.ToSlash(pattern.String()), "/"), dirParts) {
+			return true
+		}
+	}
+	return false
+}
 
-	// TODO: use io.Pipe and do proper streaming (instead of buffering everything in memory)
+// couldMatchUnder returns true if the pattern could match the directory, or a path inside of it.
+func couldMatchUnder(patternParts, dirParts []string) bool {
+	for len(dirParts) > 0 {
+		if len(patternParts) == 0 {
+			return true // the pattern matches a parent, which re-includes everything inside of it
+		}
+		if patternParts[0] == "**" {
+			return true // matches the rest of the directory and anything below it
+		}
+		if match, err := path.Match(patternParts[0], dirParts[0]); err != nil || !match {
+			return false
+		}
+		patternParts, dirParts = patternParts[1:], dirParts[1:]
+	}
+	return true // the pattern matches the directory itself or has components left for paths inside of it
+}
+
+// isParentDir returns true if file is inside dir; both are relative OS paths.
+func isParentDir(dir, file string) bool {
+	return file != "" && strings.HasPrefix(file, dir+string(filepath.Separator))
+}
+
+// largestFilesShown is the number of files listed when the build context is too large
+const largestFilesShown = 5
+
+// ContextTooLargeError is returned when the compressed build context exceeds the limit. It lists the largest files,
+// so the user knows what to add to .dockerignore.
+type ContextTooLargeError struct {
+	Limit   int64
+	Largest []ContextFile // uncompressed, largest first
+}
+
+func (e *ContextTooLargeError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "the build context is limited to %s; add large files to .dockerignore, download them in the Dockerfile, or use --max-context-size", units.BytesSize(float64(e.Limit)))
+	if len(e.Largest) > 0 {
+		sb.WriteString("; the largest files are:")
+		for _, file := range e.Largest {
+			fmt.Fprintf(&sb, "\n  %s (%s)", file.Path, units.BytesSize(float64(file.Size)))
+		}
+	}
+	return sb.String()
+}
+
+func newContextTooLargeError(ctx context.Context, root, dockerfile string, limit int64) error {
+	files, _ := ListContextFiles(ctx, root, dockerfile) // best effort
+	slices.SortStableFunc(files, func(a, b ContextFile) int {
+		return cmp.Compare(b.Size, a.Size)
+	})
+	return &ContextTooLargeError{Limit: limit, Largest: files[:min(len(files), largestFilesShown)]}
+}
+
+func createArchive(ctx context.Context, root string, dockerfile string, contentType ArchiveType, writeIgnore writeIgnoreFile, executables Executables) (*bytes.Buffer, error) {
 	buf := &bytes.Buffer{}
+	if _, err := writeArchive(ctx, buf, root, dockerfile, contentType, writeIgnore, executables, false); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// WriteCopyArchive writes a gzipped tar archive of the file or directory at root to w, with root itself named name
+// in the archive, for copying it into a container. Unlike a build context, no files are ignored.
+func WriteCopyArchive(ctx context.Context, w io.Writer, root, name string) error {
+	gzipWriter := gzip.NewWriter(w)
+	factory := &tarFactory{tar.NewWriter(gzipWriter), gzipWriter}
+	err := filepath.WalkDir(root, func(filePath string, de os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		slashPath := path.Join(name, filepath.ToSlash(relPath))
+
+		info, err := de.Info()
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+			return factory.CreateLink(info, slashPath, filepath.ToSlash(target), false)
+		}
+
+		writer, err := factory.CreateHeader(info, slashPath)
+		if err != nil || writer == nil {
+			return err
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(writer, &contextAwareReader{ctx, file})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return factory.Close()
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.Writer.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// contextSymlink returns the target to store for the symlink at path: relative, so it works in the image. Returns ""
+// for a link that points outside of the root if follow is set, so the caller copies the file it points to instead.
+func contextSymlink(root, path string, follow bool) (string, error) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return "", err
+	}
+	dir := realPath(filepath.Dir(path))
+	resolved := target
+	if !filepath.IsAbs(resolved) {
+		resolved = filepath.Join(dir, resolved)
+	}
+	resolved = realPath(resolved) // resolve chains of links; a dangling link is checked by its path only
+
+	rel, err := filepath.Rel(realPath(root), resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		if follow {
+			return "", nil
+		}
+		return "", fmt.Errorf("symlink to %q points outside of the build context; use --follow-symlinks to copy the file it points to", target)
+	}
+	if !filepath.IsAbs(target) {
+		return filepath.ToSlash(target), nil
+	}
+	// An absolute target only exists on this machine; make it relative to the link
+	rel, err = filepath.Rel(dir, resolved)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// realPath returns the path with all symlinks resolved, or the cleaned path if it doesn't exist.
+func realPath(path string) string {
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		return real
+	}
+	return filepath.Clean(path)
+}
+
+// writeArchive writes the archive of the build context to w and returns its size. Archives are reproducible, so
+// writing the same context twice gives the same bytes. When quiet, no progress or warnings are printed, because
+// these were already shown for an earlier pass.
+func writeArchive(ctx context.Context, w io.Writer, root string, dockerfile string, contentType ArchiveType, writeIgnore writeIgnoreFile, executables Executables, quiet bool) (int64, error) {
+	t := termFromContext(ctx)
+	fileCount := 0
+
+	// Files that must be executable in the image, even if the file system doesn't have an executable bit
+	executable, err := patternmatcher.New(executables.Patterns)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := UploadOptionsFromContext(ctx).MaxContextSize
+	followSymlinks := UploadOptionsFromContext(ctx).FollowSymlinks
+	hardlinks := make(map[fileID]string) // the first path of each file with multiple links
+	buf := &countingWriter{Writer: w}
 	var factory WriterFactory
 	if contentType == ArchiveTypeZip {
 		zipWriter := zip.NewWriter(buf)
@@ -444,19 +830,49 @@ func createArchive(ctx context.Context, root string, dockerfile string, contentT
 		factory = &tarFactory{tarWriter, gzipWriter}
 	}
 
-	doProgress := term.StdoutCanColor() && term.IsTerminal()
-	err := walkContextFolder(root, dockerfile, writeIgnoreFileYes, func(path string, de os.DirEntry, slashPath string) error {
-		if term.DoDebug() {
-			term.Debug("Adding", slashPath)
+	doProgress := !quiet && t.StdoutCanColor() && t.IsTerminal() && progress.FromContext(ctx) == nil // don't mess up the phase display
+	err = walkContextFolder(ctx, root, dockerfile, writeIgnore, func(path string, de os.DirEntry, slashPath string) error {
+		if t.DoDebug() && !quiet {
+			t.Debug("Adding", slashPath)
 		} else if doProgress {
-			term.Printf("%4d %s\r", fileCount, slashPath)
-			defer term.ClearLine()
+			t.Printf("%4d %s\r", fileCount, slashPath)
+			defer t.ClearLine()
 		}
 
 		info, err := de.Info()
 		if err != nil {
 			return err
 		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			target, err := contextSymlink(root, path, followSymlinks)
+			if err != nil {
+				return fmt.Errorf("%s: %w", slashPath, err)
+			}
+			if target != "" {
+				return factory.CreateLink(info, slashPath, target, false)
+			}
+			// Follow the link that points outside of the context, by copying the file it points to
+			if info, err = os.Stat(path); err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return fmt.Errorf("%s: symlink to a directory or special file outside of the build context", slashPath)
+			}
+		}
+		if id, ok := hardlinkID(info); ok {
+			if first, ok := hardlinks[id]; ok {
+				if err := factory.CreateLink(info, slashPath, first, true); !errors.Is(err, errors.ErrUnsupported) {
+					return err
+				}
+			} else {
+				hardlinks[id] = slashPath
+			}
+		}
+		if info.Mode().IsRegular() && !isExecutable(info.Mode()) {
+			if match, _ := executable.MatchesOrParentMatches(slashPath); match || (executables.Detect && !hasExecutableBit && looksExecutable(path)) {
+				info = executableFileInfo{info}
+			}
+		}
 
 		writer, err := factory.CreateHeader(info, slashPath)
 		if err != nil || writer == nil {
@@ -473,29 +889,29 @@ func createArchive(ctx context.Context, root string, dockerfile string, contentT
 		contextReader := &contextAwareReader{ctx, file}
 
 		fileCount++
-		if fileCount == ContextFileLimit+1 {
-			term.Warnf("the build context contains more than %d files; use --debug or create .dockerignore to exclude caches and build artifacts", ContextFileLimit)
+		if fileCount == ContextFileLimit+1 && !quiet {
+			warnf(ctx, CodeLargeBuildContext, "the build context contains more than %d files; use --debug or create .dockerignore to exclude caches and build artifacts", ContextFileLimit)
 		}
 
-		bufLen := buf.Len()
+		bufLen := buf.n
 		_, err = io.Copy(writer, contextReader)
-		if int64(buf.Len()) > ContextSizeHardLimit {
-			return fmt.Errorf("the build context is limited to %s; consider downloading large files in the Dockerfile or set the DEFANG_BUILD_CONTEXT_LIMIT environment variable", units.BytesSize(float64(ContextSizeHardLimit)))
+		if buf.n > limit {
+			return newContextTooLargeError(ctx, root, dockerfile, limit)
 		}
-		if bufLen <= ContextSizeSoftLimit && buf.Len() > ContextSizeSoftLimit {
-			term.Warnf("the build context is larger than %s; use --debug or create .dockerignore to exclude caches and build artifacts", units.BytesSize(float64(buf.Len())))
+		if bufLen <= ContextSizeSoftLimit && buf.n > ContextSizeSoftLimit && !quiet {
+			warnf(ctx, CodeLargeBuildContext, "the build context is larger than %s; use --debug or create .dockerignore to exclude caches and build artifacts", units.BytesSize(float64(buf.n)))
 		}
 		return err
 	})
 
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	err = factory.Close() // Close the tar or zip writer
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return buf, nil
+	return buf.n, nil
 }
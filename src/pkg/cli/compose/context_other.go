@@ -0,0 +1,34 @@
+//go:build !windows
+
+package compose
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// hasExecutableBit is true when the file mode tells whether a file is executable.
+const hasExecutableBit = true
+
+// archiveMode returns the mode to store in the archive; the file system mode is kept as-is.
+func archiveMode(mode fs.FileMode) fs.FileMode {
+	return mode
+}
+
+func longPath(path string) string {
+	return path
+}
+
+type fileID struct {
+	dev, ino uint64
+}
+
+// hardlinkID returns the identity of a regular file that has more than one link, so it's only archived once.
+func hardlinkID(info fs.FileInfo) (fileID, bool) {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || !info.Mode().IsRegular() || st.Nlink < 2 {
+		return fileID{}, false
+	}
+	//nolint:unconvert // the types of Dev and Ino differ per platform
+	return fileID{dev: uint64(st.Dev), ino: uint64(st.Ino)}, true
+}
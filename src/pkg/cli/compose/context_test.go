@@ -2,20 +2,27 @@ package compose
 
 import (
 	"archive/tar"
+	"archive/zip"
 	"bytes"
 	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path"
 	"path/filepath"
 	"reflect"
+	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/compose-spec/compose-go/v2/types"
 	"github.com/moby/patternmatcher/ignorefile"
 )
@@ -40,6 +47,10 @@ func Test_parseContextLimit(t *testing.T) {
 	})
 }
 
+func emptyBody() (io.Reader, error) {
+	return http.NoBody, nil
+}
+
 func TestUploadArchive(t *testing.T) {
 	const testproj = "testproj"
 	const path = "/upload/x/"
@@ -61,7 +72,7 @@ func TestUploadArchive(t *testing.T) {
 
 	uploadUrl := server.URL + path
 	t.Run("upload tar with digest", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeGzip, digest)
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeGzip, digest)
 		if err != nil {
 			t.Fatalf("uploadArchive() failed: %v", err)
 		}
@@ -72,7 +83,7 @@ func TestUploadArchive(t *testing.T) {
 	})
 
 	t.Run("upload zip with digest", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeZip, digest)
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeZip, digest)
 		if err != nil {
 			t.Fatalf("uploadArchive() failed: %v", err)
 		}
@@ -83,7 +94,7 @@ func TestUploadArchive(t *testing.T) {
 	})
 
 	t.Run("upload with zip", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeZip, "")
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeZip, "")
 		if err != nil {
 			t.Fatalf("uploadContent() failed: %v", err)
 		}
@@ -94,7 +105,7 @@ func TestUploadArchive(t *testing.T) {
 	})
 
 	t.Run("upload with tar", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeGzip, "")
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeGzip, "")
 		if err != nil {
 			t.Fatalf("uploadContent() failed: %v", err)
 		}
@@ -105,7 +116,7 @@ func TestUploadArchive(t *testing.T) {
 	})
 
 	t.Run("force upload tar without digest", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeGzip, "")
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeGzip, "")
 		if err != nil {
 			t.Fatalf("uploadArchive() failed: %v", err)
 		}
@@ -116,7 +127,7 @@ func TestUploadArchive(t *testing.T) {
 	})
 
 	t.Run("force upload zip without digest", func(t *testing.T) {
-		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, &bytes.Buffer{}, ArchiveTypeZip, "")
+		url, err := uploadArchive(t.Context(), client.MockProvider{UploadUrl: uploadUrl}, testproj, emptyBody, 0, ArchiveTypeZip, "")
 		if err != nil {
 			t.Fatalf("uploadArchive() failed: %v", err)
 		}
@@ -127,10 +138,70 @@ func TestUploadArchive(t *testing.T) {
 	})
 }
 
+type mockMultipartProvider struct {
+	client.MockProvider
+	uploaded []byte
+}
+
+func (m *mockMultipartProvider) UploadMultipart(ctx context.Context, req *defangv1.UploadURLRequest, body io.Reader) (*defangv1.UploadURLResponse, error) {
+	var err error
+	m.uploaded, err = io.ReadAll(body)
+	return &defangv1.UploadURLResponse{Url: "https://bucket.s3.us-west-2.amazonaws.com/uploads/" + req.Digest}, err
+}
+
+func TestUploadArchiveMultipart(t *testing.T) {
+	defer func(size int64) { multipartUploadMinSize = size }(multipartUploadMinSize)
+	multipartUploadMinSize = 4
+
+	const content = "0123456789"
+	body := func() (io.Reader, error) { return strings.NewReader(content), nil }
+
+	t.Run("large archive", func(t *testing.T) {
+		provider := &mockMultipartProvider{}
+		url, err := uploadArchive(t.Context(), provider, "testproj", body, int64(len(content)), ArchiveTypeGzip, "sha256-abc")
+		if err != nil {
+			t.Fatalf("uploadArchive() failed: %v", err)
+		}
+		if string(provider.uploaded) != content {
+			t.Errorf("expected %q to be uploaded in parts, got %q", content, provider.uploaded)
+		}
+		if expected := "https://bucket.s3.us-west-2.amazonaws.com/uploads/sha256-abc.tar.gz"; url != expected {
+			t.Errorf("expected %v, got %v", expected, url)
+		}
+	})
+
+	t.Run("small archive", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(200)
+		}))
+		t.Cleanup(server.Close)
+
+		provider := &mockMultipartProvider{MockProvider: client.MockProvider{UploadUrl: server.URL}}
+		if _, err := uploadArchive(t.Context(), provider, "testproj", emptyBody, 0, ArchiveTypeGzip, ""); err != nil {
+			t.Fatalf("uploadArchive() failed: %v", err)
+		}
+		if provider.uploaded != nil {
+			t.Error("expected a small archive to be uploaded in a single PUT")
+		}
+	})
+}
+
+func TestListContextFiles(t *testing.T) {
+	files, err := ListContextFiles(t.Context(), "../../../testdata/testproj", "")
+	if err != nil {
+		t.Fatalf("ListContextFiles(ctx) failed: %v", err)
+	}
+
+	expected := []ContextFile{{".dockerignore", 459}, {".env", 15}, {"Dockerfile", 121}, {"fileName.env", 8}}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected files: %v, got %v", expected, files)
+	}
+}
+
 func TestWalkContextFolder(t *testing.T) {
 	t.Run("Default Dockerfile", func(t *testing.T) {
 		var files []string
-		err := WalkContextFolder("../../../testdata/testproj", "", func(path string, de os.DirEntry, slashPath string) error {
+		err := WalkContextFolder(t.Context(), "../../../testdata/testproj", "", func(path string, de os.DirEntry, slashPath string) error {
 			if strings.Contains(slashPath, "testproj") {
 				t.Errorf("Path is not relative: %v", slashPath)
 			}
@@ -138,7 +209,7 @@ func TestWalkContextFolder(t *testing.T) {
 			return nil
 		})
 		if err != nil {
-			t.Fatalf("WalkContextFolder() failed: %v", err)
+			t.Fatalf("WalkContextFolder(ctx) failed: %v", err)
 		}
 
 		expected := []string{".dockerignore", ".env", "Dockerfile", "fileName.env"}
@@ -148,22 +219,22 @@ func TestWalkContextFolder(t *testing.T) {
 	})
 
 	t.Run("Missing Dockerfile", func(t *testing.T) {
-		err := WalkContextFolder("../../testdata", "Dockerfile.missing", func(string, os.DirEntry, string) error { return nil })
+		err := WalkContextFolder(t.Context(), "../../testdata", "Dockerfile.missing", func(string, os.DirEntry, string) error { return nil })
 		if err == nil {
-			t.Fatal("WalkContextFolder() should have failed")
+			t.Fatal("WalkContextFolder(ctx) should have failed")
 		}
 	})
 
 	t.Run("Missing Context", func(t *testing.T) {
-		err := WalkContextFolder("asdfqwer", "", func(string, os.DirEntry, string) error { return nil })
+		err := WalkContextFolder(t.Context(), "asdfqwer", "", func(string, os.DirEntry, string) error { return nil })
 		if err == nil {
-			t.Fatal("WalkContextFolder() should have failed")
+			t.Fatal("WalkContextFolder(ctx) should have failed")
 		}
 	})
 
 	t.Run("Default .dockerignore", func(t *testing.T) {
 		var files []string
-		err := WalkContextFolder("../../../testdata/alttestproj", "", func(path string, de os.DirEntry, slashPath string) error {
+		err := WalkContextFolder(t.Context(), "../../../testdata/alttestproj", "", func(path string, de os.DirEntry, slashPath string) error {
 			if strings.Contains(slashPath, "alttestproj") {
 				t.Errorf("Path is not relative: %v", slashPath)
 			}
@@ -171,7 +242,7 @@ func TestWalkContextFolder(t *testing.T) {
 			return nil
 		})
 		if err != nil {
-			t.Fatalf("WalkContextFolder() failed: %v", err)
+			t.Fatalf("WalkContextFolder(ctx) failed: %v", err)
 		}
 
 		expected := []string{"Dockerfile", "altcomp.yaml", "compose.yaml.fixup", "compose.yaml.golden", "compose.yaml.warnings", "subdir", "subdir/subdir2", "subdir/subdir2/.gitkeep"}
@@ -179,6 +250,102 @@ func TestWalkContextFolder(t *testing.T) {
 			t.Errorf("Expected files: %v, got %v", expected, files)
 		}
 	})
+
+	t.Run("Re-included files", func(t *testing.T) {
+		root := t.TempDir()
+		for _, dir := range []string{"node_modules/pkg", "build/cache", "logs"} {
+			if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for name, content := range map[string]string{
+			".dockerignore":         "node_modules\n!node_modules/.keep\nbuild\n!build/cache/keep.txt\nlogs\n",
+			"Dockerfile":            "FROM scratch",
+			"node_modules/.keep":    "",
+			"node_modules/pkg/a.js": "",
+			"build/out.bin":         "",
+			"build/cache/keep.txt":  "",
+			"build/cache/other.txt": "",
+			"logs/app.log":          "",
+		} {
+			if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var files []string
+		err := WalkContextFolder(t.Context(), root, "", func(path string, de os.DirEntry, slashPath string) error {
+			files = append(files, slashPath)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("WalkContextFolder(ctx) failed: %v", err)
+		}
+
+		expected := []string{".dockerignore", "Dockerfile", "build/cache/keep.txt", "node_modules/.keep"}
+		if !reflect.DeepEqual(files, expected) {
+			t.Errorf("Expected files: %v, got %v", expected, files)
+		}
+	})
+
+	t.Run("Nested negations", func(t *testing.T) {
+		root := t.TempDir()
+		for _, dir := range []string{"src/a", "node_modules/x/important", "other", "docker"} {
+			if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for name, content := range map[string]string{
+			".dockerignore":                     "*\n!src\nsrc/**/*.test.js\n!src/**/keep.test.js\nnode_modules\n!**/important/**\n",
+			"docker/Dockerfile":                 "FROM scratch",
+			"docker/other.txt":                  "",
+			"src/app.js":                        "",
+			"src/a/b.test.js":                   "",
+			"src/a/keep.test.js":                "",
+			"node_modules/x/important/keep.txt": "",
+			"node_modules/x/other.txt":          "",
+			"other/file.txt":                    "",
+		} {
+			if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var files []string
+		err := WalkContextFolder(t.Context(), root, "docker/Dockerfile", func(path string, de os.DirEntry, slashPath string) error {
+			files = append(files, slashPath)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("WalkContextFolder(ctx) failed: %v", err)
+		}
+
+		expected := []string{".dockerignore", "docker/Dockerfile", "node_modules/x/important/keep.txt", "src", "src/a", "src/a/keep.test.js", "src/app.js"}
+		if !reflect.DeepEqual(files, expected) {
+			t.Errorf("Expected files: %v, got %v", expected, files)
+		}
+	})
+}
+
+func TestCouldMatchUnder(t *testing.T) {
+	tests := []struct {
+		pattern, dir string
+		want         bool
+	}{
+		{"node_modules/.keep", "node_modules", true},
+		{"node_modules/.keep", "build", false},
+		{"**/important/**", "node_modules/x", true},
+		{"*/keep.txt", "build", true},
+		{"*/keep.txt", "build/cache", false},
+		{"src/*/keep.txt", "src/a", true},
+		{"src/*/keep.txt", "lib/a", false},
+		{"build", "build/cache", true},
+	}
+	for _, tt := range tests {
+		if got := couldMatchUnder(strings.Split(tt.pattern, "/"), strings.Split(tt.dir, "/")); got != tt.want {
+			t.Errorf("couldMatchUnder(%q, %q) = %v, want %v", tt.pattern, tt.dir, got, tt.want)
+		}
+	}
 }
 
 func Test_getRemoteBuildContext(t *testing.T) {
@@ -299,9 +466,71 @@ func standardizeDirMode(dir string) error {
 	})
 }
 
+func TestStreamArchive(t *testing.T) {
+	const root = "../../../testdata/testproj"
+	buffer, err := createArchive(t.Context(), root, "", ArchiveTypeGzip, writeIgnoreFileYes, Executables{})
+	if err != nil {
+		t.Fatalf("createArchive() failed: %v", err)
+	}
+	sum := sha256.Sum256(buffer.Bytes())
+
+	t.Run("same archive", func(t *testing.T) {
+		rc := streamArchive(t.Context(), root, "", ArchiveTypeGzip, Executables{}, int64(buffer.Len()), sum[:])
+		defer rc.Close()
+		streamed, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("streamArchive() failed: %v", err)
+		}
+		if !bytes.Equal(streamed, buffer.Bytes()) {
+			t.Error("Expected the streamed archive to equal the buffered archive")
+		}
+	})
+
+	t.Run("changed context", func(t *testing.T) {
+		rc := streamArchive(t.Context(), root, "", ArchiveTypeGzip, Executables{}, int64(buffer.Len()), make([]byte, len(sum)))
+		defer rc.Close()
+		if _, err := io.ReadAll(rc); !errors.Is(err, errContextChanged) {
+			t.Errorf("Expected errContextChanged, got %v", err)
+		}
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		lr := &lazyReader{open: func() io.ReadCloser {
+			t.Error("Expected open not to be called")
+			return nil
+		}}
+		if err := lr.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestContextTooLarge(t *testing.T) {
+	ctx := WithUploadOptions(t.Context(), UploadOptions{MaxContextSize: 1})
+	_, err := createArchive(ctx, "../../../testdata/testproj", "", ArchiveTypeGzip, writeIgnoreFileNo, Executables{})
+	var tooLarge *ContextTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("Expected ContextTooLargeError, got %v", err)
+	}
+	if tooLarge.Limit != 1 {
+		t.Errorf("Expected limit 1, got %d", tooLarge.Limit)
+	}
+	if len(tooLarge.Largest) == 0 || len(tooLarge.Largest) > largestFilesShown {
+		t.Fatalf("Expected 1 to %d files, got %v", largestFilesShown, tooLarge.Largest)
+	}
+	for i := 1; i < len(tooLarge.Largest); i++ {
+		if tooLarge.Largest[i].Size > tooLarge.Largest[i-1].Size {
+			t.Errorf("Expected files sorted by size, got %v", tooLarge.Largest)
+		}
+	}
+	if !strings.Contains(err.Error(), tooLarge.Largest[0].Path) {
+		t.Errorf("Expected error to list %q, got %v", tooLarge.Largest[0].Path, err)
+	}
+}
+
 func TestCreateTarballReader(t *testing.T) {
 	t.Run("Default Dockerfile", func(t *testing.T) {
-		buffer, err := createArchive(t.Context(), "../../../testdata/testproj", "", ArchiveTypeGzip)
+		buffer, err := createArchive(t.Context(), "../../../testdata/testproj", "", ArchiveTypeGzip, writeIgnoreFileYes, Executables{})
 		if err != nil {
 			t.Fatalf("createTarballReader() failed: %v", err)
 		}
@@ -338,20 +567,47 @@ func TestCreateTarballReader(t *testing.T) {
 	})
 
 	t.Run("Missing Dockerfile", func(t *testing.T) {
-		_, err := createArchive(t.Context(), "../../testdata", "Dockerfile.missing", ArchiveTypeGzip)
+		_, err := createArchive(t.Context(), "../../testdata", "Dockerfile.missing", ArchiveTypeGzip, writeIgnoreFileYes, Executables{})
 		if err == nil {
 			t.Fatal("createTarballReader() should have failed")
 		}
 	})
 
 	t.Run("Missing Context", func(t *testing.T) {
-		_, err := createArchive(t.Context(), "asdfqwer", "", ArchiveTypeGzip)
+		_, err := createArchive(t.Context(), "asdfqwer", "", ArchiveTypeGzip, writeIgnoreFileYes, Executables{})
 		if err == nil {
 			t.Fatal("createTarballReader() should have failed")
 		}
 	})
 }
 
+func TestArchiveMode(t *testing.T) {
+	if isExecutable(0644) {
+		t.Error("expected mode 0644 not to be executable")
+	}
+	if !isExecutable(0700) {
+		t.Error("expected mode 0700 to be executable")
+	}
+	if hasExecutableBit {
+		// The file system mode is kept as-is, so the digest doesn't change
+		for _, mode := range []fs.FileMode{fs.ModeDir | 0700, 0600, 0700} {
+			if got := archiveMode(mode); got != mode {
+				t.Errorf("expected mode %v, got %v", mode, got)
+			}
+		}
+		return
+	}
+	if got := archiveMode(fs.ModeDir | 0777); got != fs.ModeDir|0755 {
+		t.Errorf("expected dir mode %v, got %v", fs.ModeDir|0755, got)
+	}
+	if got := archiveMode(0666); got != 0644 {
+		t.Errorf("expected file mode 0644, got %v", got)
+	}
+	if got := archiveMode(0666 | 0111); got != 0755 {
+		t.Errorf("expected executable mode 0755, got %v", got)
+	}
+}
+
 func TestGetDockerIgnorePatterns(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -412,7 +668,7 @@ func TestGetDockerIgnorePatterns(t *testing.T) {
 			}
 
 			// Call the function under test
-			patterns, fileName, err := getDockerIgnorePatterns(tempDir, tt.dockerfile)
+			patterns, fileName, err := getDockerIgnorePatterns(t.Context(), tempDir, tt.dockerfile)
 			if err != nil {
 				t.Fatalf("Failed to get ignore file pattern: %v", err)
 			}
@@ -436,3 +692,121 @@ func TestGetDockerIgnorePatterns(t *testing.T) {
 		})
 	}
 }
+
+func TestArchiveLinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("creating symlinks requires privileges on Windows")
+	}
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outside, "shared.txt"), []byte("shared"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	for name, content := range map[string]string{"Dockerfile": "FROM scratch", ".dockerignore": "", "config.txt": "config"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("config.txt", filepath.Join(root, "relative.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, "config.txt"), filepath.Join(root, "absolute.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Link(filepath.Join(root, "config.txt"), filepath.Join(root, "hardlink.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	readTar := func(t *testing.T, buf *bytes.Buffer) map[string]*tar.Header {
+		t.Helper()
+		g, err := gzip.NewReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers := make(map[string]*tar.Header)
+		for ar := tar.NewReader(g); ; {
+			h, err := ar.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			headers[h.Name] = h
+		}
+		return headers
+	}
+
+	t.Run("links inside the context", func(t *testing.T) {
+		buf, err := createArchive(t.Context(), root, "", ArchiveTypeGzip, writeIgnoreFileNo, Executables{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers := readTar(t, buf)
+		if h := headers["relative.txt"]; h == nil || h.Typeflag != tar.TypeSymlink || h.Linkname != "config.txt" {
+			t.Errorf("expected relative.txt to link to config.txt, got %+v", h)
+		}
+		if h := headers["absolute.txt"]; h == nil || h.Typeflag != tar.TypeSymlink || h.Linkname != "config.txt" {
+			t.Errorf("expected absolute.txt to be a relative link to config.txt, got %+v", h)
+		}
+		// config.txt comes first in lexical order, so hardlink.txt links to it
+		if h := headers["hardlink.txt"]; h == nil || h.Typeflag != tar.TypeLink || h.Linkname != "config.txt" {
+			t.Errorf("expected hardlink.txt to be a hardlink to config.txt, got %+v", h)
+		}
+	})
+
+	if err := os.Symlink(filepath.Join(outside, "shared.txt"), filepath.Join(root, "shared.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("link outside the context", func(t *testing.T) {
+		if _, err := createArchive(t.Context(), root, "", ArchiveTypeGzip, writeIgnoreFileNo, Executables{}); err == nil || !strings.Contains(err.Error(), "outside of the build context") {
+			t.Errorf("expected an error for a link outside of the context, got %v", err)
+		}
+	})
+
+	t.Run("follow link outside the context", func(t *testing.T) {
+		ctx := WithUploadOptions(t.Context(), UploadOptions{FollowSymlinks: true})
+		buf, err := createArchive(ctx, root, "", ArchiveTypeGzip, writeIgnoreFileNo, Executables{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h := readTar(t, buf)["shared.txt"]; h == nil || h.Typeflag != tar.TypeReg || h.Size != int64(len("shared")) {
+			t.Errorf("expected shared.txt to be copied, got %+v", h)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		files, err := ListContextFiles(t.Context(), root, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []ContextFile{{".dockerignore", 0}, {"Dockerfile", 12}, {"absolute.txt", 0}, {"config.txt", 6}, {"hardlink.txt", 6}, {"relative.txt", 0}, {"shared.txt", 6}}
+		if !reflect.DeepEqual(files, expected) {
+			t.Errorf("expected the links like in the archive: %v, got %v", expected, files)
+		}
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		ctx := WithUploadOptions(t.Context(), UploadOptions{FollowSymlinks: true})
+		buf, err := createArchive(ctx, root, "", ArchiveTypeZip, writeIgnoreFileNo, Executables{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range zr.File {
+			switch f.Name {
+			case "relative.txt":
+				if f.Mode()&fs.ModeSymlink == 0 {
+					t.Errorf("expected relative.txt to be a symlink, got %v", f.Mode())
+				}
+			case "hardlink.txt":
+				if !f.Mode().IsRegular() || f.UncompressedSize64 != uint64(len("config")) {
+					t.Errorf("expected hardlink.txt to be a copy, got %v", f.Mode())
+				}
+			}
+		}
+	})
+}
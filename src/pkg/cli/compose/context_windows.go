@@ -0,0 +1,47 @@
+//go:build windows
+
+package compose
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// hasExecutableBit is true when the file mode tells whether a file is executable.
+const hasExecutableBit = false
+
+// archiveMode returns the mode to store in the archive. Windows has no executable bit and reports 0666 or
+// 0444, so use 0644 for files, unless they were marked executable.
+func archiveMode(mode fs.FileMode) fs.FileMode {
+	if mode.IsDir() {
+		return fs.ModeDir | 0755
+	}
+	if isExecutable(mode) {
+		return 0755
+	}
+	return 0644
+}
+
+// longPath returns the path with the \\?\ prefix, which lifts the 260 character MAX_PATH limit and stops
+// Windows from treating reserved names like "con" or "nul" as devices.
+func longPath(path string) string {
+	if strings.HasPrefix(path, `\\?\`) {
+		return path
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	if strings.HasPrefix(abs, `\\`) {
+		return `\\?\UNC\` + abs[2:] // network share
+	}
+	return `\\?\` + abs
+}
+
+type fileID struct{}
+
+// hardlinkID returns false: hardlinks are archived as copies on Windows.
+func hardlinkID(info fs.FileInfo) (fileID, bool) {
+	return fileID{}, false
+}
@@ -0,0 +1,159 @@
+//go:build windows
+
+package compose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readTarball(t *testing.T, root, dockerfile string) map[string]*tar.Header {
+	t.Helper()
+	buffer, err := createArchive(t.Context(), root, dockerfile, ArchiveTypeGzip, writeIgnoreFileNo, Executables{})
+	if err != nil {
+		t.Fatalf("createArchive() failed: %v", err)
+	}
+	g, err := gzip.NewReader(buffer)
+	if err != nil {
+		t.Fatalf("gzip.NewReader() failed: %v", err)
+	}
+	t.Cleanup(func() { g.Close() })
+
+	headers := make(map[string]*tar.Header)
+	ar := tar.NewReader(g)
+	for {
+		h, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers[h.Name] = h
+	}
+	return headers
+}
+
+func TestCreateTarballWindows(t *testing.T) {
+	t.Run("Forward slashes", func(t *testing.T) {
+		root := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(root, "sub", "dir"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"Dockerfile", ".dockerignore", `sub\dir\file.txt`} {
+			if err := os.WriteFile(filepath.Join(root, name), []byte("x"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		headers := readTarball(t, root, "")
+		for name, h := range headers {
+			if strings.Contains(name, `\`) {
+				t.Errorf("expected forward slashes, got %q", name)
+			}
+			if h.FileInfo().IsDir() {
+				if h.Mode != 0755 {
+					t.Errorf("expected mode 0755 for %q, got %o", name, h.Mode)
+				}
+			} else if h.Mode != 0644 {
+				t.Errorf("expected mode 0644 for %q, got %o", name, h.Mode)
+			}
+		}
+		if _, ok := headers["sub/dir/file.txt"]; !ok {
+			t.Errorf("expected sub/dir/file.txt in tarball, got %v", headers)
+		}
+	})
+
+	t.Run("Dockerfile with forward slashes", func(t *testing.T) {
+		root := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(root, "docker"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, "docker", "Dockerfile"), []byte("FROM scratch"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, ".dockerignore"), []byte("docker\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := readTarball(t, root, "docker/Dockerfile")
+		if _, ok := headers["docker/Dockerfile"]; !ok {
+			t.Errorf("expected docker/Dockerfile in tarball, got %v", headers)
+		}
+	})
+
+	t.Run("Long path", func(t *testing.T) {
+		root := t.TempDir()
+		dir := longPath(root)
+		for len(dir) < 300 {
+			dir = filepath.Join(dir, strings.Repeat("d", 50))
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "file.txt"), []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, "Dockerfile"), []byte("FROM scratch"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, ".dockerignore"), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := readTarball(t, root, "")
+		rel, err := filepath.Rel(longPath(root), filepath.Join(dir, "file.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := headers[filepath.ToSlash(rel)]; !ok {
+			t.Errorf("expected %q in tarball", filepath.ToSlash(rel))
+		}
+	})
+
+	t.Run("Reserved name", func(t *testing.T) {
+		root := t.TempDir()
+		// Reserved names can only be created with the \\?\ prefix
+		if err := os.WriteFile(filepath.Join(longPath(root), "nul"), []byte("not a device"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, "Dockerfile"), []byte("FROM scratch"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, ".dockerignore"), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := readTarball(t, root, "")
+		h, ok := headers["nul"]
+		if !ok {
+			t.Fatalf("expected nul in tarball, got %v", headers)
+		}
+		if h.Size != int64(len("not a device")) {
+			t.Errorf("expected size %d, got %d", len("not a device"), h.Size)
+		}
+	})
+}
+
+func TestLongPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{`C:\foo\bar`, `\\?\C:\foo\bar`},
+		{`\\?\C:\foo`, `\\?\C:\foo`},
+		{`\\server\share\foo`, `\\?\UNC\server\share\foo`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := longPath(tt.path); got != tt.want {
+				t.Errorf("longPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package compose
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+)
+
+const DefaultContextCacheTTL = 24 * time.Hour
+
+func parseContextCacheTTL(ttl string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(ttl); err == nil && d >= 0 {
+		return d
+	}
+	return def
+}
+
+var (
+	// ContextCacheTTL is how long an uploaded build context is reused; DEFANG_CONTEXT_CACHE_TTL overrides it, and 0 disables the cache.
+	ContextCacheTTL  = parseContextCacheTTL(os.Getenv("DEFANG_CONTEXT_CACHE_TTL"), DefaultContextCacheTTL)
+	contextCachePath = filepath.Join(client.StateDir, "context-cache.json")
+	contextCacheLock sync.Mutex // uploads run in parallel
+)
+
+// contextCacheEntry is the URL of an uploaded build context, keyed by project and digest in the cache file.
+type contextCacheEntry struct {
+	URL     string
+	Expires time.Time
+}
+
+func contextCacheKey(projectName, name string) string {
+	return projectName + "/" + name
+}
+
+func readContextCache(ctx context.Context) map[string]contextCacheEntry {
+	cache := make(map[string]contextCacheEntry)
+	if bytes, err := os.ReadFile(contextCachePath); err == nil {
+		if err := json.Unmarshal(bytes, &cache); err != nil {
+			termFromContext(ctx).Debug("Ignoring invalid build context cache:", err)
+		}
+	}
+	return cache
+}
+
+// cachedUpload returns the URL of an earlier upload of the build context with the same digest, if the cache entry
+// hasn't expired and the provider confirms the upload still exists. Without such a check, the cache isn't used.
+func cachedUpload(ctx context.Context, provider client.Provider, projectName, name string) (string, bool) {
+	checker, ok := provider.(client.BuildContextChecker)
+	if !ok || ContextCacheTTL == 0 {
+		return "", false
+	}
+
+	contextCacheLock.Lock()
+	entry, ok := readContextCache(ctx)[contextCacheKey(projectName, name)]
+	contextCacheLock.Unlock()
+	if !ok || time.Now().After(entry.Expires) {
+		return "", false
+	}
+
+	exists, err := checker.BuildContextExists(ctx, entry.URL)
+	if err != nil {
+		termFromContext(ctx).Debugf("Failed to check the cached upload %s: %v", entry.URL, err)
+		return "", false
+	}
+	return entry.URL, exists
+}
+
+// cacheUpload records the URL of an uploaded build context and drops the expired entries.
+func cacheUpload(ctx context.Context, provider client.Provider, projectName, name, url string) {
+	if _, ok := provider.(client.BuildContextChecker); !ok || ContextCacheTTL == 0 {
+		return // the cache would never be used
+	}
+	contextCacheLock.Lock()
+	defer contextCacheLock.Unlock()
+
+	now := time.Now()
+	cache := readContextCache(ctx)
+	for key, entry := range cache {
+		if now.After(entry.Expires) {
+			delete(cache, key)
+		}
+	}
+	cache[contextCacheKey(projectName, name)] = contextCacheEntry{URL: url, Expires: now.Add(ContextCacheTTL)}
+
+	bytes, err := json.MarshalIndent(cache, "", "  ")
+	if err == nil {
+		os.MkdirAll(filepath.Dir(contextCachePath), 0700)
+		err = os.WriteFile(contextCachePath, bytes, 0600)
+	}
+	if err != nil {
+		termFromContext(ctx).Debug("Failed to update the build context cache:", err)
+	}
+}
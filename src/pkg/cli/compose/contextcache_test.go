@@ -0,0 +1,61 @@
+package compose
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+)
+
+type checkingProvider struct {
+	client.MockProvider
+	exists map[string]bool
+}
+
+func (p checkingProvider) BuildContextExists(ctx context.Context, url string) (bool, error) {
+	return p.exists[url], nil
+}
+
+func TestContextCache(t *testing.T) {
+	oldPath, oldTTL := contextCachePath, ContextCacheTTL
+	t.Cleanup(func() {
+		contextCachePath, ContextCacheTTL = oldPath, oldTTL
+	})
+	contextCachePath = filepath.Join(t.TempDir(), "context-cache.json")
+	ContextCacheTTL = time.Hour
+
+	const url = "s3://bucket/uploads/sha256-abc.tar.gz"
+	provider := checkingProvider{exists: map[string]bool{url: true}}
+
+	if _, ok := cachedUpload(t.Context(), provider, "proj", "sha256-abc.tar.gz"); ok {
+		t.Error("expected a miss on an empty cache")
+	}
+
+	cacheUpload(t.Context(), client.MockProvider{}, "proj", "sha256-abc.tar.gz", url)
+	if len(readContextCache(t.Context())) != 0 {
+		t.Error("expected nothing cached for a provider that can't check uploads")
+	}
+
+	cacheUpload(t.Context(), provider, "proj", "sha256-abc.tar.gz", url)
+	if got, ok := cachedUpload(t.Context(), provider, "proj", "sha256-abc.tar.gz"); !ok || got != url {
+		t.Errorf("expected a hit with %q, got %q, %v", url, got, ok)
+	}
+	if _, ok := cachedUpload(t.Context(), provider, "other", "sha256-abc.tar.gz"); ok {
+		t.Error("expected a miss for another project")
+	}
+	if _, ok := cachedUpload(t.Context(), checkingProvider{}, "proj", "sha256-abc.tar.gz"); ok {
+		t.Error("expected a miss when the upload no longer exists")
+	}
+
+	ContextCacheTTL = -time.Hour // expire the entries that are written
+	cacheUpload(t.Context(), provider, "proj", "sha256-def.tar.gz", url)
+	if _, ok := cachedUpload(t.Context(), provider, "proj", "sha256-def.tar.gz"); ok {
+		t.Error("expected a miss for an expired entry")
+	}
+	cacheUpload(t.Context(), provider, "proj", "sha256-ghi.tar.gz", url)
+	if _, ok := readContextCache(t.Context())[contextCacheKey("proj", "sha256-def.tar.gz")]; ok {
+		t.Error("expected the expired entry to be dropped")
+	}
+}
@@ -0,0 +1,98 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+const corsSyntax = `x-defang-ingress: 'cors' must be an object {"allow_origins": string[], "allow_methods": string[], "allow_headers": string[], "expose_headers": string[], "allow_credentials": bool, "max_age": duration}`
+
+var corsMethods = []string{"DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"}
+
+func parseCors(cors any) (*defangv1.Cors, error) {
+	options, ok := cors.(map[string]any)
+	if !ok {
+		return nil, errors.New(corsSyntax)
+	}
+
+	result := &defangv1.Cors{}
+	for _, key := range slices.Sorted(maps.Keys(options)) {
+		val := options[key]
+		var err error
+		switch key {
+		case "allow_origins":
+			if result.AllowOrigins, err = toStringList("cors."+key, val); err != nil {
+				return nil, err
+			}
+			for i, origin := range result.AllowOrigins {
+				if result.AllowOrigins[i], err = normalizeCorsOrigin(origin); err != nil {
+					return nil, err
+				}
+			}
+		case "allow_methods":
+			if result.AllowMethods, err = toStringList("cors."+key, val); err != nil {
+				return nil, err
+			}
+			for i, method := range result.AllowMethods {
+				result.AllowMethods[i] = strings.ToUpper(method)
+				if method != "*" && !slices.Contains(corsMethods, result.AllowMethods[i]) {
+					return nil, fmt.Errorf("x-defang-ingress: invalid CORS method %q; must be one of %v", method, corsMethods)
+				}
+			}
+		case "allow_headers", "expose_headers":
+			headers, err := toStringList("cors."+key, val)
+			if err != nil {
+				return nil, err
+			}
+			for _, header := range headers {
+				if header != "*" && !tokenRegex.MatchString(header) {
+					return nil, fmt.Errorf("x-defang-ingress: invalid CORS header %q", header)
+				}
+			}
+			if key == "allow_headers" {
+				result.AllowHeaders = headers
+			} else {
+				result.ExposeHeaders = headers
+			}
+		case "allow_credentials":
+			if result.AllowCredentials, ok = val.(bool); !ok {
+				return nil, errors.New("x-defang-ingress: 'cors.allow_credentials' must be a boolean")
+			}
+		case "max_age":
+			maxAge, err := toSeconds(val)
+			if err != nil {
+				return nil, fmt.Errorf("x-defang-ingress: 'cors.max_age' %w", err)
+			}
+			result.MaxAgeSeconds = maxAge
+		default:
+			return nil, fmt.Errorf("x-defang-ingress: unsupported option 'cors.%s'", key)
+		}
+	}
+
+	if len(result.AllowOrigins) == 0 {
+		return nil, errors.New("x-defang-ingress: 'cors.allow_origins' is required")
+	}
+	// Browsers reject credentialed requests when the allowed origin is a wildcard
+	if result.AllowCredentials && slices.Contains(result.AllowOrigins, "*") {
+		return nil, errors.New("x-defang-ingress: 'cors.allow_credentials' cannot be used with the '*' origin; list the allowed origins instead")
+	}
+	return result, nil
+}
+
+// normalizeCorsOrigin returns the origin as sent by browsers, ie. scheme://host[:port] in lowercase
+func normalizeCorsOrigin(origin string) (string, error) {
+	if origin == "*" {
+		return origin, nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.User != nil {
+		return "", fmt.Errorf("x-defang-ingress: invalid CORS origin %q; must be '*' or like \"https://example.com\"", origin)
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host), nil
+}
@@ -0,0 +1,123 @@
+package compose
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// DeploymentOrder returns the services in batches, where each batch only depends on services in earlier batches.
+// Dependencies on services that are not in the project are ignored. Returns an error if the dependencies have a cycle.
+func DeploymentOrder(services composeTypes.Services) ([][]string, error) {
+	remaining := make(map[string][]string, len(services))
+	for name, svccfg := range services {
+		var deps []string
+		for dep := range svccfg.DependsOn {
+			if _, ok := services[dep]; ok {
+				deps = append(deps, dep)
+			}
+		}
+		remaining[name] = deps
+	}
+
+	var order [][]string
+	for len(remaining) > 0 {
+		var batch []string
+		for name, deps := range remaining {
+			if !slices.ContainsFunc(deps, func(dep string) bool { _, ok := remaining[dep]; return ok }) {
+				batch = append(batch, name)
+			}
+		}
+		if len(batch) == 0 {
+			return order, errorf(CodeInvalidDependency, "depends_on has a cycle between services %v", cycle(remaining))
+		}
+		slices.Sort(batch)
+		for _, name := range batch {
+			delete(remaining, name)
+		}
+		order = append(order, batch)
+	}
+	return order, nil
+}
+
+// cycle returns the services that are part of a cycle, by dropping the services that no other service depends on.
+func cycle(remaining map[string][]string) []string {
+	for dropped := true; dropped; {
+		dropped = false
+		for name := range remaining {
+			if !slices.ContainsFunc(slices.Collect(maps.Values(remaining)), func(deps []string) bool { return slices.Contains(deps, name) }) {
+				delete(remaining, name)
+				dropped = true
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(remaining))
+}
+
+// HealthyDependencies returns, for each service, the services it depends on with condition service_healthy, which
+// must be healthy before the service is considered started.
+func HealthyDependencies(services composeTypes.Services) map[string][]string {
+	dependencies := make(map[string][]string)
+	for name, svccfg := range services {
+		for dep, config := range svccfg.DependsOn {
+			if _, ok := services[dep]; ok && config.Condition == composeTypes.ServiceConditionHealthy {
+				dependencies[name] = append(dependencies[name], dep)
+			}
+		}
+		slices.Sort(dependencies[name])
+	}
+	return dependencies
+}
+
+// DeploymentDependencies returns the deployment order and the service_healthy dependencies of the services for the
+// DeployRequest, so the controller deploys each service after its dependencies.
+func DeploymentDependencies(services composeTypes.Services) ([]*defangv1.DeploymentBatch, map[string]*defangv1.ServiceDependencies, error) {
+	order, err := DeploymentOrder(services)
+	if err != nil {
+		return nil, nil, err
+	}
+	batches := make([]*defangv1.DeploymentBatch, len(order))
+	for i, batch := range order {
+		batches[i] = &defangv1.DeploymentBatch{Services: batch}
+	}
+	dependencies := make(map[string]*defangv1.ServiceDependencies)
+	for name, healthy := range HealthyDependencies(services) {
+		if len(healthy) > 0 {
+			dependencies[name] = &defangv1.ServiceDependencies{Healthy: healthy}
+		}
+	}
+	return batches, dependencies, nil
+}
+
+func validateDependsOn(ctx context.Context, svccfg *composeTypes.ServiceConfig, project *composeTypes.Project) error {
+	deps := slices.Sorted(maps.Keys(svccfg.DependsOn))
+	for _, dep := range deps {
+		config := svccfg.DependsOn[dep]
+		dependency, ok := project.Services[dep]
+		if !ok {
+			if config.Required {
+				return errorf(CodeInvalidDependency, "service %q: depends_on undefined service %q", svccfg.Name, dep)
+			}
+			termFromContext(ctx).Debugf("service %q: ignoring optional dependency on undefined service %q", svccfg.Name, dep)
+			continue
+		}
+		switch config.Condition {
+		case "", composeTypes.ServiceConditionStarted:
+		case composeTypes.ServiceConditionHealthy:
+			if !hasHealthCheck(dependency.HealthCheck) {
+				warnf(ctx, CodeInvalidDependency, "service %q: depends_on %q with condition service_healthy, but %q has no healthcheck; it is healthy once it is running", svccfg.Name, dep, dep)
+			}
+		case composeTypes.ServiceConditionCompletedSuccessfully:
+			warnf(ctx, CodeInvalidDependency, "service %q: depends_on %q with condition service_completed_successfully is not supported; assuming service_started", svccfg.Name, dep)
+		default:
+			return errorf(CodeInvalidDependency, "service %q: unsupported depends_on condition %q", svccfg.Name, config.Condition)
+		}
+		if config.Restart {
+			termFromContext(ctx).Debugf("service %q: unsupported depends_on option: restart", svccfg.Name)
+		}
+	}
+	return nil
+}
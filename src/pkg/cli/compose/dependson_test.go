@@ -0,0 +1,79 @@
+package compose
+
+import (
+	"slices"
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestDeploymentOrder(t *testing.T) {
+	services := composeTypes.Services{
+		"app": {Name: "app", DependsOn: composeTypes.DependsOnConfig{
+			"api":   {Condition: composeTypes.ServiceConditionHealthy, Required: true},
+			"cache": {Condition: composeTypes.ServiceConditionStarted, Required: true},
+		}},
+		"api":   {Name: "api", DependsOn: composeTypes.DependsOnConfig{"db": {Condition: composeTypes.ServiceConditionHealthy, Required: true}}},
+		"cache": {Name: "cache", DependsOn: composeTypes.DependsOnConfig{"missing": {Condition: composeTypes.ServiceConditionStarted}}},
+		"db":    {Name: "db"},
+	}
+
+	order, err := DeploymentOrder(services)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"cache", "db"}, {"api"}, {"app"}}
+	if !slices.EqualFunc(order, want, slices.Equal) {
+		t.Errorf("expected order %v, got %v", want, order)
+	}
+
+	healthy := HealthyDependencies(services)
+	if !slices.Equal(healthy["app"], []string{"api"}) || !slices.Equal(healthy["api"], []string{"db"}) || len(healthy["cache"]) != 0 {
+		t.Errorf("unexpected healthy dependencies %v", healthy)
+	}
+
+	batches, dependencies, err := DeploymentDependencies(services)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != len(want) || !slices.Equal(batches[0].Services, want[0]) || !slices.Equal(batches[2].Services, want[2]) {
+		t.Errorf("expected batches %v, got %v", want, batches)
+	}
+	if len(dependencies) != 2 || !slices.Equal(dependencies["app"].GetHealthy(), []string{"api"}) {
+		t.Errorf("unexpected dependencies %v", dependencies)
+	}
+
+	services["db"] = composeTypes.ServiceConfig{Name: "db", DependsOn: composeTypes.DependsOnConfig{"app": {Required: true}}}
+	if _, err := DeploymentOrder(services); err == nil {
+		t.Error("expected an error for a cycle")
+	} else if CodeOf(err.Error()) != CodeInvalidDependency {
+		t.Errorf("expected code %s, got %v", CodeInvalidDependency, err)
+	}
+}
+
+func TestValidateDependsOn(t *testing.T) {
+	project := &composeTypes.Project{Services: composeTypes.Services{
+		"app": {Name: "app"},
+		"db":  {Name: "db"},
+	}}
+
+	tests := []struct {
+		name      string
+		dependsOn composeTypes.DependsOnConfig
+		wantErr   bool
+	}{
+		{"started", composeTypes.DependsOnConfig{"db": {Condition: composeTypes.ServiceConditionStarted, Required: true}}, false},
+		{"healthy", composeTypes.DependsOnConfig{"db": {Condition: composeTypes.ServiceConditionHealthy, Required: true}}, false},
+		{"optional undefined", composeTypes.DependsOnConfig{"nope": {Condition: composeTypes.ServiceConditionStarted}}, false},
+		{"required undefined", composeTypes.DependsOnConfig{"nope": {Condition: composeTypes.ServiceConditionStarted, Required: true}}, true},
+		{"unknown condition", composeTypes.DependsOnConfig{"db": {Condition: "service_ready", Required: true}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svccfg := composeTypes.ServiceConfig{Name: "app", DependsOn: tt.dependsOn}
+			if err := validateDependsOn(t.Context(), &svccfg, project); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
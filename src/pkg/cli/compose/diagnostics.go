@@ -0,0 +1,192 @@
+package compose
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"go.yaml.in/yaml/v4"
+)
+
+type DiagnosticSeverity string
+
+const (
+	DiagnosticError   DiagnosticSeverity = "error"
+	DiagnosticWarning DiagnosticSeverity = "warning"
+)
+
+// Diagnostic is a machine-readable warning or error, eg. for showing inline in an editor.
+type Diagnostic struct {
+	Code     string             `json:"code"`
+	Severity DiagnosticSeverity `json:"severity"`
+	File     string             `json:"file,omitempty"`
+	Line     int                `json:"line,omitempty"` // 1-based; 0 if unknown
+	Message  string             `json:"message"`
+}
+
+var diagnosticCodes = []struct {
+	pattern *regexp.Regexp
+	code    string
+}{
+	{regexp.MustCompile(`unsupported compose directive`), "unsupported-directive"},
+	{regexp.MustCompile(`unsupported compose extension`), "unsupported-extension"},
+	{regexp.MustCompile(`missing memory reservation`), "missing-memory-reservation"},
+	{regexp.MustCompile(`normalize to the same value`), "name-collision"},
+	{regexp.MustCompile(`name .* is too long`), "name-too-long"},
+	{regexp.MustCompile(`\bx-defang-[a-z-]+`), "defang-extension"},
+	{regexp.MustCompile(`\b(yaml|line \d+)\b`), "invalid-yaml"},
+	{regexp.MustCompile(`\b(ports?|ingress)\b`), "ports"},
+	{regexp.MustCompile(`\b(config|secrets?|environment variable)\b`), "config"},
+}
+
+var (
+	serviceInMessage = regexp.MustCompile(`service "([^"]+)"`)
+	lineInMessage    = regexp.MustCompile(`\bline (\d+)\b`)
+)
+
+// DiagnosticsWriter writes warnings and errors as newline-delimited JSON Diagnostic records. The file and
+// line are derived from the service name in the message, if any, by looking it up in the Compose files.
+type DiagnosticsWriter struct {
+	mu           sync.Mutex
+	enc          *json.Encoder
+	files        []string
+	serviceLines map[string]Diagnostic // only File and Line are set
+}
+
+// NewDiagnosticsWriter returns a writer for the given Compose files; if there are none, the default Compose files
+// in the current directory are used.
+func NewDiagnosticsWriter(w io.Writer, composeFiles []string) *DiagnosticsWriter {
+	if len(composeFiles) == 0 {
+		for _, name := range cli.DefaultFileNames {
+			if _, err := os.Stat(name); err == nil {
+				composeFiles = []string{name}
+				break
+			}
+		}
+	}
+	files := make([]string, len(composeFiles))
+	for i, file := range composeFiles {
+		files[i], _ = filepath.Abs(file)
+	}
+	return &DiagnosticsWriter{enc: json.NewEncoder(w), files: files}
+}
+
+func (d *DiagnosticsWriter) Warning(msg string) {
+	d.write(DiagnosticWarning, msg)
+}
+
+// Error writes a record for each line of the error, since validation errors are joined with newlines.
+func (d *DiagnosticsWriter) Error(err error) {
+	for line := range strings.Lines(err.Error()) {
+		if line = strings.TrimSpace(line); line != "" {
+			d.write(DiagnosticError, line)
+		}
+	}
+}
+
+func (d *DiagnosticsWriter) write(severity DiagnosticSeverity, msg string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.enc.Encode(d.diagnose(severity, msg))
+}
+
+// NewDiagnostic returns a Diagnostic for the message, with the code from the message, or one derived from the
+// message if there is none, but without location.
+func NewDiagnostic(severity DiagnosticSeverity, msg string) Diagnostic {
+	diag := Diagnostic{Code: string(severity), Severity: severity, Message: msg}
+	if code := CodeOf(msg); code != "" {
+		diag.Code = string(code)
+		return diag
+	}
+	for _, c := range diagnosticCodes {
+		if c.pattern.MatchString(msg) {
+			diag.Code = c.code
+			break
+		}
+	}
+	return diag
+}
+
+// DiagnoseContent is like NewDiagnostic, but also sets the line by looking up the service name or line number
+// from the message in the content of a single Compose file, eg. an unsaved editor buffer.
+func DiagnoseContent(severity DiagnosticSeverity, msg string, content []byte) Diagnostic {
+	diag := NewDiagnostic(severity, msg)
+	if match := serviceInMessage.FindStringSubmatch(msg); match != nil {
+		diag.Line = ServiceLines(content)[match[1]]
+	} else if match := lineInMessage.FindStringSubmatch(msg); match != nil {
+		diag.Line, _ = strconv.Atoi(match[1])
+	}
+	return diag
+}
+
+func (d *DiagnosticsWriter) diagnose(severity DiagnosticSeverity, msg string) Diagnostic {
+	diag := NewDiagnostic(severity, msg)
+	if match := serviceInMessage.FindStringSubmatch(msg); match != nil {
+		location := d.locateService(match[1])
+		diag.File, diag.Line = location.File, location.Line
+	} else if match := lineInMessage.FindStringSubmatch(msg); match != nil && len(d.files) > 0 {
+		diag.File = d.files[0]
+		for _, file := range d.files {
+			if strings.Contains(msg, filepath.Base(file)) {
+				diag.File = file
+			}
+		}
+		diag.Line, _ = strconv.Atoi(match[1])
+	} else if len(d.files) > 0 {
+		diag.File = d.files[0]
+	}
+	return diag
+}
+
+func (d *DiagnosticsWriter) locateService(name string) Diagnostic {
+	if d.serviceLines == nil {
+		d.serviceLines = make(map[string]Diagnostic)
+		// Later files override earlier ones, so the last definition wins
+		for _, file := range d.files {
+			for service, line := range findServiceLines(file) {
+				d.serviceLines[service] = Diagnostic{File: file, Line: line}
+			}
+		}
+	}
+	if location, ok := d.serviceLines[name]; ok {
+		return location
+	}
+	if len(d.files) > 0 {
+		return Diagnostic{File: d.files[0]}
+	}
+	return Diagnostic{}
+}
+
+func findServiceLines(file string) map[string]int {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+	return ServiceLines(content)
+}
+
+// ServiceLines returns the 1-based line of each service key in the "services" section of the Compose file content.
+func ServiceLines(content []byte) map[string]int {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	lines := make(map[string]int)
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "services" {
+			continue
+		}
+		services := root.Content[i+1]
+		for j := 0; j+1 < len(services.Content); j += 2 {
+			lines[services.Content[j].Value] = services.Content[j].Line
+		}
+	}
+	return lines
+}
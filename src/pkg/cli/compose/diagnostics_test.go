@@ -0,0 +1,45 @@
+package compose
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiagnosticsWriter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "compose.yaml")
+	content := "services:\n  web:\n    image: nginx\n  api:\n    image: api\n"
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	d := NewDiagnosticsWriter(&buf, []string{file})
+	d.Warning(`service "api": missing memory reservation; using provider-specific defaults`)
+	d.Error(errors.Join(
+		errors.New(`service "web": unsupported compose directive: devices`),
+		errors.New("yaml: line 7: did not find expected key"),
+	))
+
+	expected := []Diagnostic{
+		{Code: "missing-memory-reservation", Severity: DiagnosticWarning, File: file, Line: 4, Message: `service "api": missing memory reservation; using provider-specific defaults`},
+		{Code: "unsupported-directive", Severity: DiagnosticError, File: file, Line: 2, Message: `service "web": unsupported compose directive: devices`},
+		{Code: "invalid-yaml", Severity: DiagnosticError, File: file, Line: 7, Message: "yaml: line 7: did not find expected key"},
+	}
+	dec := json.NewDecoder(&buf)
+	for i, want := range expected {
+		var got Diagnostic
+		if err := dec.Decode(&got); err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if got != want {
+			t.Errorf("record %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+	if dec.More() {
+		t.Error("expected no more records")
+	}
+}
@@ -2,12 +2,12 @@ package compose
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 )
 
@@ -34,8 +34,8 @@ func (e *DockerfileValidationError) Unwrap() error {
 }
 
 // ValidateDockerfile validates the syntax and basic structure of a Dockerfile
-func ValidateDockerfile(dockerfilePath string, serviceName string) error {
-	term.Debugf("Validating Dockerfile: %s for service %q", dockerfilePath, serviceName)
+func ValidateDockerfile(ctx context.Context, dockerfilePath string, serviceName string) error {
+	termFromContext(ctx).Debugf("Validating Dockerfile: %s for service %q", dockerfilePath, serviceName)
 
 	// Read the Dockerfile
 	content, err := os.ReadFile(dockerfilePath)
@@ -124,14 +124,53 @@ func ValidateDockerfile(dockerfilePath string, serviceName string) error {
 			}
 		}
 		// Log warnings but don't fail validation
-		term.Warnf("service %q: Dockerfile %q has warnings:\n  %s", serviceName, dockerfilePath, strings.Join(warnings, "\n  "))
+		warnf(ctx, CodeDockerfileWarning, "service %q: Dockerfile %q has warnings:\n  %s", serviceName, dockerfilePath, strings.Join(warnings, "\n  "))
 	}
 
 	return nil
 }
 
+// validateDockerfileTarget checks that the build target is the name of a stage in the Dockerfile, since the build
+// would otherwise fail after the build context was uploaded.
+func validateDockerfileTarget(dockerfilePath, serviceName, target string) error {
+	content, err := os.ReadFile(dockerfilePath)
+	if err != nil {
+		return nil // already validated
+	}
+	result, err := parser.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil // already validated
+	}
+	var stages []string
+	for _, child := range result.AST.Children {
+		if !strings.EqualFold(child.Value, "FROM") {
+			continue
+		}
+		// FROM [--platform=<platform>] <image> [AS <name>]
+		var args []string
+		for node := child.Next; node != nil; node = node.Next {
+			args = append(args, node.Value)
+		}
+		if len(args) == 3 && strings.EqualFold(args[1], "AS") {
+			stages = append(stages, args[2])
+			if strings.EqualFold(args[2], target) { // stage names are case-insensitive
+				return nil
+			}
+		}
+	}
+	message := fmt.Sprintf("build target %q is not a stage in the Dockerfile", target)
+	if len(stages) > 0 {
+		message += fmt.Sprintf("; expected one of %v", stages)
+	}
+	return &DockerfileValidationError{
+		ServiceName:    serviceName,
+		DockerfilePath: dockerfilePath,
+		Message:        message,
+	}
+}
+
 // ValidateServiceDockerfiles validates all Dockerfiles referenced by services in a project
-func ValidateServiceDockerfiles(project *Project) error {
+func ValidateServiceDockerfiles(ctx context.Context, project *Project) error {
 	var errors []error
 
 	for _, service := range project.Services {
@@ -161,7 +200,7 @@ func ValidateServiceDockerfiles(project *Project) error {
 			if os.IsNotExist(err) {
 				// This might be handled later by Railpack or may be a remote context
 				// Only validate if the file exists
-				term.Debugf("Skipping validation for service %q: Dockerfile %q does not exist", service.Name, dockerfilePath)
+				termFromContext(ctx).Debugf("Skipping validation for service %q: Dockerfile %q does not exist", service.Name, dockerfilePath)
 				continue
 			}
 			errors = append(errors, &DockerfileValidationError{
@@ -174,8 +213,12 @@ func ValidateServiceDockerfiles(project *Project) error {
 		}
 
 		// Validate the Dockerfile
-		if err := ValidateDockerfile(dockerfilePath, service.Name); err != nil {
+		if err := ValidateDockerfile(ctx, dockerfilePath, service.Name); err != nil {
 			errors = append(errors, err)
+		} else if service.Build.Target != "" {
+			if err := validateDockerfileTarget(dockerfilePath, service.Name, service.Build.Target); err != nil {
+				errors = append(errors, err)
+			}
 		}
 	}
 
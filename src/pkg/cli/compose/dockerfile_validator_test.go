@@ -99,7 +99,7 @@ RUN echo "hello"`,
 			}
 
 			// Validate the Dockerfile
-			err = ValidateDockerfile(dockerfilePath, "test-service")
+			err = ValidateDockerfile(t.Context(), dockerfilePath, "test-service")
 
 			if tt.expectError {
 				if err == nil {
@@ -120,7 +120,7 @@ func TestValidateDockerfileNotFound(t *testing.T) {
 	tmpDir := t.TempDir()
 	nonExistentPath := filepath.Join(tmpDir, "does-not-exist.dockerfile")
 
-	err := ValidateDockerfile(nonExistentPath, "test-service")
+	err := ValidateDockerfile(t.Context(), nonExistentPath, "test-service")
 	if err == nil {
 		t.Error("Expected error for non-existent Dockerfile")
 	}
@@ -145,6 +145,12 @@ func TestValidateServiceDockerfiles(t *testing.T) {
 		t.Fatalf("Failed to create invalid Dockerfile: %v", err)
 	}
 
+	multiStageDockerfile := filepath.Join(tmpDir, "Dockerfile.multistage")
+	err = os.WriteFile(multiStageDockerfile, []byte("FROM --platform=linux/amd64 golang AS Build\nFROM alpine AS final\nCOPY --from=build /app /app"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to create multi-stage Dockerfile: %v", err)
+	}
+
 	tests := []struct {
 		name          string
 		project       *Project
@@ -232,6 +238,39 @@ func TestValidateServiceDockerfiles(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "Build target is a stage",
+			project: &Project{
+				Services: Services{
+					"app": {
+						Name: "app",
+						Build: &BuildConfig{
+							Context:    tmpDir,
+							Dockerfile: "Dockerfile.multistage",
+							Target:     "build", // case-insensitive
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "Build target is not a stage",
+			project: &Project{
+				Services: Services{
+					"app": {
+						Name: "app",
+						Build: &BuildConfig{
+							Context:    tmpDir,
+							Dockerfile: "Dockerfile.multistage",
+							Target:     "test",
+						},
+					},
+				},
+			},
+			expectError:   true,
+			errorContains: `build target "test" is not a stage in the Dockerfile; expected one of [Build final]`,
+		},
 		{
 			name: "Service with non-existent Dockerfile (skipped)",
 			project: &Project{
@@ -251,7 +290,7 @@ func TestValidateServiceDockerfiles(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidateServiceDockerfiles(tt.project)
+			err := ValidateServiceDockerfiles(t.Context(), tt.project)
 
 			if tt.expectError {
 				if err == nil {
@@ -0,0 +1,81 @@
+package compose
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/moby/patternmatcher"
+)
+
+// Executables is the "x-defang-executable" extension of the build section.
+type Executables struct {
+	Patterns []string // .dockerignore-style patterns of files that must be executable
+	Detect   bool     // also detect scripts by their extension or shebang, where the file system has no executable bit
+}
+
+// GetExecutables parses "x-defang-executable" in the build section, which is either a list of .dockerignore-style
+// patterns or true to detect scripts. Matching files are stored with mode 0755 in the build context, even when the
+// file system lost the executable bit.
+func GetExecutables(build *composeTypes.BuildConfig) (Executables, error) {
+	var executables Executables
+	if build == nil {
+		return executables, nil
+	}
+	switch executable := build.Extensions["x-defang-executable"].(type) {
+	case nil:
+		return executables, nil
+	case bool:
+		executables.Detect = executable
+		return executables, nil
+	case string:
+		executables.Patterns = []string{executable}
+	case []any:
+		for _, pattern := range executable {
+			s, ok := pattern.(string)
+			if !ok {
+				return Executables{}, fmt.Errorf("x-defang-executable must be a list of file patterns, got %v", pattern)
+			}
+			executables.Patterns = append(executables.Patterns, s)
+		}
+	default:
+		return Executables{}, errors.New("x-defang-executable must be a list of file patterns or true")
+	}
+	if _, err := patternmatcher.New(executables.Patterns); err != nil {
+		return Executables{}, fmt.Errorf("invalid x-defang-executable pattern: %w", err)
+	}
+	return executables, nil
+}
+
+// looksExecutable returns true for shell scripts and files that start with a shebang, which would be
+// executable on Linux but have no executable bit on Windows. It opens the file, so only use it when the
+// file system has no executable bit and the user opted in.
+func looksExecutable(filePath string) bool {
+	if filepath.Ext(filePath) == ".sh" {
+		return true
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+	var magic [2]byte
+	if _, err := io.ReadFull(file, magic[:]); err != nil {
+		return false
+	}
+	return bytes.Equal(magic[:], []byte("#!"))
+}
+
+// executableFileInfo overrides the mode of a file that should be executable in the archive.
+type executableFileInfo struct {
+	fs.FileInfo
+}
+
+func (fi executableFileInfo) Mode() fs.FileMode {
+	return fi.FileInfo.Mode() | 0111
+}
@@ -0,0 +1,112 @@
+package compose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestGetExecutablePatterns(t *testing.T) {
+	tests := []struct {
+		name      string
+		extension any
+		want      []string
+		wantErr   bool
+	}{
+		{name: "none", extension: nil},
+		{name: "string", extension: "scripts/*", want: []string{"scripts/*"}},
+		{name: "list", extension: []any{"entrypoint", "bin/**"}, want: []string{"entrypoint", "bin/**"}},
+		{name: "not a string", extension: []any{42}, wantErr: true},
+		{name: "detect", extension: true},
+		{name: "number", extension: 42, wantErr: true},
+		{name: "invalid pattern", extension: []any{"[a-"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			build := &composeTypes.BuildConfig{Extensions: composeTypes.Extensions{}}
+			if tt.extension != nil {
+				build.Extensions["x-defang-executable"] = tt.extension
+			}
+			executables, err := GetExecutables(build)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetExecutables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got := executables.Patterns
+			if len(got) != len(tt.want) {
+				t.Fatalf("GetExecutables() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("GetExecutables() = %v, want %v", got, tt.want)
+				}
+			}
+			if want := tt.extension == true; executables.Detect != want {
+				t.Errorf("GetExecutables().Detect = %v, want %v", executables.Detect, want)
+			}
+		})
+	}
+}
+
+func TestCreateArchiveExecutable(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".dockerignore": "",
+		"Dockerfile":    "FROM scratch",
+		"run.sh":        "echo hello",
+		"shebang":       "#!/usr/bin/env python3",
+		"entrypoint":    "exec $@",
+		"readme.txt":    "#not a shebang",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "build"), []byte("make"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	buffer, err := createArchive(t.Context(), root, "", ArchiveTypeGzip, writeIgnoreFileNo, Executables{Patterns: []string{"entrypoint"}, Detect: true})
+	if err != nil {
+		t.Fatalf("createArchive() failed: %v", err)
+	}
+	g, err := gzip.NewReader(buffer)
+	if err != nil {
+		t.Fatalf("gzip.NewReader() failed: %v", err)
+	}
+	t.Cleanup(func() { g.Close() })
+
+	expected := map[string]int64{
+		".dockerignore": 0644,
+		"Dockerfile":    0644,
+		"run.sh":        0644,
+		"shebang":       0644,
+		"entrypoint":    0755,
+		"readme.txt":    0644,
+		"build":         0755,
+	}
+	if !hasExecutableBit {
+		// The scripts are only detected where the file system has no executable bit
+		expected["run.sh"] = 0755
+		expected["shebang"] = 0755
+	}
+	ar := tar.NewReader(g)
+	for {
+		h, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := expected[h.Name]; h.Mode != want {
+			t.Errorf("expected mode %o for %q, got %o", expected[h.Name], h.Name, h.Mode)
+		}
+	}
+}
@@ -4,14 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
 )
@@ -32,32 +33,34 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 	// Preload the current config so we can detect which environment variables should be passed as "secrets"
 	config, err := provider.ListConfig(ctx, &defangv1.ListConfigsRequest{Project: project.Name})
 	if err != nil {
-		term.Debugf("failed to load config: %v", err)
+		termFromContext(ctx).Debugf("failed to load config: %v", err)
 		config = &defangv1.Secrets{}
 	}
 	slices.Sort(config.Names) // sort for binary search
 
+	warnNonExternalSecrets(ctx, project)
+
 	// Fixup any pseudo services (this might create port configs, which will affect service name replacement by ReplaceServiceNameWithDNS)
 	for _, svccfg := range project.Services {
 		repo := GetImageRepo(svccfg.Image)
 
 		_, managedRedis := svccfg.Extensions["x-defang-redis"]
 		if managedRedis || IsRedisRepo(repo) {
-			if err := fixupRedisService(&svccfg, provider, upload); err != nil {
+			if err := fixupRedisService(ctx, &svccfg, provider, upload); err != nil {
 				return fmt.Errorf("service %q: %w", svccfg.Name, err)
 			}
 		}
 
 		_, managedPostgres := svccfg.Extensions["x-defang-postgres"]
 		if managedPostgres || IsPostgresRepo(repo) {
-			if err := fixupPostgresService(&svccfg, provider, upload); err != nil {
+			if err := fixupPostgresService(ctx, &svccfg, provider, upload); err != nil {
 				return fmt.Errorf("service %q: %w", svccfg.Name, err)
 			}
 		}
 
 		_, managedMongo := svccfg.Extensions["x-defang-mongodb"]
 		if managedMongo || IsMongoRepo(repo) {
-			if err := fixupMongoService(&svccfg, provider, upload); err != nil {
+			if err := fixupMongoService(ctx, &svccfg, provider, upload); err != nil {
 				return fmt.Errorf("service %q: %w", svccfg.Name, err)
 			}
 		}
@@ -67,17 +70,38 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 		}
 
 		if _, llm := svccfg.Extensions["x-defang-llm"]; llm {
-			fixupLLM(&svccfg)
+			fixupLLM(ctx, &svccfg)
+		}
+
+		if GetBucket(&svccfg) != nil {
+			fixupBucketService(ctx, &svccfg, project)
+		}
+
+		// Services on internal networks only are private and must not be exposed through the public load balancer
+		if IsInternalOnly(&svccfg, project) {
+			for _, port := range svccfg.Ports {
+				if port.Mode != Mode_HOST && port.Mode != Mode_PRIVATE {
+					warnf(ctx, CodeInternalPort, "service %q: port %d is only on internal networks; using 'private' mode instead of 'ingress'", svccfg.Name, port.Target)
+				}
+			}
+			fixupIngressPorts(ctx, &svccfg)
 		}
 
 		// Fixup ports, which affects service name replacement by ReplaceServiceNameWithDNS below
 		for i, port := range svccfg.Ports {
-			svccfg.Ports[i] = fixupPort(port)
+			svccfg.Ports[i] = fixupPort(ctx, port)
+		}
+
+		if svccfg.Build != nil && upload == UploadModeNoBuild {
+			if svccfg.Image == "" {
+				return errorf(CodeImageRequiredNoBuild, "service %q: an image is required when building is disabled with --no-build", svccfg.Name)
+			}
+			svccfg.Build = nil
 		}
 
 		// Ignore "build" config if we have "image", unless in --build or --force mode
 		if svccfg.Image != "" && svccfg.Build != nil && upload != UploadModeDigest && upload != UploadModeForce {
-			term.Warnf("service %q: using published image instead of rebuilding; pass --build to build and publish a new image", svccfg.Name)
+			warnf(ctx, CodeImageNotRebuilt, "service %q: using published image instead of rebuilding; pass --build to build and publish a new image", svccfg.Name)
 			svccfg.Build = nil
 		}
 
@@ -94,14 +118,22 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 	svcNameReplacer := NewServiceNameReplacer(ctx, provider, project)
 
 	for _, svccfg := range project.Services {
-		// Upload the build context, if any; TODO: parallelize
+		// Build and upload the static files of a container-less static site
+		if _, static := svccfg.Extensions["x-defang-static-files"]; static && svccfg.Image == "" && svccfg.Build == nil {
+			if err := fixupStaticSite(ctx, provider, project, &svccfg, upload); err != nil {
+				return fmt.Errorf("service %q: %w", svccfg.Name, err)
+			}
+		}
+
+		// Fixup the build config, if any; the build contexts are uploaded concurrently below
 		if svccfg.Build != nil {
 			// Because of normalization, Dockerfile is always set to "Dockerfile" even if it was not specified in the compose file.
-			if svccfg.Build.Dockerfile != "" {
+			// A remote context was already uploaded, like in a deployed Compose file, so its Dockerfile was checked then.
+			if svccfg.Build.Dockerfile != "" && !strings.Contains(svccfg.Build.Context, "://") {
 				// Check if the dockerfile exists
 				dockerfilePath := filepath.Join(svccfg.Build.Context, svccfg.Build.Dockerfile)
 				if _, err := os.Stat(dockerfilePath); err != nil {
-					term.Debugf("stat %q: %v", dockerfilePath, err)
+					termFromContext(ctx).Debugf("stat %q: %v", dockerfilePath, err)
 					// In this case we know that the dockerfile is not in the location the compose file specifies,
 					// so can assume that the dockerfile has been normalized to the default "Dockerfile".
 					if svccfg.Build.Dockerfile != "Dockerfile" {
@@ -127,15 +159,6 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 				}
 			}
 
-			if !strings.Contains(svccfg.Build.Context, "://") {
-				// Pack the build context into a Archive and upload
-				url, err := getRemoteBuildContext(ctx, provider, project.Name, svccfg.Name, svccfg.Build, upload)
-				if err != nil {
-					return err
-				}
-				svccfg.Build.Context = url
-			}
-
 			var removedArgs []string
 			for key, value := range svccfg.Build.Args {
 				if key == "" || value == nil {
@@ -144,21 +167,38 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 					continue
 				}
 
-				val := svcNameReplacer.ReplaceServiceNameWithDNS(svccfg.Name, key, *value, BuildArgs)
+				val := svcNameReplacer.ReplaceServiceNameWithDNS(ctx, svccfg.Name, key, *value, BuildArgs)
 				svccfg.Build.Args[key] = &val
 			}
 
 			if len(removedArgs) > 0 {
-				term.Warnf("service %q: skipping unset build argument %q", svccfg.Name, removedArgs)
+				warnf(ctx, CodeUnsetVariable, "service %q: skipping unset build argument %q", svccfg.Name, removedArgs)
 			}
 		}
 
+		fixupCapabilities(&svccfg)
+		fixupPidsLimit(&svccfg)
+
 		// Fixup secret references; secrets are supposed to be files, not env, but it's kept for backward compatibility
 		for i, secret := range svccfg.Secrets {
 			if i == 0 { // only warn once
-				term.Warnf("service %q: secrets will be exposed as environment variables, not files (use 'environment' instead)", svccfg.Name)
+				warnf(ctx, CodeSecretsAsEnvironment, "service %q: secrets will be exposed as environment variables, not files (use 'environment' instead)", svccfg.Name)
+			}
+			if s, ok := project.Secrets[secret.Source]; ok {
+				if err := validateSecret(secret.Source, s); err != nil {
+					return fmt.Errorf("service %q: %w", svccfg.Name, err)
+				}
+			}
+			name, ok := secretEnvName(secret)
+			if !ok {
+				warnf(ctx, CodeSecretsAsEnvironment, "service %q: secret %q cannot be mounted at %q; exposing it as %q instead", svccfg.Name, secret.Source, secret.Target, name)
+			}
+			if name == secret.Source {
+				svccfg.Environment[name] = nil // resolved from config with the same name
+			} else {
+				ref := "${" + secret.Source + "}" // interpolated from config during deployment
+				svccfg.Environment[name] = &ref
 			}
-			svccfg.Environment[secret.Source] = nil
 		}
 		svccfg.Secrets = nil
 
@@ -170,7 +210,7 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 			// A bug in Compose-go env file parsing can cause empty keys
 			if key == "" {
 				if !shownOnce {
-					term.Warnf("service %q: skipping unset environment variable key", svccfg.Name)
+					warnf(ctx, CodeUnsetVariable, "service %q: skipping unset environment variable key", svccfg.Name)
 					shownOnce = true
 				}
 				delete(svccfg.Environment, key) // remove the empty key; this is safe
@@ -192,23 +232,23 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 			}
 
 			if upload != UploadModeEstimate {
-				val := svcNameReplacer.ReplaceServiceNameWithDNS(svccfg.Name, key, *value, EnvironmentVars)
+				val := svcNameReplacer.ReplaceServiceNameWithDNS(ctx, svccfg.Name, key, *value, EnvironmentVars)
 				svccfg.Environment[key] = &val
 			}
 		}
 
 		if len(notAdjusted) > 0 {
-			term.Warnf("service %q: environment variable(s) %q will use the `defang config` value instead of adjusted service name", svccfg.Name, notAdjusted)
+			warnf(ctx, CodeConfigOverride, "service %q: environment variable(s) %q will use the `defang config` value instead of adjusted service name", svccfg.Name, notAdjusted)
 		}
 
 		if len(overridden) > 0 {
-			term.Warnf("service %q: environment variable(s) %q overridden by config", svccfg.Name, overridden)
+			warnf(ctx, CodeConfigOverride, "service %q: environment variable(s) %q overridden by config", svccfg.Name, overridden)
 		}
 
 		_, scaling := svccfg.Extensions["x-defang-autoscaling"]
 		if scaling {
-			if _, ok := provider.(*client.PlaygroundProvider); ok {
-				term.Warnf("service %q: auto-scaling is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
+			if client.IsPlayground(provider) {
+				warnf(ctx, CodePlaygroundUnsupported, "service %q: auto-scaling is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
 			}
 		}
 
@@ -216,7 +256,8 @@ func FixupServices(ctx context.Context, provider client.Provider, project *compo
 		project.Services[svccfg.Name] = svccfg
 	}
 
-	return nil
+	// Pack the build contexts into archives and upload them
+	return uploadBuildContexts(ctx, provider, project, upload)
 }
 
 func parsePortString(port string) (uint32, error) {
@@ -227,20 +268,20 @@ func parsePortString(port string) (uint32, error) {
 	}
 }
 
-func fixupLLM(svccfg *composeTypes.ServiceConfig) {
+func fixupLLM(ctx context.Context, svccfg *composeTypes.ServiceConfig) {
 	image := GetImageRepo(svccfg.Image)
 	if strings.HasSuffix(image, "/openai-access-gateway") && len(svccfg.Ports) == 0 {
 		// HACK: we must have at least one host port to get a CNAME for the service
 		var port uint32 = 80
-		term.Debugf("service %q: adding LLM host port %d", svccfg.Name, port)
+		termFromContext(ctx).Debugf("service %q: adding LLM host port %d", svccfg.Name, port)
 		svccfg.Ports = []composeTypes.ServicePortConfig{{Target: port, Mode: Mode_HOST, Protocol: Protocol_TCP}}
 	}
 }
 
-func fixupPostgresService(svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
+func fixupPostgresService(ctx context.Context, svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
 	_, managedPostgres := svccfg.Extensions["x-defang-postgres"]
-	if _, ok := provider.(*client.PlaygroundProvider); ok && managedPostgres && upload != UploadModeEstimate {
-		term.Warnf("service %q: managed postgres is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
+	if client.IsPlayground(provider) && managedPostgres && upload != UploadModeEstimate {
+		warnf(ctx, CodePlaygroundUnsupported, "service %q: managed postgres is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
 	}
 	if len(svccfg.Ports) == 0 {
 		// HACK: we must have at least one host port to get a CNAME for the service
@@ -253,18 +294,18 @@ func fixupPostgresService(svccfg *composeTypes.ServiceConfig, provider client.Pr
 				return err
 			}
 		}
-		term.Debugf("service %q: adding postgres host port %d", svccfg.Name, port)
+		termFromContext(ctx).Debugf("service %q: adding postgres host port %d", svccfg.Name, port)
 		svccfg.Ports = []composeTypes.ServicePortConfig{{Target: port, Mode: Mode_HOST, Protocol: Protocol_TCP}}
 	} else {
-		fixupIngressPorts(svccfg)
+		fixupIngressPorts(ctx, svccfg)
 	}
 	return nil
 }
 
-func fixupMongoService(svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
+func fixupMongoService(ctx context.Context, svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
 	_, managedMongo := svccfg.Extensions["x-defang-mongodb"]
-	if _, ok := provider.(*client.PlaygroundProvider); ok && managedMongo && upload != UploadModeEstimate {
-		term.Warnf("service %q: managed mongodb is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
+	if client.IsPlayground(provider) && managedMongo && upload != UploadModeEstimate {
+		warnf(ctx, CodePlaygroundUnsupported, "service %q: managed mongodb is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
 	}
 	if len(svccfg.Ports) == 0 {
 		// HACK: we must have at least one host port to get a CNAME for the service
@@ -291,18 +332,18 @@ func fixupMongoService(svccfg *composeTypes.ServiceConfig, provider client.Provi
 			}
 			break // done
 		}
-		term.Debugf("service %q: adding mongodb host port %d", svccfg.Name, port)
+		termFromContext(ctx).Debugf("service %q: adding mongodb host port %d", svccfg.Name, port)
 		svccfg.Ports = []composeTypes.ServicePortConfig{{Target: port, Mode: Mode_HOST, Protocol: Protocol_TCP}}
 	} else {
-		fixupIngressPorts(svccfg)
+		fixupIngressPorts(ctx, svccfg)
 	}
 	return nil
 }
 
-func fixupRedisService(svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
+func fixupRedisService(ctx context.Context, svccfg *composeTypes.ServiceConfig, provider client.Provider, upload UploadMode) error {
 	_, managedRedis := svccfg.Extensions["x-defang-redis"]
-	if _, ok := provider.(*client.PlaygroundProvider); ok && managedRedis && upload != UploadModeEstimate {
-		term.Warnf("service %q: Managed redis is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
+	if client.IsPlayground(provider) && managedRedis && upload != UploadModeEstimate {
+		warnf(ctx, CodePlaygroundUnsupported, "service %q: Managed redis is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
 	}
 	if len(svccfg.Ports) == 0 {
 		// HACK: we must have at least one host port to get a CNAME for the service https://redis.io/docs/latest/operate/oss_and_stack/management/config/
@@ -319,18 +360,148 @@ func fixupRedisService(svccfg *composeTypes.ServiceConfig, provider client.Provi
 				// continue; last one wins
 			}
 		}
-		term.Debugf("service %q: adding redis host port %d", svccfg.Name, port)
+		termFromContext(ctx).Debugf("service %q: adding redis host port %d", svccfg.Name, port)
 		svccfg.Ports = []composeTypes.ServicePortConfig{{Target: port, Mode: Mode_HOST, Protocol: Protocol_TCP}}
 	} else {
-		fixupIngressPorts(svccfg)
+		fixupIngressPorts(ctx, svccfg)
+	}
+	return nil
+}
+
+// fixupStaticSite runs the optional build command of a static site and uploads the output folder,
+// so the CD can serve the files from the CDN without building a container image.
+func fixupStaticSite(ctx context.Context, provider client.Provider, project *composeTypes.Project, svccfg *composeTypes.ServiceConfig, upload UploadMode) error {
+	staticFiles, ok := svccfg.Extensions["x-defang-static-files"].(map[string]any)
+	if !ok {
+		staticFiles = map[string]any{"folder": svccfg.Extensions["x-defang-static-files"]}
+	}
+	folder, _ := staticFiles["folder"].(string)
+	if folder == "" || strings.Contains(folder, "://") {
+		return nil // nothing to upload
+	}
+	if !filepath.IsAbs(folder) {
+		folder = filepath.Join(project.WorkingDir, folder)
+	}
+
+	if build, _ := staticFiles["build"].(string); build != "" && runsBuildCommand(upload) {
+		termFromContext(ctx).Info("Building the static files for", svccfg.Name)
+		if err := runBuildCommand(ctx, project.WorkingDir, build); err != nil {
+			return fmt.Errorf("static files build command failed: %w", err)
+		}
+	} else if build != "" && upload != UploadModeNoBuild {
+		// Nothing is deployed, but the output folder may not exist until the build command has run
+		if _, err := os.Stat(folder); os.IsNotExist(err) {
+			termFromContext(ctx).Debugf("service %q: skipped the static files build command; %q does not exist yet", svccfg.Name, folder)
+			staticFiles["folder"] = folder
+			svccfg.Extensions["x-defang-static-files"] = staticFiles
+			return nil
+		}
+	}
+
+	url, err := getRemoteArchive(ctx, provider, project.Name, svccfg.Name, folder, "", ArchiveTypeGzip, writeIgnoreFileNo, upload, Executables{})
+	if err != nil {
+		return err
 	}
+	staticFiles["folder"] = url
+	svccfg.Extensions["x-defang-static-files"] = staticFiles
 	return nil
 }
 
-func fixupIngressPorts(svccfg *composeTypes.ServiceConfig) {
+// runsBuildCommand returns true if the upload mode has side effects, so the user's build command may run;
+// dry-run, preview (incl. --offline) and estimate must not touch the network.
+func runsBuildCommand(upload UploadMode) bool {
+	switch upload {
+	case UploadModeIgnore, UploadModePreview, UploadModeEstimate, UploadModeNoBuild:
+		return false
+	default:
+		return true
+	}
+}
+
+func runBuildCommand(ctx context.Context, dir, command string) error {
+	termFromContext(ctx).Debug("Running build command `", command, "` in dir ", dir)
+	shell := []string{"sh", "-c"}
+	if runtime.GOOS == "windows" {
+		shell = []string{"cmd", "/C"}
+	}
+	// #nosec G204 - the command comes from the user's own compose file
+	cmd := exec.CommandContext(ctx, shell[0], shell[1], command)
+	cmd.Dir = dir
+	// The build output goes to stderr, so it doesn't mix with machine-readable output on stdout
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Environment variables that are injected into services which depend on a managed bucket
+var bucketEnvVarSuffixes = []string{"_BUCKET_NAME", "_BUCKET_ENDPOINT", "_BUCKET_ACCESS_KEY_ID", "_BUCKET_SECRET_ACCESS_KEY"}
+
+func fixupBucketService(ctx context.Context, svccfg *composeTypes.ServiceConfig, project *composeTypes.Project) {
+	if svccfg.Image != "" {
+		termFromContext(ctx).Debugf("service %q: ignoring image for managed bucket", svccfg.Name)
+		svccfg.Image = ""
+	}
+
+	// Services that depend on the bucket get its name, endpoint, and credentials as environment variables.
+	// The actual values are only known after the bucket has been provisioned, so these are config references
+	// that CD resolves; credentials will be empty when the task role grants access to the bucket.
+	for _, dependency := range project.Services {
+		if _, ok := dependency.DependsOn[svccfg.Name]; !ok {
+			continue
+		}
+		if dependency.Environment == nil {
+			dependency.Environment = make(composeTypes.MappingWithEquals)
+		}
+		for _, key := range bucketEnvVarNames(svccfg.Name) {
+			if _, ok := dependency.Environment[key]; !ok {
+				val := "${DEFANG_" + key + "}"
+				dependency.Environment[key] = &val
+			}
+		}
+		project.Services[dependency.Name] = dependency
+	}
+}
+
+// bucketEnvVarNames returns the names of the environment variables that are injected for the bucket service.
+func bucketEnvVarNames(bucket string) []string {
+	envName := ToEnvVarName(bucket)
+	names := make([]string, len(bucketEnvVarSuffixes))
+	for i, suffix := range bucketEnvVarSuffixes {
+		names[i] = envName + suffix
+	}
+	return names
+}
+
+// ToEnvVarName converts a service name into a prefix that is valid in environment variable names
+func ToEnvVarName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// IsInternalOnly returns true if the service is only attached to networks declared with `internal: true`.
+func IsInternalOnly(svccfg *composeTypes.ServiceConfig, project *composeTypes.Project) bool {
+	if len(svccfg.Networks) == 0 {
+		return false // default network
+	}
+	for name := range svccfg.Networks {
+		if network, ok := project.Networks[name]; !ok || !network.Internal {
+			return false
+		}
+	}
+	return true
+}
+
+func fixupIngressPorts(ctx context.Context, svccfg *composeTypes.ServiceConfig) {
 	for i, port := range svccfg.Ports {
 		if port.Mode == Mode_INGRESS || port.Mode == "" {
-			term.Debugf("service %q: changing port %d to host mode", svccfg.Name, port.Target)
+			termFromContext(ctx).Debugf("service %q: changing port %d to host mode", svccfg.Name, port.Target)
 			svccfg.Ports[i].Mode = Mode_HOST
 		}
 	}
@@ -437,29 +608,31 @@ func GetImageRepo(image string) string {
 	return strings.ToLower(repo)
 }
 
-func fixupPort(port composeTypes.ServicePortConfig) composeTypes.ServicePortConfig {
+func fixupPort(ctx context.Context, port composeTypes.ServicePortConfig) composeTypes.ServicePortConfig {
 	switch port.Mode {
 	case "":
-		term.Warnf("port %d: no 'mode' was specified; defaulting to 'ingress' (add 'mode: ingress' to silence)", port.Target)
+		warnf(ctx, CodeDefaultPortMode, "port %d: no 'mode' was specified; defaulting to 'ingress' (add 'mode: ingress' to silence)", port.Target)
 		fallthrough
 	case Mode_INGRESS:
 		// This code is unnecessarily complex because compose-go silently converts short `ports:` syntax to ingress+tcp
 		if port.Protocol == Protocol_UDP {
-			term.Warnf("port %d: UDP ports default to 'host' mode (add 'mode: host' to silence)", port.Target)
+			warnf(ctx, CodeDefaultPortMode, "port %d: UDP ports default to 'host' mode (add 'mode: host' to silence)", port.Target)
 			port.Mode = Mode_HOST
 		} else {
 			if port.Published != "" {
-				term.Debugf("port %d: ignoring 'published: %s' in 'ingress' mode", port.Target, port.Published)
+				termFromContext(ctx).Debugf("port %d: ignoring 'published: %s' in 'ingress' mode", port.Target, port.Published)
 			}
 			if port.AppProtocol == "" {
 				// TCP ingress is not supported; assuming HTTP (add 'app_protocol: http' to silence)"
 				port.AppProtocol = "http"
 			}
 		}
+	case Mode_PRIVATE:
+		port.Mode = Mode_HOST
 	case Mode_HOST:
 		// no-op
 	default:
-		panic(fmt.Sprintf("port %d: 'mode' should have been validated to be one of [host ingress] but got: %v", port.Target, port.Mode))
+		panic(fmt.Sprintf("port %d: 'mode' should have been validated to be one of [host ingress private] but got: %v", port.Target, port.Mode))
 	}
 	return port
 }
@@ -2,6 +2,10 @@ package compose
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -9,6 +13,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFixup(t *testing.T) {
@@ -43,3 +48,113 @@ func TestFixup(t *testing.T) {
 		}
 	})
 }
+
+func TestFixupStaticSite(t *testing.T) {
+	dir := t.TempDir()
+	project := &composeTypes.Project{
+		Name:       "static",
+		WorkingDir: dir,
+		Services: composeTypes.Services{
+			"web": {
+				Name: "web",
+				Extensions: map[string]any{
+					"x-defang-static-files": map[string]any{"folder": "dist", "build": "mkdir dist", "spa": true},
+				},
+			},
+		},
+	}
+
+	svccfg := project.Services["web"]
+	if err := fixupStaticSite(t.Context(), client.MockProvider{}, project, &svccfg, UploadModeIgnore); err != nil {
+		t.Fatal(err)
+	}
+
+	staticFiles := svccfg.Extensions["x-defang-static-files"].(map[string]any)
+	assert.Equal(t, filepath.Join(dir, "dist"), staticFiles["folder"])
+	assert.Equal(t, true, staticFiles["spa"])
+	assert.NoDirExists(t, filepath.Join(dir, "dist"), "build command should not run in dry-run mode")
+}
+
+func TestFixupStaticSiteNoBuild(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK) // return 200 OK same as S3
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "dist"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dist", "index.html"), []byte("<html></html>"), 0644))
+	project := &composeTypes.Project{
+		Name:       "static",
+		WorkingDir: dir,
+		Services: composeTypes.Services{
+			"web": {
+				Name: "web",
+				Extensions: map[string]any{
+					"x-defang-static-files": map[string]any{"folder": "dist", "build": "mkdir built"},
+				},
+			},
+		},
+	}
+
+	svccfg := project.Services["web"]
+	if err := fixupStaticSite(t.Context(), client.MockProvider{UploadUrl: server.URL + "/"}, project, &svccfg, UploadModeNoBuild); err != nil {
+		t.Fatal(err)
+	}
+
+	staticFiles := svccfg.Extensions["x-defang-static-files"].(map[string]any)
+	assert.Contains(t, staticFiles["folder"], server.URL, "static files should still be uploaded with --no-build")
+	assert.NoDirExists(t, filepath.Join(dir, "built"), "build command should not run with --no-build")
+}
+
+func TestIsInternalOnly(t *testing.T) {
+	project := &composeTypes.Project{
+		Networks: composeTypes.Networks{
+			"backend":  {Internal: true},
+			"frontend": {},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		networks map[string]*composeTypes.ServiceNetworkConfig
+		want     bool
+	}{
+		{"default network", nil, false},
+		{"internal network", map[string]*composeTypes.ServiceNetworkConfig{"backend": nil}, true},
+		{"public network", map[string]*composeTypes.ServiceNetworkConfig{"frontend": nil}, false},
+		{"mixed networks", map[string]*composeTypes.ServiceNetworkConfig{"backend": nil, "frontend": nil}, false},
+		{"undeclared network", map[string]*composeTypes.ServiceNetworkConfig{"other": nil}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svccfg := &composeTypes.ServiceConfig{Name: "svc", Networks: tt.networks}
+			assert.Equal(t, tt.want, IsInternalOnly(svccfg, project))
+		})
+	}
+}
+
+func TestFixupNoBuild(t *testing.T) {
+	newProject := func() *composeTypes.Project {
+		return &composeTypes.Project{
+			Name: "app",
+			Services: composeTypes.Services{
+				"web": {Name: "web", Image: "example/web:1.2", Build: &composeTypes.BuildConfig{Context: "."}},
+			},
+		}
+	}
+
+	t.Run("image and build", func(t *testing.T) {
+		project := newProject()
+		assert.NoError(t, FixupServices(t.Context(), &client.MockProvider{}, project, UploadModeNoBuild))
+		assert.Nil(t, project.Services["web"].Build)
+		assert.Equal(t, "example/web:1.2", project.Services["web"].Image)
+	})
+
+	t.Run("build only", func(t *testing.T) {
+		project := newProject()
+		project.Services["worker"] = composeTypes.ServiceConfig{Name: "worker", Build: &composeTypes.BuildConfig{Context: "."}}
+		err := FixupServices(t.Context(), &client.MockProvider{}, project, UploadModeNoBuild)
+		assert.ErrorContains(t, err, `service "worker": an image is required`)
+	})
+}
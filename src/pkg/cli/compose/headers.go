@@ -0,0 +1,77 @@
+package compose
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// Headers that are managed by the load balancer and cannot be rewritten
+var protectedHeaders = []string{"Connection", "Content-Length", "Host", "Keep-Alive", "Te", "Trailer", "Transfer-Encoding", "Upgrade"}
+
+func parseHeaderRules(key string, rules any) (*defangv1.HeaderRules, error) {
+	options, ok := rules.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf(`x-defang-ingress: '%s' must be an object {"set": {string: string}, "remove": string[]}`, key)
+	}
+
+	result := &defangv1.HeaderRules{}
+	for _, op := range slices.Sorted(maps.Keys(options)) {
+		switch op {
+		case "set":
+			headers, ok := options[op].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("x-defang-ingress: '%s.set' must be a map of header names to values", key)
+			}
+			result.Set = make(map[string]string, len(headers))
+			for _, name := range slices.Sorted(maps.Keys(headers)) {
+				value := headers[name]
+				canonical, err := validateHeaderName(key, name)
+				if err != nil {
+					return nil, err
+				}
+				str, ok := value.(string)
+				if !ok || strings.ContainsAny(str, "\r\n") {
+					return nil, fmt.Errorf("x-defang-ingress: '%s.set' value for %q must be a single-line string", key, name)
+				}
+				result.Set[canonical] = str
+			}
+		case "remove":
+			names, err := toStringList(key+".remove", options[op])
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range names {
+				canonical, err := validateHeaderName(key, name)
+				if err != nil {
+					return nil, err
+				}
+				result.Remove = append(result.Remove, canonical)
+			}
+		default:
+			return nil, fmt.Errorf("x-defang-ingress: unsupported option '%s.%s'", key, op)
+		}
+	}
+
+	for _, name := range result.Remove {
+		if _, ok := result.Set[name]; ok {
+			return nil, fmt.Errorf("x-defang-ingress: header %q is both set and removed in '%s'", name, key)
+		}
+	}
+	return result, nil
+}
+
+func validateHeaderName(key, name string) (string, error) {
+	if !tokenRegex.MatchString(name) {
+		return "", fmt.Errorf("x-defang-ingress: invalid header name %q in '%s'", name, key)
+	}
+	canonical := http.CanonicalHeaderKey(name)
+	if slices.Contains(protectedHeaders, canonical) {
+		return "", fmt.Errorf("x-defang-ingress: header %q in '%s' is managed by the load balancer and cannot be changed", name, key)
+	}
+	return canonical, nil
+}
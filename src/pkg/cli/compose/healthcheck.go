@@ -1,6 +1,7 @@
 package compose
 
 import (
+	"context"
 	"regexp"
 	"strconv"
 
@@ -10,6 +11,34 @@ import (
 // Based on cd/aws/defang_service.ts
 var healthcheckUrlRegex = regexp.MustCompile(`(?i)(?:http:\/\/)?(?:localhost|127\.0\.0\.1)(?::(\d{1,5}))?([?/](?:[?/a-z0-9._~!$&()*+,;=:@-]|%[a-f0-9]{2}){0,333})?`)
 
+var grpcProbeRegex = regexp.MustCompile(`(?i)\bgrpc[_-]health[_-](probe|check)\b|\bgrpcurl\b`)
+
+// IsGrpcHealthCheck returns true if the healthcheck uses a gRPC probe, like grpc_health_probe or grpcurl.
+func IsGrpcHealthCheck(hc *types.HealthCheckConfig) bool {
+	if hc == nil || len(hc.Test) < 1 || (hc.Test[0] != "CMD" && hc.Test[0] != "CMD-SHELL") {
+		return false
+	}
+	for _, arg := range hc.Test[1:] {
+		if grpcProbeRegex.MatchString(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHttpHealthCheck returns true if the healthcheck probes a local HTTP endpoint, like curl or wget.
+func isHttpHealthCheck(hc *types.HealthCheckConfig) bool {
+	if hc == nil || len(hc.Test) < 1 || (hc.Test[0] != "CMD" && hc.Test[0] != "CMD-SHELL") {
+		return false
+	}
+	for _, arg := range hc.Test[1:] {
+		if healthcheckUrlRegex.MatchString(arg) {
+			return true
+		}
+	}
+	return false
+}
+
 func GetHealthCheckPathAndPort(hc *types.HealthCheckConfig) (string, int) {
 	path := "/"
 	port := 80
@@ -30,3 +59,39 @@ func GetHealthCheckPathAndPort(hc *types.HealthCheckConfig) (string, int) {
 	}
 	return path, port
 }
+
+// hasHealthCheck returns true if the healthcheck is set and not disabled, with "disable: true" or a NONE test.
+func hasHealthCheck(hc *types.HealthCheckConfig) bool {
+	return hc != nil && !hc.Disable && (len(hc.Test) == 0 || hc.Test[0] != "NONE")
+}
+
+// validateHealthCheckTest checks the form of the healthcheck test, which is passed as-is to the platform: a CMD with
+// the command and its arguments, or a CMD-SHELL with a single command line, which requires a shell in the image.
+func validateHealthCheckTest(ctx context.Context, service string, hc *types.HealthCheckConfig) error {
+	if len(hc.Test) == 0 {
+		// The platform doesn't use the HEALTHCHECK of the image
+		warnf(ctx, CodeInvalidHealthcheck, "service %q: healthcheck without test; the HEALTHCHECK of the image is not used", service)
+		return nil
+	}
+	switch hc.Test[0] {
+	case "CMD":
+		if len(hc.Test) < 2 {
+			return errorf(CodeInvalidHealthcheck, "service %q: healthcheck test CMD requires a command, eg. [\"CMD\", \"curl\", \"-f\", \"http://localhost/\"]", service)
+		}
+	case "CMD-SHELL":
+		if len(hc.Test) < 2 || hc.Test[1] == "" {
+			return errorf(CodeInvalidHealthcheck, "service %q: healthcheck test CMD-SHELL requires a command line, eg. [\"CMD-SHELL\", \"curl -f http://localhost/ || exit 1\"]", service)
+		}
+		if len(hc.Test) > 2 {
+			warnf(ctx, CodeInvalidHealthcheck, "service %q: healthcheck test CMD-SHELL runs a single command line; the other %d argument(s) are passed to the shell as positional parameters", service, len(hc.Test)-2)
+		}
+	case "NONE":
+		termFromContext(ctx).Debugf("service %q: healthcheck test NONE disables the healthcheck", service)
+	default:
+		return errorf(CodeInvalidHealthcheck, "service %q: healthcheck test must start with \"CMD\", \"CMD-SHELL\", or \"NONE\", not %q", service, hc.Test[0])
+	}
+	if hc.Retries != nil && *hc.Retries == 0 {
+		termFromContext(ctx).Debugf("service %q: healthcheck retries 0; using the default of 3", service)
+	}
+	return nil
+}
@@ -73,3 +73,63 @@ func TestGetHealthCheckPathAndPort(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGrpcHealthCheck(t *testing.T) {
+	tests := []struct {
+		test []string
+		want bool
+	}{
+		{[]string{"CMD", "grpc_health_probe", "-addr=:9000"}, true},
+		{[]string{"CMD", "/bin/grpc-health-probe", "-addr=localhost:9000"}, true},
+		{[]string{"CMD-SHELL", "grpcurl -plaintext localhost:9000 grpc.health.v1.Health/Check"}, true},
+		{[]string{"CMD", "curl", "-f", "http://localhost:9000/"}, false},
+		{[]string{"NONE"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.test), func(t *testing.T) {
+			if got := IsGrpcHealthCheck(&types.HealthCheckConfig{Test: tt.test}); got != tt.want {
+				t.Errorf("IsGrpcHealthCheck(%v) = %v, want %v", tt.test, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateHealthCheckTest(t *testing.T) {
+	tests := []struct {
+		test    []string
+		wantErr bool
+	}{
+		{[]string{"CMD", "curl", "-f", "http://localhost/"}, false},
+		{[]string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"}, false},
+		{[]string{"CMD-SHELL", "echo hello", "ignored"}, false}, // warning
+		{[]string{"NONE"}, false},
+		{nil, false}, // warning
+		{[]string{"CMD"}, true},
+		{[]string{"CMD-SHELL", ""}, true},
+		{[]string{"curl", "-f", "http://localhost/"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.test), func(t *testing.T) {
+			err := validateHealthCheckTest(t.Context(), "svc", &types.HealthCheckConfig{Test: tt.test})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHasHealthCheck(t *testing.T) {
+	if hasHealthCheck(nil) {
+		t.Error("expected no healthcheck for nil")
+	}
+	if hasHealthCheck(&types.HealthCheckConfig{Disable: true}) {
+		t.Error("expected no healthcheck when disabled")
+	}
+	if hasHealthCheck(&types.HealthCheckConfig{Test: []string{"NONE"}}) {
+		t.Error("expected no healthcheck for a NONE test")
+	}
+	if !hasHealthCheck(&types.HealthCheckConfig{Test: []string{"CMD", "true"}}) {
+		t.Error("expected a healthcheck for a CMD test")
+	}
+}
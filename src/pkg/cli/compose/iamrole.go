@@ -0,0 +1,36 @@
+package compose
+
+import (
+	"errors"
+	"regexp"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+var (
+	awsRoleArnRegex        = regexp.MustCompile(`^arn:aws(-[a-z]+)*:iam::\d{12}:role/[\w+=,.@/-]{1,512}$`)
+	gcpServiceAccountRegex = regexp.MustCompile(`^[a-z0-9-]{1,63}@([a-z0-9-]+\.iam|developer|appspot)\.gserviceaccount\.com$`)
+)
+
+// IsAwsRoleArn returns true if the identifier looks like an AWS IAM role ARN, eg. arn:aws:iam::123456789012:role/my-role
+func IsAwsRoleArn(id string) bool {
+	return awsRoleArnRegex.MatchString(id)
+}
+
+// IsGcpServiceAccount returns true if the identifier looks like a GCP service account email, eg. my-sa@my-project.iam.gserviceaccount.com
+func IsGcpServiceAccount(id string) bool {
+	return gcpServiceAccountRegex.MatchString(id)
+}
+
+// GetIamRole returns the IAM role ARN or service account email from the x-defang-iam-role extension, if any.
+func GetIamRole(service *composeTypes.ServiceConfig) string {
+	role, _ := service.Extensions["x-defang-iam-role"].(string)
+	return role
+}
+
+func validateIamRole(role any) error {
+	if role, ok := role.(string); ok && (IsAwsRoleArn(role) || IsGcpServiceAccount(role)) {
+		return nil
+	}
+	return errors.New("x-defang-iam-role must be an AWS IAM role ARN or a GCP service account email")
+}
@@ -0,0 +1,31 @@
+package compose
+
+import "testing"
+
+func TestIamRoleFormats(t *testing.T) {
+	tests := []struct {
+		id  string
+		aws bool
+		gcp bool
+	}{
+		{"arn:aws:iam::123456789012:role/my-role", true, false},
+		{"arn:aws:iam::123456789012:role/path/to/my-role", true, false},
+		{"arn:aws-us-gov:iam::123456789012:role/my-role", true, false},
+		{"arn:aws:iam::123456789012:user/my-user", false, false},
+		{"arn:aws:iam::1234:role/my-role", false, false},
+		{"my-sa@my-project.iam.gserviceaccount.com", false, true},
+		{"123456789012-compute@developer.gserviceaccount.com", false, true},
+		{"my-sa@gmail.com", false, false},
+		{"my-role", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := IsAwsRoleArn(tt.id); got != tt.aws {
+				t.Errorf("IsAwsRoleArn(%q) = %v, want %v", tt.id, got, tt.aws)
+			}
+			if got := IsGcpServiceAccount(tt.id); got != tt.gcp {
+				t.Errorf("IsGcpServiceAccount(%q) = %v, want %v", tt.id, got, tt.gcp)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// IsIgnored returns true if the service is marked with "x-defang-ignore: true", for local-only helpers like mailhog.
+func IsIgnored(service *composeTypes.ServiceConfig) (bool, error) {
+	switch ignore := service.Extensions["x-defang-ignore"].(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return ignore, nil
+	case string:
+		if b, err := strconv.ParseBool(ignore); err == nil {
+			return b, nil
+		}
+	}
+	return false, errorf(CodeInvalidExtension, "service %q: x-defang-ignore must be a boolean", service.Name)
+}
+
+// dropIgnoredServices removes the services marked with x-defang-ignore from the project, along with any
+// depends_on and links that reference them, so they are never deployed.
+func dropIgnoredServices(ctx context.Context, project *composeTypes.Project, suppressWarn bool) error {
+	var ignored []string
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		svccfg := project.Services[name]
+		ignore, err := IsIgnored(&svccfg)
+		if err != nil {
+			return err
+		}
+		if ignore {
+			ignored = append(ignored, name)
+		}
+	}
+	if len(ignored) == 0 {
+		return nil
+	}
+	if len(ignored) == len(project.Services) {
+		return errors.New("all services are marked with x-defang-ignore; nothing to deploy")
+	}
+
+	t := termFromContext(ctx)
+	logf, logWarnf := t.Infof, func(code Code, format string, a ...any) { warnf(ctx, code, format, a...) }
+	if suppressWarn {
+		logf = t.Debugf
+		logWarnf = func(_ Code, format string, a ...any) { t.Debugf(format, a...) }
+	}
+	logf("Ignoring service(s) marked with x-defang-ignore: %s", strings.Join(ignored, ", "))
+	if dependents := DependentServices(project, ignored...); len(dependents) > 0 {
+		logWarnf(CodeInvalidDependency, "service(s) %s reference ignored service(s) and might not work when deployed", strings.Join(dependents, ", "))
+	}
+	return RemoveServices(project, ignored...)
+}
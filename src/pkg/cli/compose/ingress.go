@@ -0,0 +1,480 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg"
+	"github.com/DefangLabs/defang/src/pkg/dns"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+const (
+	ingressSyntax = `x-defang-ingress must be an object {"https_redirect": bool, "hsts": object, "paths": string[], "domains": string[], "sticky": object, "idle_timeout": duration, "request_timeout": duration, "grpc_web": bool, "http2": bool, "cors": object, "auth": object, "allow_ips": string[], "deny_ips": string[], "rate_limit": int | object, "request_headers": object, "response_headers": object}`
+	hstsSyntax    = `x-defang-ingress: 'hsts' must be a boolean or object {"max_age": int, "include_subdomains": bool}`
+	authSyntax    = `x-defang-ingress: 'auth' must be an object {"type": "basic" | "bearer", "config": string, "realm": string}`
+	rateSyntax    = `x-defang-ingress: 'rate_limit' must be an integer or object {"requests_per_second": int, "burst": int}`
+	stickySyntax  = `x-defang-ingress: 'sticky' must be a boolean or object {"cookie": string, "ttl": duration}`
+
+	DefaultHstsMaxAge = 365 * 24 * 60 * 60 // 1 year, in seconds
+	DefaultStickyTTL  = 24 * 60 * 60       // 1 day, in seconds
+	MaxStickyTTL      = 7 * 24 * 60 * 60   // 7 days, in seconds; the lowest maximum across providers
+	MaxIdleTimeout    = 4000               // seconds; the AWS ALB maximum
+	MaxRequestTimeout = 60 * 60            // 1 hour, in seconds; the Cloud Run maximum
+)
+
+// ParseIngress parses the x-defang-ingress extension into the ingress options of the service.
+func ParseIngress(ingress any) (*defangv1.Ingress, error) {
+	options, ok := ingress.(map[string]any)
+	if !ok {
+		return nil, errors.New(ingressSyntax)
+	}
+
+	result := &defangv1.Ingress{}
+	for _, key := range slices.Sorted(maps.Keys(options)) {
+		val := options[key]
+		switch key {
+		case "https_redirect":
+			redirect, ok := val.(bool)
+			if !ok {
+				return nil, errors.New("x-defang-ingress: 'https_redirect' must be a boolean")
+			}
+			result.DisableHttpsRedirect = !redirect
+		case "hsts":
+			hsts, err := parseHsts(val)
+			if err != nil {
+				return nil, err
+			}
+			result.Hsts = hsts
+		case "paths":
+			paths, err := toStringList(key, val)
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range paths {
+				if !ingressPathRegex.MatchString(path) {
+					return nil, fmt.Errorf("x-defang-ingress: invalid path %q; must start with '/' and may only end with a '*' wildcard", path)
+				}
+			}
+			result.Paths = paths
+		case "domains":
+			domains, err := toStringList(key, val)
+			if err != nil {
+				return nil, err
+			}
+			for _, domain := range domains {
+				if !dns.IsValidDomain(domain) {
+					return nil, fmt.Errorf("x-defang-ingress: invalid domain %q", domain)
+				}
+				result.Domains = append(result.Domains, dns.Normalize(domain))
+			}
+		case "sticky":
+			sticky, err := parseStickySessions(val)
+			if err != nil {
+				return nil, err
+			}
+			result.StickySessions = sticky
+		case "idle_timeout":
+			timeout, err := parseTimeout(key, val, MaxIdleTimeout)
+			if err != nil {
+				return nil, err
+			}
+			result.IdleTimeoutSeconds = timeout
+		case "request_timeout":
+			timeout, err := parseTimeout(key, val, MaxRequestTimeout)
+			if err != nil {
+				return nil, err
+			}
+			result.RequestTimeoutSeconds = timeout
+		case "grpc_web", "http2":
+			enabled, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("x-defang-ingress: '%s' must be a boolean", key)
+			}
+			if key == "grpc_web" {
+				result.GrpcWeb = enabled
+			} else {
+				result.Http2 = enabled
+			}
+		case "cors":
+			cors, err := parseCors(val)
+			if err != nil {
+				return nil, err
+			}
+			result.Cors = cors
+		case "auth":
+			auth, err := parseIngressAuth(val)
+			if err != nil {
+				return nil, err
+			}
+			result.Auth = auth
+		case "allow_ips", "deny_ips":
+			cidrs, err := toStringList(key, val)
+			if err != nil {
+				return nil, err
+			}
+			for i, cidr := range cidrs {
+				if cidrs[i], err = normalizeCIDR(cidr); err != nil {
+					return nil, fmt.Errorf("x-defang-ingress: '%s' %w", key, err)
+				}
+			}
+			if key == "allow_ips" {
+				result.AllowCidrs = cidrs
+			} else {
+				result.DenyCidrs = cidrs
+			}
+		case "rate_limit":
+			rateLimit, err := parseRateLimit(val)
+			if err != nil {
+				return nil, err
+			}
+			result.RateLimit = rateLimit
+		case "request_headers", "response_headers":
+			rules, err := parseHeaderRules(key, val)
+			if err != nil {
+				return nil, err
+			}
+			if key == "request_headers" {
+				result.RequestHeaders = rules
+			} else {
+				result.ResponseHeaders = rules
+			}
+		default:
+			return nil, fmt.Errorf("x-defang-ingress: unsupported option '%s'", key)
+		}
+	}
+	for _, cidr := range result.DenyCidrs {
+		if slices.Contains(result.AllowCidrs, cidr) {
+			return nil, fmt.Errorf("x-defang-ingress: %s is in both 'allow_ips' and 'deny_ips'", cidr)
+		}
+	}
+	return result, nil
+}
+
+// normalizeCIDR parses an IP address or CIDR range and returns it in canonical CIDR notation.
+func normalizeCIDR(cidr string) (string, error) {
+	if addr, err := netip.ParseAddr(cidr); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address or CIDR range %q", cidr)
+	}
+	return prefix.Masked().String(), nil
+}
+
+func parseHsts(hsts any) (*defangv1.Hsts, error) {
+	switch hsts := hsts.(type) {
+	case bool:
+		if !hsts {
+			return &defangv1.Hsts{}, nil // max-age 0 disables HSTS
+		}
+		return &defangv1.Hsts{MaxAge: DefaultHstsMaxAge}, nil
+	case map[string]any:
+		result := &defangv1.Hsts{MaxAge: DefaultHstsMaxAge}
+		if maxAge, ok := hsts["max_age"]; ok {
+			n, err := toUint32(maxAge)
+			if err != nil {
+				return nil, fmt.Errorf("x-defang-ingress: 'hsts.max_age' %w", err)
+			}
+			result.MaxAge = n
+		}
+		if includeSubdomains, ok := hsts["include_subdomains"]; ok {
+			if result.IncludeSubdomains, ok = includeSubdomains.(bool); !ok {
+				return nil, errors.New("x-defang-ingress: 'hsts.include_subdomains' must be a boolean")
+			}
+		}
+		for key := range hsts {
+			if key != "max_age" && key != "include_subdomains" {
+				return nil, fmt.Errorf("x-defang-ingress: unsupported option 'hsts.%s'", key)
+			}
+		}
+		return result, nil
+	default:
+		return nil, errors.New(hstsSyntax)
+	}
+}
+
+// RFC 7230 tokens, used for cookie and header names
+var tokenRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+func parseStickySessions(sticky any) (*defangv1.StickySessions, error) {
+	switch sticky := sticky.(type) {
+	case bool:
+		if !sticky {
+			return nil, nil
+		}
+		return &defangv1.StickySessions{TtlSeconds: DefaultStickyTTL}, nil
+	case map[string]any:
+		result := &defangv1.StickySessions{TtlSeconds: DefaultStickyTTL}
+		for _, key := range slices.Sorted(maps.Keys(sticky)) {
+			switch key {
+			case "cookie":
+				cookie, ok := sticky[key].(string)
+				if !ok || !tokenRegex.MatchString(cookie) {
+					return nil, errors.New("x-defang-ingress: 'sticky.cookie' must be a valid cookie name")
+				}
+				result.CookieName = cookie
+			case "ttl":
+				ttl, err := toSeconds(sticky[key])
+				if err != nil {
+					return nil, fmt.Errorf("x-defang-ingress: 'sticky.ttl' %w", err)
+				}
+				if ttl < 1 || ttl > MaxStickyTTL {
+					return nil, fmt.Errorf("x-defang-ingress: 'sticky.ttl' must be between 1s and %v", time.Duration(MaxStickyTTL)*time.Second)
+				}
+				result.TtlSeconds = ttl
+			default:
+				return nil, fmt.Errorf("x-defang-ingress: unsupported option 'sticky.%s'", key)
+			}
+		}
+		return result, nil
+	default:
+		return nil, errors.New(stickySyntax)
+	}
+}
+
+func toStringList(key string, val any) ([]string, error) {
+	list, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("x-defang-ingress: '%s' must be a list of strings", key)
+	}
+	strs := make([]string, 0, len(list))
+	for _, item := range list {
+		str, ok := item.(string)
+		if !ok || str == "" {
+			return nil, fmt.Errorf("x-defang-ingress: '%s' must be a list of strings", key)
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func parseTimeout(key string, val any, maxSeconds uint32) (uint32, error) {
+	timeout, err := toSeconds(val)
+	if err != nil {
+		return 0, fmt.Errorf("x-defang-ingress: '%s' %w", key, err)
+	}
+	if timeout < 1 || timeout > maxSeconds {
+		return 0, fmt.Errorf("x-defang-ingress: '%s' must be between 1s and %v", key, time.Duration(maxSeconds)*time.Second)
+	}
+	return timeout, nil
+}
+
+func parseRateLimit(rateLimit any) (*defangv1.RateLimit, error) {
+	result := &defangv1.RateLimit{}
+	if options, ok := rateLimit.(map[string]any); ok {
+		for _, key := range slices.Sorted(maps.Keys(options)) {
+			var dst *uint32
+			switch key {
+			case "requests_per_second":
+				dst = &result.RequestsPerSecond
+			case "burst":
+				dst = &result.Burst
+			default:
+				return nil, fmt.Errorf("x-defang-ingress: unsupported option 'rate_limit.%s'", key)
+			}
+			n, err := toUint32(options[key])
+			if err != nil {
+				return nil, fmt.Errorf("x-defang-ingress: 'rate_limit.%s' %w", key, err)
+			}
+			*dst = n
+		}
+	} else {
+		rps, err := toUint32(rateLimit)
+		if err != nil {
+			return nil, errors.New(rateSyntax)
+		}
+		result.RequestsPerSecond = rps
+	}
+
+	if result.RequestsPerSecond == 0 {
+		return nil, errors.New("x-defang-ingress: 'rate_limit.requests_per_second' must be at least 1")
+	}
+	if result.Burst == 0 {
+		result.Burst = result.RequestsPerSecond
+	}
+	return result, nil
+}
+
+func parseIngressAuth(auth any) (*defangv1.IngressAuth, error) {
+	options, ok := auth.(map[string]any)
+	if !ok {
+		return nil, errors.New(authSyntax)
+	}
+
+	result := &defangv1.IngressAuth{}
+	for _, key := range slices.Sorted(maps.Keys(options)) {
+		str, ok := options[key].(string)
+		if !ok {
+			return nil, fmt.Errorf("x-defang-ingress: 'auth.%s' must be a string", key)
+		}
+		switch key {
+		case "type":
+			switch strings.ToLower(str) {
+			case "basic":
+				result.Type = defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BASIC
+			case "bearer":
+				result.Type = defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BEARER
+			default:
+				return nil, fmt.Errorf("x-defang-ingress: 'auth.type' must be one of [basic bearer], got %q", str)
+			}
+		case "config":
+			if !pkg.IsValidSecretName(str) {
+				return nil, fmt.Errorf("x-defang-ingress: 'auth.config' %q is not a valid config name", str)
+			}
+			result.Config = str
+		case "realm":
+			if strings.ContainsAny(str, "\"\\") {
+				return nil, errors.New("x-defang-ingress: 'auth.realm' must not contain quotes or backslashes")
+			}
+			result.Realm = str
+		default:
+			return nil, fmt.Errorf("x-defang-ingress: unsupported option 'auth.%s'", key)
+		}
+	}
+
+	if result.Type == defangv1.IngressAuthType_INGRESS_AUTH_TYPE_UNSPECIFIED {
+		return nil, errors.New("x-defang-ingress: 'auth.type' is required")
+	}
+	if result.Config == "" {
+		return nil, errors.New("x-defang-ingress: 'auth.config' is required; use 'defang config set' to store the credentials")
+	}
+	if result.Realm != "" && result.Type != defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BASIC {
+		return nil, errors.New("x-defang-ingress: 'auth.realm' is only supported for basic auth")
+	}
+	return result, nil
+}
+
+// toSeconds converts a duration string like "1h30m" or a number of seconds into whole seconds.
+func toSeconds(val any) (uint32, error) {
+	if str, ok := val.(string); ok {
+		if d, err := time.ParseDuration(str); err == nil {
+			if d < 0 || d%time.Second != 0 || d.Seconds() > math.MaxUint32 {
+				return 0, errors.New("must be a whole number of seconds")
+			}
+			return uint32(d.Seconds()), nil
+		}
+	}
+	n, err := toUint32(val)
+	if err != nil {
+		return 0, errors.New("must be a duration, eg. \"1h\" or a number of seconds")
+	}
+	return n, nil
+}
+
+var ingressPathRegex = regexp.MustCompile(`^/[A-Za-z0-9._~!$&'()+,;=:@%/-]*\*?$`)
+
+// normalizeIngressPath returns the canonical form of the path pattern; "/" is a catch-all, like "/*".
+func normalizeIngressPath(path string) string {
+	if path == "/" {
+		return "/*"
+	}
+	return path
+}
+
+// GetDomainNames returns the domainname of the service followed by any additional domains from x-defang-ingress.
+func GetDomainNames(service *composeTypes.ServiceConfig) []string {
+	if service.DomainName == "" {
+		return nil
+	}
+	domains := []string{dns.Normalize(service.DomainName)}
+	if ingress := GetIngress(service); ingress != nil {
+		for _, domain := range ingress.Domains {
+			if !slices.Contains(domains, domain) {
+				domains = append(domains, domain)
+			}
+		}
+	}
+	return domains
+}
+
+// validateIngressRoutes checks that services sharing the same domain name don't claim the same paths, including
+// nested prefixes like "/api/*" and "/api/v1/*". The catch-all path is only the fallback, so it can be shared.
+func validateIngressRoutes(services []composeTypes.ServiceConfig) error {
+	type route struct {
+		service string
+		path    string
+	}
+	routes := make(map[string][]route) // domain => routes
+	var errs []error
+	for _, svccfg := range services {
+		paths := []string{"/*"} // all paths by default
+		if ingress := GetIngress(&svccfg); ingress != nil && len(ingress.Paths) > 0 {
+			paths = ingress.Paths
+		}
+		for _, domain := range GetDomainNames(&svccfg) {
+			for _, path := range paths {
+				i := slices.IndexFunc(routes[domain], func(other route) bool {
+					return ingressPathsOverlap(path, other.path)
+				})
+				if i < 0 {
+					routes[domain] = append(routes[domain], route{service: svccfg.Name, path: path})
+					continue
+				}
+				if other := routes[domain][i]; other.service != svccfg.Name { // overlapping paths within the same service are fine
+					errs = append(errs, errorf(CodeRouteOverlap, "domainname %q: path %q of service %q overlaps with path %q of service %q; use x-defang-ingress 'paths' to route distinct paths to each service", domain, path, svccfg.Name, other.path, other.service))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ingressPathsOverlap returns whether a request could match both path patterns. Two catch-alls overlap, but a catch-all
+// doesn't overlap with a more specific path.
+func ingressPathsOverlap(a, b string) bool {
+	a, b = normalizeIngressPath(a), normalizeIngressPath(b)
+	if a == b {
+		return true
+	}
+	if a == "/*" || b == "/*" {
+		return false
+	}
+	matches := func(pattern, path string) bool {
+		prefix, wildcard := strings.CutSuffix(pattern, "*")
+		return wildcard && strings.HasPrefix(path, prefix)
+	}
+	return matches(a, b) || matches(b, a)
+}
+
+// GetIngress returns the ingress options of the service, or nil to use the platform defaults.
+func GetIngress(service *composeTypes.ServiceConfig) *defangv1.Ingress {
+	var ingress *defangv1.Ingress
+	if ingressVal, ok := service.Extensions["x-defang-ingress"]; ok {
+		ingress, _ = ParseIngress(ingressVal) // already validated
+	}
+	if maintenanceVal, ok := service.Extensions[maintenanceExtension]; ok {
+		if maintenance, _ := ParseMaintenance(maintenanceVal); maintenance != nil { // already validated
+			if ingress == nil {
+				ingress = &defangv1.Ingress{}
+			}
+			ingress.Maintenance = maintenance
+		}
+	}
+	return ingress
+}
+
+func hasIngressPort(service *composeTypes.ServiceConfig) bool {
+	return slices.ContainsFunc(service.Ports, func(port composeTypes.ServicePortConfig) bool {
+		return port.Mode == Mode_INGRESS
+	})
+}
+
+func isGrpcPort(port composeTypes.ServicePortConfig) bool {
+	return port.AppProtocol == Protocol_GRPC || port.Protocol == Protocol_GRPC
+}
+
+func hasGrpcIngressPort(service *composeTypes.ServiceConfig) bool {
+	return slices.ContainsFunc(service.Ports, func(port composeTypes.ServicePortConfig) bool {
+		return port.Mode == Mode_INGRESS && isGrpcPort(port)
+	})
+}
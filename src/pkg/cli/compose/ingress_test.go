@@ -0,0 +1,207 @@
+package compose
+
+import (
+	"slices"
+	"testing"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseIngress(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    *defangv1.Ingress
+		wantErr string
+	}{
+		{"empty", map[string]any{}, &defangv1.Ingress{}, ""},
+		{"no redirect", map[string]any{"https_redirect": false}, &defangv1.Ingress{DisableHttpsRedirect: true}, ""},
+		{"hsts true", map[string]any{"hsts": true}, &defangv1.Ingress{Hsts: &defangv1.Hsts{MaxAge: DefaultHstsMaxAge}}, ""},
+		{"hsts false", map[string]any{"hsts": false}, &defangv1.Ingress{Hsts: &defangv1.Hsts{}}, ""},
+		{
+			name:  "hsts policy",
+			value: map[string]any{"https_redirect": true, "hsts": map[string]any{"max_age": 86400, "include_subdomains": true}},
+			want:  &defangv1.Ingress{Hsts: &defangv1.Hsts{MaxAge: 86400, IncludeSubdomains: true}},
+		},
+		{"hsts default max_age", map[string]any{"hsts": map[string]any{"include_subdomains": true}}, &defangv1.Ingress{Hsts: &defangv1.Hsts{MaxAge: DefaultHstsMaxAge, IncludeSubdomains: true}}, ""},
+		{"paths", map[string]any{"paths": []any{"/api/*", "/healthz"}}, &defangv1.Ingress{Paths: []string{"/api/*", "/healthz"}}, ""},
+		{"relative path", map[string]any{"paths": []any{"api/*"}}, nil, `x-defang-ingress: invalid path "api/*"; must start with '/' and may only end with a '*' wildcard`},
+		{"inner wildcard", map[string]any{"paths": []any{"/*/api"}}, nil, `x-defang-ingress: invalid path "/*/api"; must start with '/' and may only end with a '*' wildcard`},
+		{"invalid paths", map[string]any{"paths": "/api/*"}, nil, "x-defang-ingress: 'paths' must be a list of strings"},
+		{"domains", map[string]any{"domains": []any{"WWW.example.com.", "example.com"}}, &defangv1.Ingress{Domains: []string{"www.example.com", "example.com"}}, ""},
+		{"wildcard domain", map[string]any{"domains": []any{"*.example.com"}}, nil, `x-defang-ingress: invalid domain "*.example.com"`},
+		{"invalid domains", map[string]any{"domains": "example.com"}, nil, "x-defang-ingress: 'domains' must be a list of strings"},
+		{"sticky true", map[string]any{"sticky": true}, &defangv1.Ingress{StickySessions: &defangv1.StickySessions{TtlSeconds: DefaultStickyTTL}}, ""},
+		{"sticky false", map[string]any{"sticky": false}, &defangv1.Ingress{}, ""},
+		{
+			name:  "sticky policy",
+			value: map[string]any{"sticky": map[string]any{"cookie": "SESSIONID", "ttl": "1h30m"}},
+			want:  &defangv1.Ingress{StickySessions: &defangv1.StickySessions{CookieName: "SESSIONID", TtlSeconds: 5400}},
+		},
+		{"sticky ttl seconds", map[string]any{"sticky": map[string]any{"ttl": 600}}, &defangv1.Ingress{StickySessions: &defangv1.StickySessions{TtlSeconds: 600}}, ""},
+		{"sticky ttl days", map[string]any{"sticky": map[string]any{"ttl": "30d"}}, nil, `x-defang-ingress: 'sticky.ttl' must be a duration, eg. "1h" or a number of seconds`},
+		{"sticky ttl max", map[string]any{"sticky": map[string]any{"ttl": "169h"}}, nil, "x-defang-ingress: 'sticky.ttl' must be between 1s and 168h0m0s"},
+		{"sticky ttl fraction", map[string]any{"sticky": map[string]any{"ttl": "1.5s"}}, nil, "x-defang-ingress: 'sticky.ttl' must be a whole number of seconds"},
+		{"sticky invalid cookie", map[string]any{"sticky": map[string]any{"cookie": "my session"}}, nil, "x-defang-ingress: 'sticky.cookie' must be a valid cookie name"},
+		{"sticky unknown option", map[string]any{"sticky": map[string]any{"path": "/"}}, nil, "x-defang-ingress: unsupported option 'sticky.path'"},
+		{"invalid sticky", map[string]any{"sticky": "yes"}, nil, stickySyntax},
+		{"timeouts", map[string]any{"idle_timeout": "15m", "request_timeout": 300}, &defangv1.Ingress{IdleTimeoutSeconds: 900, RequestTimeoutSeconds: 300}, ""},
+		{"idle timeout too long", map[string]any{"idle_timeout": "2h"}, nil, "x-defang-ingress: 'idle_timeout' must be between 1s and 1h6m40s"},
+		{"request timeout too long", map[string]any{"request_timeout": "2h"}, nil, "x-defang-ingress: 'request_timeout' must be between 1s and 1h0m0s"},
+		{"zero timeout", map[string]any{"idle_timeout": 0}, nil, "x-defang-ingress: 'idle_timeout' must be between 1s and 1h6m40s"},
+		{"invalid timeout", map[string]any{"request_timeout": "forever"}, nil, `x-defang-ingress: 'request_timeout' must be a duration, eg. "1h" or a number of seconds`},
+		{"grpc web", map[string]any{"grpc_web": true, "http2": true}, &defangv1.Ingress{GrpcWeb: true, Http2: true}, ""},
+		{"invalid grpc web", map[string]any{"grpc_web": "true"}, nil, "x-defang-ingress: 'grpc_web' must be a boolean"},
+		{
+			name:  "cors",
+			value: map[string]any{"cors": map[string]any{"allow_origins": []any{"https://App.example.com/", "http://localhost:3000"}, "allow_methods": []any{"get", "POST"}, "allow_headers": []any{"Authorization", "Content-Type"}, "allow_credentials": true, "max_age": "10m"}},
+			want:  &defangv1.Ingress{Cors: &defangv1.Cors{AllowOrigins: []string{"https://app.example.com", "http://localhost:3000"}, AllowMethods: []string{"GET", "POST"}, AllowHeaders: []string{"Authorization", "Content-Type"}, AllowCredentials: true, MaxAgeSeconds: 600}},
+		},
+		{"cors wildcard", map[string]any{"cors": map[string]any{"allow_origins": []any{"*"}, "expose_headers": []any{"X-Request-Id"}}}, &defangv1.Ingress{Cors: &defangv1.Cors{AllowOrigins: []string{"*"}, ExposeHeaders: []string{"X-Request-Id"}}}, ""},
+		{"cors wildcard credentials", map[string]any{"cors": map[string]any{"allow_origins": []any{"*"}, "allow_credentials": true}}, nil, "x-defang-ingress: 'cors.allow_credentials' cannot be used with the '*' origin; list the allowed origins instead"},
+		{"cors missing origins", map[string]any{"cors": map[string]any{"allow_methods": []any{"GET"}}}, nil, "x-defang-ingress: 'cors.allow_origins' is required"},
+		{"cors invalid origin", map[string]any{"cors": map[string]any{"allow_origins": []any{"example.com"}}}, nil, `x-defang-ingress: invalid CORS origin "example.com"; must be '*' or like "https://example.com"`},
+		{"cors origin with path", map[string]any{"cors": map[string]any{"allow_origins": []any{"https://example.com/app"}}}, nil, `x-defang-ingress: invalid CORS origin "https://example.com/app"; must be '*' or like "https://example.com"`},
+		{"cors invalid method", map[string]any{"cors": map[string]any{"allow_origins": []any{"*"}, "allow_methods": []any{"FETCH"}}}, nil, `x-defang-ingress: invalid CORS method "FETCH"; must be one of [DELETE GET HEAD OPTIONS PATCH POST PUT]`},
+		{"cors invalid header", map[string]any{"cors": map[string]any{"allow_origins": []any{"*"}, "allow_headers": []any{"X Custom"}}}, nil, `x-defang-ingress: invalid CORS header "X Custom"`},
+		{"invalid cors", map[string]any{"cors": true}, nil, corsSyntax},
+		{"basic auth", map[string]any{"auth": map[string]any{"type": "Basic", "config": "STAGING_AUTH", "realm": "staging"}}, &defangv1.Ingress{Auth: &defangv1.IngressAuth{Type: defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BASIC, Config: "STAGING_AUTH", Realm: "staging"}}, ""},
+		{"bearer auth", map[string]any{"auth": map[string]any{"type": "bearer", "config": "API_TOKEN"}}, &defangv1.Ingress{Auth: &defangv1.IngressAuth{Type: defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BEARER, Config: "API_TOKEN"}}, ""},
+		{"auth missing type", map[string]any{"auth": map[string]any{"config": "API_TOKEN"}}, nil, "x-defang-ingress: 'auth.type' is required"},
+		{"auth missing config", map[string]any{"auth": map[string]any{"type": "bearer"}}, nil, "x-defang-ingress: 'auth.config' is required; use 'defang config set' to store the credentials"},
+		{"auth invalid type", map[string]any{"auth": map[string]any{"type": "oauth", "config": "API_TOKEN"}}, nil, `x-defang-ingress: 'auth.type' must be one of [basic bearer], got "oauth"`},
+		{"auth invalid config", map[string]any{"auth": map[string]any{"type": "basic", "config": "my-secret"}}, nil, `x-defang-ingress: 'auth.config' "my-secret" is not a valid config name`},
+		{"bearer realm", map[string]any{"auth": map[string]any{"type": "bearer", "config": "API_TOKEN", "realm": "api"}}, nil, "x-defang-ingress: 'auth.realm' is only supported for basic auth"},
+		{"invalid auth", map[string]any{"auth": "basic"}, nil, authSyntax},
+		{"ip filter", map[string]any{"allow_ips": []any{"10.0.0.0/8", "203.0.113.7", "2001:db8::1/64"}, "deny_ips": []any{"10.1.2.3"}}, &defangv1.Ingress{AllowCidrs: []string{"10.0.0.0/8", "203.0.113.7/32", "2001:db8::/64"}, DenyCidrs: []string{"10.1.2.3/32"}}, ""},
+		{"invalid cidr", map[string]any{"allow_ips": []any{"10.0.0.0/33"}}, nil, `x-defang-ingress: 'allow_ips' invalid IP address or CIDR range "10.0.0.0/33"`},
+		{"allow and deny", map[string]any{"allow_ips": []any{"192.168.1.0/24"}, "deny_ips": []any{"192.168.1.1/24"}}, nil, "x-defang-ingress: 192.168.1.0/24 is in both 'allow_ips' and 'deny_ips'"},
+		{"rate limit", map[string]any{"rate_limit": 10}, &defangv1.Ingress{RateLimit: &defangv1.RateLimit{RequestsPerSecond: 10, Burst: 10}}, ""},
+		{"rate limit burst", map[string]any{"rate_limit": map[string]any{"requests_per_second": 5, "burst": 20}}, &defangv1.Ingress{RateLimit: &defangv1.RateLimit{RequestsPerSecond: 5, Burst: 20}}, ""},
+		{"rate limit zero", map[string]any{"rate_limit": map[string]any{"burst": 20}}, nil, "x-defang-ingress: 'rate_limit.requests_per_second' must be at least 1"},
+		{"rate limit negative", map[string]any{"rate_limit": map[string]any{"requests_per_second": -1}}, nil, "x-defang-ingress: 'rate_limit.requests_per_second' must be a non-negative integer"},
+		{"rate limit unknown option", map[string]any{"rate_limit": map[string]any{"per": "minute"}}, nil, "x-defang-ingress: unsupported option 'rate_limit.per'"},
+		{"invalid rate limit", map[string]any{"rate_limit": true}, nil, rateSyntax},
+		{
+			name: "headers",
+			value: map[string]any{
+				"request_headers":  map[string]any{"set": map[string]any{"x-forwarded-prefix": "/api"}, "remove": []any{"Cookie"}},
+				"response_headers": map[string]any{"set": map[string]any{"X-Frame-Options": "DENY"}, "remove": []any{"server"}},
+			},
+			want: &defangv1.Ingress{
+				RequestHeaders:  &defangv1.HeaderRules{Set: map[string]string{"X-Forwarded-Prefix": "/api"}, Remove: []string{"Cookie"}},
+				ResponseHeaders: &defangv1.HeaderRules{Set: map[string]string{"X-Frame-Options": "DENY"}, Remove: []string{"Server"}},
+			},
+		},
+		{"protected header", map[string]any{"request_headers": map[string]any{"set": map[string]any{"host": "example.com"}}}, nil, `x-defang-ingress: header "host" in 'request_headers' is managed by the load balancer and cannot be changed`},
+		{"invalid header name", map[string]any{"response_headers": map[string]any{"remove": []any{"X Powered By"}}}, nil, `x-defang-ingress: invalid header name "X Powered By" in 'response_headers'`},
+		{"multiline header value", map[string]any{"response_headers": map[string]any{"set": map[string]any{"X-Test": "a\r\nSet-Cookie: b"}}}, nil, `x-defang-ingress: 'response_headers.set' value for "X-Test" must be a single-line string`},
+		{"set and remove", map[string]any{"response_headers": map[string]any{"set": map[string]any{"x-test": "1"}, "remove": []any{"X-Test"}}}, nil, `x-defang-ingress: header "X-Test" is both set and removed in 'response_headers'`},
+		{"unknown header op", map[string]any{"request_headers": map[string]any{"append": map[string]any{}}}, nil, "x-defang-ingress: unsupported option 'request_headers.append'"},
+		{"invalid redirect", map[string]any{"https_redirect": "yes"}, nil, "x-defang-ingress: 'https_redirect' must be a boolean"},
+		{"negative max_age", map[string]any{"hsts": map[string]any{"max_age": -1}}, nil, "x-defang-ingress: 'hsts.max_age' must be a non-negative integer"},
+		{"invalid include_subdomains", map[string]any{"hsts": map[string]any{"include_subdomains": 1}}, nil, "x-defang-ingress: 'hsts.include_subdomains' must be a boolean"},
+		{"unknown hsts option", map[string]any{"hsts": map[string]any{"preload": true}}, nil, "x-defang-ingress: unsupported option 'hsts.preload'"},
+		{"invalid hsts", map[string]any{"hsts": "on"}, nil, hstsSyntax},
+		{"unknown option", map[string]any{"compress": true}, nil, "x-defang-ingress: unsupported option 'compress'"},
+		{"invalid", true, nil, ingressSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIngress(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateIngressRoutes(t *testing.T) {
+	withPaths := func(name, domain string, paths ...any) composeTypes.ServiceConfig {
+		svccfg := composeTypes.ServiceConfig{Name: name, DomainName: domain}
+		if len(paths) > 0 {
+			svccfg.Extensions = composeTypes.Extensions{"x-defang-ingress": map[string]any{"paths": paths}}
+		}
+		return svccfg
+	}
+	withDomains := func(name, domain string, domains ...any) composeTypes.ServiceConfig {
+		return composeTypes.ServiceConfig{Name: name, DomainName: domain, Extensions: composeTypes.Extensions{"x-defang-ingress": map[string]any{"domains": domains}}}
+	}
+
+	tests := []struct {
+		name     string
+		services []composeTypes.ServiceConfig
+		wantErr  string
+	}{
+		{"distinct domains", []composeTypes.ServiceConfig{withPaths("api", "api.example.com"), withPaths("web", "www.example.com")}, ""},
+		{"spa and api", []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/*"), withPaths("web", "example.com", "/")}, ""},
+		{"catch-all and prefixes", []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/*"), withPaths("docs", "example.com", "/api"), withPaths("web", "example.com")}, ""},
+		{"sibling prefixes", []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/*"), withPaths("app", "example.com", "/app/*")}, ""},
+		{"same service", []composeTypes.ServiceConfig{withPaths("web", "example.com", "/app/*", "/app/*")}, ""},
+		{"additional domains", []composeTypes.ServiceConfig{withDomains("web", "example.com", "www.example.com"), withDomains("blog", "blog.example.com", "example.blog")}, ""},
+		{
+			name:     "additional domain conflict",
+			services: []composeTypes.ServiceConfig{withDomains("web", "example.com", "www.example.com"), withPaths("www", "www.example.com")},
+			wantErr:  `domainname "www.example.com": path "/*" of service "www" overlaps with path "/*" of service "web"; use x-defang-ingress 'paths' to route distinct paths to each service`,
+		},
+		{
+			name:     "both default",
+			services: []composeTypes.ServiceConfig{withPaths("api", "example.com"), withPaths("web", "Example.com.")},
+			wantErr:  `domainname "example.com": path "/*" of service "web" overlaps with path "/*" of service "api"; use x-defang-ingress 'paths' to route distinct paths to each service`,
+		},
+		{
+			name:     "nested prefix",
+			services: []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/*"), withPaths("v1", "example.com", "/api/v1/*"), withPaths("web", "example.com")},
+			wantErr:  `domainname "example.com": path "/api/v1/*" of service "v1" overlaps with path "/api/*" of service "api"; use x-defang-ingress 'paths' to route distinct paths to each service`,
+		},
+		{
+			name:     "exact path under prefix",
+			services: []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/health"), withPaths("v1", "example.com", "/api*")},
+			wantErr:  `domainname "example.com": path "/api*" of service "v1" overlaps with path "/api/health" of service "api"; use x-defang-ingress 'paths' to route distinct paths to each service`,
+		},
+		{
+			name:     "root and wildcard",
+			services: []composeTypes.ServiceConfig{withPaths("api", "example.com", "/api/*", "/*"), withPaths("web", "example.com", "/")},
+			wantErr:  `domainname "example.com": path "/" of service "web" overlaps with path "/*" of service "api"; use x-defang-ingress 'paths' to route distinct paths to each service`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIngressRoutes(tt.services)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || err.Error() != withCode(CodeRouteOverlap, tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGetDomainNames(t *testing.T) {
+	service := &composeTypes.ServiceConfig{
+		DomainName: "Example.com",
+		Extensions: composeTypes.Extensions{"x-defang-ingress": map[string]any{"domains": []any{"www.example.com", "example.com"}}},
+	}
+	got := GetDomainNames(service)
+	want := []string{"example.com", "www.example.com"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := GetDomainNames(&composeTypes.ServiceConfig{}); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,65 @@
+package compose
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+type instanceCatalog struct {
+	families   []string // instance families (AWS), machine series (GCP), or droplet slug prefixes (DO)
+	gpuClasses []string
+}
+
+// Not exhaustive; only the families that can be used for container workloads by the CD
+var instanceCatalogs = map[client.ProviderID]instanceCatalog{
+	client.ProviderAWS: {
+		families:   []string{"c5", "c6a", "c6g", "c6i", "c7g", "c7i", "g4dn", "g5", "g6", "g6e", "inf2", "m5", "m6a", "m6g", "m6i", "m7g", "m7i", "p3", "p4d", "p5", "r5", "r6g", "r6i", "r7g", "r7i", "t3", "t3a", "t4g"},
+		gpuClasses: []string{"a10g", "a100", "h100", "l4", "l40s", "t4", "v100"},
+	},
+	client.ProviderGCP: {
+		families:   []string{"a2", "a3", "c2", "c2d", "c3", "c3d", "e2", "g2", "n1", "n2", "n2d", "n4", "t2a", "t2d"},
+		gpuClasses: []string{"a100", "h100", "l4", "t4", "v100"},
+	},
+	client.ProviderDO: {
+		families:   []string{"c", "c2", "g", "gd", "gpu", "m", "m3", "s", "so"},
+		gpuClasses: []string{"h100", "l40s", "mi300x", "rtx4000", "rtx6000"},
+	},
+}
+
+func instanceFamily(instanceType string) string {
+	family, _, _ := strings.Cut(instanceType, ".") // AWS: g5.xlarge
+	family, _, _ = strings.Cut(family, "-")        // GCP: n2-standard-4, DO: s-2vcpu-4gb
+	return strings.ToLower(family)
+}
+
+// ValidateInstanceTypes checks the instance type and GPU class of each service against the provider's catalog.
+func ValidateInstanceTypes(ctx context.Context, project *composeTypes.Project, providerID client.ProviderID) error {
+	for _, svccfg := range project.Services {
+		instanceType, _ := svccfg.Extensions["x-defang-instance-type"].(string)
+		gpuClass, _ := svccfg.Extensions["x-defang-gpu"].(string)
+		if instanceType == "" && gpuClass == "" {
+			continue
+		}
+
+		if providerID == client.ProviderDefang {
+			warnf(ctx, CodePlaygroundUnsupported, "service %q: instance type selection is not supported in the Playground; consider using BYOC (https://s.defang.io/byoc)", svccfg.Name)
+			continue
+		}
+		catalog, ok := instanceCatalogs[providerID]
+		if !ok {
+			continue // unknown provider; leave it to the CD
+		}
+
+		if instanceType != "" && !slices.Contains(catalog.families, instanceFamily(instanceType)) {
+			return errorf(CodeUnsupportedInstance, "service %q: x-defang-instance-type %q is not supported by provider %q", svccfg.Name, instanceType, providerID)
+		}
+		if gpuClass != "" && !slices.Contains(catalog.gpuClasses, strings.ToLower(gpuClass)) {
+			return errorf(CodeUnsupportedInstance, "service %q: x-defang-gpu %q is not supported by provider %q; supported GPU classes: %v", svccfg.Name, gpuClass, providerID, catalog.gpuClasses)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package compose
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestValidateInstanceTypes(t *testing.T) {
+	tests := []struct {
+		provider     client.ProviderID
+		instanceType string
+		gpu          string
+		wantErr      bool
+	}{
+		{client.ProviderAWS, "g5.xlarge", "a10g", false},
+		{client.ProviderAWS, "n2-standard-4", "", true},
+		{client.ProviderAWS, "", "mi300x", true},
+		{client.ProviderGCP, "n2-standard-4", "L4", false},
+		{client.ProviderGCP, "g5.xlarge", "", true},
+		{client.ProviderDO, "s-2vcpu-4gb", "", false},
+		{client.ProviderDefang, "g5.xlarge", "", false}, // warning only
+		{client.ProviderAuto, "anything", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+tt.instanceType+"/"+tt.gpu, func(t *testing.T) {
+			extensions := composeTypes.Extensions{}
+			if tt.instanceType != "" {
+				extensions["x-defang-instance-type"] = tt.instanceType
+			}
+			if tt.gpu != "" {
+				extensions["x-defang-gpu"] = tt.gpu
+			}
+			project := &composeTypes.Project{
+				Services: composeTypes.Services{"svc": {Name: "svc", Extensions: extensions}},
+			}
+			err := ValidateInstanceTypes(t.Context(), project, tt.provider)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateProjectInstanceTypes(t *testing.T) {
+	project := &composeTypes.Project{
+		Services: composeTypes.Services{"svc": {
+			Name:       "svc",
+			Image:      "nginx",
+			Extensions: composeTypes.Extensions{"x-defang-instance-type": "n2-standard-4"},
+		}},
+	}
+	if err := ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderAWS); err == nil || !strings.Contains(err.Error(), "x-defang-instance-type") {
+		t.Errorf("expected an instance type error, got %v", err)
+	}
+	if err := ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderGCP); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package compose
+
+import "context"
+
+import composeTypes "github.com/compose-spec/compose-go/v2/types"
+
+func getMemoryLimit(svccfg *composeTypes.ServiceConfig) composeTypes.UnitBytes {
+	if svccfg.Deploy != nil && svccfg.Deploy.Resources.Limits != nil && svccfg.Deploy.Resources.Limits.MemoryBytes > 0 {
+		return svccfg.Deploy.Resources.Limits.MemoryBytes
+	}
+	return svccfg.MemLimit
+}
+
+// fixupPidsLimit moves deploy.resources.limits.pids to pids_limit, so the CD only has to look in one place.
+func fixupPidsLimit(svccfg *composeTypes.ServiceConfig) {
+	if svccfg.Deploy == nil || svccfg.Deploy.Resources.Limits == nil || svccfg.Deploy.Resources.Limits.Pids == 0 {
+		return
+	}
+	if svccfg.PidsLimit == 0 {
+		svccfg.PidsLimit = svccfg.Deploy.Resources.Limits.Pids
+	}
+	svccfg.Deploy.Resources.Limits.Pids = 0
+}
+
+// validateProcessLimits checks pids_limit, memswap_limit and the OOM settings of the service.
+func validateProcessLimits(ctx context.Context, svccfg *composeTypes.ServiceConfig) error {
+	pidsLimit := svccfg.PidsLimit
+	if svccfg.Deploy != nil && svccfg.Deploy.Resources.Limits != nil && svccfg.Deploy.Resources.Limits.Pids != 0 {
+		if pidsLimit != 0 && pidsLimit != svccfg.Deploy.Resources.Limits.Pids {
+			return errorf(CodeInvalidResources, "service %q: pids_limit (%d) and deploy.resources.limits.pids (%d) must be the same", svccfg.Name, pidsLimit, svccfg.Deploy.Resources.Limits.Pids)
+		}
+		pidsLimit = svccfg.Deploy.Resources.Limits.Pids
+	}
+	if pidsLimit < -1 {
+		return errorf(CodeInvalidResources, "service %q: invalid value for pids_limit: %d", svccfg.Name, pidsLimit)
+	}
+
+	// Swap is not available on any of the platforms, so only a limit equal to the memory limit (ie. no swap) is honored
+	if svccfg.MemSwapLimit != 0 {
+		memLimit := getMemoryLimit(svccfg)
+		if svccfg.MemSwapLimit > 0 && svccfg.MemSwapLimit < memLimit {
+			return errorf(CodeInvalidResources, "service %q: memswap_limit (%v MiB) must not be less than the memory limit (%v MiB)", svccfg.Name, int64(svccfg.MemSwapLimit)/MiB, int64(memLimit)/MiB)
+		}
+		if svccfg.MemSwapLimit != memLimit {
+			warnf(ctx, CodeIgnoredLimit, "service %q: swap is not supported; memswap_limit is ignored", svccfg.Name)
+		}
+	}
+
+	if svccfg.OomScoreAdj < -1000 || svccfg.OomScoreAdj > 1000 {
+		return errorf(CodeInvalidResources, "service %q: oom_score_adj must be between -1000 and 1000, got %d", svccfg.Name, svccfg.OomScoreAdj)
+	}
+	if svccfg.OomScoreAdj < 0 {
+		warnf(ctx, CodeIgnoredLimit, "service %q: negative oom_score_adj requires privileges the platform does not grant; it is ignored", svccfg.Name)
+	}
+	if svccfg.OomKillDisable {
+		warnf(ctx, CodeIgnoredLimit, "service %q: oom_kill_disable is not supported; the service will be restarted when it runs out of memory", svccfg.Name)
+	}
+	return nil
+}
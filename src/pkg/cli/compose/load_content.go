@@ -2,7 +2,9 @@ package compose
 
 import (
 	"context"
+	"path/filepath"
 
+	"github.com/compose-spec/compose-go/v2/dotenv"
 	"github.com/compose-spec/compose-go/v2/loader"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
 )
@@ -24,3 +26,37 @@ func LoadFromContent(ctx context.Context, content []byte, nameFallback string) (
 func LoadFromContentWithInterpolation(ctx context.Context, content []byte, nameFallback string) (*Project, error) {
 	return loadFromContent(ctx, content, nameFallback, false)
 }
+
+// LoadFromBuffer loads the Compose file from the given content instead of from disk, eg. an unsaved editor buffer,
+// with the same Defang-specific processing as the Loader. Variables from the .env file next to it are interpolated.
+func LoadFromBuffer(ctx context.Context, filename string, content []byte) (*Project, error) {
+	workingDir := filepath.Dir(filename)
+	env := composeTypes.NewMapping(onlyComposeEnv())
+	if dotEnv, err := dotenv.GetEnvFromFile(env, []string{filepath.Join(workingDir, ".env")}); err == nil {
+		env.Merge(dotEnv)
+	}
+	project, err := loader.LoadWithContext(ctx, composeTypes.ConfigDetails{
+		WorkingDir:  workingDir,
+		ConfigFiles: []composeTypes.ConfigFile{{Filename: filename, Content: content}},
+		Environment: env,
+	}, func(o *loader.Options) {
+		o.SetProjectName(loader.NormalizeProjectName(filepath.Base(workingDir)), false)
+		o.Profiles = []string{"defang"}
+		o.SkipConsistencyCheck = true
+		o.Interpolate.Substitute = leaveUnresolved(ctx, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	declareUndefinedSecrets(project)
+	if err := checkServiceReferences(project); err != nil {
+		return nil, err
+	}
+	if err := dropIgnoredServices(ctx, project, false); err != nil {
+		return nil, err
+	}
+	if err := shortenLongNames(ctx, project, false); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
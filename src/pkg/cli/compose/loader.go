@@ -10,8 +10,8 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/logs"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	"github.com/compose-spec/compose-go/v2/cli"
 	"github.com/compose-spec/compose-go/v2/errdefs"
@@ -31,8 +31,11 @@ type Services = composeTypes.Services
 type BuildConfig = composeTypes.BuildConfig
 
 type LoaderOptions struct {
-	ConfigPaths []string
-	ProjectName string
+	ConfigPaths   []string
+	ProjectName   string
+	EnvFiles      []string // replace the default .env file, like "docker compose --env-file"
+	OsEnv         bool     // also interpolate variables from the host environment
+	NoInterpolate bool     // leave all variables as-is, for resolution from config by the CD
 }
 
 type Loader struct {
@@ -42,12 +45,36 @@ type Loader struct {
 
 type LoaderOption func(*LoaderOptions)
 
+// WithPath sets the compose file(s) to load. Like "docker compose -f a.yaml -f b.yaml", later files are merged into
+// earlier ones using the compose-spec merge rules and relative paths are resolved against the first file.
 func WithPath(paths ...string) LoaderOption {
 	return func(o *LoaderOptions) {
 		o.ConfigPaths = paths
 	}
 }
 
+// WithEnvFiles sets the env files used for interpolation, instead of the .env file in the project directory.
+func WithEnvFiles(files ...string) LoaderOption {
+	return func(o *LoaderOptions) {
+		o.EnvFiles = files
+	}
+}
+
+// WithOsEnv interpolates variables from the host environment. This is off by default, so a deployment doesn't
+// depend on the shell it was started from.
+func WithOsEnv(osEnv bool) LoaderOption {
+	return func(o *LoaderOptions) {
+		o.OsEnv = osEnv
+	}
+}
+
+// WithNoInterpolate leaves all ${VAR} references in the compose file as-is.
+func WithNoInterpolate(noInterpolate bool) LoaderOption {
+	return func(o *LoaderOptions) {
+		o.NoInterpolate = noInterpolate
+	}
+}
+
 func WithProjectName(name string) LoaderOption {
 	return func(o *LoaderOptions) {
 		o.ProjectName = name
@@ -79,6 +106,10 @@ func (l *Loader) LoadProjectName(ctx context.Context) (string, bool, error) {
 	project, err := l.loadProject(ctx, true)
 	if err != nil {
 		if errors.Is(err, types.ErrComposeFileNotFound) {
+			if activeProject := client.GetActiveProject(); activeProject != "" {
+				termFromContext(ctx).Debugf("Using the active project %q", activeProject)
+				return activeProject, false, nil
+			}
 			return "", false, fmt.Errorf("no --project-name specified and %w", err)
 		}
 		return "", false, err
@@ -101,15 +132,24 @@ func (l *Loader) TargetDirectory(ctx context.Context) string {
 }
 
 func (l *Loader) loadProject(ctx context.Context, suppressWarn bool) (*Project, error) {
+	t := termFromContext(ctx)
 	if l.cached != nil {
 		return l.cached, nil
 	}
 
-	projOpts, err := l.newProjectOptions(suppressWarn)
+	projOpts, err := l.newProjectOptions(ctx, suppressWarn)
 	if err != nil {
 		return nil, err
 	}
 
+	if !suppressWarn {
+		if file := discoveredInParent(l.options.ConfigPaths, projOpts.ConfigPaths); file != "" {
+			t.Info("Using compose file", file)
+		}
+	}
+
+	bareArgs := resolveBareBuildArgs(ctx, projOpts)
+
 	project, err := projOpts.LoadProject(ctx)
 	if err != nil {
 		if errors.Is(err, errdefs.ErrNotFound) {
@@ -118,32 +158,65 @@ func (l *Loader) loadProject(ctx context.Context, suppressWarn bool) (*Project,
 		return nil, err
 	}
 
-	if term.DoDebug() {
+	declareUndefinedSecrets(project)
+	if err := checkServiceReferences(project); err != nil {
+		return nil, err
+	}
+
+	if !suppressWarn {
+		warnUnsetBuildArgs(ctx, project, bareArgs)
+	}
+
+	if err := dropIgnoredServices(ctx, project, suppressWarn); err != nil {
+		return nil, err
+	}
+
+	if err := shortenLongNames(ctx, project, suppressWarn); err != nil {
+		return nil, err
+	}
+
+	if t.DoDebug() {
 		b, _ := yaml.Marshal(project)
-		term.Println(string(b))
+		t.Println(string(b))
 	}
 
 	l.cached = project
 	return project, nil
 }
 
-func (l *Loader) newProjectOptions(suppressWarn bool) (*cli.ProjectOptions, error) {
+// discoveredInParent returns the compose file that was found in a parent folder of the current directory, if no
+// explicit compose file was given. The project's working directory is the folder of that file, like Docker Compose.
+func discoveredInParent(explicitPaths, configPaths []string) string {
+	if len(explicitPaths) > 0 || len(configPaths) == 0 {
+		return ""
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	if filepath.Dir(configPaths[0]) == wd {
+		return ""
+	}
+	return configPaths[0]
+}
+
+func (l *Loader) newProjectOptions(ctx context.Context, suppressWarn bool) (*cli.ProjectOptions, error) {
 	// Set logrus send logs via the term package
-	termLogger := logs.TermLogFormatter{Term: term.DefaultTerm}
+	termLogger := logs.TermLogFormatter{Term: termFromContext(ctx)}
 	logrus.SetFormatter(termLogger)
 
-	onlyComposeEnv := slices.DeleteFunc(os.Environ(), func(kv string) bool {
-		return !strings.HasPrefix(kv, "COMPOSE_") // only keep COMPOSE_* variables
-	})
-
 	// Based on how docker compose setup its own project options
 	// https://github.com/docker/compose/blob/1a14fcb1e6645dd92f5a4f2da00071bd59c2e887/cmd/compose/compose.go#L326-L346
+	env := onlyComposeEnv()
+	if l.options.OsEnv {
+		env = os.Environ()
+	}
 	return cli.NewProjectOptions(l.options.ConfigPaths,
 		// First apply os.Environment, always win
-		// -- DISABLED FOR DEFANG -- cli.WithOsEnv,
-		cli.WithEnv(onlyComposeEnv),
+		// -- ONLY WITH --os-env FOR DEFANG -- cli.WithOsEnv,
+		cli.WithEnv(env),
 		// Load PWD/.env if present and no explicit --env-file has been set
-		cli.WithEnvFiles(), // TODO: Support --env-file to be added as param to this call
+		cli.WithEnvFiles(l.options.EnvFiles...),
 		// read dot env file to populate project environment
 		cli.WithDotEnv,
 		// get compose file path set by COMPOSE_FILE
@@ -152,7 +225,7 @@ func (l *Loader) newProjectOptions(suppressWarn bool) (*cli.ProjectOptions, erro
 		cli.WithDefaultConfigPath,
 		// Calling the 2 functions below the 2nd time as the loaded env in first call modifies the behavior of the 2nd call:
 		// .. and then, a project directory != PWD maybe has been set so let's load .env file
-		cli.WithEnvFiles(), // TODO: Support --env-file to be added as param to this call
+		cli.WithEnvFiles(l.options.EnvFiles...),
 		cli.WithDotEnv,
 		// eventually COMPOSE_PROFILES should have been set
 		// cli.WithDefaultProfiles(c.Profiles...), TODO: Support --profile to be added as param to this call
@@ -160,66 +233,80 @@ func (l *Loader) newProjectOptions(suppressWarn bool) (*cli.ProjectOptions, erro
 		// DEFANG SPECIFIC OPTIONS
 		cli.WithDefaultProfiles("defang"), // FIXME: this overrides any COMPOSE_PROFILES env
 		cli.WithDiscardEnvFile,
-		cli.WithConsistency(false), // TODO: check fails if secrets are used but top-level 'secrets:' is missing
+		cli.WithConsistency(false), // references are checked by loadProject, once the undefined secrets are declared
+		cli.WithInterpolation(!l.options.NoInterpolate),
 		cli.WithLoadOptions(func(o *loader.Options) {
 			// As suggested by https://github.com/compose-spec/compose-go/issues/710#issuecomment-2462287043, we'll be called again once the project is loaded
 			if o.Interpolate == nil {
 				return
 			}
 			// Override the interpolation substitution function to leave unresolved variables as is for resolution later by CD
-			o.Interpolate.Substitute = func(templ string, mapping template.Mapping) (string, error) {
-				return template.Substitute(templ, func(key string) (string, bool) {
-					if v, ok := mapping(key); ok {
-						return v, true
-					}
-					// Check if the variable is defined in the environment to warn the user that it's not used
-					_, inEnv := os.LookupEnv(key)
-					if hasSubstitution(templ, key) {
-						// We don't (yet) support substitution patterns during deployment
-						if inEnv && !suppressWarn {
-							term.Warnf("Environment variable %q is ignored; add it to `.env` if needed", key)
-						} else {
-							term.Debugf("Unresolved environment variable %q", key)
-						}
-						return "", false
-					}
-					if inEnv && !suppressWarn {
-						term.Warnf("Environment variable %q is ignored; add it to `.env` or it may be resolved from config during deployment", key)
-					} else {
-						term.Debugf("Environment variable %q was not resolved locally. It may be resolved from config during deployment", key)
-					}
-					// Leave unresolved variables as-is for resolution later by CD
-					return "${" + key + "}", true
-				})
-			}
+			o.Interpolate.Substitute = leaveUnresolved(ctx, suppressWarn)
 		}),
 	)
 }
 
+func onlyComposeEnv() []string {
+	return slices.DeleteFunc(os.Environ(), func(kv string) bool {
+		return !strings.HasPrefix(kv, "COMPOSE_") // only keep COMPOSE_* variables
+	})
+}
+
+// leaveUnresolved returns an interpolation substitution function that leaves unresolved variables as is, so they can
+// be resolved from config by the CD during deployment.
+func leaveUnresolved(ctx context.Context, suppressWarn bool) func(string, template.Mapping) (string, error) {
+	return func(templ string, mapping template.Mapping) (string, error) {
+		return template.Substitute(templ, func(key string) (string, bool) {
+			if v, ok := mapping(key); ok {
+				return v, true
+			}
+			// Check if the variable is defined in the environment to warn the user that it's not used
+			_, inEnv := os.LookupEnv(key)
+			if hasSubstitution(templ, key) {
+				// We don't (yet) support substitution patterns during deployment
+				if inEnv && !suppressWarn {
+					warnf(ctx, CodeIgnoredEnvironment, "Environment variable %q is ignored; add it to `.env` or use --os-env if needed", key)
+				} else {
+					termFromContext(ctx).Debugf("Unresolved environment variable %q", key)
+				}
+				return "", false
+			}
+			if inEnv && !suppressWarn {
+				warnf(ctx, CodeIgnoredEnvironment, "Environment variable %q is ignored; add it to `.env`, use --os-env, or it may be resolved from config during deployment", key)
+			} else {
+				termFromContext(ctx).Debugf("Environment variable %q was not resolved locally. It may be resolved from config during deployment", key)
+			}
+			// Leave unresolved variables as-is for resolution later by CD
+			return "${" + key + "}", true
+		})
+	}
+}
+
 func hasSubstitution(s, key string) bool {
 	// Check in the original `templ` string if the variable uses any substitution patterns like - :- + :+ ? :?
 	pattern := regexp.MustCompile(`(^|[^$])\$\{` + regexp.QuoteMeta(key) + `:?[-+?]`)
 	return pattern.MatchString(s)
 }
 
-func (l *Loader) CreateProjectForDebug() (*Project, error) {
-	projOpts, err := l.newProjectOptions(true)
+func (l *Loader) CreateProjectForDebug(ctx context.Context) (*Project, error) {
+	projOpts, err := l.newProjectOptions(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// the working directory is the folder of the compose file, which might be a parent of the current directory
+	workingDir, err := projOpts.GetWorkingDir()
 	if err != nil {
 		return nil, err
 	}
 
 	// get the project name
 	if projOpts.Name == "" {
-		dir, err := os.Getwd()
-		if err != nil {
-			return nil, err
-		}
-
-		projOpts.Name = filepath.Base(dir)
+		projOpts.Name = filepath.Base(workingDir)
 	}
 	project := &Project{
 		Name:         projOpts.Name,
-		WorkingDir:   projOpts.WorkingDir,
+		WorkingDir:   workingDir,
 		Environment:  projOpts.Environment,
 		ComposeFiles: projOpts.ConfigPaths,
 	}
@@ -115,3 +115,51 @@ func TestComposeEnv(t *testing.T) {
 	assert.Len(t, p.Services, 2)
 	assert.Equal(t, types.NewMappingWithEquals([]string{"A=${A}"}), p.Services["service1"].Environment)
 }
+
+func TestLoaderOverride(t *testing.T) {
+	t.Setenv("COMPOSE_DISABLE_ENV_FILE", "1")
+
+	loader := NewLoader(WithPath("../../../testdata/multiple/compose1.yaml", "../../../testdata/multiple/compose3.yaml"))
+	p, err := loader.LoadProject(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "multiple", p.Name)
+	assert.Len(t, p.Services, 1)
+	service1 := p.Services["service1"]
+	assert.Equal(t, "example:prod", service1.Image)
+	assert.Equal(t, types.NewMappingWithEquals([]string{"A=${A}", "B=b"}), service1.Environment)
+	assert.Len(t, service1.Ports, 1)
+	assert.Len(t, p.ComposeFiles, 2)
+}
+
+func TestLoaderInterpolation(t *testing.T) {
+	const composeFile = "../../../testdata/multiple/compose1.yaml"
+	envFile := filepath.Join(t.TempDir(), "test.env")
+	if err := os.WriteFile(envFile, []byte("A=fromfile\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("COMPOSE_DISABLE_ENV_FILE", "1")
+	t.Setenv("A", "fromos")
+
+	tests := []struct {
+		name string
+		opts []LoaderOption
+		want string
+	}{
+		{"default", nil, "${A}"},
+		{"env file", []LoaderOption{WithEnvFiles(envFile)}, "fromfile"},
+		{"os env", []LoaderOption{WithOsEnv(true)}, "fromos"},
+		{"no interpolate", []LoaderOption{WithEnvFiles(envFile), WithNoInterpolate(true)}, "${A}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(append(tt.opts, WithPath(composeFile))...)
+			p, err := loader.LoadProject(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, types.NewMappingWithEquals([]string{"A=" + tt.want}), p.Services["service1"].Environment)
+		})
+	}
+}
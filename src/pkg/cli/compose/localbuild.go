@@ -0,0 +1,125 @@
+package compose
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/progress"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// defaultBuildPlatform is the platform of the images built locally, unless the service has a platform; the services
+// run on amd64, which might not be the platform of the local machine.
+const defaultBuildPlatform = "linux/amd64"
+
+// ValidateRegistry checks that the registry for local builds is a repository, like "ghcr.io/org/app", since each
+// service is pushed with its own tag.
+func ValidateRegistry(registry string) error {
+	name := registry[strings.LastIndex(registry, "/")+1:]
+	if registry == "" || strings.Contains(registry, "://") || strings.ContainsAny(name, ":@") {
+		return fmt.Errorf("invalid registry %q: expected a repository without a tag, like ghcr.io/org/app", registry)
+	}
+	return nil
+}
+
+// localBuildArgs returns the arguments of "docker build" for the service, tagged as the given image.
+func localBuildArgs(svccfg *composeTypes.ServiceConfig, image string) []string {
+	build := svccfg.Build
+	platform := svccfg.Platform
+	if platform == "" {
+		platform = defaultBuildPlatform
+	}
+	args := []string{"build", "--platform", platform, "--tag", image}
+	if build.Dockerfile != "" {
+		dockerfile := build.Dockerfile
+		if !filepath.IsAbs(dockerfile) {
+			dockerfile = filepath.Join(build.Context, dockerfile)
+		}
+		args = append(args, "--file", dockerfile)
+	}
+	if build.Target != "" {
+		args = append(args, "--target", build.Target)
+	}
+	for _, key := range slices.Sorted(maps.Keys(build.Args)) {
+		if value := build.Args[key]; value != nil {
+			args = append(args, "--build-arg", key+"="+*value)
+		}
+	}
+	if build.ShmSize > 0 {
+		args = append(args, "--shm-size", fmt.Sprint(int64(build.ShmSize)))
+	}
+	return append(args, build.Context)
+}
+
+// pushedDigest returns the reference by digest of the image pushed to the repository, from its RepoDigests.
+func pushedDigest(repoDigests []string, repository string) (string, bool) {
+	// Docker leaves out the Docker Hub registry from the names in RepoDigests
+	short := strings.TrimPrefix(strings.TrimPrefix(repository, "docker.io/"), "index.docker.io/")
+	for _, digest := range repoDigests {
+		if name, hash, ok := strings.Cut(digest, "@"); ok && (name == repository || name == short) {
+			return repository + "@" + hash, true
+		}
+	}
+	return "", false
+}
+
+// runDocker runs the docker CLI and returns its output; the output is part of the error if it fails.
+var runDocker = func(ctx context.Context, args ...string) ([]byte, error) {
+	termFromContext(ctx).Debug("Running docker", strings.Join(args, " "))
+	// #nosec G204
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("docker %s failed: %w\n%s", args[0], err, strings.TrimSpace(out.String()))
+	}
+	return out.Bytes(), nil
+}
+
+// buildLocalImage builds the image of the service with the local Docker daemon and pushes it to the registry, using
+// the credentials of "docker login". Returns the pushed image by digest, so the deployment runs exactly this build.
+func buildLocalImage(ctx context.Context, projectName string, svccfg *composeTypes.ServiceConfig, registry string) (string, error) {
+	if svccfg.Build.Dockerfile == RAILPACK {
+		return "", errors.New("building locally requires a Dockerfile")
+	}
+	image := registry + ":" + projectName + "-" + svccfg.Name
+
+	if !progress.Report(ctx, svccfg.Name, progress.PhaseBuilding) {
+		termFromContext(ctx).Info("Building the image for", svccfg.Name, "locally")
+	}
+	if _, err := runDocker(ctx, localBuildArgs(svccfg, image)...); err != nil {
+		return "", err
+	}
+	if !progress.Report(ctx, svccfg.Name, progress.PhasePushing) {
+		termFromContext(ctx).Debug("Pushing", image)
+	}
+	if _, err := runDocker(ctx, "push", image); err != nil {
+		return "", err
+	}
+
+	out, err := runDocker(ctx, "image", "inspect", "--format", "{{json .RepoDigests}}", image)
+	if err != nil {
+		return "", err
+	}
+	var repoDigests []string
+	if err := json.Unmarshal(out, &repoDigests); err != nil {
+		return "", fmt.Errorf("failed to get the digest of %s: %w", image, err)
+	}
+	digest, ok := pushedDigest(repoDigests, registry)
+	if !ok {
+		return "", fmt.Errorf("failed to get the digest of %s: not pushed to %s", image, registry)
+	}
+	return digest, nil
+}
@@ -0,0 +1,98 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRegistry(t *testing.T) {
+	for registry, valid := range map[string]bool{
+		"ghcr.io/org/app":         true,
+		"localhost:5000/app":      true,
+		"docker.io/user/app":      true,
+		"":                        false,
+		"ghcr.io/org/app:latest":  false,
+		"ghcr.io/org/app@sha256:": false,
+		"https://ghcr.io/org/app": false,
+	} {
+		t.Run(registry, func(t *testing.T) {
+			err := ValidateRegistry(registry)
+			assert.Equal(t, valid, err == nil, err)
+		})
+	}
+}
+
+func TestLocalBuildArgs(t *testing.T) {
+	one, two := "1", "2"
+	svccfg := &composeTypes.ServiceConfig{
+		Name: "app",
+		Build: &composeTypes.BuildConfig{
+			Context:    "/src/app",
+			Dockerfile: "Dockerfile.prod",
+			Target:     "runtime",
+			Args:       composeTypes.MappingWithEquals{"B": &two, "A": &one, "UNSET": nil},
+			ShmSize:    1024,
+		},
+	}
+	assert.Equal(t, []string{
+		"build", "--platform", "linux/amd64", "--tag", "ghcr.io/org/app:project-app",
+		"--file", "/src/app/Dockerfile.prod", "--target", "runtime",
+		"--build-arg", "A=1", "--build-arg", "B=2", "--shm-size", "1024", "/src/app",
+	}, localBuildArgs(svccfg, "ghcr.io/org/app:project-app"))
+
+	svccfg.Platform = "linux/arm64"
+	svccfg.Build = &composeTypes.BuildConfig{Context: "/src/app"}
+	assert.Equal(t, []string{"build", "--platform", "linux/arm64", "--tag", "img", "/src/app"}, localBuildArgs(svccfg, "img"))
+}
+
+func TestPushedDigest(t *testing.T) {
+	repoDigests := []string{"other/app@sha256:111", "user/app@sha256:222", "ghcr.io/org/app@sha256:333"}
+	for repository, expected := range map[string]string{
+		"ghcr.io/org/app":    "ghcr.io/org/app@sha256:333",
+		"docker.io/user/app": "docker.io/user/app@sha256:222",
+		"user/app":           "user/app@sha256:222",
+		"ghcr.io/org/other":  "",
+	} {
+		digest, ok := pushedDigest(repoDigests, repository)
+		assert.Equal(t, expected, digest, repository)
+		assert.Equal(t, expected != "", ok, repository)
+	}
+}
+
+func TestBuildLocalImage(t *testing.T) {
+	var commands []string
+	old := runDocker
+	t.Cleanup(func() { runDocker = old })
+	runDocker = func(ctx context.Context, args ...string) ([]byte, error) {
+		commands = append(commands, args[0])
+		switch args[0] {
+		case "image":
+			return []byte(`["ghcr.io/org/app@sha256:abc"]` + "\n"), nil
+		case "push":
+			if strings.HasSuffix(args[1], "-denied") {
+				return nil, errors.New("docker push failed: denied")
+			}
+		}
+		return nil, nil
+	}
+
+	svccfg := &composeTypes.ServiceConfig{Name: "web", Build: &composeTypes.BuildConfig{Context: "/src/web"}}
+	image, err := buildLocalImage(t.Context(), "project", svccfg, "ghcr.io/org/app")
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io/org/app@sha256:abc", image)
+	assert.Equal(t, []string{"build", "push", "image"}, commands)
+
+	svccfg.Name = "denied"
+	_, err = buildLocalImage(t.Context(), "project", svccfg, "ghcr.io/org/app")
+	assert.ErrorContains(t, err, "denied")
+
+	svccfg.Build.Dockerfile = RAILPACK
+	_, err = buildLocalImage(t.Context(), "project", svccfg, "ghcr.io/org/app")
+	assert.ErrorContains(t, err, "requires a Dockerfile")
+}
@@ -0,0 +1,90 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// The x-defang-maintenance extension makes the ingress serve a maintenance page instead of the service
+const maintenanceExtension = "x-defang-maintenance"
+
+const maintenanceSyntax = `x-defang-maintenance must be a boolean or object {"message": string}`
+
+// ParseMaintenance parses the x-defang-maintenance extension. Returns nil if maintenance mode is off.
+func ParseMaintenance(maintenance any) (*defangv1.Maintenance, error) {
+	switch maintenance := maintenance.(type) {
+	case nil:
+		return &defangv1.Maintenance{}, nil
+	case bool:
+		if !maintenance {
+			return nil, nil
+		}
+		return &defangv1.Maintenance{}, nil
+	case string:
+		enabled, err := strconv.ParseBool(maintenance)
+		if err != nil {
+			return nil, errors.New(maintenanceSyntax)
+		}
+		return ParseMaintenance(enabled)
+	case map[string]any:
+		result := &defangv1.Maintenance{}
+		for key, val := range maintenance {
+			if key != "message" {
+				return nil, errors.New(maintenanceSyntax)
+			}
+			message, ok := val.(string)
+			if !ok {
+				return nil, errors.New("x-defang-maintenance: 'message' must be a string")
+			}
+			result.Message = message
+		}
+		return result, nil
+	default:
+		return nil, errors.New(maintenanceSyntax)
+	}
+}
+
+// IsInMaintenance returns true if the ingress of the service serves a maintenance page.
+func IsInMaintenance(service *composeTypes.ServiceConfig) bool {
+	maintenanceVal, ok := service.Extensions[maintenanceExtension]
+	if !ok {
+		return false
+	}
+	maintenance, _ := ParseMaintenance(maintenanceVal)
+	return maintenance != nil
+}
+
+// EnableMaintenance makes the ingress of the service serve a maintenance page with the given message,
+// while the service itself keeps running. Calling it again updates the message.
+func EnableMaintenance(service *composeTypes.ServiceConfig, message string) error {
+	if !hasIngressPort(service) {
+		return fmt.Errorf("service %q: only services with ingress ports can be put in maintenance mode", service.Name)
+	}
+
+	service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+	if service.Extensions == nil {
+		service.Extensions = make(composeTypes.Extensions)
+	}
+	if message == "" {
+		service.Extensions[maintenanceExtension] = true
+	} else {
+		service.Extensions[maintenanceExtension] = map[string]any{"message": message}
+	}
+	return nil
+}
+
+// DisableMaintenance restores the traffic to a service put in maintenance mode with EnableMaintenance.
+func DisableMaintenance(service *composeTypes.ServiceConfig) error {
+	if !IsInMaintenance(service) {
+		return fmt.Errorf("service %q is not in maintenance mode", service.Name)
+	}
+
+	service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+	delete(service.Extensions, maintenanceExtension)
+	return nil
+}
@@ -0,0 +1,96 @@
+package compose
+
+import (
+	"testing"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseMaintenance(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    *defangv1.Maintenance
+		wantErr string
+	}{
+		{"true", true, &defangv1.Maintenance{}, ""},
+		{"false", false, nil, ""},
+		{"string", "false", nil, ""},
+		{"message", map[string]any{"message": "Back at 9:00 UTC"}, &defangv1.Maintenance{Message: "Back at 9:00 UTC"}, ""},
+		{"bad message", map[string]any{"message": 9}, nil, "x-defang-maintenance: 'message' must be a string"},
+		{"unknown key", map[string]any{"until": "9:00"}, nil, maintenanceSyntax},
+		{"invalid", 42, nil, maintenanceSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMaintenance(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEnableDisableMaintenance(t *testing.T) {
+	original := composeTypes.ServiceConfig{
+		Name:       "web",
+		Image:      "nginx",
+		Ports:      []composeTypes.ServicePortConfig{{Target: 80, Mode: Mode_INGRESS}},
+		Extensions: composeTypes.Extensions{"x-defang-ingress": map[string]any{"paths": []any{"/"}}},
+	}
+
+	service := original
+	if err := EnableMaintenance(&service, "Back at 9:00 UTC"); err != nil {
+		t.Fatal(err)
+	}
+	if !IsInMaintenance(&service) {
+		t.Fatal("expected service to be in maintenance mode")
+	}
+	if IsInMaintenance(&original) {
+		t.Error("expected the original service to be unmodified")
+	}
+
+	// Make sure the maintenance mode survives a round-trip through the deployed Compose file
+	bytes, err := MarshalYAML(&composeTypes.Project{Name: "app", Services: composeTypes.Services{"web": service}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployed, err := LoadFromContent(t.Context(), bytes, "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service = deployed.Services["web"]
+	ingress := GetIngress(&service)
+	if ingress.GetMaintenance().GetMessage() != "Back at 9:00 UTC" {
+		t.Errorf("expected maintenance message in the ingress options, got %v", ingress)
+	}
+	if len(ingress.Paths) != 1 {
+		t.Errorf("expected the other ingress options to be kept, got %v", ingress)
+	}
+
+	if err := DisableMaintenance(&service); err != nil {
+		t.Fatal(err)
+	}
+	if IsInMaintenance(&service) || GetIngress(&service).GetMaintenance() != nil {
+		t.Error("expected service not to be in maintenance mode")
+	}
+	if err := DisableMaintenance(&service); err == nil {
+		t.Error("expected error when disabling maintenance mode twice")
+	}
+
+	worker := composeTypes.ServiceConfig{Name: "worker", Image: "worker"}
+	if err := EnableMaintenance(&worker, ""); err == nil {
+		t.Error("expected error for a service without ingress ports")
+	}
+}
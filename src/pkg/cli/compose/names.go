@@ -0,0 +1,167 @@
+package compose
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// MaxNameLength is the maximum length of a project or service name, since each ends up as a DNS label
+const MaxNameLength = 63
+
+type LongNameStrategy string
+
+const (
+	LongNamesWarn     LongNameStrategy = "warn"     // the default: warn about names that are too long for some providers
+	LongNamesError    LongNameStrategy = "error"    // reject names that are too long
+	LongNamesTruncate LongNameStrategy = "truncate" // shorten names that are too long with ShortenName
+)
+
+// GetLongNameStrategy returns the strategy for over-long names from the x-defang-long-names project extension.
+func GetLongNameStrategy(project *composeTypes.Project) (LongNameStrategy, error) {
+	switch strategy := project.Extensions["x-defang-long-names"]; strategy {
+	case nil, string(LongNamesWarn):
+		return LongNamesWarn, nil
+	case string(LongNamesError):
+		return LongNamesError, nil
+	case string(LongNamesTruncate):
+		return LongNamesTruncate, nil
+	default:
+		return "", fmt.Errorf("x-defang-long-names must be one of %q, %q, or %q, got %v", LongNamesWarn, LongNamesError, LongNamesTruncate, strategy)
+	}
+}
+
+// ShortenName deterministically shortens a name longer than maxLength, by truncating it and
+// appending a hash of the full name, so distinct long names still map to distinct short names.
+func ShortenName(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(hash[:])[:8]
+	return strings.TrimRight(name[:maxLength-len(suffix)], "-_.") + suffix
+}
+
+// shortenLongNames renames the project and services with names longer than MaxNameLength, if the project
+// opted in with "x-defang-long-names: truncate". References to renamed services are updated as well.
+func shortenLongNames(ctx context.Context, project *composeTypes.Project, suppressWarn bool) error {
+	strategy, err := GetLongNameStrategy(project)
+	if err != nil || strategy != LongNamesTruncate {
+		return err
+	}
+	t := termFromContext(ctx)
+	logf := t.Infof
+	if suppressWarn {
+		logf = t.Debugf
+	}
+
+	if name := ShortenName(project.Name, MaxNameLength); name != project.Name {
+		logf("Project name %q is too long; using %q", project.Name, name)
+		project.Name = name
+	}
+
+	renamed := make(map[string]string)
+	var quotedNames []string
+	for _, oldName := range slices.Sorted(maps.Keys(project.Services)) {
+		newName := ShortenName(oldName, MaxNameLength)
+		if newName == oldName {
+			continue
+		}
+		logf("Service name %q is too long; using %q", oldName, newName)
+		svccfg := project.Services[oldName]
+		svccfg.Name = newName
+		delete(project.Services, oldName)
+		project.Services[newName] = svccfg
+		renamed[oldName] = newName
+		quotedNames = append(quotedNames, regexp.QuoteMeta(oldName))
+	}
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	serviceNames := makeServiceNameRegex(quotedNames)
+	rename := func(value *string) *string {
+		if value == nil {
+			return nil
+		}
+		replaced := replaceServiceNames(*value, serviceNames, renamed)
+		return &replaced
+	}
+	for name, svccfg := range project.Services {
+		for dep, config := range svccfg.DependsOn {
+			if newName, ok := renamed[dep]; ok {
+				delete(svccfg.DependsOn, dep)
+				svccfg.DependsOn[newName] = config
+			}
+		}
+		for i, link := range svccfg.Links {
+			service, alias, hasAlias := strings.Cut(link, ":")
+			if newName, ok := renamed[service]; ok {
+				if !hasAlias {
+					alias = service // keep the old name as the hostname
+				}
+				svccfg.Links[i] = newName + ":" + alias
+			}
+		}
+		for key, value := range svccfg.Environment {
+			svccfg.Environment[key] = rename(value)
+		}
+		if svccfg.Build != nil {
+			for key, value := range svccfg.Build.Args {
+				svccfg.Build.Args[key] = rename(value)
+			}
+		}
+		project.Services[name] = svccfg
+	}
+	return nil
+}
+
+// replaceServiceNames replaces each service name matched by the regex (see makeServiceNameRegex) with its new name.
+func replaceServiceNames(value string, serviceNames *regexp.Regexp, renamed map[string]string) string {
+	var sb strings.Builder
+	last := 0
+	for _, match := range serviceNames.FindAllStringSubmatchIndex(value, -1) {
+		sb.WriteString(value[last:match[2]])
+		sb.WriteString(renamed[value[match[2]:match[3]]])
+		last = match[3]
+	}
+	sb.WriteString(value[last:])
+	return sb.String()
+}
+
+// validateNameLengths checks the project and service names against MaxNameLength. Not every provider enforces
+// the limit, so this only warns, unless the project opted in with "x-defang-long-names: error".
+func validateNameLengths(ctx context.Context, project *composeTypes.Project) error {
+	strategy, err := GetLongNameStrategy(project)
+	if err != nil || strategy == LongNamesTruncate {
+		return err // names will have been shortened by the loader
+	}
+	var errs []error
+	if len(project.Name) > MaxNameLength {
+		errs = append(errs, fmt.Errorf("project name %q is too long (%d > %d characters); use a shorter name or add 'x-defang-long-names: truncate' to the project", project.Name, len(project.Name), MaxNameLength))
+	}
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		if svccfg := project.Services[name]; len(svccfg.Name) > MaxNameLength {
+			errs = append(errs, fmt.Errorf("service name %q is too long (%d > %d characters); use a shorter name or add 'x-defang-long-names: truncate' to the project", svccfg.Name, len(svccfg.Name), MaxNameLength))
+		}
+	}
+	for i, err := range errs {
+		if strategy == LongNamesError {
+			errs[i] = &CodedError{Code: CodeNameTooLong, Err: err}
+		} else {
+			warnf(ctx, CodeNameTooLong, "%v; some providers may fail to deploy it", err)
+		}
+	}
+	if strategy == LongNamesError {
+		return errors.Join(errs...)
+	}
+	return nil
+}
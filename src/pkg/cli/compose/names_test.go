@@ -0,0 +1,145 @@
+package compose
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestShortenName(t *testing.T) {
+	long := strings.Repeat("generated-service-", 5)
+	short := ShortenName(long, MaxNameLength)
+	if len(short) > MaxNameLength {
+		t.Errorf("expected at most %d characters, got %d: %q", MaxNameLength, len(short), short)
+	}
+	if short != ShortenName(long, MaxNameLength) {
+		t.Error("expected ShortenName to be deterministic")
+	}
+	if other := ShortenName(long+"2", MaxNameLength); other == short {
+		t.Errorf("expected distinct names to be shortened differently, got %q for both", short)
+	}
+	if strings.Contains(short, "--") {
+		t.Errorf("expected no double hyphen, got %q", short)
+	}
+	if got := ShortenName("web", MaxNameLength); got != "web" {
+		t.Errorf("expected short names to be unchanged, got %q", got)
+	}
+}
+
+func TestShortenLongNames(t *testing.T) {
+	longName := strings.Repeat("a", 70)
+	url := "http://" + longName + ":8080/api"
+	newProject := func(strategy any) *composeTypes.Project {
+		return &composeTypes.Project{
+			Name:       strings.Repeat("p", 70),
+			Extensions: composeTypes.Extensions{"x-defang-long-names": strategy},
+			Services: composeTypes.Services{
+				longName: {Name: longName, Image: "api"},
+				"web": {
+					Name:        "web",
+					Image:       "web",
+					DependsOn:   composeTypes.DependsOnConfig{longName: {Condition: "service_started"}},
+					Environment: composeTypes.MappingWithEquals{"API_URL": &url, "UNSET": nil},
+				},
+			},
+		}
+	}
+
+	t.Run("warn", func(t *testing.T) {
+		stdout, _ := term.SetupTestTerm(t)
+		project := newProject(nil)
+		if err := shortenLongNames(t.Context(), project, true); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := project.Services[longName]; !ok {
+			t.Error("expected service names to be unchanged")
+		}
+		if err := validateNameLengths(t.Context(), project); err != nil {
+			t.Errorf("expected only warnings, got: %v", err)
+		}
+		if got := stdout.String(); !strings.Contains(got, "project name") || !strings.Contains(got, "service name") {
+			t.Errorf("expected warnings for the project and service names, got: %q", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		project := newProject("error")
+		if err := shortenLongNames(t.Context(), project, true); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := project.Services[longName]; !ok {
+			t.Error("expected service names to be unchanged")
+		}
+		err := validateNameLengths(t.Context(), project)
+		if err == nil || !strings.Contains(err.Error(), "project name") || !strings.Contains(err.Error(), "service name") {
+			t.Errorf("expected errors for the project and service names, got: %v", err)
+		}
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		project := newProject("truncate")
+		if err := shortenLongNames(t.Context(), project, true); err != nil {
+			t.Fatal(err)
+		}
+		newName := ShortenName(longName, MaxNameLength)
+		if project.Name != ShortenName(strings.Repeat("p", 70), MaxNameLength) {
+			t.Errorf("expected project name to be shortened, got %q", project.Name)
+		}
+		if svccfg, ok := project.Services[newName]; !ok || svccfg.Name != newName {
+			t.Fatalf("expected service to be renamed to %q", newName)
+		}
+		web := project.Services["web"]
+		if _, ok := web.DependsOn[newName]; !ok || len(web.DependsOn) != 1 {
+			t.Errorf("expected depends_on to be renamed, got %v", web.DependsOn)
+		}
+		if got := *web.Environment["API_URL"]; got != "http://"+newName+":8080/api" {
+			t.Errorf("expected reference to be renamed, got %q", got)
+		}
+		if web.Environment["UNSET"] != nil {
+			t.Error("expected unset variable to remain unset")
+		}
+		if err := validateNameLengths(t.Context(), project); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if err := shortenLongNames(t.Context(), newProject("hash"), true); err == nil {
+			t.Error("expected an error for an invalid strategy")
+		}
+	})
+}
+
+func TestValidateNameLengths(t *testing.T) {
+	newProject := func(strategy any) *composeTypes.Project {
+		return &composeTypes.Project{
+			Name:       strings.Repeat("p", 70),
+			Extensions: composeTypes.Extensions{"x-defang-long-names": strategy},
+		}
+	}
+	message := `project name "` + strings.Repeat("p", 70) + `" is too long (70 > 63 characters); use a shorter name or add 'x-defang-long-names: truncate' to the project`
+
+	t.Run("warn", func(t *testing.T) {
+		var out bytes.Buffer
+		ctx := WithTerm(t.Context(), term.NewTerm(os.Stdin, &out, &out))
+		if err := validateNameLengths(ctx, newProject(nil)); err != nil {
+			t.Fatalf("expected only a warning, got: %v", err)
+		}
+		want := " ! " + message + "; some providers may fail to deploy it (DFG1034: https://s.defang.io/dfg1034)\n"
+		if got := out.String(); got != want {
+			t.Errorf("expected warning %q, got %q", want, got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := validateNameLengths(t.Context(), newProject("error"))
+		want := message + " (DFG1034: https://s.defang.io/dfg1034)"
+		if err == nil || err.Error() != want {
+			t.Errorf("expected error %q, got %v", want, err)
+		}
+	})
+}
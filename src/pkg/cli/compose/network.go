@@ -0,0 +1,94 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// NetworkConfig is the project-level x-defang-network extension, used to deploy into an existing network
+// instead of having the CD create a new default VPC.
+type NetworkConfig struct {
+	Vpc            string   // VPC ID (AWS) or VPC network name (GCP)
+	PrivateSubnets []string // subnet IDs (AWS) or subnetwork names (GCP)
+	SecurityGroups []string // security group IDs (AWS only)
+}
+
+const networkSyntax = `x-defang-network must be an object {"vpc": string, "private_subnets": string[], "security_groups": string[]}`
+
+// GetNetworkConfig parses the x-defang-network extension of the project; returns nil if not set.
+func GetNetworkConfig(project *composeTypes.Project) (*NetworkConfig, error) {
+	networkVal, ok := project.Extensions["x-defang-network"]
+	if !ok || networkVal == nil {
+		return nil, nil
+	}
+	network, ok := networkVal.(map[string]any)
+	if !ok {
+		return nil, errors.New(networkSyntax)
+	}
+
+	var config NetworkConfig
+	if vpc, ok := network["vpc"]; ok {
+		if config.Vpc, ok = vpc.(string); !ok {
+			return nil, errors.New("x-defang-network: 'vpc' must be a string")
+		}
+	}
+	var err error
+	if config.PrivateSubnets, err = getStringList(network, "private_subnets"); err != nil {
+		return nil, err
+	}
+	if config.SecurityGroups, err = getStringList(network, "security_groups"); err != nil {
+		return nil, err
+	}
+
+	if config.Vpc == "" {
+		return nil, errors.New("x-defang-network: 'vpc' is required")
+	}
+	return &config, nil
+}
+
+func getStringList(obj map[string]any, key string) ([]string, error) {
+	val, ok := obj[key]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("x-defang-network: '%s' must be a list of strings", key)
+	}
+	var strs []string
+	for _, item := range list {
+		str, ok := item.(string)
+		if !ok || str == "" {
+			return nil, fmt.Errorf("x-defang-network: '%s' must be a list of strings", key)
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func validateNetworkConfig(ctx context.Context, project *composeTypes.Project) error {
+	network, err := GetNetworkConfig(project)
+	if err != nil || network == nil {
+		return err
+	}
+	if len(network.PrivateSubnets) == 1 {
+		warnf(ctx, CodeSingleSubnet, "x-defang-network: a single private subnet does not provide high availability; specify subnets in at least 2 availability zones")
+	}
+	return nil
+}
+
+// Environment returns the CD environment variables for the network configuration.
+func (n *NetworkConfig) Environment() map[string]string {
+	env := map[string]string{"DEFANG_VPC": n.Vpc}
+	if len(n.PrivateSubnets) > 0 {
+		env["DEFANG_PRIVATE_SUBNETS"] = strings.Join(n.PrivateSubnets, ",")
+	}
+	if len(n.SecurityGroups) > 0 {
+		env["DEFANG_SECURITY_GROUPS"] = strings.Join(n.SecurityGroups, ",")
+	}
+	return env
+}
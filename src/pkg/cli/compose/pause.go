@@ -0,0 +1,84 @@
+package compose
+
+import (
+	"fmt"
+	"maps"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// The x-defang-paused extension keeps the scaling settings of a paused service, so it can be resumed as it was
+const pausedExtension = "x-defang-paused"
+
+// IsPaused returns true if the service was scaled to zero with PauseService.
+func IsPaused(service *composeTypes.ServiceConfig) bool {
+	_, ok := service.Extensions[pausedExtension]
+	return ok
+}
+
+// PauseService scales the service to zero replicas. The configured replicas and autoscaling policy
+// are stashed in the x-defang-paused extension, so that ResumeService can restore them.
+func PauseService(service *composeTypes.ServiceConfig) error {
+	if !IsComputeService(service) {
+		return fmt.Errorf("service %q: only compute services can be paused", service.Name)
+	}
+	if IsPaused(service) {
+		return fmt.Errorf("service %q is already paused", service.Name)
+	}
+
+	replicas := 1
+	if service.Deploy != nil && service.Deploy.Replicas != nil {
+		replicas = *service.Deploy.Replicas
+	}
+	if replicas == 0 {
+		return fmt.Errorf("service %q is already scaled to zero replicas", service.Name)
+	}
+	paused := map[string]any{"replicas": replicas}
+
+	service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+	if service.Extensions == nil {
+		service.Extensions = make(composeTypes.Extensions)
+	}
+	if autoscaling, ok := service.Extensions["x-defang-autoscaling"]; ok {
+		// The autoscaler would scale the service back up, so take it out while paused
+		paused["autoscaling"] = autoscaling
+		delete(service.Extensions, "x-defang-autoscaling")
+	}
+	service.Extensions[pausedExtension] = paused
+
+	setReplicas(service, 0)
+	return nil
+}
+
+// ResumeService restores the replicas and autoscaling policy of a service paused with PauseService.
+func ResumeService(service *composeTypes.ServiceConfig) error {
+	if !IsPaused(service) {
+		return fmt.Errorf("service %q is not paused", service.Name)
+	}
+	paused, ok := service.Extensions[pausedExtension].(map[string]any)
+	if !ok {
+		return fmt.Errorf("service %q: x-defang-paused must be an object", service.Name)
+	}
+	replicas, err := toUint32(paused["replicas"])
+	if err != nil {
+		return fmt.Errorf("service %q: x-defang-paused 'replicas' %w", service.Name, err)
+	}
+
+	service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+	if autoscaling, ok := paused["autoscaling"]; ok {
+		service.Extensions["x-defang-autoscaling"] = autoscaling
+	}
+	delete(service.Extensions, pausedExtension)
+
+	setReplicas(service, int(replicas))
+	return nil
+}
+
+func setReplicas(service *composeTypes.ServiceConfig, replicas int) {
+	var deploy composeTypes.DeployConfig
+	if service.Deploy != nil {
+		deploy = *service.Deploy // don't modify the original project
+	}
+	deploy.Replicas = &replicas
+	service.Deploy = &deploy
+}
@@ -0,0 +1,80 @@
+package compose
+
+import (
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestPauseResumeService(t *testing.T) {
+	replicas := 3
+	project := &composeTypes.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"worker": {
+				Name:       "worker",
+				Image:      "worker",
+				Deploy:     &composeTypes.DeployConfig{Replicas: &replicas},
+				Extensions: composeTypes.Extensions{"x-defang-autoscaling": map[string]any{"max_replicas": 5}},
+			},
+			"web": {Name: "web", Image: "nginx"},
+		},
+	}
+
+	for name := range project.Services {
+		service := project.Services[name]
+		if err := PauseService(&service); err != nil {
+			t.Fatalf("service %q: unexpected error: %v", name, err)
+		}
+		if !IsPaused(&service) || *service.Deploy.Replicas != 0 {
+			t.Errorf("service %q: expected paused with 0 replicas", name)
+		}
+		if _, ok := service.Extensions["x-defang-autoscaling"]; ok {
+			t.Errorf("service %q: expected autoscaling to be removed while paused", name)
+		}
+		if err := PauseService(&service); err == nil {
+			t.Errorf("service %q: expected error when pausing twice", name)
+		}
+
+		// Make sure the paused state survives a round-trip through the deployed Compose file
+		bytes, err := MarshalYAML(&composeTypes.Project{Name: "app", Services: composeTypes.Services{name: service}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		deployed, err := LoadFromContent(t.Context(), bytes, "app")
+		if err != nil {
+			t.Fatal(err)
+		}
+		service = deployed.Services[name]
+		if err := ResumeService(&service); err != nil {
+			t.Fatalf("service %q: unexpected error: %v", name, err)
+		}
+		if IsPaused(&service) {
+			t.Errorf("service %q: expected not paused after resume", name)
+		}
+		original := project.Services[name]
+		want := 1
+		if original.Deploy != nil {
+			want = *original.Deploy.Replicas
+		}
+		if got := *service.Deploy.Replicas; got != want {
+			t.Errorf("service %q: expected %d replicas after resume, got %d", name, want, got)
+		}
+		if _, ok := original.Extensions["x-defang-autoscaling"]; ok != (service.Extensions["x-defang-autoscaling"] != nil) {
+			t.Errorf("service %q: expected autoscaling to be restored", name)
+		}
+	}
+
+	if *project.Services["worker"].Deploy.Replicas != 3 || project.Services["web"].Deploy != nil {
+		t.Error("expected the original project to be unmodified")
+	}
+
+	web := project.Services["web"]
+	if err := ResumeService(&web); err == nil {
+		t.Error("expected error when resuming a service that is not paused")
+	}
+	managed := composeTypes.ServiceConfig{Name: "cache", Image: "redis", Extensions: composeTypes.Extensions{"x-defang-redis": true}}
+	if err := PauseService(&managed); err == nil {
+		t.Error("expected error when pausing a managed service")
+	}
+}
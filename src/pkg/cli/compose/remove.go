@@ -0,0 +1,105 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// DependentServices returns the names of the other services that reference any of the given services,
+// either with depends_on, links, or by name in an environment variable or build argument.
+func DependentServices(project *composeTypes.Project, serviceNames ...string) []string {
+	quotedNames := make([]string, len(serviceNames))
+	for i, name := range serviceNames {
+		quotedNames[i] = regexp.QuoteMeta(name)
+	}
+	serviceNameRegex := makeServiceNameRegex(quotedNames)
+	references := func(value *string) bool {
+		return value != nil && serviceNameRegex != nil && serviceNameRegex.MatchString(*value)
+	}
+
+	var dependents []string
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		if slices.Contains(serviceNames, name) {
+			continue
+		}
+		svccfg := project.Services[name]
+		dependent := slices.ContainsFunc(serviceNames, func(serviceName string) bool {
+			_, ok := svccfg.DependsOn[serviceName]
+			return ok
+		}) || slices.ContainsFunc(svccfg.Links, func(link string) bool {
+			service, _, _ := strings.Cut(link, ":")
+			return slices.Contains(serviceNames, service)
+		}) || slices.ContainsFunc(slices.Collect(maps.Values(svccfg.Environment)), references)
+		if !dependent && svccfg.Build != nil {
+			dependent = slices.ContainsFunc(slices.Collect(maps.Values(svccfg.Build.Args)), references)
+		}
+		if dependent {
+			dependents = append(dependents, name)
+		}
+	}
+	return dependents
+}
+
+// RemoveServices removes the given services from the project, as well as any depends_on and links
+// that reference them, so the remaining services can be deployed without them.
+func RemoveServices(project *composeTypes.Project, serviceNames ...string) error {
+	var errs []error
+	for _, name := range serviceNames {
+		if _, ok := project.Services[name]; !ok {
+			errs = append(errs, fmt.Errorf("service %q not found in project %q", name, project.Name))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	if len(project.Services) == len(slices.Compact(slices.Sorted(slices.Values(serviceNames)))) {
+		return errors.New("cannot remove all services; use \"compose down\" to remove the project instead")
+	}
+
+	for _, name := range serviceNames {
+		delete(project.Services, name)
+	}
+	for name, svccfg := range project.Services {
+		for _, removed := range serviceNames {
+			delete(svccfg.DependsOn, removed)
+		}
+		svccfg.Links = slices.DeleteFunc(svccfg.Links, func(link string) bool {
+			service, _, _ := strings.Cut(link, ":")
+			return slices.Contains(serviceNames, service)
+		})
+		project.Services[name] = svccfg
+	}
+	return nil
+}
+
+// OrphanedServices returns the names of the services in the deployed project that are not in the project.
+func OrphanedServices(deployed, project *composeTypes.Project) []string {
+	var orphans []string
+	for _, name := range slices.Sorted(maps.Keys(deployed.Services)) {
+		if _, ok := project.Services[name]; !ok {
+			orphans = append(orphans, name)
+		}
+	}
+	return orphans
+}
+
+// UnusedVolumes returns the names of the top-level volumes that are not mounted by any service of the project.
+func UnusedVolumes(project *composeTypes.Project) []string {
+	var unused []string
+	for _, name := range slices.Sorted(maps.Keys(project.Volumes)) {
+		if !slices.ContainsFunc(slices.Collect(maps.Values(project.Services)), func(svccfg composeTypes.ServiceConfig) bool {
+			return slices.ContainsFunc(svccfg.Volumes, func(volume composeTypes.ServiceVolumeConfig) bool {
+				return volume.Type == composeTypes.VolumeTypeVolume && volume.Source == name
+			})
+		}) {
+			unused = append(unused, name)
+		}
+	}
+	return unused
+}
@@ -0,0 +1,107 @@
+package compose
+
+import (
+	"slices"
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestRemoveServices(t *testing.T) {
+	dbUrl := "postgres://db:5432/app"
+	newProject := func() *composeTypes.Project {
+		return &composeTypes.Project{
+			Name: "app",
+			Services: composeTypes.Services{
+				"db":    {Name: "db", Image: "postgres"},
+				"cache": {Name: "cache", Image: "redis"},
+				"web": {
+					Name:      "web",
+					Image:     "web",
+					DependsOn: composeTypes.DependsOnConfig{"db": {Condition: "service_started"}, "cache": {Condition: "service_started"}},
+					Links:     []string{"cache:redis"},
+				},
+				"worker": {
+					Name:        "worker",
+					Image:       "worker",
+					Environment: composeTypes.MappingWithEquals{"DATABASE_URL": &dbUrl, "UNSET": nil},
+				},
+				"dbadmin": {Name: "dbadmin", Image: "pgadmin"}, // not a reference to "db"
+			},
+		}
+	}
+
+	t.Run("dependents", func(t *testing.T) {
+		project := newProject()
+		if got := DependentServices(project, "db"); !slices.Equal(got, []string{"web", "worker"}) {
+			t.Errorf("expected [web worker], got %v", got)
+		}
+		if got := DependentServices(project, "cache"); !slices.Equal(got, []string{"web"}) {
+			t.Errorf("expected [web], got %v", got)
+		}
+		if got := DependentServices(project, "web"); len(got) != 0 {
+			t.Errorf("expected no dependents, got %v", got)
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		project := newProject()
+		if err := RemoveServices(project, "cache"); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := project.Services["cache"]; ok {
+			t.Error("expected cache to be removed")
+		}
+		web := project.Services["web"]
+		if _, ok := web.DependsOn["cache"]; ok {
+			t.Error("expected depends_on cache to be removed")
+		}
+		if _, ok := web.DependsOn["db"]; !ok {
+			t.Error("expected depends_on db to be kept")
+		}
+		if len(web.Links) != 0 {
+			t.Errorf("expected links to be removed, got %v", web.Links)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		project := newProject()
+		if err := RemoveServices(project, "db", "nope"); err == nil {
+			t.Error("expected error for unknown service")
+		}
+		if len(project.Services) != 5 {
+			t.Error("expected no services to be removed")
+		}
+	})
+
+	t.Run("all services", func(t *testing.T) {
+		project := newProject()
+		if err := RemoveServices(project, "db", "cache", "web", "worker", "dbadmin", "db"); err == nil {
+			t.Error("expected error when removing all services")
+		}
+	})
+}
+
+func TestUnusedVolumes(t *testing.T) {
+	project := &composeTypes.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"db": {Name: "db", Image: "postgres", Volumes: []composeTypes.ServiceVolumeConfig{
+				{Type: composeTypes.VolumeTypeVolume, Source: "data", Target: "/var/lib/postgresql/data"},
+				{Type: composeTypes.VolumeTypeTmpfs, Target: "/tmp"},
+			}},
+			"web": {Name: "web", Image: "web", Volumes: []composeTypes.ServiceVolumeConfig{
+				{Type: composeTypes.VolumeTypeVolume, Target: "/cache"}, // anonymous
+			}},
+		},
+		Volumes: composeTypes.Volumes{"data": {}, "uploads": {}, "logs": {}},
+	}
+	if got := UnusedVolumes(project); !slices.Equal(got, []string{"logs", "uploads"}) {
+		t.Errorf("expected [logs uploads], got %v", got)
+	}
+
+	delete(project.Services, "db")
+	if got := UnusedVolumes(project); !slices.Equal(got, []string{"data", "logs", "uploads"}) {
+		t.Errorf("expected [data logs uploads], got %v", got)
+	}
+}
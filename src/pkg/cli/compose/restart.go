@@ -0,0 +1,27 @@
+package compose
+
+import (
+	"fmt"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// RestartService marks the service with a new revision, so that the next deployment replaces its containers
+// without changing their definition; the new containers also pick up updated config values.
+func RestartService(service *composeTypes.ServiceConfig, revision string) error {
+	if !IsComputeService(service) {
+		return fmt.Errorf("service %q: only compute services can be restarted", service.Name)
+	}
+	if IsPaused(service) {
+		return fmt.Errorf("service %q is paused; use resume to start it again", service.Name)
+	}
+	SetConfigRevision(service, revision)
+	return nil
+}
+
+// RedeployService is like RestartService, for a service whose image has a mutable tag like "latest": the new
+// containers pull the image when they start, so they get the image the tag points to now. The pull policy is left
+// as is, since it would end up in the deployed Compose file. Images built from a build context are not rebuilt.
+func RedeployService(service *composeTypes.ServiceConfig, revision string) error {
+	return RestartService(service, revision)
+}
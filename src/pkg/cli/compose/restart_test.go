@@ -0,0 +1,52 @@
+package compose
+
+import (
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestRestartService(t *testing.T) {
+	t.Run("restart", func(t *testing.T) {
+		original := composeTypes.ServiceConfig{Name: "web", Image: "nginx"}
+		service := original
+		if err := RestartService(&service, "rev1"); err != nil {
+			t.Fatal(err)
+		}
+		if service.Extensions[configRevisionExtension] != "rev1" || service.PullPolicy != "" {
+			t.Errorf("expected only a new revision, got %v, %q", service.Extensions, service.PullPolicy)
+		}
+		if original.Extensions != nil {
+			t.Error("expected the original service to be unchanged")
+		}
+	})
+
+	t.Run("redeploy", func(t *testing.T) {
+		service := composeTypes.ServiceConfig{Name: "web", Image: "nginx:latest"}
+		if err := RedeployService(&service, "rev2"); err != nil {
+			t.Fatal(err)
+		}
+		if service.Extensions[configRevisionExtension] != "rev2" || service.PullPolicy != "" {
+			t.Errorf("expected only a new revision, which pulls the image again, got %v, %q", service.Extensions, service.PullPolicy)
+		}
+
+		missing := composeTypes.ServiceConfig{Name: "web", Image: "nginx:latest", PullPolicy: composeTypes.PullPolicyMissing}
+		if err := RedeployService(&missing, "rev2"); err != nil {
+			t.Fatal(err)
+		}
+		if missing.PullPolicy != composeTypes.PullPolicyMissing {
+			t.Errorf("expected the pull policy to be kept, got %q", missing.PullPolicy)
+		}
+	})
+
+	t.Run("not restartable", func(t *testing.T) {
+		redis := composeTypes.ServiceConfig{Name: "cache", Image: "redis", Extensions: composeTypes.Extensions{"x-defang-redis": true}}
+		if err := RestartService(&redis, "rev"); err == nil {
+			t.Error("expected an error for a managed service")
+		}
+		paused := composeTypes.ServiceConfig{Name: "worker", Image: "worker", Extensions: composeTypes.Extensions{pausedExtension: map[string]any{"replicas": 1}}}
+		if err := RestartService(&paused, "rev"); err == nil {
+			t.Error("expected an error for a paused service")
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+const secretsDir = "/run/secrets/"
+
+var envVarNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// secretEnvName returns the name of the environment variable that exposes the secret. Docker mounts a secret at
+// /run/secrets/<target>, so a target like "DB_PASSWORD" or "/run/secrets/DB_PASSWORD" renames the secret. Other
+// targets can't be mapped to an environment variable, in which case ok is false.
+func secretEnvName(secret composeTypes.ServiceSecretConfig) (name string, ok bool) {
+	target := secret.Target
+	if target == "" {
+		return secret.Source, true
+	}
+	if path.IsAbs(target) {
+		if path.Dir(target)+"/" != secretsDir {
+			return secret.Source, false
+		}
+		target = path.Base(target)
+	}
+	if !envVarNameRegex.MatchString(target) {
+		return secret.Source, false
+	}
+	return target, true
+}
+
+// validateSecret checks the top-level definition of a secret. Secret values are stored with "defang config set",
+// so only external secrets are supported; their values are resolved from the config store during deployment.
+func validateSecret(name string, secret composeTypes.SecretConfig) error {
+	kind := ""
+	switch {
+	case secret.File != "":
+		kind = "file"
+	case secret.Environment != "":
+		kind = "environment"
+	case secret.Content != "":
+		kind = "content"
+	}
+	if kind != "" {
+		return errorf(CodeUnsupportedSecret, "unsupported secret %q: the %q option is not supported; store the value with \"defang config set %s\" and mark the secret external: true", name, kind, name)
+	}
+	if secret.Name != "" && secret.Name != name {
+		return errorf(CodeUnsupportedSecret, "unsupported secret %q: cannot override name %q", name, secret.Name) // TODO: support custom secret names
+	}
+	return nil
+}
+
+// warnNonExternalSecrets warns once for each secret used by the services that's not marked external, even if several
+// services use it, since its value is read from config like any other secret.
+func warnNonExternalSecrets(ctx context.Context, project *composeTypes.Project) {
+	used := map[string]bool{}
+	for _, svccfg := range project.Services {
+		for _, secret := range svccfg.Secrets {
+			used[secret.Source] = true
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(used)) {
+		if s, ok := project.Secrets[name]; ok && !bool(s.External) {
+			warnf(ctx, CodeUnsupportedSecret, "unsupported secret %q: not marked external:true; its value is read from config", name)
+		}
+	}
+}
+
+// declareUndefinedSecrets adds the secrets that services use without a top-level definition as external secrets,
+// which is how their values are resolved from config anyway. The deployed compose files have them declared already.
+func declareUndefinedSecrets(project *composeTypes.Project) {
+	for _, svccfg := range project.Services {
+		for _, secret := range svccfg.Secrets {
+			if _, ok := project.Secrets[secret.Source]; ok {
+				continue
+			}
+			if project.Secrets == nil {
+				project.Secrets = composeTypes.Secrets{}
+			}
+			project.Secrets[secret.Source] = composeTypes.SecretConfig{Name: secret.Source, External: true}
+		}
+	}
+}
+
+// checkServiceReferences checks that the secrets and configs used by the services are defined at the top level, like
+// the consistency check of compose-go, which can't be run on a loaded project. Undefined networks, volumes, and
+// dependencies are checked by ValidateProject instead, with a code.
+func checkServiceReferences(project *composeTypes.Project) error {
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		svccfg := project.Services[name]
+		for _, secret := range svccfg.Secrets {
+			if _, ok := project.Secrets[secret.Source]; !ok {
+				return fmt.Errorf("service %q refers to undefined secret %s", name, secret.Source)
+			}
+		}
+		if svccfg.Build != nil {
+			for _, secret := range svccfg.Build.Secrets {
+				if _, ok := project.Secrets[secret.Source]; !ok {
+					return fmt.Errorf("service %q refers to undefined build secret %s", name, secret.Source)
+				}
+			}
+		}
+		for _, config := range svccfg.Configs {
+			if _, ok := project.Configs[config.Source]; !ok {
+				return fmt.Errorf("service %q refers to undefined config %s", name, config.Source)
+			}
+		}
+	}
+	return nil
+}
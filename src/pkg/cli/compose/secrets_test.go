@@ -0,0 +1,145 @@
+package compose
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretEnvName(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+		wantOk bool
+	}{
+		{"", "api_key", true},
+		{"/run/secrets/api_key", "api_key", true},
+		{"API_KEY", "API_KEY", true},
+		{"/run/secrets/API_KEY", "API_KEY", true},
+		{"/etc/ssl/key.pem", "api_key", false},
+		{"key.pem", "api_key", false},
+		{"/run/secrets/nested/key", "api_key", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, ok := secretEnvName(composeTypes.ServiceSecretConfig{Source: "api_key", Target: tt.target})
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("secretEnvName() = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestValidateSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  composeTypes.SecretConfig
+		wantErr bool
+	}{
+		{"external", composeTypes.SecretConfig{External: true}, false},
+		{"not external", composeTypes.SecretConfig{}, false},
+		{"file", composeTypes.SecretConfig{File: "./api_key.txt"}, true},
+		{"environment", composeTypes.SecretConfig{Environment: "API_KEY"}, true},
+		{"content", composeTypes.SecretConfig{Content: "hunter2"}, true},
+		{"renamed", composeTypes.SecretConfig{Name: "other", External: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSecret("api_key", tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var coded *CodedError
+			if err != nil && (!errors.As(err, &coded) || coded.Code != CodeUnsupportedSecret) {
+				t.Errorf("expected a %s error, got %v", CodeUnsupportedSecret, err)
+			}
+		})
+	}
+}
+
+func TestLoadUndefinedSecrets(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	project, err := LoadFromBuffer(t.Context(), filepath.Join(t.TempDir(), "compose.yaml"), []byte(`services:
+  app:
+    image: nginx
+    secrets:
+      - api_key
+`))
+	require.NoError(t, err)
+	require.Equal(t, composeTypes.SecretConfig{Name: "api_key", External: true}, project.Secrets["api_key"])
+
+	t.Run("undefined config", func(t *testing.T) {
+		_, err := LoadFromBuffer(t.Context(), filepath.Join(t.TempDir(), "compose.yaml"), []byte(`services:
+  app:
+    image: nginx
+    configs:
+      - missing
+`))
+		require.ErrorContains(t, err, `service "app" refers to undefined config missing`)
+	})
+
+	t.Run("deployed compose file", func(t *testing.T) {
+		// The compose file of a previous deployment is loaded as is
+		project, err := LoadFromContent(t.Context(), []byte(`services:
+  app:
+    image: nginx
+    configs:
+      - missing
+`), "test")
+		require.NoError(t, err)
+		require.Empty(t, project.Secrets)
+	})
+}
+
+func TestCheckServiceReferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		service composeTypes.ServiceConfig
+		wantErr string
+	}{
+		{"defined", composeTypes.ServiceConfig{Secrets: []composeTypes.ServiceSecretConfig{{Source: "api_key"}}, Configs: []composeTypes.ServiceConfigObjConfig{{Source: "nginx_conf"}}}, ""},
+		{"secret", composeTypes.ServiceConfig{Secrets: []composeTypes.ServiceSecretConfig{{Source: "missing"}}}, `service "app" refers to undefined secret missing`},
+		{"build secret", composeTypes.ServiceConfig{Build: &composeTypes.BuildConfig{Secrets: []composeTypes.ServiceSecretConfig{{Source: "missing"}}}}, `service "app" refers to undefined build secret missing`},
+		{"config", composeTypes.ServiceConfig{Configs: []composeTypes.ServiceConfigObjConfig{{Source: "missing"}}}, `service "app" refers to undefined config missing`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := &composeTypes.Project{
+				Services: composeTypes.Services{"app": tt.service},
+				Secrets:  composeTypes.Secrets{"api_key": {External: true}},
+				Configs:  composeTypes.Configs{"nginx_conf": {}},
+			}
+			err := checkServiceReferences(project)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWarnNonExternalSecretsOnce(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+	secrets := []composeTypes.ServiceSecretConfig{{Source: "api_key"}}
+	project := &composeTypes.Project{
+		Name: "test",
+		Services: composeTypes.Services{
+			"app":    {Name: "app", Image: "nginx", Secrets: secrets, Environment: composeTypes.MappingWithEquals{}},
+			"worker": {Name: "worker", Image: "nginx", Secrets: secrets, Environment: composeTypes.MappingWithEquals{}},
+		},
+		Secrets: composeTypes.Secrets{"api_key": {}},
+	}
+
+	require.NoError(t, FixupServices(t.Context(), client.MockProvider{}, project, UploadModeIgnore))
+	if count := strings.Count(stdout.String(), `unsupported secret "api_key": not marked external:true`); count != 1 {
+		t.Errorf("expected the secret to be warned about once, got %d times: %s", count, stdout.String())
+	}
+}
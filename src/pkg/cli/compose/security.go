@@ -0,0 +1,57 @@
+package compose
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// defaultCapabilities are granted to containers by default, so adding them is a no-op on every platform
+var defaultCapabilities = []string{
+	"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD", "NET_BIND_SERVICE",
+	"NET_RAW", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+}
+
+// grantableCapabilities can be added on top of the defaults; ECS on Fargate only allows SYS_PTRACE
+var grantableCapabilities = []string{"SYS_PTRACE"}
+
+// NormalizeCapability returns the capability in the canonical form, eg. "cap_sys_ptrace" becomes "SYS_PTRACE".
+func NormalizeCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(capability)), "CAP_")
+}
+
+func fixupCapabilities(svccfg *composeTypes.ServiceConfig) {
+	for i, capability := range svccfg.CapAdd {
+		svccfg.CapAdd[i] = NormalizeCapability(capability)
+	}
+	for i, capability := range svccfg.CapDrop {
+		svccfg.CapDrop[i] = NormalizeCapability(capability)
+	}
+}
+
+// validateSecurity checks that the platform can enforce the security settings of the service
+func validateSecurity(ctx context.Context, svccfg *composeTypes.ServiceConfig) error {
+	if svccfg.Privileged {
+		return errorf(CodeUnsupportedSecurityOpts, "service %q: unsupported compose directive: privileged", svccfg.Name)
+	}
+	for _, capability := range svccfg.CapAdd {
+		capability = NormalizeCapability(capability)
+		if capability == "ALL" || !slices.Contains(defaultCapabilities, capability) && !slices.Contains(grantableCapabilities, capability) {
+			return errorf(CodeCapability, "service %q: cap_add %q cannot be granted; only %v can be added", svccfg.Name, capability, grantableCapabilities)
+		}
+		if slices.ContainsFunc(svccfg.CapDrop, func(dropped string) bool { return NormalizeCapability(dropped) == capability }) {
+			warnf(ctx, CodeCapability, "service %q: capability %q is both added and dropped; it will be dropped", svccfg.Name, capability)
+		}
+	}
+	for _, opt := range svccfg.SecurityOpt {
+		switch opt {
+		case "no-new-privileges", "no-new-privileges:true", "no-new-privileges=true":
+		case "no-new-privileges:false", "no-new-privileges=false":
+		default:
+			return errorf(CodeUnsupportedSecurityOpts, "service %q: unsupported security_opt %q; only no-new-privileges is supported", svccfg.Name, opt)
+		}
+	}
+	return nil
+}
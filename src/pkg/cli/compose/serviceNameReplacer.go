@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
 )
 
@@ -29,7 +28,7 @@ type ServiceNameReplacer struct {
 func NewServiceNameReplacer(ctx context.Context, dnsResolver client.DNSResolver, project *composeTypes.Project) ServiceNameReplacer {
 	var skipPublicReplacement bool
 	if err := dnsResolver.UpdateShardDomain(ctx); err != nil {
-		term.Debugf("failed to update shard domain: %v", err)
+		termFromContext(ctx).Debugf("failed to update shard domain: %v", err)
 		skipPublicReplacement = true
 	}
 	// Create a regexp to detect private service names in environment variable and build arg values
@@ -53,7 +52,7 @@ func NewServiceNameReplacer(ctx context.Context, dnsResolver client.DNSResolver,
 	}
 }
 
-func (s *ServiceNameReplacer) replaceServiceNameWithDNS(value string) string {
+func (s *ServiceNameReplacer) replaceServiceNameWithDNS(ctx context.Context, value string) string {
 	// First check for private services
 	if s.privateServiceNames != nil {
 		match := s.privateServiceNames.FindStringSubmatchIndex(value)
@@ -74,7 +73,7 @@ func (s *ServiceNameReplacer) replaceServiceNameWithDNS(value string) string {
 			serviceEnd := match[3]
 			serviceName := value[serviceStart:serviceEnd]
 			if s.skipPublicReplacement {
-				term.Warnf("service %q: reference to public DNS cannot be replaced in %q, use `defang login` and try again", serviceName, value)
+				warnf(ctx, CodePublicDNSReference, "service %q: reference to public DNS cannot be replaced in %q, use `defang login` and try again", serviceName, value)
 			} else {
 				return value[:serviceStart] + s.dnsResolver.ServicePublicDNS(NormalizeServiceName(serviceName), s.projectName) + value[serviceEnd:]
 			}
@@ -84,13 +83,13 @@ func (s *ServiceNameReplacer) replaceServiceNameWithDNS(value string) string {
 	return value
 }
 
-func (s *ServiceNameReplacer) ReplaceServiceNameWithDNS(serviceName string, key, value string, fixupTarget FixupTarget) string {
-	val := s.replaceServiceNameWithDNS(value)
+func (s *ServiceNameReplacer) ReplaceServiceNameWithDNS(ctx context.Context, serviceName string, key, value string, fixupTarget FixupTarget) string {
+	val := s.replaceServiceNameWithDNS(ctx, value)
 
 	if val != value {
-		term.Debugf("service %q: service name was adjusted: %s %q assigned value %q", serviceName, fixupTarget, key, val)
+		termFromContext(ctx).Debugf("service %q: service name was adjusted: %s %q assigned value %q", serviceName, fixupTarget, key, val)
 	} else if s.publicServiceNames != nil && s.publicServiceNames.MatchString(value) {
-		term.Debugf("service %q: service name in the %s %q was not adjusted; only references to other services with port mode set to 'host' will be fixed-up", serviceName, fixupTarget, key)
+		termFromContext(ctx).Debugf("service %q: service name in the %s %q was not adjusted; only references to other services with port mode set to 'host' will be fixed-up", serviceName, fixupTarget, key)
 	}
 
 	return val
@@ -126,7 +126,7 @@ func TestServiceNameReplacer(t *testing.T) {
 		})
 
 		replacer.skipPublicReplacement = tc.skipPublicReplacement
-		got := replacer.ReplaceServiceNameWithDNS(tc.service, tc.key, tc.value, tc.fixUpTarget)
+		got := replacer.ReplaceServiceNameWithDNS(t.Context(), tc.service, tc.key, tc.value, tc.fixUpTarget)
 		if got != tc.expected {
 			t.Errorf("Expected %q, got %q", tc.expected, got)
 		}
@@ -180,7 +180,7 @@ func TestMakeServiceNameRegex(t *testing.T) {
 		{"ingress-service", "ingress-service.project1.tenant2.defang.app"},
 	}
 	for _, tt := range tdt {
-		if got := s.replaceServiceNameWithDNS(tt.value); got != tt.expected {
+		if got := s.replaceServiceNameWithDNS(t.Context(), tt.value); got != tt.expected {
 			t.Errorf("makeServiceNameRegex(%q) expected %s, got: %s", tt.value, tt.expected, got)
 		}
 	}
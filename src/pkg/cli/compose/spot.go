@@ -0,0 +1,59 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strconv"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// IsSpot returns true if the service tolerates spot/preemptible capacity.
+func IsSpot(service *composeTypes.ServiceConfig) bool {
+	spot, _ := parseSpot(service.Extensions["x-defang-spot"])
+	return spot
+}
+
+func parseSpot(spot any) (bool, error) {
+	switch spot := spot.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return spot, nil
+	case string:
+		if b, err := strconv.ParseBool(spot); err == nil {
+			return b, nil
+		}
+	}
+	return false, errors.New("x-defang-spot must be a boolean")
+}
+
+// isSingleReplicaStateful returns true if the service keeps state and cannot tolerate being preempted,
+// ie. it runs a stateful image or has persistent volumes, with only a single replica.
+func isSingleReplicaStateful(service *composeTypes.ServiceConfig) bool {
+	if service.Deploy != nil && service.Deploy.Replicas != nil && *service.Deploy.Replicas > 1 {
+		return false
+	}
+	return isStatefulImage(service.Image) || hasPersistentVolume(service)
+}
+
+// UseSpotCapacity marks all compute services as tolerant of spot/preemptible capacity,
+// except those that explicitly opt out or cannot tolerate interruptions.
+func UseSpotCapacity(ctx context.Context, project *composeTypes.Project) {
+	for name, service := range project.Services {
+		if _, ok := service.Extensions["x-defang-spot"]; ok || !IsComputeService(&service) {
+			continue
+		}
+		if isSingleReplicaStateful(&service) {
+			warnf(ctx, CodeSpotStateful, "service %q: not using spot capacity for a stateful service with a single replica", name)
+			continue
+		}
+		service.Extensions = maps.Clone(service.Extensions) // don't modify the original project
+		if service.Extensions == nil {
+			service.Extensions = make(composeTypes.Extensions)
+		}
+		service.Extensions["x-defang-spot"] = true
+		project.Services[name] = service
+	}
+}
@@ -0,0 +1,30 @@
+package compose
+
+import (
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestUseSpotCapacity(t *testing.T) {
+	replicas := 3
+	project := &composeTypes.Project{
+		Services: composeTypes.Services{
+			"web":     {Name: "web", Image: "nginx"},
+			"db":      {Name: "db", Image: "postgres:16"},
+			"cluster": {Name: "cluster", Image: "redis", Deploy: &composeTypes.DeployConfig{Replicas: &replicas}},
+			"optout":  {Name: "optout", Image: "nginx", Extensions: composeTypes.Extensions{"x-defang-spot": false}},
+			"managed": {Name: "managed", Image: "redis", Extensions: composeTypes.Extensions{"x-defang-redis": true}},
+		},
+	}
+
+	UseSpotCapacity(t.Context(), project)
+
+	expected := map[string]bool{"web": true, "db": false, "cluster": true, "optout": false, "managed": false}
+	for name, want := range expected {
+		service := project.Services[name]
+		if got := IsSpot(&service); got != want {
+			t.Errorf("service %q: expected spot %v, got %v", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package compose
+
+import (
+	"context"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+type termKey struct{}
+
+// WithTerm returns a context that carries the terminal this package logs to, so a caller can redirect or silence the
+// warnings about the project, like the cli package does with Options.Term.
+func WithTerm(ctx context.Context, t *term.Term) context.Context {
+	return context.WithValue(ctx, termKey{}, t)
+}
+
+// termFromContext returns the terminal of the context; without one, it's term.DefaultTerm.
+func termFromContext(ctx context.Context) *term.Term {
+	if t, ok := ctx.Value(termKey{}).(*term.Term); ok && t != nil {
+		return t
+	}
+	return term.DefaultTerm
+}
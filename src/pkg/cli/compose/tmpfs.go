@@ -0,0 +1,77 @@
+package compose
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/go-units"
+)
+
+// TmpfsMount is an in-memory filesystem mounted into the container, from either "tmpfs" or a volume of type tmpfs.
+type TmpfsMount struct {
+	Target    string
+	SizeBytes int64 // 0 means the platform default
+}
+
+// GetTmpfsMounts returns the tmpfs mounts of the service. The short syntax "tmpfs: /run:size=64m,mode=1777"
+// accepts the same options as "docker run --tmpfs".
+func GetTmpfsMounts(svccfg *composeTypes.ServiceConfig) ([]TmpfsMount, error) {
+	var mounts []TmpfsMount
+	for _, tmpfs := range svccfg.Tmpfs {
+		target, options, _ := strings.Cut(tmpfs, ":")
+		mount := TmpfsMount{Target: target}
+		for option := range strings.SplitSeq(options, ",") {
+			key, value, _ := strings.Cut(option, "=")
+			if key != "size" {
+				continue // other options, like mode, don't affect the limits
+			}
+			size, err := units.RAMInBytes(value)
+			if err != nil || size < 0 {
+				return nil, fmt.Errorf("invalid tmpfs size %q for %q", value, target)
+			}
+			mount.SizeBytes = size
+		}
+		mounts = append(mounts, mount)
+	}
+	for _, volume := range svccfg.Volumes {
+		if volume.Type != composeTypes.VolumeTypeTmpfs {
+			continue
+		}
+		mount := TmpfsMount{Target: volume.Target}
+		if volume.Tmpfs != nil {
+			if volume.Tmpfs.Size < 0 {
+				return nil, fmt.Errorf("invalid tmpfs size %d for %q", volume.Tmpfs.Size, volume.Target)
+			}
+			mount.SizeBytes = int64(volume.Tmpfs.Size)
+		}
+		mounts = append(mounts, mount)
+	}
+
+	targets := make(map[string]bool)
+	for _, mount := range mounts {
+		if !path.IsAbs(mount.Target) {
+			return nil, fmt.Errorf("tmpfs target %q must be an absolute path", mount.Target)
+		}
+		if targets[path.Clean(mount.Target)] {
+			return nil, fmt.Errorf("duplicate tmpfs target %q", mount.Target)
+		}
+		targets[path.Clean(mount.Target)] = true
+	}
+	return mounts, nil
+}
+
+// GetInMemoryBytes returns the memory used by shm_size and the sized tmpfs mounts of the service, which counts
+// against its memory reservation.
+func GetInMemoryBytes(svccfg *composeTypes.ServiceConfig) (int64, error) {
+	mounts, err := GetTmpfsMounts(svccfg)
+	if err != nil {
+		return 0, err
+	}
+	total := int64(svccfg.ShmSize)
+	for _, mount := range mounts {
+		total += mount.SizeBytes
+	}
+	return total, nil
+}
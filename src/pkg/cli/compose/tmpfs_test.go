@@ -0,0 +1,81 @@
+package compose
+
+import (
+	"testing"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestGetTmpfsMounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		svccfg   composeTypes.ServiceConfig
+		want     []TmpfsMount
+		wantErr  string
+		inMemory int64
+	}{
+		{
+			name: "short syntax",
+			svccfg: composeTypes.ServiceConfig{
+				Tmpfs: composeTypes.StringList{"/run", "/tmp:rw,size=64m,mode=1777"},
+			},
+			want:     []TmpfsMount{{Target: "/run"}, {Target: "/tmp", SizeBytes: 64 * MiB}},
+			inMemory: 64 * MiB,
+		},
+		{
+			name: "tmpfs volume and shm_size",
+			svccfg: composeTypes.ServiceConfig{
+				ShmSize: 256 * MiB,
+				Volumes: []composeTypes.ServiceVolumeConfig{
+					{Type: composeTypes.VolumeTypeVolume, Source: "data", Target: "/data"},
+					{Type: composeTypes.VolumeTypeTmpfs, Target: "/cache", Tmpfs: &composeTypes.ServiceVolumeTmpfs{Size: 128 * MiB}},
+				},
+			},
+			want:     []TmpfsMount{{Target: "/cache", SizeBytes: 128 * MiB}},
+			inMemory: 384 * MiB,
+		},
+		{
+			name:    "invalid size",
+			svccfg:  composeTypes.ServiceConfig{Tmpfs: composeTypes.StringList{"/tmp:size=lots"}},
+			wantErr: `invalid tmpfs size "lots" for "/tmp"`,
+		},
+		{
+			name:    "relative target",
+			svccfg:  composeTypes.ServiceConfig{Tmpfs: composeTypes.StringList{"tmp"}},
+			wantErr: `tmpfs target "tmp" must be an absolute path`,
+		},
+		{
+			name: "duplicate target",
+			svccfg: composeTypes.ServiceConfig{
+				Tmpfs:   composeTypes.StringList{"/tmp"},
+				Volumes: []composeTypes.ServiceVolumeConfig{{Type: composeTypes.VolumeTypeTmpfs, Target: "/tmp/"}},
+			},
+			wantErr: `duplicate tmpfs target "/tmp/"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mounts, err := GetTmpfsMounts(&tt.svccfg)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(mounts) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, mounts)
+			}
+			for i := range mounts {
+				if mounts[i] != tt.want[i] {
+					t.Errorf("expected %v, got %v", tt.want[i], mounts[i])
+				}
+			}
+			if inMemory, _ := GetInMemoryBytes(&tt.svccfg); inMemory != tt.inMemory {
+				t.Errorf("expected %d bytes in memory, got %d", tt.inMemory, inMemory)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/progress"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+const DefaultUploadParallelism = 4
+
+func parseParallelism(parallelism string, def int) int {
+	if n, err := strconv.Atoi(parallelism); err == nil && n > 0 {
+		return n
+	}
+	return def
+}
+
+// UploadOptions control how the build contexts of a project are packaged and uploaded.
+type UploadOptions struct {
+	Parallelism     int    // maximum number of build contexts that are packaged and uploaded at the same time; math.MaxInt for unlimited
+	ContinueOnError bool   // skip the services whose build context failed to upload, instead of failing the deployment
+	MaxContextSize  int64  // maximum size of a compressed build context; DEFANG_BUILD_CONTEXT_LIMIT sets the default
+	FollowSymlinks  bool   // copy the files that symlinks outside of the build context point to, instead of failing
+	Registry        string // build the images with the local Docker daemon and push them to this repository, instead of uploading the build contexts
+}
+
+// DefaultUploadOptions returns the options used when none are set in the context; DEFANG_UPLOAD_PARALLELISM overrides the parallelism.
+func DefaultUploadOptions() UploadOptions {
+	return UploadOptions{
+		Parallelism:    parseParallelism(os.Getenv("DEFANG_UPLOAD_PARALLELISM"), DefaultUploadParallelism),
+		MaxContextSize: ContextSizeHardLimit,
+	}
+}
+
+type uploadOptionsKey struct{}
+
+// WithUploadOptions returns a copy of ctx that carries the given upload options.
+func WithUploadOptions(ctx context.Context, opts UploadOptions) context.Context {
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = DefaultUploadOptions().Parallelism
+	}
+	if opts.MaxContextSize <= 0 {
+		opts.MaxContextSize = DefaultUploadOptions().MaxContextSize
+	}
+	return context.WithValue(ctx, uploadOptionsKey{}, opts)
+}
+
+// UploadOptionsFromContext returns the options set with WithUploadOptions, or the defaults.
+func UploadOptionsFromContext(ctx context.Context) UploadOptions {
+	if opts, ok := ctx.Value(uploadOptionsKey{}).(UploadOptions); ok {
+		return opts
+	}
+	return DefaultUploadOptions()
+}
+
+// uploadBuildContexts packages and uploads the local build contexts of all services concurrently,
+// replacing each build context with the URL of the uploaded archive. With ContinueOnError, the services
+// that failed are removed from the project, so the others can still be deployed.
+func uploadBuildContexts(ctx context.Context, provider client.Provider, project *composeTypes.Project, upload UploadMode) error {
+	t := termFromContext(ctx)
+	opts := UploadOptionsFromContext(ctx)
+	// Services that share a build context are handled by the same worker, since packaging might write a .dockerignore file,
+	// and so the same archive is only packaged and uploaded once
+	groups := make(map[string][]string)
+	total := 0
+	for _, svccfg := range project.Services {
+		if svccfg.Build == nil || strings.Contains(svccfg.Build.Context, "://") {
+			continue
+		}
+		root, _ := filepath.Abs(svccfg.Build.Context) // already checked in ValidateProject
+		groups[root] = append(groups[root], svccfg.Name)
+		total++
+	}
+	if total == 0 {
+		return nil
+	}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Build locally only when deploying; a preview only needs the digest of the build context
+	localBuild := opts.Registry != "" && (upload == UploadModeDefault || upload == UploadModeDigest || upload == UploadModeForce)
+	if localBuild {
+		if _, err := runDocker(ctx, "version"); err != nil { // also fails if the daemon is not running
+			return fmt.Errorf("building locally requires Docker: %w", err)
+		}
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	var failed []string
+	images := make(map[string]string) // services built locally; applied once all workers are done
+	done := 0
+	sem := make(chan struct{}, min(max(opts.Parallelism, 1), len(groups)))
+	wg := &sync.WaitGroup{}
+	for _, root := range slices.Sorted(maps.Keys(groups)) {
+		names := groups[root]
+		slices.Sort(names)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-uploadCtx.Done():
+				return
+			}
+			uploaded := make(map[string]string) // the services with the same archive share the upload
+			for _, name := range names {
+				svccfg := project.Services[name]
+				var url, image string
+				var err error
+				if localBuild {
+					image, err = buildLocalImage(uploadCtx, project.Name, &svccfg, opts.Registry) // the images differ by their build args
+				} else if key := archiveKey(svccfg.Build); uploaded[key] != "" {
+					url = uploaded[key]
+					t.Debugf("Reusing the project files of %q for %q", root, name)
+				} else if url, err = getRemoteBuildContext(uploadCtx, provider, project.Name, name, svccfg.Build, upload); err == nil {
+					uploaded[key] = url
+				}
+
+				mu.Lock()
+				if err != nil {
+					// Don't report every aborted upload when the user interrupted us
+					if ctx.Err() == nil && (uploadCtx.Err() == nil || opts.ContinueOnError) {
+						errs = append(errs, fmt.Errorf("service %q: %w", name, err))
+						failed = append(failed, name)
+					}
+				} else if localBuild {
+					images[name] = image
+					done++
+					if progress.FromContext(ctx) == nil {
+						t.Infof("Pushed the image for %s (%d/%d)", name, done, total)
+					}
+				} else {
+					svccfg.Build.Context = url
+					done++
+					if (upload == UploadModeDefault || upload == UploadModeDigest || upload == UploadModeForce) && progress.FromContext(ctx) == nil {
+						t.Infof("Uploaded the project files for %s (%d/%d)", name, done, total)
+					}
+				}
+				mu.Unlock()
+
+				if err != nil && (!opts.ContinueOnError || ctx.Err() != nil) {
+					cancel() // fail fast: stop the other uploads
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The services built locally are deployed from the pushed image, by digest
+	for name, image := range images {
+		svccfg := project.Services[name]
+		svccfg.Image = image
+		svccfg.Build = nil
+		project.Services[name] = svccfg
+	}
+
+	if ctx.Err() != nil {
+		t.Infof("Interrupted after uploading %d of %d build context(s); nothing was deployed", done, total)
+		return ctx.Err()
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if !opts.ContinueOnError {
+		return errors.Join(errs...)
+	}
+	slices.Sort(failed)
+	if err := RemoveServices(project, failed...); err != nil {
+		return errors.Join(errs...) // nothing left to deploy
+	}
+	for _, err := range errs {
+		warnf(ctx, CodeUploadFailed, "Skipping %v", err)
+	}
+	warnf(ctx, CodeUploadFailed, "Failed to upload %d of %d build context(s); deploying the other services", len(failed), total)
+	return nil
+}
+
+// archiveKey identifies the archive of a build context within its root directory: the Dockerfile decides the
+// .dockerignore file and the archive type, and the executable patterns decide the file modes.
+func archiveKey(build *composeTypes.BuildConfig) string {
+	executables, _ := GetExecutables(build) // already checked in ValidateProject
+	return fmt.Sprint(build.Dockerfile, executables)
+}
@@ -0,0 +1,170 @@
+package compose
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestUploadBuildContexts(t *testing.T) {
+	var inflight, maxInflight, puts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts.Add(1)
+		}
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			m := maxInflight.Load()
+			if n <= m || maxInflight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(200)
+	}))
+	t.Cleanup(server.Close)
+
+	makeContext := func(name string) string {
+		dir := filepath.Join(t.TempDir(), name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for file, content := range map[string]string{"Dockerfile": "FROM scratch\nCOPY . /\n", ".dockerignore": "", name: name} {
+			if err := os.WriteFile(filepath.Join(dir, file), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dir
+	}
+
+	newProject := func(contexts map[string]string) *composeTypes.Project {
+		project := &composeTypes.Project{Name: "project1", Services: composeTypes.Services{}}
+		for name, context := range contexts {
+			project.Services[name] = composeTypes.ServiceConfig{Name: name, Build: &composeTypes.BuildConfig{Context: context, Dockerfile: "Dockerfile"}}
+		}
+		return project
+	}
+
+	t.Run("bounded parallelism", func(t *testing.T) {
+		ctx := WithUploadOptions(t.Context(), UploadOptions{Parallelism: 2})
+		shared := makeContext("shared")
+		project := newProject(map[string]string{
+			"a": makeContext("a"), "b": makeContext("b"), "c": makeContext("c"), "d": shared, "e": shared,
+			"remote": "s3://bucket/remote.tar.gz",
+		})
+
+		err := uploadBuildContexts(ctx, client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err != nil {
+			t.Fatalf("uploadBuildContexts() failed: %v", err)
+		}
+		for name, svccfg := range project.Services {
+			if name == "remote" {
+				if svccfg.Build.Context != "s3://bucket/remote.tar.gz" {
+					t.Errorf("service %q: expected remote context to be unchanged, got %q", name, svccfg.Build.Context)
+				}
+			} else if !strings.HasPrefix(svccfg.Build.Context, server.URL+"/project1/sha256-") {
+				t.Errorf("service %q: expected uploaded context, got %q", name, svccfg.Build.Context)
+			}
+		}
+		if got := maxInflight.Load(); got > 2 {
+			t.Errorf("expected at most 2 concurrent uploads, got %d", got)
+		}
+	})
+
+	t.Run("unlimited parallelism", func(t *testing.T) {
+		maxInflight.Store(0)
+		ctx := WithUploadOptions(t.Context(), UploadOptions{Parallelism: math.MaxInt})
+		project := newProject(map[string]string{"a": makeContext("a"), "b": makeContext("b"), "c": makeContext("c")})
+
+		err := uploadBuildContexts(ctx, client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err != nil {
+			t.Fatalf("uploadBuildContexts() failed: %v", err)
+		}
+		if got := maxInflight.Load(); got > 3 {
+			t.Errorf("expected at most 3 concurrent uploads, got %d", got)
+		}
+	})
+
+	t.Run("shared build context", func(t *testing.T) {
+		puts.Store(0)
+		shared := makeContext("shared")
+		project := newProject(map[string]string{"a": shared, "b": shared, "c": shared})
+		c := project.Services["c"]
+		c.Build.Extensions = map[string]any{"x-defang-executable": true} // a different archive
+		project.Services["c"] = c
+
+		err := uploadBuildContexts(t.Context(), client.MockProvider{UploadUrl: server.URL}, project, UploadModeForce)
+		if err != nil {
+			t.Fatalf("uploadBuildContexts() failed: %v", err)
+		}
+		if a, b := project.Services["a"].Build.Context, project.Services["b"].Build.Context; a != b {
+			t.Errorf("expected the same URL for the same build context, got %q and %q", a, b)
+		}
+		if got := puts.Load(); got != 2 { // a and b, and c with different executables
+			t.Errorf("expected 2 uploads, got %d", got)
+		}
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		ctx := WithUploadOptions(t.Context(), UploadOptions{ContinueOnError: true})
+		missing := filepath.Join(t.TempDir(), "missing")
+		project := newProject(map[string]string{"bad": missing, "good": makeContext("good")})
+
+		err := uploadBuildContexts(ctx, client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err != nil {
+			t.Fatalf("expected the failed service to be skipped, got: %v", err)
+		}
+		if _, ok := project.Services["bad"]; ok {
+			t.Error("expected the failed service to be removed from the project")
+		}
+		if ctx := project.Services["good"].Build.Context; !strings.HasPrefix(ctx, server.URL) {
+			t.Errorf("expected the other service to be uploaded, got %q", ctx)
+		}
+	})
+
+	t.Run("continue on error, all failed", func(t *testing.T) {
+		ctx := WithUploadOptions(t.Context(), UploadOptions{ContinueOnError: true})
+		project := newProject(map[string]string{"bad": filepath.Join(t.TempDir(), "missing")})
+
+		err := uploadBuildContexts(ctx, client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err == nil || !strings.Contains(err.Error(), `service "bad"`) {
+			t.Fatalf("expected error for service \"bad\", got: %v", err)
+		}
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "missing")
+		project := newProject(map[string]string{"bad": missing, "good": makeContext("good")})
+
+		err := uploadBuildContexts(t.Context(), client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err == nil || !strings.Contains(err.Error(), `service "bad"`) {
+			t.Fatalf("expected error for service \"bad\", got: %v", err)
+		}
+		if strings.Contains(err.Error(), "context canceled") {
+			t.Errorf("expected only the first error, got: %v", err)
+		}
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		project := newProject(map[string]string{"a": makeContext("a"), "b": makeContext("b")})
+
+		ctx, cancel := context.WithCancel(WithUploadOptions(t.Context(), UploadOptions{ContinueOnError: true}))
+		cancel() // as if the user pressed Ctrl+C
+
+		err := uploadBuildContexts(ctx, client.MockProvider{UploadUrl: server.URL}, project, UploadModeDigest)
+		if err != context.Canceled {
+			t.Fatalf("expected only context.Canceled, got: %v", err)
+		}
+	})
+}
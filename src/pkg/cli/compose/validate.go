@@ -17,7 +17,7 @@ func ValidateService(service *types.ServiceConfig) error {
 		if service.Build.Context == "" {
 			return errors.New("build.context is required") // CodeInvalidArgument
 		}
-	} else {
+	} else if GetBucket(service) == nil && service.Extensions["x-defang-static-files"] == nil {
 		if service.Image == "" {
 			return errors.New("missing image or build") // CodeInvalidArgument
 		}
@@ -14,10 +14,11 @@ import (
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/clouds/gcp"
 	"github.com/DefangLabs/defang/src/pkg/modes"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/go-units"
 )
 
 type ListConfigNamesFunc func(context.Context) ([]string, error)
@@ -25,12 +26,14 @@ type ListConfigNamesFunc func(context.Context) ([]string, error)
 type ErrMissingConfig []string
 
 func (e ErrMissingConfig) Error() string {
-	return fmt.Sprintf("missing configs %q (https://s.defang.io/config)", ([]string)(e))
+	return withCode(CodeMissingConfig, fmt.Sprintf("missing configs %q", ([]string)(e)))
 }
 
 var ErrDockerfileNotFound = errors.New("dockerfile not found")
 
-func ValidateProject(project *composeTypes.Project, mode modes.Mode) error {
+// ValidateProject checks the project before it's deployed. The instance types are checked against the catalog of the
+// given provider; pass client.ProviderAuto when the provider is not known.
+func ValidateProject(ctx context.Context, project *composeTypes.Project, mode modes.Mode, providerID client.ProviderID) error {
 	if project == nil {
 		return errors.New("no project found")
 	}
@@ -43,161 +46,182 @@ func ValidateProject(project *composeTypes.Project, mode modes.Mode) error {
 		return services[i].Name < services[j].Name
 	})
 
-	var errs []error
+	errs := []error{validateNameLengths(ctx, project), validateNetworkConfig(ctx, project), validateIngressRoutes(services)}
+	if _, err := DeploymentOrder(project.Services); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateProjectVolumes(ctx, project); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ValidateInstanceTypes(ctx, project, providerID); err != nil {
+		errs = append(errs, err)
+	}
 	for _, svccfg := range services {
-		errs = append(errs, validateService(&svccfg, project, mode))
+		errs = append(errs, validateService(ctx, &svccfg, project, mode))
 	}
 	for i, svccfg := range services {
 		for j := i + 1; j < len(services); j++ {
-			if gcp.SafeLabelValue(svccfg.Name) == gcp.SafeLabelValue(services[j].Name) { // TODO: Shouldn't be just gcp specific
-				errs = append(errs, fmt.Errorf("the service names %q and %q normalize to the same value, which causes a conflict. Please use distinct names that differ after normalization", svccfg.Name, services[j].Name))
+			// Normalized names are used for DNS names and cloud resources, so they must be unique
+			if NormalizeServiceName(svccfg.Name) == NormalizeServiceName(services[j].Name) ||
+				gcp.SafeLabelValue(svccfg.Name) == gcp.SafeLabelValue(services[j].Name) {
+				errs = append(errs, errorf(CodeNameConflict, "the service names %q and %q normalize to the same value, which causes a conflict. Please use distinct names that differ after normalization", svccfg.Name, services[j].Name))
 			}
 		}
 	}
 	return errors.Join(errs...)
 }
 
-func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.Project, mode modes.Mode) error {
-	if svccfg.ReadOnly {
-		term.Debugf("service %q: unsupported compose directive: read_only", svccfg.Name)
+func validateService(ctx context.Context, svccfg *composeTypes.ServiceConfig, project *composeTypes.Project, mode modes.Mode) error {
+	t := termFromContext(ctx)
+	if err := validateSecurity(ctx, svccfg); err != nil {
+		return err
+	}
+	if err := validateProcessLimits(ctx, svccfg); err != nil {
+		return err
+	}
+	if err := validateDependsOn(ctx, svccfg, project); err != nil {
+		return err
 	}
 	if svccfg.Restart == "" {
 		// This was a warning, but we don't really care and want to reduce the noise
-		term.Debugf("service %q: missing compose directive: restart; assuming 'unless-stopped' (add 'restart' to silence)", svccfg.Name)
+		t.Debugf("service %q: missing compose directive: restart; assuming 'unless-stopped' (add 'restart' to silence)", svccfg.Name)
 	} else if svccfg.Restart != "always" && svccfg.Restart != "unless-stopped" {
-		term.Debugf("service %q: unsupported compose directive: restart; assuming 'unless-stopped' (add 'restart' to silence)", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: restart; assuming 'unless-stopped' (add 'restart' to silence)", svccfg.Name)
 	}
 	if svccfg.ContainerName != "" {
-		term.Debugf("service %q: unsupported compose directive: container_name", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: container_name", svccfg.Name)
 	}
 	if svccfg.Hostname != "" {
-		return fmt.Errorf("service %q: unsupported compose directive: hostname; consider using 'domainname' instead", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: hostname; consider using 'domainname' instead", svccfg.Name)
 	}
 	if len(svccfg.DNSSearch) != 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: dns_search", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: dns_search", svccfg.Name)
 	}
 	if len(svccfg.DNSOpts) != 0 {
-		term.Debugf("service %q: unsupported compose directive: dns_opt", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: dns_opt", svccfg.Name)
 	}
 	if len(svccfg.DNS) != 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: dns", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: dns", svccfg.Name)
 	}
 	if len(svccfg.Devices) != 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: devices", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: devices", svccfg.Name)
 	}
 	if len(svccfg.DeviceCgroupRules) != 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: device_cgroup_rules", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: device_cgroup_rules", svccfg.Name)
 	}
 	if len(svccfg.Entrypoint) > 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: entrypoint", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: entrypoint", svccfg.Name)
 	}
 	if len(svccfg.GroupAdd) > 0 {
-		return fmt.Errorf("service %q: unsupported compose directive: group_add", svccfg.Name)
+		return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: group_add", svccfg.Name)
 	}
 	if len(svccfg.Ipc) > 0 {
-		term.Debugf("service %q: unsupported compose directive: ipc", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: ipc", svccfg.Name)
 	}
 	if len(svccfg.Uts) > 0 {
-		term.Debugf("service %q: unsupported compose directive: uts", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: uts", svccfg.Name)
 	}
 	if svccfg.Isolation != "" {
-		term.Debugf("service %q: unsupported compose directive: isolation", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: isolation", svccfg.Name)
 	}
 	if svccfg.MacAddress != "" {
-		term.Debugf("service %q: unsupported compose directive: mac_address", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: mac_address", svccfg.Name)
 	}
 	if len(svccfg.Labels) > 0 {
-		term.Debugf("service %q: unsupported compose directive: labels", svccfg.Name) // TODO: add support for labels
+		t.Debugf("service %q: unsupported compose directive: labels", svccfg.Name) // TODO: add support for labels
 	}
 	if len(svccfg.Links) > 0 {
-		term.Debugf("service %q: unsupported compose directive: links", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: links", svccfg.Name)
 	}
 	if svccfg.Logging != nil {
-		term.Debugf("service %q: unsupported compose directive: logging", svccfg.Name)
+		t.Debugf("service %q: unsupported compose directive: logging", svccfg.Name)
 	}
 	for name := range svccfg.Networks {
 		if _, ok := project.Networks[name]; !ok {
 			// This was a warning, but we don't really care and want to reduce the noise
-			term.Debugf("service %q: network %q is not defined in the top-level networks section", svccfg.Name, name)
+			t.Debugf("service %q: network %q is not defined in the top-level networks section", svccfg.Name, name)
 		}
 	}
-	if len(svccfg.Volumes) > 0 {
-		term.Warnf("service %q: unsupported compose directive: volumes", svccfg.Name) // TODO: add support for volumes
+	if err := validateVolumes(ctx, svccfg, project); err != nil {
+		return err
 	}
 	if len(svccfg.VolumesFrom) > 0 {
-		term.Warnf("service %q: unsupported compose directive: volumes_from", svccfg.Name) // TODO: add support for volumes_from
+		warnf(ctx, CodeUnsupportedDirective, "service %q: unsupported compose directive: volumes_from", svccfg.Name) // TODO: add support for volumes_from
 	}
 	if svccfg.Build != nil {
 		_, err := filepath.Abs(svccfg.Build.Context)
 		if err != nil {
-			return fmt.Errorf("service %q: invalid build context: %w", svccfg.Name, err)
+			return errorf(CodeInvalidBuild, "service %q: invalid build context: %w", svccfg.Name, err)
 		}
 		if svccfg.Build.Dockerfile != "" {
 			if filepath.IsAbs(svccfg.Build.Dockerfile) {
-				return fmt.Errorf("service %q: dockerfile path must be relative to the build context: %q", svccfg.Name, svccfg.Build.Dockerfile)
+				return errorf(CodeInvalidBuild, "service %q: dockerfile path must be relative to the build context: %q", svccfg.Name, svccfg.Build.Dockerfile)
 			}
 			if strings.HasPrefix(svccfg.Build.Dockerfile, "../") {
-				return fmt.Errorf("service %q: dockerfile path must be inside the build context: %q", svccfg.Name, svccfg.Build.Dockerfile)
+				return errorf(CodeInvalidBuild, "service %q: dockerfile path must be inside the build context: %q", svccfg.Name, svccfg.Build.Dockerfile)
 			}
 		}
 		if svccfg.Build.SSH != nil {
-			return fmt.Errorf("service %q: unsupported compose directive: build ssh", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build ssh; the image is built remotely, without access to your SSH agent or keys", svccfg.Name)
 		}
 		if len(svccfg.Build.Labels) != 0 {
-			term.Debugf("service %q: unsupported compose directive: build labels", svccfg.Name) // TODO: add support for Kaniko --label
+			t.Debugf("service %q: unsupported compose directive: build labels", svccfg.Name) // TODO: add support for Kaniko --label
 		}
 		if len(svccfg.Build.CacheFrom) != 0 {
-			term.Debugf("service %q: unsupported compose directive: build cache_from", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: build cache_from", svccfg.Name)
 		}
 		if len(svccfg.Build.CacheTo) != 0 {
-			term.Debugf("service %q: unsupported compose directive: build cache_to", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: build cache_to", svccfg.Name)
 		}
 		if svccfg.Build.NoCache {
-			term.Debugf("service %q: unsupported compose directive: build no_cache", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: build no_cache", svccfg.Name)
 		}
 		if len(svccfg.Build.ExtraHosts) != 0 {
-			return fmt.Errorf("service %q: unsupported compose directive: build extra_hosts", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build extra_hosts", svccfg.Name)
 		}
 		if svccfg.Build.Isolation != "" {
-			term.Debugf("service %q: unsupported compose directive: build isolation", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: build isolation", svccfg.Name)
 		}
 		if svccfg.Build.Network != "" {
-			return fmt.Errorf("service %q: unsupported compose directive: build network", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build network", svccfg.Name)
 		}
 		if len(svccfg.Build.Secrets) != 0 {
-			return fmt.Errorf("service %q: unsupported compose directive: build secrets", svccfg.Name) // TODO: support build secrets
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build secrets", svccfg.Name) // TODO: support build secrets
 		}
 		if len(svccfg.Build.Tags) != 0 {
-			return fmt.Errorf("service %q: unsupported compose directive: build tags", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build tags", svccfg.Name)
 		}
 		if len(svccfg.Build.Platforms) != 0 {
-			return fmt.Errorf("service %q: unsupported compose directive: build platforms", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build platforms", svccfg.Name)
 		}
 		if svccfg.Build.Privileged {
-			return fmt.Errorf("service %q: unsupported compose directive: build privileged", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build privileged", svccfg.Name)
 		}
 		if svccfg.Build.DockerfileInline != "" {
-			return fmt.Errorf("service %q: unsupported compose directive: build dockerfile_inline", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build dockerfile_inline", svccfg.Name)
 		}
 		if svccfg.Build.AdditionalContexts != nil {
-			return fmt.Errorf("service %q: unsupported compose directive: build additional_contexts", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: build additional_contexts", svccfg.Name)
 		}
 		if svccfg.Build.Ulimits != nil {
-			term.Warnf("service %q: unsupported compose directive: build ulimits", svccfg.Name) // TODO: add support for build ulimits
+			warnf(ctx, CodeUnsupportedDirective, "service %q: unsupported compose directive: build ulimits", svccfg.Name) // TODO: add support for build ulimits
+		}
+		if _, err := GetExecutables(svccfg.Build); err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
 		}
 	}
 	for _, secret := range svccfg.Secrets {
 		if !pkg.IsValidSecretName(secret.Source) {
-			return fmt.Errorf("service %q: secret name is invalid: %q", svccfg.Name, secret.Source)
+			return errorf(CodeUnsupportedSecret, "service %q: secret name is invalid: %q", svccfg.Name, secret.Source)
 		}
-		// secret.Target will always be automatically constructed by compose-go to "/run/secrets/<source>"
 		if s, ok := project.Secrets[secret.Source]; !ok {
 			// This was a warning, but we don't really care and want to reduce the noise
-			term.Debugf("secret %q is not defined in the top-level secrets section", secret.Source)
-		} else if s.Name != "" && s.Name != secret.Source {
-			return fmt.Errorf("unsupported secret %q: cannot override name %q", secret.Source, s.Name) // TODO: support custom secret names
-		} else if !s.External {
-			term.Warnf("unsupported secret %q: not marked external:true", secret.Source) // TODO: support secrets from environment/file
+			t.Debugf("secret %q is not defined in the top-level secrets section", secret.Source)
+		} else if err := validateSecret(secret.Source, s); err != nil {
+			return err
+		}
+		if secret.UID != "" || secret.GID != "" || secret.Mode != nil {
+			t.Debugf("service %q: secret %q: uid, gid, and mode are ignored", svccfg.Name, secret.Source)
 		}
 	}
 
@@ -212,106 +236,240 @@ func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.P
 
 			// show warning if sensitive information is detected
 			if isSecret {
-				term.Warnf("service %q: environment %q may contain sensitive information; consider using 'defang config set %s' to securely store this value", svccfg.Name, key, key)
-				term.Debugf("service %q: environment %q may contain detected secrets of type: %v", svccfg.Name, key, ds)
+				warnf(ctx, CodeSensitiveEnvironment, "service %q: environment %q may contain sensitive information; consider using 'defang config set %s' to securely store this value", svccfg.Name, key, key)
+				t.Debugf("service %q: environment %q may contain detected secrets of type: %v", svccfg.Name, key, ds)
 			}
 		}
 	}
 
-	err := validatePorts(svccfg.Ports)
+	err := validatePorts(ctx, svccfg.Ports)
 	if err != nil {
 		return fmt.Errorf("service %q: %w", svccfg.Name, err)
 	}
-	if svccfg.HealthCheck == nil || svccfg.HealthCheck.Disable {
+	if svccfg.HealthCheck != nil && !svccfg.HealthCheck.Disable {
+		if err := validateHealthCheckTest(ctx, svccfg.Name, svccfg.HealthCheck); err != nil {
+			return err
+		}
+	}
+	if !hasHealthCheck(svccfg.HealthCheck) {
 		// Show a warning when we have ingress ports but no explicit healthcheck
 		for _, port := range svccfg.Ports {
+			if port.Mode == Mode_INGRESS && isGrpcPort(port) {
+				warnf(ctx, CodeMissingHealthcheck, "service %q: gRPC ingress port %d without healthcheck; add a gRPC probe, eg. [\"CMD\", \"grpc_health_probe\", \"-addr=:%d\"]", svccfg.Name, port.Target, port.Target)
+				break
+			}
 			if port.Mode == Mode_INGRESS {
-				term.Warnf("service %q: ingress port %d without healthcheck; defaults to GET / HTTP/1.1", svccfg.Name, port.Target)
+				warnf(ctx, CodeMissingHealthcheck, "service %q: ingress port %d without healthcheck; defaults to GET / HTTP/1.1", svccfg.Name, port.Target)
 				break
 			}
 		}
 	} else {
+		if hasGrpcIngressPort(svccfg) && !IsGrpcHealthCheck(svccfg.HealthCheck) && isHttpHealthCheck(svccfg.HealthCheck) {
+			return errorf(CodeInvalidHealthcheck, "service %q: healthcheck for a gRPC ingress port must use a gRPC probe, eg. grpc_health_probe, instead of HTTP", svccfg.Name)
+		}
 		timeout := 5.0
 		if svccfg.HealthCheck.Timeout != nil {
 			timeout = time.Duration(*svccfg.HealthCheck.Timeout).Seconds()
 			if _, frac := math.Modf(timeout); frac != 0 {
-				term.Warnf("service %q: healthcheck timeout must be a multiple of 1s", svccfg.Name)
+				warnf(ctx, CodeInvalidHealthcheck, "service %q: healthcheck timeout must be a multiple of 1s", svccfg.Name)
 			}
 		}
 		interval := 30.0 // default per compose spec
 		if svccfg.HealthCheck.Interval != nil {
 			interval = time.Duration(*svccfg.HealthCheck.Interval).Seconds()
 			if _, frac := math.Modf(interval); frac != 0 {
-				term.Warnf("service %q: healthcheck interval must be a multiple of 1s", svccfg.Name)
+				warnf(ctx, CodeInvalidHealthcheck, "service %q: healthcheck interval must be a multiple of 1s", svccfg.Name)
 			}
 		}
 		// Technically this should test for <= but both interval and timeout have 30s as the default value
 		if interval < timeout || timeout <= 0 {
-			return fmt.Errorf("service %q: healthcheck timeout %fs must be positive and smaller than the interval %fs", svccfg.Name, timeout, interval)
+			return errorf(CodeInvalidHealthcheck, "service %q: healthcheck timeout %fs must be positive and smaller than the interval %fs", svccfg.Name, timeout, interval)
 		}
 		if svccfg.HealthCheck.StartPeriod != nil {
-			term.Debugf("service %q: unsupported compose directive: healthcheck start_period", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: healthcheck start_period", svccfg.Name)
 		}
 		if svccfg.HealthCheck.StartInterval != nil {
-			term.Debugf("service %q: unsupported compose directive: healthcheck start_interval", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: healthcheck start_interval", svccfg.Name)
 		}
 	}
 	var replicas int
 	var reservations *composeTypes.Resource
 	if svccfg.Deploy != nil {
 		if svccfg.Deploy.Mode != "" && svccfg.Deploy.Mode != "replicated" {
-			return fmt.Errorf("service %q: unsupported compose directive: deploy mode: %q", svccfg.Name, svccfg.Deploy.Mode)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: deploy mode: %q", svccfg.Name, svccfg.Deploy.Mode)
 		}
 		if svccfg.Deploy.UpdateConfig != nil {
-			return fmt.Errorf("service %q: unsupported compose directive: deploy update_config", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: deploy update_config", svccfg.Name)
 		}
 		if svccfg.Deploy.RollbackConfig != nil {
-			return fmt.Errorf("service %q: unsupported compose directive: deploy rollback_config", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: deploy rollback_config", svccfg.Name)
 		}
 		if svccfg.Deploy.RestartPolicy != nil {
-			return fmt.Errorf("service %q: unsupported compose directive: deploy restart_policy", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: deploy restart_policy", svccfg.Name)
 		}
 		if svccfg.Deploy.EndpointMode != "" {
-			return fmt.Errorf("service %q: unsupported compose directive: deploy endpoint_mode", svccfg.Name)
+			return errorf(CodeUnsupportedDirective, "service %q: unsupported compose directive: deploy endpoint_mode", svccfg.Name)
 		}
 		if svccfg.Deploy.Resources.Limits != nil && svccfg.Deploy.Resources.Reservations == nil {
-			term.Debugf("service %q: no reservations specified; using limits as reservations", svccfg.Name)
+			t.Debugf("service %q: no reservations specified; using limits as reservations", svccfg.Name)
 		}
 		reservations = getResourceReservations(svccfg.Deploy.Resources)
 		if reservations != nil && reservations.NanoCPUs < 0 { // "0" just means "as small as possible"
-			return fmt.Errorf("service %q: invalid value for cpus: %v", svccfg.Name, reservations.NanoCPUs)
+			return errorf(CodeInvalidResources, "service %q: invalid value for cpus: %v", svccfg.Name, reservations.NanoCPUs)
 		}
 		if len(svccfg.Deploy.Labels) > 0 {
-			term.Debugf("service %q: unsupported compose directive: deploy labels", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: deploy labels", svccfg.Name)
 		}
 		if len(svccfg.Deploy.Placement.Constraints) != 0 || len(svccfg.Deploy.Placement.Preferences) != 0 || svccfg.Deploy.Placement.MaxReplicas != 0 {
-			term.Debugf("service %q: unsupported compose directive: deploy placement", svccfg.Name)
+			t.Debugf("service %q: unsupported compose directive: deploy placement", svccfg.Name)
 		}
 		if svccfg.Deploy.Replicas != nil {
 			replicas = *svccfg.Deploy.Replicas
+			if replicas < 0 {
+				return errorf(CodeInvalidResources, "service %q: deploy.replicas must not be negative, got %d", svccfg.Name, replicas)
+			}
+		}
+		if err := validateLimits(svccfg.Name, svccfg.Deploy.Resources); err != nil {
+			return err
+		}
+	}
+	if autoscalingVal, ok := svccfg.Extensions["x-defang-autoscaling"]; ok {
+		autoscaling, err := ParseAutoscaling(autoscalingVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if autoscaling != nil && autoscaling.MaxReplicas > 0 && replicas > int(autoscaling.MaxReplicas) {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: replicas (%d) exceeds x-defang-autoscaling max_replicas (%d)", svccfg.Name, replicas, autoscaling.MaxReplicas)
+		}
+		if autoscaling != nil && svccfg.Deploy != nil && svccfg.Deploy.Replicas != nil && replicas < int(autoscaling.MinReplicas) {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: replicas (%d) is less than x-defang-autoscaling min_replicas (%d); starting with %d replicas", svccfg.Name, replicas, autoscaling.MinReplicas, autoscaling.MinReplicas)
+		}
+	}
+	for _, ext := range []string{"x-defang-instance-type", "x-defang-gpu"} {
+		if val, ok := svccfg.Extensions[ext]; ok {
+			if str, ok := val.(string); !ok || str == "" {
+				return errorf(CodeInvalidExtension, "service %q: %s must be a non-empty string", svccfg.Name, ext)
+			}
+		}
+	}
+	if _, ok := svccfg.Extensions["x-defang-gpu"]; ok && gpuDeviceCount(svccfg) == 0 {
+		warnf(ctx, CodeExtensionNoEffect, "service %q: x-defang-gpu has no effect without a GPU device reservation; see https://s.defang.io/gpu", svccfg.Name)
+	}
+
+	if spotVal, ok := svccfg.Extensions["x-defang-spot"]; ok {
+		spot, err := parseSpot(spotVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if spot && isSingleReplicaStateful(svccfg) {
+			return errorf(CodeSpotStateful, "service %q: x-defang-spot cannot be used for a stateful service with a single replica; add replicas or remove x-defang-spot", svccfg.Name)
+		}
+	}
+	if backupVal, ok := svccfg.Extensions["x-defang-backup"]; ok {
+		backup, err := ParseBackupPolicy(backupVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if backup != nil && !HasBackupableState(svccfg) {
+			return errorf(CodeBackupWithoutState, "service %q: x-defang-backup requires a managed store or volumes", svccfg.Name)
 		}
 	}
 	if mode == modes.ModeHighAvailability && replicas < 2 && svccfg.Extensions["x-defang-autoscaling"] == nil {
-		term.Warnf("service %q: high-availability mode requires at least 2 replicas or x-defang-autoscaling", svccfg.Name)
+		warnf(ctx, CodeHighAvailability, "service %q: high-availability mode requires at least 2 replicas or x-defang-autoscaling", svccfg.Name)
 	}
 	if reservations == nil || reservations.MemoryBytes == 0 {
-		// Don't show this warning for managed pseudo-services like CDN
-		if svccfg.Extensions["x-defang-static-files"] == nil {
-			term.Warnf("service %q: missing memory reservation; using provider-specific defaults. Specify deploy.resources.reservations.memory to avoid out-of-memory errors", svccfg.Name)
+		// Don't show this warning for managed pseudo-services like CDN or buckets
+		if svccfg.Extensions["x-defang-static-files"] == nil && GetBucket(svccfg) == nil {
+			warnf(ctx, CodeMissingMemory, "service %q: missing memory reservation; using provider-specific defaults. Specify deploy.resources.reservations.memory to avoid out-of-memory errors", svccfg.Name)
 		}
 	}
+	if svccfg.ShmSize < 0 {
+		return errorf(CodeInvalidResources, "service %q: invalid value for shm_size: %v", svccfg.Name, svccfg.ShmSize)
+	}
+	inMemoryBytes, err := GetInMemoryBytes(svccfg)
+	if err != nil {
+		return errorf(CodeInvalidResources, "service %q: %w", svccfg.Name, err)
+	}
+	if reservations != nil && reservations.MemoryBytes > 0 && inMemoryBytes > int64(reservations.MemoryBytes) {
+		return errorf(CodeInvalidResources, "service %q: shm_size and tmpfs (%v MiB) exceed the memory reservation (%v MiB)", svccfg.Name, inMemoryBytes/MiB, int64(reservations.MemoryBytes)/MiB)
+	}
+
+	if svccfg.DomainName != "" && IsInternalOnly(svccfg, project) {
+		return errorf(CodeInternalDomainname, "service %q: domainname cannot be used for a service that is only on internal networks", svccfg.Name)
+	}
 
 	if dnsRoleVal := svccfg.Extensions["x-defang-dns-role"]; dnsRoleVal != nil {
 		if _, ok := dnsRoleVal.(string); !ok {
-			return fmt.Errorf("service %q: x-defang-dns-role must be a string", svccfg.Name)
+			return errorf(CodeInvalidExtension, "service %q: x-defang-dns-role must be a string", svccfg.Name)
+		}
+	}
+
+	if iamRoleVal, ok := svccfg.Extensions["x-defang-iam-role"]; ok {
+		if err := validateIamRole(iamRoleVal); err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+	}
+
+	if ingressVal, ok := svccfg.Extensions["x-defang-ingress"]; ok {
+		ingress, err := ParseIngress(ingressVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if !hasIngressPort(svccfg) {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: x-defang-ingress has no effect without ingress ports", svccfg.Name)
+		}
+		if ingress.StickySessions != nil && replicas <= 1 && svccfg.Extensions["x-defang-autoscaling"] == nil {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: x-defang-ingress 'sticky' has no effect with a single replica", svccfg.Name)
+		}
+		if ingress.RequestTimeoutSeconds > 0 && ingress.IdleTimeoutSeconds > 0 && ingress.RequestTimeoutSeconds > ingress.IdleTimeoutSeconds {
+			warnf(ctx, CodeIngressConflict, "service %q: x-defang-ingress 'request_timeout' exceeds 'idle_timeout'; requests without traffic for %ds will be cut off", svccfg.Name, ingress.IdleTimeoutSeconds)
+		}
+		if ingress.GrpcWeb && !hasGrpcIngressPort(svccfg) {
+			return errorf(CodeIngressConflict, "service %q: x-defang-ingress 'grpc_web' requires a gRPC ingress port; add 'app_protocol: grpc' to the port", svccfg.Name)
+		}
+		if ingress.Auth != nil && ingress.DisableHttpsRedirect {
+			warnf(ctx, CodeIngressConflict, "service %q: x-defang-ingress 'auth' credentials may be sent in cleartext over HTTP; consider enabling 'https_redirect'", svccfg.Name)
+		}
+		if slices.ContainsFunc(ingress.AllowCidrs, func(cidr string) bool { return cidr == "0.0.0.0/0" || cidr == "::/0" }) {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: x-defang-ingress 'allow_ips' includes all addresses and has no effect", svccfg.Name)
+		}
+		if ingress.RateLimit != nil && ingress.RateLimit.Burst < ingress.RateLimit.RequestsPerSecond {
+			warnf(ctx, CodeIngressConflict, "service %q: x-defang-ingress 'rate_limit.burst' (%d) is less than 'requests_per_second' (%d)", svccfg.Name, ingress.RateLimit.Burst, ingress.RateLimit.RequestsPerSecond)
+		}
+		if len(ingress.Domains) > 0 && svccfg.DomainName == "" {
+			return errorf(CodeIngressConflict, "service %q: x-defang-ingress 'domains' requires a 'domainname'", svccfg.Name)
+		}
+		if ingress.DisableHttpsRedirect && ingress.Hsts.GetMaxAge() > 0 {
+			warnf(ctx, CodeIngressConflict, "service %q: HSTS is only sent over HTTPS; clients that connect over HTTP will not be redirected", svccfg.Name)
+		}
+		if ingress.Hsts.GetIncludeSubdomains() && ingress.Hsts.GetMaxAge() == 0 {
+			warnf(ctx, CodeExtensionNoEffect, "service %q: x-defang-ingress 'hsts.include_subdomains' has no effect when 'max_age' is 0", svccfg.Name)
+		}
+	}
+
+	if maintenanceVal, ok := svccfg.Extensions[maintenanceExtension]; ok {
+		maintenance, err := ParseMaintenance(maintenanceVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if maintenance != nil && !hasIngressPort(svccfg) {
+			return errorf(CodeMaintenanceNoIngress, "service %q: x-defang-maintenance requires ingress ports", svccfg.Name)
 		}
 	}
 
 	if staticFilesVal := svccfg.Extensions["x-defang-static-files"]; staticFilesVal != nil {
-		_, str := staticFilesVal.(string)
-		_, obj := staticFilesVal.(map[string]any)
-		if !str && !obj {
-			return fmt.Errorf(`service %q: x-defang-static-files must be a string or object {"folder": string, "redirects": string[]}`, svccfg.Name)
+		if err := validateStaticFiles(staticFilesVal); err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+	}
+
+	if bucketVal, ok := svccfg.Extensions["x-defang-bucket"]; ok {
+		bucket, err := ParseBucket(bucketVal)
+		if err != nil {
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
+		}
+		if bucket != nil && (svccfg.Build != nil || len(svccfg.Ports) > 0) {
+			return errorf(CodeBucketBuildOrPorts, "service %q: managed bucket cannot have build or ports", svccfg.Name)
 		}
 	}
 
@@ -321,10 +479,10 @@ func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.P
 	if managedRedis {
 		// Ensure the repo is a valid Redis repo
 		if !IsRedisRepo(repo) {
-			term.Warnf("service %q: managed Redis service should use a redis or valkey image", svccfg.Name)
+			warnf(ctx, CodeManagedImageMismatch, "service %q: managed Redis service should use a redis or valkey image", svccfg.Name)
 		}
 		if _, err = validateManagedStore(redisExtension); err != nil {
-			return fmt.Errorf("service %q: %w", svccfg.Name, err)
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
 		}
 	}
 
@@ -332,10 +490,10 @@ func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.P
 	if managedPostgres {
 		// Ensure the repo is a valid Postgres repo
 		if !IsPostgresRepo(repo) {
-			term.Warnf("service %q: managed Postgres service should use a postgres image", svccfg.Name)
+			warnf(ctx, CodeManagedImageMismatch, "service %q: managed Postgres service should use a postgres image", svccfg.Name)
 		}
 		if _, err = validateManagedStore(postgresExtension); err != nil {
-			return fmt.Errorf("service %q: %w", svccfg.Name, err)
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
 		}
 	}
 
@@ -343,15 +501,15 @@ func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.P
 	if managedMongodb {
 		// Ensure the repo is a valid MongoDB repo
 		if !IsMongoRepo(repo) {
-			term.Warnf("service %q: managed MongoDB service should use a mongo image", svccfg.Name)
+			warnf(ctx, CodeManagedImageMismatch, "service %q: managed MongoDB service should use a mongo image", svccfg.Name)
 		}
 		if _, err = validateManagedStore(mongodbExtension); err != nil {
-			return fmt.Errorf("service %q: %w", svccfg.Name, err)
+			return errorf(CodeInvalidExtension, "service %q: %w", svccfg.Name, err)
 		}
 	}
 
-	if !managedRedis && !managedPostgres && !managedMongodb && isStatefulImage(svccfg.Image) {
-		term.Warnf("service %q: stateful service will lose data on restart; use a managed service instead", svccfg.Name)
+	if !managedRedis && !managedPostgres && !managedMongodb && isStatefulImage(svccfg.Image) && !hasPersistentVolume(svccfg) {
+		warnf(ctx, CodeStatefulImage, "service %q: stateful service will lose data on restart; use a managed service or a named volume instead", svccfg.Name)
 	}
 
 	for k := range svccfg.Extensions {
@@ -362,58 +520,73 @@ func validateService(svccfg *composeTypes.ServiceConfig, project *composeTypes.P
 			"x-defang-postgres",
 			"x-defang-mongodb",
 			"x-defang-llm",
-			"x-defang-autoscaling":
+			"x-defang-autoscaling",
+			"x-defang-backup",
+			"x-defang-bucket",
+			"x-defang-spot",
+			"x-defang-instance-type",
+			"x-defang-gpu",
+			"x-defang-iam-role",
+			"x-defang-ingress",
+			"x-defang-maintenance",
+			"x-defang-paused",
+			"x-defang-ignore",
+			"x-defang-config-revision":
 			continue
 		default:
-			term.Warnf("service %q: unsupported compose extension: %q", svccfg.Name, k)
+			warnf(ctx, CodeUnsupportedExtension, "service %q: unsupported compose extension: %q", svccfg.Name, k)
 		}
 	}
 
 	return nil
 }
 
-func validatePorts(ports []composeTypes.ServicePortConfig) error {
+func validatePorts(ctx context.Context, ports []composeTypes.ServicePortConfig) error {
 	errs := make([]error, len(ports))
 	for i, port := range ports {
-		errs[i] = validatePort(port)
+		errs[i] = validatePort(ctx, port)
 	}
 	return errors.Join(errs...)
 }
 
 // We can changed to slices.contains when we upgrade to go 1.21 or above
 var validProtocols = map[string]bool{"": true, "tcp": true, "udp": true, "http": true, "http2": true, "grpc": true}
-var validModes = map[string]bool{"": true, "host": true, "ingress": true}
+var validModes = map[string]bool{"": true, "host": true, "ingress": true, "private": true}
+var validAppProtocols = map[string]bool{"": true, "http": true, "http2": true, "grpc": true}
 
-func validatePort(port composeTypes.ServicePortConfig) error {
+func validatePort(ctx context.Context, port composeTypes.ServicePortConfig) error {
 	if port.Target < 1 || port.Target > 32767 {
-		return fmt.Errorf("port %d: 'target' must be an integer between 1 and 32767", port.Target)
+		return errorf(CodeInvalidPort, "port %d: 'target' must be an integer between 1 and 32767", port.Target)
 	}
 	if port.HostIP != "" {
-		return fmt.Errorf("port %d: 'host_ip' is not supported", port.Target)
+		return errorf(CodeInvalidPort, "port %d: 'host_ip' is not supported", port.Target)
 	}
 	if !validProtocols[port.Protocol] {
-		return fmt.Errorf("port %d: 'protocol' not one of [tcp udp http http2 grpc]: %v", port.Target, port.Protocol)
+		return errorf(CodeInvalidPort, "port %d: 'protocol' not one of [tcp udp http http2 grpc]: %v", port.Target, port.Protocol)
+	}
+	if !validAppProtocols[port.AppProtocol] {
+		return errorf(CodeInvalidPort, "port %d: 'app_protocol' not one of [http http2 grpc]: %v", port.Target, port.AppProtocol)
 	}
 	if !validModes[port.Mode] {
-		return fmt.Errorf("port %d: 'mode' not one of [host ingress]: %v", port.Target, port.Mode)
+		return errorf(CodeUnsupportedPortMode, "port %d: 'mode' not one of [host ingress private]: %v", port.Target, port.Mode)
 	}
 	if port.Published != "" {
 		portRange := strings.SplitN(port.Published, "-", 2)
 		start, err := strconv.ParseUint(portRange[0], 10, 16)
 		if err != nil {
-			term.Warnf("port %d: 'published' range start should be an integer; ignoring 'published: %v'", port.Target, portRange[0])
+			warnf(ctx, CodePublishedPortIgnored, "port %d: 'published' range start should be an integer; ignoring 'published: %v'", port.Target, portRange[0])
 		} else if len(portRange) == 2 {
 			end, err := strconv.ParseUint(portRange[1], 10, 16)
 			if err != nil {
-				term.Warnf("port %d: 'published' range end should be an integer; ignoring 'published: %v'", port.Target, portRange[1])
+				warnf(ctx, CodePublishedPortIgnored, "port %d: 'published' range end should be an integer; ignoring 'published: %v'", port.Target, portRange[1])
 			} else if start > end {
-				term.Warnf("port %d: 'published' range start should be less than end; ignoring 'published: %v'", port.Target, port.Published)
+				warnf(ctx, CodePublishedPortIgnored, "port %d: 'published' range start should be less than end; ignoring 'published: %v'", port.Target, port.Published)
 			} else if port.Target < uint32(start) || port.Target > uint32(end) {
-				term.Warnf("port %d: 'published' range should include 'target'; ignoring 'published: %v'", port.Target, port.Published)
+				warnf(ctx, CodePublishedPortIgnored, "port %d: 'published' range should include 'target'; ignoring 'published: %v'", port.Target, port.Published)
 			}
 		} else {
 			if start != uint64(port.Target) {
-				term.Warnf("port %d: 'published' should be equal to 'target'; ignoring 'published: %v'", port.Target, port.Published)
+				warnf(ctx, CodePublishedPortIgnored, "port %d: 'published' should be equal to 'target'; ignoring 'published: %v'", port.Target, port.Published)
 			}
 		}
 	}
@@ -421,6 +594,24 @@ func validatePort(port composeTypes.ServicePortConfig) error {
 	return nil
 }
 
+// validateLimits checks that the limits of a service are not lower than its reservations; otherwise the service
+// could never be scheduled.
+func validateLimits(service string, r composeTypes.Resources) error {
+	if r.Limits == nil || r.Reservations == nil {
+		return nil
+	}
+	if r.Limits.NanoCPUs < 0 {
+		return errorf(CodeInvalidResources, "service %q: invalid value for cpus limit: %v", service, r.Limits.NanoCPUs)
+	}
+	if r.Limits.NanoCPUs > 0 && r.Limits.NanoCPUs < r.Reservations.NanoCPUs {
+		return errorf(CodeInvalidResources, "service %q: cpus limit (%v) must not be lower than the reservation (%v)", service, r.Limits.NanoCPUs, r.Reservations.NanoCPUs)
+	}
+	if r.Limits.MemoryBytes > 0 && r.Limits.MemoryBytes < r.Reservations.MemoryBytes {
+		return errorf(CodeInvalidResources, "service %q: memory limit (%s) must not be lower than the reservation (%s)", service, units.BytesSize(float64(r.Limits.MemoryBytes)), units.BytesSize(float64(r.Reservations.MemoryBytes)))
+	}
+	return nil
+}
+
 func getResourceReservations(r composeTypes.Resources) *composeTypes.Resource {
 	if r.Reservations == nil {
 		// TODO: we might not want to default to all the limits, maybe only memory?
@@ -447,9 +638,15 @@ func DetectInterpolationVariables(value string) []string {
 }
 
 func ValidateProjectConfig(composeProject *composeTypes.Project, listConfigNames []string) error {
-	var names []string
+	var names, provided []string
 	// make list of secrets
 	for _, service := range composeProject.Services {
+		// the name, endpoint, and credentials of a managed bucket are provided by CD once it is provisioned
+		if GetBucket(&service) != nil {
+			for _, key := range bucketEnvVarNames(service.Name) {
+				provided = append(provided, "DEFANG_"+key)
+			}
+		}
 		for key, value := range service.Environment {
 			if value == nil {
 				names = append(names, key)
@@ -458,6 +655,13 @@ func ValidateProjectConfig(composeProject *composeTypes.Project, listConfigNames
 			detectedNames := DetectInterpolationVariables(*value)
 			names = append(names, detectedNames...)
 		}
+		// the values of secrets are resolved from config, like environment variables without a value
+		for _, secret := range service.Secrets {
+			names = append(names, secret.Source)
+		}
+		if ingress := GetIngress(&service); ingress != nil && ingress.Auth != nil {
+			names = append(names, ingress.Auth.Config)
+		}
 	}
 
 	if len(names) == 0 {
@@ -470,7 +674,7 @@ func ValidateProjectConfig(composeProject *composeTypes.Project, listConfigNames
 
 	errMissingConfig := ErrMissingConfig{}
 	for _, name := range names {
-		if !slices.Contains(listConfigNames, name) {
+		if !slices.Contains(listConfigNames, name) && !slices.Contains(provided, name) {
 			errMissingConfig = append(errMissingConfig, name)
 		}
 	}
@@ -502,12 +706,39 @@ func validateManagedStore(managedStore any) (bool, error) {
 	}
 }
 
+func validateStaticFiles(staticFiles any) error {
+	switch staticFiles := staticFiles.(type) {
+	case string:
+		return nil
+	case map[string]any:
+		for _, key := range []string{"folder", "build"} {
+			if val, ok := staticFiles[key]; ok {
+				if _, ok := val.(string); !ok {
+					return fmt.Errorf("x-defang-static-files: '%s' must be a string", key)
+				}
+			}
+		}
+		if val, ok := staticFiles["spa"]; ok {
+			if _, ok := val.(bool); !ok {
+				return errors.New("x-defang-static-files: 'spa' must be a boolean")
+			}
+		}
+		if _, ok := staticFiles["build"]; ok && staticFiles["folder"] == nil {
+			return errors.New("x-defang-static-files: 'build' requires a 'folder' for the build output")
+		}
+		return nil
+	default:
+		return errors.New(`x-defang-static-files must be a string or object {"folder": string, "build": string, "spa": bool, "redirects": string[]}`)
+	}
+}
+
 func IsComputeService(service *composeTypes.ServiceConfig) bool {
 	if service.Extensions == nil {
 		return true
 	}
 
 	return service.Extensions["x-defang-static-files"] == nil &&
+		GetBucket(service) == nil &&
 		service.Extensions["x-defang-redis"] == nil &&
 		service.Extensions["x-defang-mongodb"] == nil &&
 		service.Extensions["x-defang-postgres"] == nil
@@ -69,7 +69,7 @@ func TestValidationAndConvert(t *testing.T) {
 		if strings.Contains(path, "replicas") {
 			mode = modes.ModeHighAvailability
 		}
-		if err := ValidateProject(project, mode); err != nil {
+		if err := ValidateProject(t.Context(), project, mode, client.ProviderAuto); err != nil {
 			t.Logf("Project validation failed: %v", err)
 			logs.WriteString("Error: " + err.Error() + "\n") // no coverage!
 		}
@@ -140,6 +140,23 @@ func TestValidateConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("Missing ingress auth config", func(t *testing.T) {
+		testProject.Services["service1"] = composeTypes.ServiceConfig{
+			Extensions: composeTypes.Extensions{"x-defang-ingress": map[string]any{"auth": map[string]any{"type": "basic", "config": "STAGING_AUTH"}}},
+		}
+
+		var missing ErrMissingConfig
+		if err := ValidateProjectConfig(&testProject, []string{}); !errors.As(err, &missing) {
+			t.Fatalf("expected ErrMissingConfig, got: %v", err)
+		} else if len(missing) != 1 || missing[0] != "STAGING_AUTH" {
+			t.Fatalf("unexpected error: missing, got: %v expected [STAGING_AUTH]", missing)
+		}
+
+		if err := ValidateProjectConfig(&testProject, []string{"STAGING_AUTH"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
 	t.Run("Missing interpolated variable", func(t *testing.T) {
 		env := map[string]*string{
 			"interpolated": ptr.String(`${CONFIG_VAR}`),
@@ -234,3 +251,66 @@ func TestManagedStoreParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateServiceNameCollisions(t *testing.T) {
+	tests := []struct {
+		names   []string
+		wantErr string
+	}{
+		{names: []string{"web", "api"}},
+		{names: []string{"my_svc", "my-svc"}, wantErr: `the service names "my-svc" and "my_svc" normalize to the same value`},
+		{names: []string{"My.Svc", "my-svc"}, wantErr: `the service names "My.Svc" and "my-svc" normalize to the same value`},
+		{names: []string{"Web", "web"}, wantErr: `the service names "Web" and "web" normalize to the same value`},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.names, ","), func(t *testing.T) {
+			project := &composeTypes.Project{Name: "app", Services: composeTypes.Services{}}
+			for _, name := range tt.names {
+				project.Services[name] = composeTypes.ServiceConfig{Name: name, Image: "nginx"}
+			}
+			err := ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderAuto)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReplicasAndLimits(t *testing.T) {
+	replicas := func(n int) *int { return &n }
+	tests := []struct {
+		name    string
+		deploy  *composeTypes.DeployConfig
+		wantErr string
+	}{
+		{name: "replicas", deploy: &composeTypes.DeployConfig{Replicas: replicas(3)}},
+		{name: "negative replicas", deploy: &composeTypes.DeployConfig{Replicas: replicas(-1)}, wantErr: "deploy.replicas must not be negative"},
+		{name: "limits above reservations", deploy: &composeTypes.DeployConfig{Resources: composeTypes.Resources{
+			Limits:       &composeTypes.Resource{NanoCPUs: 2, MemoryBytes: 1024 * MiB},
+			Reservations: &composeTypes.Resource{NanoCPUs: 1, MemoryBytes: 512 * MiB},
+		}}},
+		{name: "memory limit below reservation", deploy: &composeTypes.DeployConfig{Resources: composeTypes.Resources{
+			Limits:       &composeTypes.Resource{MemoryBytes: 256 * MiB},
+			Reservations: &composeTypes.Resource{MemoryBytes: 512 * MiB},
+		}}, wantErr: "memory limit (256MiB) must not be lower than the reservation (512MiB)"},
+		{name: "cpus limit below reservation", deploy: &composeTypes.DeployConfig{Resources: composeTypes.Resources{
+			Limits:       &composeTypes.Resource{NanoCPUs: 0.5},
+			Reservations: &composeTypes.Resource{NanoCPUs: 1, MemoryBytes: 512 * MiB},
+		}}, wantErr: "cpus limit (0.5) must not be lower than the reservation (1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := &composeTypes.Project{Name: "app", Services: composeTypes.Services{
+				"web": {Name: "web", Image: "nginx", Deploy: tt.deploy},
+			}}
+			err := ValidateProject(t.Context(), project, modes.ModeAffordable, client.ProviderAuto)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
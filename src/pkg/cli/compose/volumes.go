@@ -0,0 +1,68 @@
+package compose
+
+import (
+	"context"
+	"maps"
+	"path"
+	"slices"
+
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// hasPersistentVolume returns true if the service mounts a named volume, which keeps its data when the container is
+// replaced. Anonymous volumes and tmpfs mounts are ephemeral.
+func hasPersistentVolume(svccfg *composeTypes.ServiceConfig) bool {
+	return slices.ContainsFunc(svccfg.Volumes, func(volume composeTypes.ServiceVolumeConfig) bool {
+		return volume.Type == composeTypes.VolumeTypeVolume && volume.Source != ""
+	})
+}
+
+// validateVolumes checks how the volumes of the service map to the platform: named volumes become persistent
+// volumes, anonymous volumes and tmpfs mounts use ephemeral storage, and bind mounts are not possible, because the
+// files on this machine are not available in the cloud.
+func validateVolumes(ctx context.Context, svccfg *composeTypes.ServiceConfig, project *composeTypes.Project) error {
+	for _, volume := range svccfg.Volumes {
+		if !path.IsAbs(volume.Target) {
+			return errorf(CodeInvalidVolume, "service %q: volume target %q must be an absolute path", svccfg.Name, volume.Target)
+		}
+		switch volume.Type {
+		case composeTypes.VolumeTypeTmpfs:
+			// checked by GetTmpfsMounts
+		case composeTypes.VolumeTypeBind:
+			return errorf(CodeInvalidVolume, "service %q: bind mount of %q is not supported, because the files on this machine are not available in the cloud; COPY the files into the image in the Dockerfile, or use a named volume for data", svccfg.Name, volume.Source)
+		case composeTypes.VolumeTypeVolume:
+			if volume.Source == "" {
+				warnf(ctx, CodeInvalidVolume, "service %q: anonymous volume at %q uses ephemeral storage, which is lost when the container is replaced; name the volume to keep its data", svccfg.Name, volume.Target)
+				continue
+			}
+			if _, ok := project.Volumes[volume.Source]; !ok {
+				return errorf(CodeInvalidVolume, "service %q: volume %q is not defined in the top-level volumes section", svccfg.Name, volume.Source)
+			}
+			if volume.Volume != nil && volume.Volume.Subpath != "" {
+				return errorf(CodeInvalidVolume, "service %q: unsupported volume option: subpath", svccfg.Name)
+			}
+			termFromContext(ctx).Debugf("service %q: volume %q is mounted as a persistent volume at %q", svccfg.Name, volume.Source, volume.Target)
+		default:
+			return errorf(CodeInvalidVolume, "service %q: unsupported volume type %q", svccfg.Name, volume.Type)
+		}
+	}
+	return nil
+}
+
+// validateProjectVolumes checks the top-level volumes section: the platform creates the persistent volumes, so
+// external volumes and volume drivers are not supported.
+func validateProjectVolumes(ctx context.Context, project *composeTypes.Project) error {
+	for _, name := range slices.Sorted(maps.Keys(project.Volumes)) {
+		volume := project.Volumes[name]
+		if volume.External {
+			return errorf(CodeInvalidVolume, "volume %q: external volumes are not supported; remove 'external' to have a persistent volume created", name)
+		}
+		if volume.Driver != "" && volume.Driver != "local" {
+			warnf(ctx, CodeInvalidVolume, "volume %q: unsupported volume driver %q; using a persistent volume of the platform", name, volume.Driver)
+		}
+		if len(volume.DriverOpts) > 0 {
+			warnf(ctx, CodeInvalidVolume, "volume %q: unsupported volume driver_opts; using a persistent volume of the platform", name)
+		}
+	}
+	return nil
+}
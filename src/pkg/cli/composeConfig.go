@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+)
+
+// ConfigFormat is the format of the project printed by "compose config"
+type ConfigFormat string
+
+const (
+	ConfigFormatYAML ConfigFormat = "yaml"
+	ConfigFormatJSON ConfigFormat = "json"
+)
+
+var AllConfigFormats = []ConfigFormat{ConfigFormatYAML, ConfigFormatJSON}
+
+func (f ConfigFormat) String() string {
+	return string(f)
+}
+
+func (f *ConfigFormat) Set(s string) error {
+	format := ConfigFormat(strings.ToLower(s))
+	if !slices.Contains(AllConfigFormats, format) {
+		return fmt.Errorf("invalid config format: %q, not one of %v", s, AllConfigFormats)
+	}
+	*f = format
+	return nil
+}
+
+func (f ConfigFormat) Type() string {
+	return "config-format"
+}
+
+// printProjectConfig prints the normalized project, as it would be deployed.
+func printProjectConfig(w io.Writer, project *compose.Project, format ConfigFormat) error {
+	if format != ConfigFormatJSON {
+		b, err := compose.MarshalYAML(project)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+	b, err := project.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", "  "); err != nil {
+		return err
+	}
+	out.WriteByte('\n')
+	_, err = out.WriteTo(w)
+	return err
+}
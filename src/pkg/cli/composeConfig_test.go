@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+)
+
+func TestPrintProjectConfig(t *testing.T) {
+	project := &compose.Project{
+		Name:     "app",
+		Services: compose.Services{"web": {Name: "web", Image: "nginx"}},
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := printProjectConfig(&out, project, ConfigFormatYAML); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "name: app") || !strings.Contains(out.String(), "image: nginx") {
+			t.Errorf("unexpected YAML:\n%s", out.String())
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := printProjectConfig(&out, project, ConfigFormatJSON); err != nil {
+			t.Fatal(err)
+		}
+		var parsed struct {
+			Name     string `json:"name"`
+			Services map[string]struct {
+				Image string `json:"image"`
+			} `json:"services"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &parsed); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+		}
+		if parsed.Name != "app" || parsed.Services["web"].Image != "nginx" {
+			t.Errorf("unexpected JSON:\n%s", out.String())
+		}
+	})
+}
+
+func TestConfigFormat(t *testing.T) {
+	var format ConfigFormat
+	if err := format.Set("JSON"); err != nil || format != ConfigFormatJSON {
+		t.Errorf("Set(JSON) = %v, %q", err, format)
+	}
+	if err := format.Set("toml"); err == nil {
+		t.Error("expected an error for an invalid format")
+	}
+}
@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/state"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/types"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"go.yaml.in/yaml/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// errOffline is returned by the methods of the offline provider that would need credentials or network access.
+var errOffline = errors.New("offline")
+
+// offlineProvider only resolves the service names like the wrapped provider does, so a deployment can be rendered
+// without credentials or network access. The other methods return errOffline.
+type offlineProvider struct {
+	provider client.Provider
+}
+
+var _ client.Provider = offlineProvider{}
+
+// NewOfflineProvider returns a provider for RenderDeployment that names the services like the given provider, which
+// is never called otherwise.
+func NewOfflineProvider(provider client.Provider) client.Provider {
+	return offlineProvider{provider: provider}
+}
+
+// Unwrap returns the wrapped provider, so client.IsPlayground can tell the kind of provider.
+func (p offlineProvider) Unwrap() client.Provider {
+	return p.provider
+}
+
+func (p offlineProvider) ServicePrivateDNS(name string) string {
+	return p.provider.ServicePrivateDNS(name)
+}
+
+func (p offlineProvider) ServicePublicDNS(name string, projectName string) string {
+	return p.provider.ServicePublicDNS(name, projectName)
+}
+
+func (p offlineProvider) UpdateShardDomain(ctx context.Context) error {
+	if client.IsPlayground(p.provider) {
+		return errOffline // the Playground domain is unknown, so public names are not replaced
+	}
+	return p.provider.UpdateShardDomain(ctx)
+}
+
+func (p offlineProvider) GetStackName() string {
+	return p.provider.GetStackName()
+}
+
+func (p offlineProvider) GetStackNameForDomain() string {
+	return p.provider.GetStackNameForDomain()
+}
+
+func (offlineProvider) ListConfig(context.Context, *defangv1.ListConfigsRequest) (*defangv1.Secrets, error) {
+	return &defangv1.Secrets{}, nil // unknown; the config is checked when deploying
+}
+
+func (offlineProvider) SetCanIUseConfig(*defangv1.CanIUseResponse) {}
+
+func (offlineProvider) AccountInfo(context.Context) (*client.AccountInfo, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) CdCommand(context.Context, client.CdCommandRequest) (types.ETag, error) {
+	return "", errOffline
+}
+
+func (offlineProvider) CdList(context.Context, bool) (iter.Seq[state.Info], error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) CreateUploadURL(context.Context, *defangv1.UploadURLRequest) (*defangv1.UploadURLResponse, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) DelayBeforeRetry(context.Context) error {
+	return errOffline
+}
+
+func (offlineProvider) DeleteConfig(context.Context, *defangv1.Secrets) error {
+	return errOffline
+}
+
+func (offlineProvider) Deploy(context.Context, *client.DeployRequest) (*defangv1.DeployResponse, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) GetDeploymentStatus(context.Context) (bool, error) {
+	return false, errOffline
+}
+
+func (offlineProvider) GetProjectUpdate(context.Context, string) (*defangv1.ProjectUpdate, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) GetService(context.Context, *defangv1.GetRequest) (*defangv1.ServiceInfo, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) GetServices(context.Context, *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) PrepareDomainDelegation(context.Context, client.PrepareDomainDelegationRequest) (*client.PrepareDomainDelegationResponse, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) Preview(context.Context, *client.DeployRequest) (*defangv1.DeployResponse, error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) PutConfig(context.Context, *defangv1.PutConfigRequest) error {
+	return errOffline
+}
+
+func (offlineProvider) QueryLogs(context.Context, *defangv1.TailRequest) (iter.Seq2[*defangv1.TailResponse, error], error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) RemoteProjectName(context.Context) (string, error) {
+	return "", errOffline
+}
+
+func (offlineProvider) SetUpCD(context.Context) error {
+	return errOffline
+}
+
+func (offlineProvider) Subscribe(context.Context, *defangv1.SubscribeRequest) (iter.Seq2[*defangv1.SubscribeResponse, error], error) {
+	return nil, errOffline
+}
+
+func (offlineProvider) TearDownCD(context.Context) error {
+	return errOffline
+}
+
+// RenderDeployment does what ComposeUp does before it deploys, using only the local files: validating the project,
+// computing the digests of the build contexts, and converting the project. Nothing is uploaded or deployed, so it
+// can be used to lint a project in CI without credentials. The provider must be an offline provider.
+func RenderDeployment(ctx context.Context, provider client.Provider, stack *stacks.Parameters, project *compose.Project, mode modes.Mode, spot bool) (*defangv1.DeployRequest, error) {
+	if err := compose.ValidateServiceDockerfiles(ctx, project); err != nil {
+		return nil, &ComposeError{err}
+	}
+
+	fixedProject := project.WithoutUnnecessaryResources()
+	if spot {
+		compose.UseSpotCapacity(ctx, fixedProject)
+	}
+	// Like for a preview, the build contexts are archived for their digest, but not uploaded
+	if err := compose.FixupServices(ctx, provider, fixedProject, compose.UploadModePreview); err != nil {
+		return nil, err
+	}
+
+	if err := compose.ValidateProject(ctx, fixedProject, mode, stackProviderID(stack)); err != nil {
+		return nil, &ComposeError{err}
+	}
+
+	bytes, err := compose.MarshalYAML(fixedProject)
+	if err != nil {
+		return nil, err
+	}
+	order, dependencies, err := compose.DeploymentDependencies(fixedProject.Services)
+	if err != nil {
+		return nil, &ComposeError{err}
+	}
+	return &defangv1.DeployRequest{
+		Mode:         mode.Value(),
+		Project:      project.Name,
+		Compose:      bytes,
+		Order:        order,
+		Dependencies: dependencies,
+	}, nil
+}
+
+// PrintDeployRequest prints the request with the Compose file as text, instead of the base64 of its bytes.
+func PrintDeployRequest(w io.Writer, req *defangv1.DeployRequest, format ConfigFormat) error {
+	bytes, err := protojson.Marshal(&defangv1.DeployRequest{Mode: req.Mode, Project: req.Project, DelegateDomain: req.DelegateDomain, Order: req.Order, Dependencies: req.Dependencies})
+	if err != nil {
+		return err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		return err
+	}
+	raw["compose"] = string(req.Compose)
+
+	if format == ConfigFormatJSON {
+		bytes, err = json.MarshalIndent(raw, "", "  ")
+	} else {
+		bytes, err = yaml.Marshal(raw)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bytes))
+	return err
+}
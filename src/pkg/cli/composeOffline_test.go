@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestRenderDeployment(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	newProject := func() *compose.Project {
+		return &compose.Project{
+			Name: "app",
+			Services: compose.Services{
+				"web": {
+					Name:  "web",
+					Build: &composeTypes.BuildConfig{Context: dir, Dockerfile: "Dockerfile"},
+					Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS, Protocol: compose.Protocol_TCP}},
+				},
+			},
+		}
+	}
+	provider := NewOfflineProvider(client.MockProvider{})
+
+	t.Run("rendered", func(t *testing.T) {
+		req, err := RenderDeployment(t.Context(), provider, nil, newProject(), modes.ModeAffordable, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.Project != "app" || req.Mode != modes.ModeAffordable.Value() {
+			t.Errorf("unexpected request: %v", req)
+		}
+		if !strings.Contains(string(req.Compose), "context: s3://cd-preview/sha256-") {
+			t.Errorf("expected the digest of the build context, got:\n%s", req.Compose)
+		}
+		if len(req.Order) != 1 || !slices.Equal(req.Order[0].Services, []string{"web"}) {
+			t.Errorf("expected the deployment order, got %v", req.Order)
+		}
+
+		var out bytes.Buffer
+		if err := PrintDeployRequest(&out, req, ConfigFormatJSON); err != nil {
+			t.Fatal(err)
+		}
+		var printed struct {
+			Project string `json:"project"`
+			Compose string `json:"compose"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &printed); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+		}
+		if printed.Project != "app" || printed.Compose != string(req.Compose) {
+			t.Errorf("unexpected JSON:\n%s", out.String())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		project := newProject()
+		web := project.Services["web"]
+		web.Volumes = []composeTypes.ServiceVolumeConfig{{Type: composeTypes.VolumeTypeBind, Source: "/tmp", Target: "/data"}}
+		project.Services["web"] = web
+		_, err := RenderDeployment(t.Context(), provider, nil, project, modes.ModeAffordable, false)
+		var composeErr *ComposeError
+		if !errors.As(err, &composeErr) {
+			t.Errorf("expected a ComposeError, got %v", err)
+		}
+	})
+	t.Run("playground", func(t *testing.T) {
+		stdout, _ := term.SetupTestTerm(t)
+		project := newProject()
+		web := project.Services["web"]
+		web.Extensions = map[string]any{"x-defang-postgres": true}
+		project.Services["web"] = web
+		_, err := RenderDeployment(t.Context(), NewOfflineProvider(&client.PlaygroundProvider{}), nil, project, modes.ModeAffordable, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(stdout.String(), "managed postgres is not supported in the Playground") {
+			t.Errorf("expected a Playground warning, got %q", stdout.String())
+		}
+	})
+	t.Run("static site build command", func(t *testing.T) {
+		project := newProject()
+		project.WorkingDir = dir
+		project.Services["site"] = composeTypes.ServiceConfig{
+			Name: "site",
+			Extensions: map[string]any{
+				"x-defang-static-files": map[string]any{"folder": "dist", "build": "mkdir dist"},
+			},
+		}
+		if _, err := RenderDeployment(t.Context(), provider, nil, project, modes.ModeAffordable, false); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "dist")); !os.IsNotExist(err) {
+			t.Error("expected the build command not to run offline")
+		}
+	})
+}
+
+func TestOfflineProvider(t *testing.T) {
+	provider := NewOfflineProvider(client.MockProvider{})
+	if client.IsPlayground(provider) {
+		t.Error("expected the offline provider not to be the Playground")
+	}
+	if !client.IsPlayground(NewOfflineProvider(&client.PlaygroundProvider{})) {
+		t.Error("expected the offline provider to be the Playground")
+	}
+	if _, err := provider.Deploy(t.Context(), &client.DeployRequest{}); !errors.Is(err, errOffline) {
+		t.Errorf("expected an offline error, got %v", err)
+	}
+	if _, err := provider.GetProjectUpdate(t.Context(), "app"); !errors.Is(err, errOffline) {
+		t.Errorf("expected an offline error, got %v", err)
+	}
+}
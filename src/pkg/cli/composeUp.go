@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"time"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
 	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/progress"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
@@ -22,13 +28,56 @@ func (e ComposeError) Unwrap() error {
 	return e.error
 }
 
+// InterruptedError is returned when ComposeUp is canceled, eg. by Ctrl+C, so the caller can tell the user
+// whether anything was deployed.
+type InterruptedError struct {
+	ProjectName    string
+	MaybeSubmitted bool // interrupted while submitting the deployment, so it might be in progress
+	error
+}
+
+func (e InterruptedError) Unwrap() error {
+	return e.error
+}
+
 type ComposeUpParams struct {
-	Project    *compose.Project
-	UploadMode compose.UploadMode
-	Mode       modes.Mode
+	Project       *compose.Project
+	UploadMode    compose.UploadMode
+	Mode          modes.Mode
+	Spot          bool // use spot/preemptible capacity where possible
+	RemoveOrphans bool // remove deployed services that are not in the project, instead of keeping them
+	Confirm       bool // show the changes to the deployed project and ask before deploying
+	Upload        compose.UploadOptions
+	ConfigFormat  ConfigFormat // format of the project that is printed with UploadModeIgnore; YAML by default
+	ConfigOut     io.Writer    // where the project is printed with UploadModeIgnore; defaults to the terminal
 }
 
-func checkDeploymentMode(prevMode, newMode modes.Mode) (modes.Mode, error) {
+// planBeforeUpload returns the plan of the deployment without uploading the build contexts, by fixing up a copy of
+// the project like for a preview. The fixup is repeated for the deployment, so its output is discarded here.
+func planBeforeUpload(ctx context.Context, provider client.Provider, prevUpdate *defangv1.ProjectUpdate, fixedProject *compose.Project, mode modes.Mode, providerID client.ProviderID) (DeploymentPlan, error) {
+	planProject := fixedProject.WithoutUnnecessaryResources() // a deep copy
+	opts := OptionsFromContext(ctx)
+	opts.Term = term.NewTerm(os.Stdin, io.Discard, io.Discard)
+	quietCtx := WithOptions(ctx, opts)
+
+	if err := compose.FixupServices(quietCtx, provider, planProject, compose.UploadModePreview); err != nil {
+		return DeploymentPlan{}, err
+	}
+	if err := compose.ValidateProject(quietCtx, planProject, mode, providerID); err != nil {
+		return DeploymentPlan{}, &ComposeError{err}
+	}
+	return planDeployment(quietCtx, prevUpdate, planProject, provider.GetStackName())
+}
+
+// stackProviderID returns the provider of the stack, or ProviderAuto if there is no stack.
+func stackProviderID(stack *stacks.Parameters) client.ProviderID {
+	if stack == nil {
+		return client.ProviderAuto
+	}
+	return stack.Provider
+}
+
+func checkDeploymentMode(t *term.Term, prevMode, newMode modes.Mode) (modes.Mode, error) {
 	// previous deployment mode | new mode          | behavior:
 	// -------------------------|-------------------|-----------------------
 	// any                      | unspecified       | previous mode
@@ -44,7 +93,7 @@ func checkDeploymentMode(prevMode, newMode modes.Mode) (modes.Mode, error) {
 	switch newMode {
 	case modes.ModeUnspecified:
 		if prevMode != modes.ModeUnspecified {
-			term.Debug("No deployment mode specified; using previous deployment mode:", prevMode)
+			t.Debug("No deployment mode specified; using previous deployment mode:", prevMode)
 			newMode = prevMode
 		}
 	case modes.ModeAffordable:
@@ -52,11 +101,11 @@ func checkDeploymentMode(prevMode, newMode modes.Mode) (modes.Mode, error) {
 		case modes.ModeHighAvailability:
 			return newMode, fmt.Errorf("will not downgrade deployment mode from %s to %s; use %s", prevMode, newMode, modes.ModeBalanced)
 		case modes.ModeBalanced:
-			term.Warnf("Downgrading deployment mode from %s to %s", prevMode, newMode)
+			t.Warnf("Downgrading deployment mode from %s to %s", prevMode, newMode)
 		}
 	case modes.ModeBalanced:
 		if prevMode == modes.ModeHighAvailability {
-			term.Warnf("Downgrading deployment mode from %s to %s", prevMode, newMode)
+			t.Warnf("Downgrading deployment mode from %s to %s", prevMode, newMode)
 		}
 	case modes.ModeHighAvailability:
 		// from anything to high-availability is allowed
@@ -65,19 +114,27 @@ func checkDeploymentMode(prevMode, newMode modes.Mode) (modes.Mode, error) {
 }
 
 // ComposeUp validates a compose project and uploads the services using the client
-func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, params ComposeUpParams) (*defangv1.DeployResponse, *compose.Project, error) {
+func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, params ComposeUpParams) (_ *defangv1.DeployResponse, _ *compose.Project, err error) {
+	opts := OptionsFromContext(ctx)
 	upload := params.UploadMode
 	project := params.Project
 	mode := params.Mode
 
-	if dryrun.DoDryRun {
+	submitting := false
+	defer func() {
+		if err != nil && ctx.Err() != nil {
+			err = &InterruptedError{ProjectName: project.Name, MaybeSubmitted: submitting, error: err}
+		}
+	}()
+
+	if opts.DryRun {
 		upload = compose.UploadModeIgnore
 	}
 
 	// Validate Dockerfiles before processing the build contexts
 	// Only validate when actually deploying (not for dry-run/ignore mode)
-	if upload != compose.UploadModeIgnore && upload != compose.UploadModeEstimate {
-		if err := compose.ValidateServiceDockerfiles(project); err != nil {
+	if upload != compose.UploadModeIgnore && upload != compose.UploadModeEstimate && upload != compose.UploadModeNoBuild {
+		if err := compose.ValidateServiceDockerfiles(ctx, project); err != nil {
 			return nil, project, &ComposeError{err}
 		}
 	}
@@ -96,22 +153,77 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 	// Create a new project with only the necessary resources.
 	// Do not modify the original project, because the caller needs it for debugging.
 	fixedProject := project.WithoutUnnecessaryResources()
-	if err := compose.FixupServices(ctx, provider, fixedProject, upload); err != nil {
+
+	var prevUpdate *defangv1.ProjectUpdate
+	if upload != compose.UploadModeIgnore {
+		var err error
+		if prevUpdate, err = provider.GetProjectUpdate(ctx, project.Name); err != nil {
+			opts.Term.Debug("GetProjectUpdate failed:", err)
+			prevUpdate = nil
+		}
+		if upload != compose.UploadModeEstimate {
+			handleOrphans(ctx, prevUpdate, fixedProject, params.RemoveOrphans)
+		}
+	}
+
+	if params.Spot {
+		compose.UseSpotCapacity(ctx, fixedProject)
+	}
+	uploadOpts := params.Upload
+	if upload == compose.UploadModeDefault || upload == compose.UploadModeDigest || upload == compose.UploadModeForce {
+		uploadOpts.MaxContextSize = maxContextSize(ctx, fabric, uploadOpts.MaxContextSize)
+	}
+	// Confirm the plan before anything gets uploaded; like a preview, the build contexts are only digested for the plan
+	if params.Confirm && upload != compose.UploadModeIgnore && upload != compose.UploadModePreview && upload != compose.UploadModeEstimate {
+		plan, err := planBeforeUpload(ctx, provider, prevUpdate, fixedProject, mode, stackProviderID(stack))
+		if err != nil {
+			return nil, project, err
+		}
+		if display, ok := progress.FromContext(ctx).(*progress.Display); ok {
+			display.SetInPlace(false) // or the plan gets overwritten
+		}
+		if err := PrintDeploymentPlan(ctx, opts.Term.Stdout(), plan, OutputFormatText); err != nil {
+			return nil, project, err
+		}
+		if err := Confirm(ctx, ConfirmPrompt{Message: "Deploy these changes?", Event: "Deploy Plan Prompt Answered"}); err != nil {
+			return nil, project, err
+		}
+	}
+
+	services := slices.Collect(maps.Keys(fixedProject.Services))
+	if err := compose.FixupServices(compose.WithUploadOptions(ctx, uploadOpts), provider, fixedProject, upload); err != nil {
 		return nil, project, err
 	}
+	// Services whose build context failed to upload were skipped; keep running their deployed version
+	if skipped := slices.DeleteFunc(services, func(name string) bool {
+		_, ok := fixedProject.Services[name]
+		return ok
+	}); len(skipped) > 0 {
+		keepDeployedServices(ctx, prevUpdate, fixedProject, skipped)
+	}
 
-	if err := compose.ValidateProject(fixedProject, mode); err != nil {
+	if err := compose.ValidateProject(ctx, fixedProject, mode, stackProviderID(stack)); err != nil {
 		return nil, project, &ComposeError{err}
 	}
 
+	if upload == compose.UploadModeIgnore {
+		out := params.ConfigOut
+		if out == nil {
+			out = opts.Term.Stdout()
+		}
+		if err := printProjectConfig(out, fixedProject, params.ConfigFormat); err != nil {
+			return nil, project, err
+		}
+		return nil, project, dryrun.ErrDryRun
+	}
+
 	bytes, err := compose.MarshalYAML(fixedProject)
 	if err != nil {
 		return nil, project, err
 	}
-
-	if upload == compose.UploadModeIgnore {
-		term.Println(string(bytes))
-		return nil, project, dryrun.ErrDryRun
+	order, dependencies, err := compose.DeploymentDependencies(fixedProject.Services)
+	if err != nil {
+		return nil, project, err
 	}
 
 	delegateDomain, err := fabric.GetDelegateSubdomainZone(ctx, &defangv1.GetDelegateSubdomainZoneRequest{
@@ -119,13 +231,13 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 		Stack:   provider.GetStackNameForDomain(),
 	})
 	if err != nil {
-		term.Debug("GetDelegateSubdomainZone failed:", err)
+		opts.Term.Debug("GetDelegateSubdomainZone failed:", err)
 		return nil, project, errors.New("failed to get delegate domain")
 	}
 
-	if prevUpdate, err := provider.GetProjectUpdate(ctx, project.Name); err == nil && prevUpdate != nil {
+	if prevUpdate != nil {
 		prevMode := modes.Mode(prevUpdate.Mode)
-		mode, err = checkDeploymentMode(prevMode, mode)
+		mode, err = checkDeploymentMode(opts.Term, prevMode, mode)
 		if err != nil {
 			return nil, project, err
 		}
@@ -137,6 +249,8 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 			Project:        project.Name,
 			Compose:        bytes,
 			DelegateDomain: delegateDomain.Zone,
+			Order:          order,
+			Dependencies:   dependencies,
 		},
 	}
 
@@ -182,6 +296,7 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 			deployRequest.EventsUrl = eventsUrl
 		}
 
+		submitting = true
 		resp, err = provider.Deploy(ctx, deployRequest)
 		if err != nil {
 			return nil, project, err
@@ -189,7 +304,10 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 		action = defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP
 	}
 
-	err = putDeploymentAndStack(ctx, provider, fabric, stack, putDeploymentParams{
+	// The deployment was submitted, so record it even if we're interrupted now
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+	err = putDeploymentAndStack(recordCtx, provider, fabric, stack, putDeploymentParams{
 		Action:       action,
 		ETag:         resp.Etag,
 		Mode:         mode.Value(),
@@ -197,16 +315,24 @@ func ComposeUp(ctx context.Context, fabric client.FabricClient, provider client.
 		StatesUrl:    statesUrl,
 		EventsUrl:    eventsUrl,
 		ServiceInfos: resp.Services,
+		Compose:      deployRequest.Compose,
+		GitCommit:    getGitCommit(recordCtx, project.WorkingDir),
 	})
 	if err != nil {
-		term.Debug("Failed to record deployment:", err)
-		term.Warn("Unable to update deployment history; deployment will proceed anyway.")
+		opts.Term.Debug("Failed to record deployment:", err)
+		opts.Term.Warn("Unable to update deployment history; deployment will proceed anyway.")
+	}
+	if action == defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP {
+		// Also keep the Compose file locally, so we can roll back even if the deployment history is unavailable
+		if err := saveRevision(project.Name, provider.GetStackName(), resp.Etag, deployRequest.Compose); err != nil {
+			opts.Term.Debug("Failed to save deployment revision:", err)
+		}
 	}
 
-	if term.DoDebug() {
-		term.Println("Project:", project.Name)
+	if opts.DoDebug() {
+		opts.Term.Println("Project:", project.Name)
 		for _, serviceInfo := range resp.Services {
-			PrintObject(serviceInfo.Service.Name, serviceInfo)
+			PrintObject(ctx, serviceInfo.Service.Name, serviceInfo)
 		}
 	}
 	return resp, project, nil
@@ -7,6 +7,7 @@ import (
 	"iter"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
 	"github.com/DefangLabs/defang/src/pkg/modes"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,7 @@ type mockDeployProvider struct {
 	subscribeStream   *client.MockWaitStream[defangv1.SubscribeResponse]
 	tailStream        *client.MockWaitStream[defangv1.TailResponse]
 	prevProjectUpdate *defangv1.ProjectUpdate
+	deployedCompose   []byte
 	lock              sync.Mutex
 }
 
@@ -35,10 +38,13 @@ func (d *mockDeployProvider) Deploy(ctx context.Context, req *client.DeployReque
 	return d.Preview(ctx, req)
 }
 
-func (*mockDeployProvider) Preview(ctx context.Context, req *client.DeployRequest) (*defangv1.DeployResponse, error) {
+func (d *mockDeployProvider) Preview(ctx context.Context, req *client.DeployRequest) (*defangv1.DeployResponse, error) {
 	if len(req.Compose) == 0 {
 		return nil, errors.New("DeployRequest needs Compose")
 	}
+	d.lock.Lock()
+	d.deployedCompose = req.Compose
+	d.lock.Unlock()
 
 	project, err := compose.LoadFromContent(ctx, req.Compose, "")
 	if err != nil {
@@ -146,6 +152,70 @@ func TestComposeUp(t *testing.T) {
 		}
 	})
 
+	t.Run("orphans", func(t *testing.T) {
+		t.Cleanup(func() { mp.prevProjectUpdate = nil })
+		mp.prevProjectUpdate = &defangv1.ProjectUpdate{
+			Compose: []byte("name: " + proj.Name + "\nservices:\n  orphan:\n    image: nginx\n"),
+		}
+		for _, removeOrphans := range []bool{false, true} {
+			d, _, err := ComposeUp(t.Context(), mc, mp, stack, ComposeUpParams{
+				Mode:          modes.ModeAffordable,
+				Project:       proj,
+				UploadMode:    compose.UploadModeDigest,
+				RemoveOrphans: removeOrphans,
+			})
+			if err != nil {
+				t.Fatalf("ComposeUp() failed: %v", err)
+			}
+			kept := slices.ContainsFunc(d.Services, func(s *defangv1.ServiceInfo) bool { return s.Service.Name == "orphan" })
+			if kept == removeOrphans {
+				t.Errorf("ComposeUp(RemoveOrphans=%v): expected orphan to be kept: %v", removeOrphans, !removeOrphans)
+			}
+		}
+		if _, ok := proj.Services["orphan"]; ok {
+			t.Error("ComposeUp() should not modify the project")
+		}
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // as if the user pressed Ctrl+C
+
+		_, _, err := ComposeUp(ctx, mc, mp, stack, ComposeUpParams{
+			Mode:       modes.ModeAffordable,
+			Project:    proj,
+			UploadMode: compose.UploadModeDigest,
+		})
+		var ierr *InterruptedError
+		if !errors.As(err, &ierr) {
+			t.Fatalf("expected InterruptedError, got %v", err)
+		}
+		if ierr.MaybeSubmitted {
+			t.Error("expected the deployment not to be submitted")
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("confirm before upload", func(t *testing.T) {
+		gotContext.Store(false)
+		opts := OptionsFromContext(t.Context())
+		opts.NonInteractive = true // so the confirmation is declined
+		ctx := WithOptions(t.Context(), opts)
+
+		_, _, err := ComposeUp(ctx, mc, mp, stack, ComposeUpParams{
+			Mode:       modes.ModeAffordable,
+			Project:    proj,
+			UploadMode: compose.UploadModeDigest,
+			Confirm:    true,
+		})
+		require.ErrorIs(t, err, ErrConfirmationRequired)
+		if gotContext.Load() {
+			t.Error("ComposeUp() uploaded the build context before the plan was confirmed")
+		}
+	})
+
 	t.Run("no downgrade from HA to affordable", func(t *testing.T) {
 		mp.prevProjectUpdate = &defangv1.ProjectUpdate{
 			Mode: defangv1.DeploymentMode_PRODUCTION,
@@ -411,7 +481,7 @@ func Test_checkDeploymentMode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.prevMode.String()+"->"+tt.newMode.String(), func(t *testing.T) {
-			gotMode, err := checkDeploymentMode(tt.prevMode, tt.newMode)
+			gotMode, err := checkDeploymentMode(term.DefaultTerm, tt.prevMode, tt.newMode)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("checkDeploymentMode() error = %v, wantErr %v", err, tt.wantErr)
 			}
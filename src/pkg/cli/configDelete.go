@@ -5,14 +5,14 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
 
 func ConfigDelete(ctx context.Context, projectName string, provider client.Provider, names ...string) error {
-	term.Debugf("Deleting config %v in project %q", names, projectName)
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Deleting config %v in project %q", names, projectName)
 
-	if dryrun.DoDryRun {
+	if opts.DryRun {
 		return dryrun.ErrDryRun
 	}
 
@@ -20,8 +20,7 @@ func TestConfigDelete(t *testing.T) {
 	})
 
 	t.Run("expect error on DryRun", func(t *testing.T) {
-		dryrun.DoDryRun = true
-		t.Cleanup(func() { dryrun.DoDryRun = false })
+		ctx := WithOptions(ctx, Options{DryRun: true})
 
 		if err := ConfigDelete(ctx, "test", provider, "test_name"); err != dryrun.ErrDryRun {
 			t.Fatalf("Expected dryrun.ErrDryRun, got %v", err)
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
 
@@ -13,7 +12,8 @@ type PrintConfig struct {
 }
 
 func ConfigList(ctx context.Context, projectName string, provider client.Provider) error {
-	term.Debugf("Listing config in project %q", projectName)
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Listing config in project %q", projectName)
 
 	config, err := provider.ListConfig(ctx, &defangv1.ListConfigsRequest{Project: projectName})
 	if err != nil {
@@ -22,7 +22,7 @@ func ConfigList(ctx context.Context, projectName string, provider client.Provide
 
 	numConfigs := len(config.Names)
 	if numConfigs == 0 {
-		_, err := term.Warn("No configs found")
+		_, err := opts.Term.Warn("No configs found")
 		return err
 	}
 
@@ -31,5 +31,5 @@ func ConfigList(ctx context.Context, projectName string, provider client.Provide
 		configNames[i] = PrintConfig{Name: c}
 	}
 
-	return term.Table(configNames, "Name")
+	return opts.Term.Table(configNames, "Name")
 }
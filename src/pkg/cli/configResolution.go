@@ -67,7 +67,7 @@ func determineConfigSource(envKey string, envValue *string, defangConfigs map[st
 
 // printConfigResolutionSummary prints a summary of where each environment variable in the compose file is coming from (compose file, defang config, or interpolation).
 // If redact is true, it will mask values that are from the compose file and look like secrets.
-func printConfigResolutionSummary(project *types.Project, defangConfig []string, redact bool) error {
+func printConfigResolutionSummary(t *term.Term, project *types.Project, defangConfig []string, redact bool) error {
 	configset := make(map[string]struct{})
 	for _, name := range defangConfig {
 		configset[name] = struct{}{}
@@ -112,9 +112,9 @@ func printConfigResolutionSummary(project *types.Project, defangConfig []string,
 
 	projectEnvVars = slices.Compact(projectEnvVars)
 
-	term.Info("Service environment variables resolution summary:")
+	t.Info("Service environment variables resolution summary:")
 
-	return term.Table(projectEnvVars, "Service", "Environment", "Source", "Value")
+	return t.Table(projectEnvVars, "Service", "Environment", "Source", "Value")
 }
 
 func printConfigSummaryAndValidate(ctx context.Context, provider client.Provider, project *compose.Project, redact bool) error {
@@ -123,7 +123,7 @@ func printConfigSummaryAndValidate(ctx context.Context, provider client.Provider
 		return err
 	}
 
-	err = printConfigResolutionSummary(project, configs.Names, redact)
+	err = printConfigResolutionSummary(OptionsFromContext(ctx).Term, project, configs.Names, redact)
 	if err != nil {
 		return err
 	}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
 
@@ -28,13 +27,14 @@ type ConfigManager interface {
 }
 
 func ConfigSet(ctx context.Context, projectName string, provider ConfigManager, name string, value string, options ConfigSetOptions) (bool, error) {
-	term.Debugf("Setting config %q in project %q", name, projectName)
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Setting config %q in project %q", name, projectName)
 
 	if !pkg.IsValidSecretName(name) {
 		return false, ErrInvalidConfigName{Name: name}
 	}
 
-	if dryrun.DoDryRun {
+	if opts.DryRun {
 		return false, dryrun.ErrDryRun
 	}
 
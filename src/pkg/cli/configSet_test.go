@@ -113,8 +113,7 @@ func TestConfigSet(t *testing.T) {
 	})
 
 	t.Run("expect error on DryRun", func(t *testing.T) {
-		dryrun.DoDryRun = true
-		t.Cleanup(func() { dryrun.DoDryRun = false })
+		ctx := WithOptions(ctx, Options{DryRun: true})
 		_, err := ConfigSet(ctx, "test", provider, "test_name", "test_value", ConfigSetOptions{})
 		require.ErrorIs(t, err, dryrun.ErrDryRun)
 	})
@@ -7,25 +7,24 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/aws"
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/do"
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/gcp"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 )
 
 // Connect builds a client carrying the requested tenant (name or ID).
 func Connect(cluster string, requestedTenant types.TenantNameOrID) *client.GrpcClient {
 	host := client.NormalizeHost(cluster)
-	term.Debugf("Using tenant %q for cluster %q", requestedTenant, host)
-
 	accessToken := client.GetExistingToken(host)
 	return client.NewGrpcClient(host, accessToken, requestedTenant)
 }
 
 func ConnectWithTenant(ctx context.Context, addr string, requestedTenant types.TenantNameOrID) (*client.GrpcClient, error) {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Using tenant %q for cluster %q", requestedTenant, client.NormalizeHost(addr))
 	grpcClient := Connect(addr, requestedTenant)
 
 	resp, err := grpcClient.WhoAmI(ctx)
 	if err != nil {
-		term.Debug("Unable to validate tenant with server:", err)
+		opts.Term.Debug("Unable to validate tenant with server:", err)
 		return grpcClient, err
 	}
 
@@ -34,8 +33,9 @@ func ConnectWithTenant(ctx context.Context, addr string, requestedTenant types.T
 }
 
 func NewProvider(ctx context.Context, providerID client.ProviderID, fabricClient client.FabricClient, stack string) client.Provider {
+	opts := OptionsFromContext(ctx)
 	var provider client.Provider
-	term.Debugf("Creating %s provider", providerID)
+	opts.Term.Debugf("Creating %s provider", providerID)
 	switch providerID {
 	case client.ProviderAWS:
 		provider = aws.NewByocProvider(ctx, fabricClient.GetTenantName(), stack)
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/docker/go-units"
+)
+
+type ContextFileLineItem struct {
+	Path string
+	Size string
+}
+
+type BuildContextListing struct {
+	Service string
+	Root    string
+	Files   []compose.ContextFile
+	Size    int64 // uncompressed
+}
+
+// ListBuildContextFiles returns the files that would be uploaded for each service with a local build context.
+func ListBuildContextFiles(ctx context.Context, project *compose.Project) ([]BuildContextListing, error) {
+	var listings []BuildContextListing
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		svccfg := project.Services[name]
+		if svccfg.Build == nil || strings.Contains(svccfg.Build.Context, "://") {
+			continue // no build or remote build context
+		}
+		root, err := filepath.Abs(svccfg.Build.Context)
+		if err != nil {
+			return nil, fmt.Errorf("service %q: invalid build context: %w", name, err)
+		}
+		files, err := compose.ListContextFiles(ctx, root, svccfg.Build.Dockerfile)
+		if err != nil {
+			return nil, fmt.Errorf("service %q: %w", name, err)
+		}
+		listing := BuildContextListing{Service: name, Root: root, Files: files}
+		for _, file := range files {
+			listing.Size += file.Size
+		}
+		listings = append(listings, listing)
+	}
+	return listings, nil
+}
+
+func PrintBuildContextFiles(ctx context.Context, project *compose.Project) error {
+	opts := OptionsFromContext(ctx)
+	listings, err := ListBuildContextFiles(ctx, project)
+	if err != nil {
+		return err
+	}
+	if len(listings) == 0 {
+		opts.Term.Info("No services with a local build context")
+		return nil
+	}
+
+	for _, listing := range listings {
+		opts.Term.Infof("Service %q: %d file(s), %s uncompressed, from %s", listing.Service, len(listing.Files), units.BytesSize(float64(listing.Size)), listing.Root)
+		items := make([]ContextFileLineItem, len(listing.Files))
+		for i, file := range listing.Files {
+			items[i] = ContextFileLineItem{Path: file.Path, Size: units.BytesSize(float64(file.Size))}
+		}
+		if err := opts.Term.Table(items, "Path", "Size"); err != nil {
+			return err
+		}
+		if len(listing.Files) > compose.ContextFileLimit {
+			opts.Term.Warnf("service %q: the build context contains more than %d files; create .dockerignore to exclude caches and build artifacts", listing.Service, compose.ContextFileLimit)
+		}
+		if listing.Size > compose.ContextSizeHardLimit {
+			opts.Term.Warnf("service %q: the build context is larger than the %s limit before compression", listing.Service, units.BytesSize(float64(compose.ContextSizeHardLimit)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBuildContextFiles(t *testing.T) {
+	project := &compose.Project{
+		Services: composeTypes.Services{
+			"app":    {Name: "app", Build: &composeTypes.BuildConfig{Context: "../../testdata/testproj"}},
+			"remote": {Name: "remote", Build: &composeTypes.BuildConfig{Context: "https://github.com/example/repo.git"}},
+			"db":     {Name: "db", Image: "postgres"},
+		},
+	}
+
+	listings, err := ListBuildContextFiles(t.Context(), project)
+	require.NoError(t, err)
+	require.Len(t, listings, 1)
+	assert.Equal(t, "app", listings[0].Service)
+	root, _ := filepath.Abs("../../testdata/testproj")
+	assert.Equal(t, root, listings[0].Root)
+	assert.Len(t, listings[0].Files, 4)
+	assert.EqualValues(t, 459+15+121+8, listings[0].Size)
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// ParseCopyPath splits an argument of "defang cp" into the service and the path in its container, like "app:/data",
+// or returns an empty service for a local path.
+func ParseCopyPath(arg string) (service, path string) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.ContainsAny(arg[:i], `/\`) || filepath.VolumeName(arg) != "" {
+		return "", arg // a local path, like ./a:b or C:\data on Windows
+	}
+	return arg[:i], arg[i+1:]
+}
+
+// copyIntoScript extracts the archive from stdin into the directory $1, or else renames its single entry $2 to $1, like
+// cp does; the archive is extracted next to $1 first, so it's never extracted over a file of the same name.
+const copyIntoScript = `if [ -d "$1" ]; then exec tar -xzmf - -C "$1"; fi
+tmp=$(mktemp -d "$(dirname "$1")/.defang-cp.XXXXXX") || exit
+tar -xzmf - -C "$tmp" && mv -f "$tmp/$2" "$1"
+status=$?
+rm -rf "$tmp"
+exit $status`
+
+// CopyToService copies the local file or directory src to dst in a running container of the service, by piping a
+// tar archive into tar in the container; the container must have sh and tar.
+func CopyToService(ctx context.Context, fabric client.FabricClient, projectName, stack, service, src, dst string) error {
+	opts := OptionsFromContext(ctx)
+	if service == "" {
+		return errors.New("missing service name")
+	}
+	if _, err := os.Lstat(src); err != nil {
+		return err
+	}
+	// Like cp, copy into dst if it's a directory, or else to dst itself; only the container can tell which it is
+	name := filepath.Base(src)
+	command := []string{"sh", "-c", copyIntoScript, "sh", path.Clean(dst), name}
+	if strings.HasSuffix(dst, "/") {
+		command = []string{"tar", "-xzmf", "-", "-C", path.Clean(dst)} // -m: the archive has no modification times
+	}
+	opts.Term.Debugf("Copying %q to %q in service %q in project %q", src, dst, service, projectName)
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	session := &execSession{stream: fabric.Exec(ctx)}
+	defer session.stream.CloseResponse()
+
+	err := session.send(&defangv1.ExecRequest{
+		Project: projectName,
+		Stack:   stack,
+		Service: service,
+		Command: command,
+		Stdin:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close() // stop writing the archive if the command failed
+	go func() {
+		pw.CloseWithError(compose.WriteCopyArchive(ctx, pw, src, name))
+	}()
+	go func() {
+		if err := session.sendInput(pr); err != nil {
+			cancel(err) // the command would wait for more input forever
+		}
+	}()
+
+	var stderr bytes.Buffer
+	if err := session.receive(io.Discard, &stderr); err != nil {
+		if cause := context.Cause(ctx); ctx.Err() != nil && cause != nil {
+			err = cause
+		}
+		return copyError(err, &stderr)
+	}
+	return nil
+}
+
+// CopyFromService copies the file or directory src in a running container of the service to the local path dst, by
+// extracting the output of tar in the container; the container must have tar.
+func CopyFromService(ctx context.Context, fabric client.FabricClient, projectName, stack, service, src, dst string) error {
+	opts := OptionsFromContext(ctx)
+	if service == "" {
+		return errors.New("missing service name")
+	}
+	src = path.Clean(src)
+	// Like cp, copy into dst if it's a directory, or else to dst itself
+	if info, err := os.Stat(dst); err == nil && info.IsDir() || strings.HasSuffix(dst, string(filepath.Separator)) {
+		dst = filepath.Join(dst, path.Base(src))
+	}
+	opts.Term.Debugf("Copying %q in service %q in project %q to %q", src, service, projectName, dst)
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	session := &execSession{stream: fabric.Exec(ctx)}
+	defer session.stream.CloseResponse()
+
+	err := session.send(&defangv1.ExecRequest{
+		Project: projectName,
+		Stack:   stack,
+		Service: service,
+		Command: []string{"tar", "-czf", "-", "-C", path.Dir(src), path.Base(src)},
+	})
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	extracted := make(chan error, 1)
+	go func() {
+		err := extractCopyArchive(ctx, pr, dst)
+		pr.CloseWithError(err)
+		io.Copy(io.Discard, pr) // let the command finish, even if the archive has trailing data
+		extracted <- err
+	}()
+
+	var stderr bytes.Buffer
+	err = session.receive(pw, &stderr)
+	pw.CloseWithError(err)
+	if extractErr := <-extracted; err == nil {
+		err = extractErr
+	}
+	if err != nil {
+		return copyError(err, &stderr)
+	}
+	return nil
+}
+
+// copyError adds the error output of tar to the error, which only has its exit code.
+func copyError(err error, stderr *bytes.Buffer) error {
+	if msg := strings.TrimSpace(stderr.String()); msg != "" && errors.As(err, new(ExecExitError)) {
+		return fmt.Errorf("%w: %s", err, msg)
+	}
+	return err
+}
+
+// extractCopyArchive extracts a gzipped tar archive with a single top-level file or directory to dst. Links are
+// skipped, so the archive can't write outside of dst.
+func extractCopyArchive(ctx context.Context, r io.Reader, dst string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Replace the top-level name with dst
+		_, relPath, _ := strings.Cut(path.Clean(header.Name), "/")
+		relPath = filepath.FromSlash(relPath)
+		if relPath != "" && !filepath.IsLocal(relPath) {
+			return fmt.Errorf("invalid path in archive: %q", header.Name)
+		}
+		target := filepath.Join(dst, relPath)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, header.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, tarReader)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			OptionsFromContext(ctx).Term.Warnf("Skipping %s: only files and directories are copied", header.Name)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+func TestParseCopyPath(t *testing.T) {
+	tests := []struct {
+		arg, service, path string
+	}{
+		{"app:/data", "app", "/data"},
+		{"app:", "app", ""},
+		{"./data", "", "./data"},
+		{"./a:b", "", "./a:b"},
+		{":data", "", ":data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			if service, path := ParseCopyPath(tt.arg); service != tt.service || path != tt.path {
+				t.Errorf("ParseCopyPath() = %q, %q; want %q, %q", service, path, tt.service, tt.path)
+			}
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	src := filepath.Join(t.TempDir(), "src")
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "sub", "file.txt"), []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Upload the directory; the mock echoes the archive back once it has been sent completely
+	upload := &echoExecStream{resps: make(chan *defangv1.ExecResponse, 2)}
+	err := CopyToService(t.Context(), &mockExecFabricClient{stream: upload}, "test", "beta", "app", src, "/data/")
+	if err != nil {
+		t.Fatalf("CopyToService() error = %v", err)
+	}
+	reqs := upload.Requests()
+	if start := reqs[0]; start.Service != "app" || !start.Stdin || !slices.Equal(start.Command, []string{"tar", "-xzmf", "-", "-C", "/data"}) {
+		t.Errorf("unexpected start request: %v", start)
+	}
+	archive := upload.input
+
+	// Download the same archive again, into an existing directory
+	dst := t.TempDir()
+	download := &client.MockExecStream{Resps: []*defangv1.ExecResponse{{Stdout: archive}, {Exited: true}}}
+	err = CopyFromService(t.Context(), &mockExecFabricClient{stream: download}, "test", "beta", "app", "/data/src", dst)
+	if err != nil {
+		t.Fatalf("CopyFromService() error = %v", err)
+	}
+	if start := download.Requests()[0]; !slices.Equal(start.Command, []string{"tar", "-czf", "-", "-C", "/data", "src"}) {
+		t.Errorf("unexpected start request: %v", start)
+	}
+	if content, err := os.ReadFile(filepath.Join(dst, "src", "sub", "file.txt")); err != nil || string(content) != "hello" {
+		t.Errorf("expected the file to be copied, got %q, %v", content, err)
+	}
+
+	t.Run("tar error", func(t *testing.T) {
+		stream := &client.MockExecStream{Resps: []*defangv1.ExecResponse{
+			{Stderr: []byte("tar: /nope: No such file or directory\n")},
+			{Exited: true, ExitCode: 2},
+		}}
+		err := CopyFromService(t.Context(), &mockExecFabricClient{stream: stream}, "test", "", "app", "/nope", t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), "exit code 2: tar: /nope: No such file or directory") {
+			t.Errorf("CopyFromService() error = %v, want the error of tar", err)
+		}
+	})
+}
+
+func TestCopyToServiceWithoutSlash(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runs the command of the container with sh")
+	}
+	term.SetupTestTerm(t)
+
+	src := filepath.Join(t.TempDir(), "src")
+	if err := os.WriteFile(src, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Run the command that would run in the container locally, with the uploaded archive as input
+	copyTo := func(t *testing.T, dst string) {
+		t.Helper()
+		upload := &echoExecStream{resps: make(chan *defangv1.ExecResponse, 2)}
+		if err := CopyToService(t.Context(), &mockExecFabricClient{stream: upload}, "test", "", "app", src, dst); err != nil {
+			t.Fatalf("CopyToService() error = %v", err)
+		}
+		command := upload.Requests()[0].Command
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin = bytes.NewReader(upload.input)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("%v failed: %v: %s", command, err, out)
+		}
+	}
+
+	t.Run("existing directory", func(t *testing.T) {
+		dir := t.TempDir()
+		copyTo(t, dir) // like db:/tmp, without a trailing slash
+		if content, err := os.ReadFile(filepath.Join(dir, "src")); err != nil || string(content) != "hello" {
+			t.Errorf("expected the file to be copied into the directory, got %q, %v", content, err)
+		}
+	})
+
+	t.Run("new file", func(t *testing.T) {
+		dir := t.TempDir()
+		copyTo(t, filepath.Join(dir, "renamed"))
+		if content, err := os.ReadFile(filepath.Join(dir, "renamed")); err != nil || string(content) != "hello" {
+			t.Errorf("expected the file to be copied as renamed, got %q, %v", content, err)
+		}
+		if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+			t.Errorf("expected only the copied file, got %v", entries)
+		}
+	})
+
+	t.Run("existing file", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "existing")
+		if err := os.WriteFile(dst, []byte("old"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		copyTo(t, dst)
+		if content, err := os.ReadFile(dst); err != nil || string(content) != "hello" {
+			t.Errorf("expected the file to be overwritten, got %q, %v", content, err)
+		}
+	})
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type CurlParams struct {
+	Service string
+	Path    string
+	Method  string
+	Data    string
+	Headers []string // "Name: value"
+}
+
+// Curl performs an HTTP request against the deployed endpoint of a service and prints the response body. A private
+// service can't be reached from outside, so the request is sent from inside its own container instead, by running
+// curl there like "defang exec"; the container must have curl.
+func Curl(ctx context.Context, fabric client.FabricClient, projectName, stack string, provider client.Provider, params CurlParams) error {
+	opts := OptionsFromContext(ctx)
+	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: projectName})
+	if err != nil {
+		return err
+	}
+	var serviceInfo *defangv1.ServiceInfo
+	for _, si := range servicesResponse.Services {
+		if si.Service.GetName() == params.Service {
+			serviceInfo = si
+			break
+		}
+	}
+	if serviceInfo == nil {
+		return fmt.Errorf("service %q not found in project %q", params.Service, projectName)
+	}
+
+	if isPrivateService(serviceInfo) {
+		command, err := newPrivateCurlCommand(serviceInfo, params)
+		if err != nil {
+			return err
+		}
+		opts.Term.Debugf("Running %q in service %q", command, params.Service)
+		if err := Exec(ctx, fabric, projectName, stack, params.Service, ExecOptions{Command: command}); err != nil {
+			if errors.As(err, new(ExecExitError)) {
+				return fmt.Errorf("request failed: curl in service %q: %w", params.Service, err)
+			}
+			return err
+		}
+		return nil
+	}
+
+	req, err := newCurlRequest(ctx, serviceInfo, params)
+	if err != nil {
+		return err
+	}
+
+	opts.Term.Debugf("%s %s", req.Method, req.URL)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	opts.Term.Info(resp.Proto, resp.Status)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	opts.Term.Print(string(body))
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("request failed: %s", resp.Status)
+	}
+	return nil
+}
+
+func getServiceURL(serviceInfo *defangv1.ServiceInfo) (string, error) {
+	switch {
+	case serviceInfo.Domainname != "":
+		return "https://" + serviceInfo.Domainname, nil
+	case serviceInfo.PublicFqdn != "":
+		return "https://" + serviceInfo.PublicFqdn, nil
+	default:
+		return "", fmt.Errorf("service %q has no endpoints", serviceInfo.Service.GetName())
+	}
+}
+
+func newCurlRequest(ctx context.Context, serviceInfo *defangv1.ServiceInfo, params CurlParams) (*http.Request, error) {
+	endpoint, err := getServiceURL(serviceInfo)
+	if err != nil {
+		return nil, err
+	}
+	path, query, _ := strings.Cut(params.Path, "?")
+	u, err := url.JoinPath(endpoint, path)
+	if err != nil {
+		return nil, err
+	}
+	if query != "" {
+		u += "?" + query
+	}
+
+	var body io.Reader
+	if params.Data != "" {
+		body = strings.NewReader(params.Data)
+	}
+	req, err := http.NewRequestWithContext(ctx, curlMethod(params), u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := parseCurlHeaders(params.Headers)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(req.Header, header)
+
+	if auth := serviceInfo.GetIngress().GetAuth(); auth != nil && req.Header.Get("Authorization") == "" {
+		if err := setIngressAuth(OptionsFromContext(ctx).Term, req, auth); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// isPrivateService returns true if the service has no public endpoint, but can be reached by the other services.
+func isPrivateService(serviceInfo *defangv1.ServiceInfo) bool {
+	return serviceInfo.Domainname == "" && serviceInfo.PublicFqdn == "" && serviceInfo.PrivateFqdn != ""
+}
+
+// newPrivateCurlCommand returns the curl command that sends the request to the private service from inside its own
+// container, on the port of its first endpoint, like "db.app.internal:5432".
+func newPrivateCurlCommand(serviceInfo *defangv1.ServiceInfo, params CurlParams) ([]string, error) {
+	var port string
+	for _, endpoint := range serviceInfo.Endpoints {
+		if _, p, ok := strings.Cut(endpoint, ":"); ok {
+			port = p
+			break
+		}
+	}
+	if port == "" {
+		return nil, fmt.Errorf("service %q has no endpoints", serviceInfo.Service.GetName())
+	}
+	path, query, _ := strings.Cut(params.Path, "?")
+	u, err := url.JoinPath("http://localhost:"+port, path)
+	if err != nil {
+		return nil, err
+	}
+	if query != "" {
+		u += "?" + query
+	}
+
+	header, err := parseCurlHeaders(params.Headers)
+	if err != nil {
+		return nil, err
+	}
+	command := []string{"curl", "--silent", "--show-error", "--fail-with-body", "--request", curlMethod(params)}
+	for _, name := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[name] {
+			command = append(command, "--header", name+": "+value)
+		}
+	}
+	if params.Data != "" {
+		command = append(command, "--data-raw", params.Data)
+	}
+	return append(command, u), nil
+}
+
+// curlMethod returns the HTTP method of the request, which is POST by default if there's data to send, like curl.
+func curlMethod(params CurlParams) string {
+	switch {
+	case params.Method != "":
+		return strings.ToUpper(params.Method)
+	case params.Data != "":
+		return http.MethodPost
+	default:
+		return http.MethodGet
+	}
+}
+
+func parseCurlHeaders(headers []string) (http.Header, error) {
+	header := http.Header{}
+	for _, h := range headers {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid header %q; expected \"Name: value\"", h)
+		}
+		header.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	return header, nil
+}
+
+// setIngressAuth adds the credentials of the ingress auth to the request; since config values cannot be read back,
+// the credentials are taken from the environment variable with the same name as the config.
+func setIngressAuth(t *term.Term, req *http.Request, auth *defangv1.IngressAuth) error {
+	credentials, ok := os.LookupEnv(auth.Config)
+	if !ok {
+		t.Warnf("service requires authentication; set the %s environment variable to send credentials", auth.Config)
+		return nil
+	}
+	switch auth.Type {
+	case defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BASIC:
+		username, password, ok := strings.Cut(credentials, ":")
+		if !ok {
+			return fmt.Errorf("environment variable %s must be in the form \"username:password\"", auth.Config)
+		}
+		req.SetBasicAuth(username, password)
+	case defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BEARER:
+		req.Header.Set("Authorization", "Bearer "+credentials)
+	default:
+		return errors.New("unsupported ingress auth type: " + auth.Type.String())
+	}
+	return nil
+}
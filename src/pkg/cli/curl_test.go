@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCurlRequest(t *testing.T) {
+	public := &defangv1.ServiceInfo{
+		Service:    &defangv1.Service{Name: "api"},
+		PublicFqdn: "api--8080.example.com",
+	}
+
+	t.Run("GET", func(t *testing.T) {
+		req, err := newCurlRequest(t.Context(), public, CurlParams{Path: "/healthz?verbose=1"})
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "https://api--8080.example.com/healthz?verbose=1", req.URL.String())
+	})
+
+	t.Run("POST data with headers", func(t *testing.T) {
+		req, err := newCurlRequest(t.Context(), public, CurlParams{Path: "items", Data: `{}`, Headers: []string{"Content-Type: application/json"}})
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "https://api--8080.example.com/items", req.URL.String())
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	})
+
+	t.Run("domainname", func(t *testing.T) {
+		si := &defangv1.ServiceInfo{Service: &defangv1.Service{Name: "api"}, PublicFqdn: "api--8080.example.com", Domainname: "api.example.org"}
+		req, err := newCurlRequest(t.Context(), si, CurlParams{})
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.example.org", req.URL.String())
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		_, err := newCurlRequest(t.Context(), public, CurlParams{Headers: []string{"bogus"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("basic auth", func(t *testing.T) {
+		t.Setenv("ADMIN_CREDENTIALS", "admin:s3cret")
+		si := &defangv1.ServiceInfo{
+			Service:    &defangv1.Service{Name: "admin"},
+			PublicFqdn: "admin--80.example.com",
+			Ingress: &defangv1.Ingress{Auth: &defangv1.IngressAuth{
+				Type:   defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BASIC,
+				Config: "ADMIN_CREDENTIALS",
+			}},
+		}
+		req, err := newCurlRequest(t.Context(), si, CurlParams{})
+		require.NoError(t, err)
+		username, password, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "s3cret", password)
+	})
+
+	t.Run("bearer auth", func(t *testing.T) {
+		t.Setenv("API_TOKEN", "tok3n")
+		si := &defangv1.ServiceInfo{
+			Service:    &defangv1.Service{Name: "api"},
+			PublicFqdn: "api--80.example.com",
+			Ingress: &defangv1.Ingress{Auth: &defangv1.IngressAuth{
+				Type:   defangv1.IngressAuthType_INGRESS_AUTH_TYPE_BEARER,
+				Config: "API_TOKEN",
+			}},
+		}
+		req, err := newCurlRequest(t.Context(), si, CurlParams{})
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer tok3n", req.Header.Get("Authorization"))
+	})
+}
+
+type mockCurlProvider struct {
+	client.Provider
+	services []*defangv1.ServiceInfo
+}
+
+func (m mockCurlProvider) GetServices(ctx context.Context, req *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return &defangv1.GetServicesResponse{Project: req.Project, Services: m.services}, nil
+}
+
+func TestCurlPrivateService(t *testing.T) {
+	private := &defangv1.ServiceInfo{
+		Service:     &defangv1.Service{Name: "api"},
+		PrivateFqdn: "api.app.internal",
+		Endpoints:   []string{"api.app.internal:8080"},
+	}
+	provider := mockCurlProvider{services: []*defangv1.ServiceInfo{private}}
+
+	t.Run("command", func(t *testing.T) {
+		command, err := newPrivateCurlCommand(private, CurlParams{Path: "items?limit=1", Data: `{}`, Headers: []string{"content-type: application/json"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"curl", "--silent", "--show-error", "--fail-with-body", "--request", "POST",
+			"--header", "Content-Type: application/json",
+			"--data-raw", "{}",
+			"http://localhost:8080/items?limit=1",
+		}, command)
+	})
+
+	t.Run("no endpoints", func(t *testing.T) {
+		_, err := newPrivateCurlCommand(&defangv1.ServiceInfo{Service: &defangv1.Service{Name: "db"}, PrivateFqdn: "db.app.internal"}, CurlParams{})
+		assert.ErrorContains(t, err, `service "db" has no endpoints`)
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		stdout, _ := term.SetupTestTerm(t)
+		stream := &client.MockExecStream{Resps: []*defangv1.ExecResponse{{Stdout: []byte("ok\n")}, {Exited: true}}}
+
+		err := Curl(t.Context(), &mockExecFabricClient{stream: stream}, "app", "beta", provider, CurlParams{Service: "api", Path: "/healthz"})
+		require.NoError(t, err)
+		assert.Equal(t, "ok\n", stdout.String())
+		reqs := stream.Requests()
+		require.Len(t, reqs, 1)
+		assert.Equal(t, "api", reqs[0].Service)
+		assert.Equal(t, "beta", reqs[0].Stack)
+		assert.Equal(t, "http://localhost:8080/healthz", reqs[0].Command[len(reqs[0].Command)-1])
+	})
+
+	t.Run("request failed", func(t *testing.T) {
+		term.SetupTestTerm(t)
+		stream := &client.MockExecStream{Resps: []*defangv1.ExecResponse{{Stderr: []byte("curl: (22) The requested URL returned error: 500\n")}, {Exited: true, ExitCode: 22}}}
+
+		err := Curl(t.Context(), &mockExecFabricClient{stream: stream}, "app", "", provider, CurlParams{Service: "api"})
+		assert.ErrorContains(t, err, "request failed")
+	})
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// DeleteServices removes the given services from the deployed project, see LoadDeployedProject, and
+// redeploys the remaining services unchanged; the removed services are deprovisioned by the CD. The
+// volumes of the removed services are kept, unless removeVolumes is set and no other service uses them.
+func DeleteServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, project *compose.Project, serviceNames []string, removeVolumes bool) (*defangv1.DeployResponse, error) {
+	opts := OptionsFromContext(ctx)
+	if err := compose.RemoveServices(project, serviceNames...); err != nil {
+		return nil, err
+	}
+	if unused := compose.UnusedVolumes(project); removeVolumes && len(unused) > 0 {
+		opts.Term.Info("Removing volume(s):", strings.Join(unused, ", "))
+		for _, name := range unused {
+			delete(project.Volumes, name)
+		}
+	}
+	opts.Term.Debugf("Deleting service(s) %v from project %q", serviceNames, project.Name)
+	return redeployProject(ctx, fabric, provider, stack, project, true) // the deleted services are now orphans
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+func TestDeleteServicesRemoteBuildContext(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	provider := &mockDeployProvider{prevProjectUpdate: &defangv1.ProjectUpdate{Compose: []byte(deployedWithRemoteContexts)}}
+	stack := &stacks.Parameters{Provider: client.ProviderDefang}
+	project, err := LoadDeployedProject(t.Context(), provider, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DeleteServices(t.Context(), client.MockFabricClient{}, provider, stack, project, []string{"worker"}, false); err != nil {
+		t.Fatalf("DeleteServices() error = %v", err)
+	}
+
+	deployed, err := compose.LoadFromContent(t.Context(), provider.deployedCompose, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := deployed.Services["worker"]; ok {
+		t.Error("expected the worker service to be deleted")
+	}
+	if build := deployed.Services["app"].Build; build == nil || build.Dockerfile != "Dockerfile.prod" {
+		t.Errorf("expected the dockerfile of the uploaded build context to be kept, got %+v", build)
+	}
+}
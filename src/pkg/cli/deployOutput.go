@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// OutputFormat is the format of the summary printed after a deployment
+type OutputFormat string
+
+const (
+	OutputFormatText OutputFormat = "text"
+	OutputFormatJSON OutputFormat = "json"
+)
+
+var AllOutputFormats = []OutputFormat{OutputFormatText, OutputFormatJSON}
+
+func (o OutputFormat) String() string {
+	return string(o)
+}
+
+func (o *OutputFormat) Set(s string) error {
+	format := OutputFormat(strings.ToLower(s))
+	if !slices.Contains(AllOutputFormats, format) {
+		return fmt.Errorf("invalid output format: %q, not one of %v", s, AllOutputFormats)
+	}
+	*o = format
+	return nil
+}
+
+func (o OutputFormat) Type() string {
+	return "output-format"
+}
+
+// DeploymentOutput is the machine-readable summary of a deployment; the field names are part of the CLI's interface.
+type DeploymentOutput struct {
+	Project  string          `json:"project"`
+	Stack    string          `json:"stack,omitempty"`
+	Etag     string          `json:"etag"`
+	Services []ServiceOutput `json:"services"`
+}
+
+type ServiceOutput struct {
+	Name        string       `json:"name"`
+	Etag        string       `json:"etag"`
+	State       string       `json:"state"`
+	URLs        []string     `json:"urls"`
+	PrivateFqdn string       `json:"private_fqdn,omitempty"`
+	Ports       []PortOutput `json:"ports"`
+}
+
+type PortOutput struct {
+	Target      uint32 `json:"target"`
+	Mode        string `json:"mode"`
+	Protocol    string `json:"protocol"`
+	AppProtocol string `json:"app_protocol,omitempty"`
+}
+
+func NewDeploymentOutput(project *compose.Project, stack, etag string, serviceInfos []*defangv1.ServiceInfo) DeploymentOutput {
+	output := DeploymentOutput{
+		Project:  project.Name,
+		Stack:    stack,
+		Etag:     etag,
+		Services: []ServiceOutput{}, // never null
+	}
+	for _, serviceInfo := range serviceInfos {
+		service := ServiceOutput{
+			Name:        serviceInfo.Service.GetName(),
+			Etag:        serviceInfo.Etag,
+			State:       serviceInfo.State.String(),
+			URLs:        getServiceURLs(serviceInfo),
+			PrivateFqdn: serviceInfo.PrivateFqdn,
+			Ports:       []PortOutput{},
+		}
+		if svccfg, ok := project.Services[service.Name]; ok {
+			for _, port := range svccfg.Ports {
+				service.Ports = append(service.Ports, PortOutput{
+					Target:      port.Target,
+					Mode:        port.Mode,
+					Protocol:    port.Protocol,
+					AppProtocol: port.AppProtocol,
+				})
+			}
+		}
+		output.Services = append(output.Services, service)
+	}
+	slices.SortFunc(output.Services, func(a, b ServiceOutput) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return output
+}
+
+// getServiceURLs returns the public HTTPS URLs of the service, starting with its custom domain, if any.
+func getServiceURLs(serviceInfo *defangv1.ServiceInfo) []string {
+	urls := []string{}
+	add := func(host string) {
+		if host != "" && !slices.Contains(urls, "https://"+host) {
+			urls = append(urls, "https://"+host)
+		}
+	}
+	add(serviceInfo.Domainname)
+	for _, domain := range serviceInfo.GetIngress().GetDomains() {
+		add(domain)
+	}
+	add(serviceInfo.PublicFqdn)
+	for _, endpoint := range serviceInfo.Endpoints {
+		if !strings.Contains(endpoint, ":") { // host ports are host:port
+			add(endpoint)
+		}
+	}
+	return urls
+}
+
+func PrintDeploymentOutput(w io.Writer, output DeploymentOutput) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
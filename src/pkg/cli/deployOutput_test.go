@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentOutput(t *testing.T) {
+	project := &compose.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"web": {Name: "web", Ports: []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS, Protocol: "tcp", AppProtocol: "http"}}},
+			"db":  {Name: "db", Ports: []composeTypes.ServicePortConfig{{Target: 5432, Mode: compose.Mode_HOST, Protocol: "tcp"}}},
+		},
+	}
+	serviceInfos := []*defangv1.ServiceInfo{
+		{
+			Service:    &defangv1.Service{Name: "web"},
+			Etag:       "a1b2c3",
+			State:      defangv1.ServiceState_DEPLOYMENT_COMPLETED,
+			Domainname: "example.com",
+			PublicFqdn: "web.app.example.dev",
+			Endpoints:  []string{"web--80.app.example.dev"},
+		},
+		{
+			Service:     &defangv1.Service{Name: "db"},
+			Etag:        "a1b2c3",
+			State:       defangv1.ServiceState_DEPLOYMENT_COMPLETED,
+			PrivateFqdn: "db.app.internal",
+			Endpoints:   []string{"db.app.internal:5432"},
+		},
+	}
+
+	output := NewDeploymentOutput(project, "beta", "a1b2c3", serviceInfos)
+
+	var buf bytes.Buffer
+	require.NoError(t, PrintDeploymentOutput(&buf, output))
+	const expected = `{
+  "project": "app",
+  "stack": "beta",
+  "etag": "a1b2c3",
+  "services": [
+    {
+      "name": "db",
+      "etag": "a1b2c3",
+      "state": "DEPLOYMENT_COMPLETED",
+      "urls": [],
+      "private_fqdn": "db.app.internal",
+      "ports": [
+        {
+          "target": 5432,
+          "mode": "host",
+          "protocol": "tcp"
+        }
+      ]
+    },
+    {
+      "name": "web",
+      "etag": "a1b2c3",
+      "state": "DEPLOYMENT_COMPLETED",
+      "urls": [
+        "https://example.com",
+        "https://web.app.example.dev",
+        "https://web--80.app.example.dev"
+      ],
+      "ports": [
+        {
+          "target": 80,
+          "mode": "ingress",
+          "protocol": "tcp",
+          "app_protocol": "http"
+        }
+      ]
+    }
+  ]
+}
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestOutputFormatSet(t *testing.T) {
+	var format OutputFormat
+	require.NoError(t, format.Set("JSON"))
+	assert.Equal(t, OutputFormatJSON, format)
+	assert.Error(t, format.Set("yaml"))
+}
@@ -2,12 +2,13 @@ package cli
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
 
@@ -20,6 +21,9 @@ type DeploymentLineItem struct {
 	Provider    string
 	Region      string
 	Mode        string
+	Commit      string // the Git commit of the project, if known
+	Changes     string // the services that changed since the previous deployment, like "+new ~changed -removed"
+	DeployedBy  string
 }
 
 type ListDeploymentsParams struct {
@@ -30,6 +34,7 @@ type ListDeploymentsParams struct {
 }
 
 func DeploymentsList(ctx context.Context, client client.FabricClient, params ListDeploymentsParams) error {
+	opts := OptionsFromContext(ctx)
 	response, err := client.ListDeployments(ctx, &defangv1.ListDeploymentsRequest{
 		Type:    params.ListType,
 		Project: params.ProjectName,
@@ -48,14 +53,15 @@ func DeploymentsList(ctx context.Context, client client.FabricClient, params Lis
 		}
 		var err error
 		if params.ProjectName == "" {
-			_, err = term.Warnf("No%s deployments found; use --workspace to specify a different workspace", active)
+			_, err = opts.Term.Warnf("No%s deployments found; use --workspace to specify a different workspace", active)
 		} else {
-			_, err = term.Warnf("No%s deployments found for project %q", active, params.ProjectName)
+			_, err = opts.Term.Warnf("No%s deployments found for project %q", active, params.ProjectName)
 		}
 		return err
 	}
 
 	// map to Deployment struct
+	changes := serviceChanges(ctx, response.Deployments)
 	deployments := make([]DeploymentLineItem, numDeployments)
 	for i, d := range response.Deployments {
 		deployedAt := d.Timestamp.AsTime().Local().Format(time.RFC3339)
@@ -68,6 +74,9 @@ func DeploymentsList(ctx context.Context, client client.FabricClient, params Lis
 			Provider:    getProvider(d.Provider, d.ProviderString),
 			Region:      d.Region,
 			Mode:        d.Mode.String(),
+			Commit:      shortCommit(d.OriginMetadata["GIT_COMMIT"]),
+			Changes:     changes[d.Id],
+			DeployedBy:  deployedBy(d),
 		}
 	}
 
@@ -81,7 +90,72 @@ func DeploymentsList(ctx context.Context, client client.FabricClient, params Lis
 		return sortKeys[i] < sortKeys[j]
 	})
 
-	return term.Table(deployments, "ProjectName", "Stack", "Provider", "AccountId", "Region", "Deployment", "Mode", "DeployedAt")
+	return opts.Term.Table(deployments, "ProjectName", "Stack", "Provider", "AccountId", "Region", "Deployment", "Mode", "DeployedAt", "Commit", "Changes", "DeployedBy")
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
+
+// deployedBy returns the user that deployed, or the CI user for older deployments.
+func deployedBy(d *defangv1.Deployment) string {
+	if d.DeployedBy != "" {
+		return d.DeployedBy
+	}
+	for _, key := range []string{"GITHUB_ACTOR", "GITLAB_USER_LOGIN", "GIT_COMMITTER_EMAIL", "GIT_AUTHOR_EMAIL"} {
+		if user := d.OriginMetadata[key]; user != "" {
+			return user
+		}
+	}
+	return ""
+}
+
+// serviceChanges compares the Compose file of each deployment with that of the previous deployment of the same
+// project and stack, by deployment ID. Deployments without a Compose file, or without an earlier one in the list, are
+// left out, since there is nothing to compare with.
+func serviceChanges(ctx context.Context, deployments []*defangv1.Deployment) map[string]string {
+	opts := OptionsFromContext(ctx)
+	sorted := slices.Clone(deployments)
+	slices.SortStableFunc(sorted, func(a, b *defangv1.Deployment) int {
+		return a.Timestamp.AsTime().Compare(b.Timestamp.AsTime()) // oldest first
+	})
+
+	changes := make(map[string]string)
+	previous := make(map[string]*compose.Project) // the last deployed project, by project and stack
+	for _, d := range sorted {
+		if len(d.Compose) == 0 {
+			continue
+		}
+		project, err := compose.LoadFromContent(ctx, d.Compose, d.Project)
+		if err != nil {
+			opts.Term.Debugf("Failed to load the Compose file of deployment %q: %v", d.Id, err)
+			continue
+		}
+		key := d.Project + "|" + d.Stack
+		if prev, ok := previous[key]; ok {
+			var names []string
+			for _, svc := range NewDeploymentPlan(prev, project, d.Stack).Services {
+				names = append(names, planActionPrefix[svc.Action]+svc.Name)
+			}
+			if len(names) == 0 {
+				names = append(names, "none")
+			}
+			changes[d.Id] = strings.Join(names, " ")
+		}
+		if d.Action == defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP {
+			previous[key] = project // previews don't change what is deployed
+		}
+	}
+	return changes
+}
+
+var planActionPrefix = map[PlanAction]string{
+	PlanActionAdd:    "+",
+	PlanActionChange: "~",
+	PlanActionRemove: "-",
 }
 
 func getProvider(provider defangv1.Provider, providerString string) string {
@@ -90,3 +164,31 @@ func getProvider(provider defangv1.Provider, providerString string) string {
 	}
 	return strings.ToLower(provider.String())
 }
+
+// DeploymentLatest is the deployment ID that refers to the most recent active deployment of the project
+const DeploymentLatest = "latest"
+
+// FindDeployment returns the deployment with the given ID, or the most recent active deployment for
+// DeploymentLatest. Returns nil if the ID is not among the recent deployments of the project.
+func FindDeployment(ctx context.Context, fabric client.FabricClient, projectName, stackName, deploymentID string) (*defangv1.Deployment, error) {
+	req := &defangv1.ListDeploymentsRequest{
+		Type:    defangv1.DeploymentType_DEPLOYMENT_TYPE_HISTORY,
+		Project: projectName,
+		Stack:   stackName,
+		Limit:   100,
+	}
+	if deploymentID == DeploymentLatest {
+		req.Type = defangv1.DeploymentType_DEPLOYMENT_TYPE_ACTIVE
+		req.Limit = 1
+	}
+	resp, err := fabric.ListDeployments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Deployments {
+		if deploymentID == DeploymentLatest || d.Id == deploymentID {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"context"
+	"maps"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
@@ -89,7 +91,7 @@ func TestDeploymentsList(t *testing.T) {
 		if err != nil {
 			t.Fatalf("DeploymentsList() error = %v", err)
 		}
-		expectedOutput := "\x1b[1m\nPROJECTNAME  STACK  PROVIDER  ACCOUNTID   REGION     DEPLOYMENT  MODE              DEPLOYEDAT\x1b[0m" + `
+		expectedOutput := "\x1b[1m\nPROJECTNAME  STACK  PROVIDER  ACCOUNTID   REGION     DEPLOYMENT  MODE              DEPLOYEDAT            COMMIT  CHANGES  DEPLOYEDBY\x1b[0m" + `
 test                defang    1234567890  us-test-2  a1b2c3      MODE_UNSPECIFIED  ` + time.Time{}.Local().Format(time.RFC3339) + "\n"
 
 		receivedLines := strings.Split(stdout.String(), "\n")
@@ -197,3 +199,80 @@ func TestActiveDeployments(t *testing.T) {
 		}
 	})
 }
+
+func TestFindDeployment(t *testing.T) {
+	fabric := client.MockFabricClient{}
+
+	tests := []struct {
+		deployment string
+		want       string
+	}{
+		{DeploymentLatest, "a1b2c3"},
+		{"a1b2c3", "a1b2c3"},
+		{"unknown", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.deployment, func(t *testing.T) {
+			found, err := FindDeployment(t.Context(), fabric, "test", "beta", tt.deployment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := found.GetId(); got != tt.want {
+				t.Errorf("FindDeployment() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceChanges(t *testing.T) {
+	deployment := func(id string, minutesAgo int, stack, composeFile string) *defangv1.Deployment {
+		return &defangv1.Deployment{
+			Id:        id,
+			Project:   "test",
+			Stack:     stack,
+			Action:    defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP,
+			Timestamp: timestamppb.New(time.Now().Add(-time.Duration(minutesAgo) * time.Minute)),
+			Compose:   []byte(composeFile),
+		}
+	}
+	const (
+		v1 = "services:\n  api:\n    image: api:1\n  worker:\n    image: worker:1\n"
+		v2 = "services:\n  api:\n    image: api:2\n  web:\n    image: web:1\n"
+	)
+	preview := deployment("preview", 2, "beta", v1)
+	preview.Action = defangv1.DeploymentAction_DEPLOYMENT_ACTION_PREVIEW
+
+	changes := serviceChanges(t.Context(), []*defangv1.Deployment{
+		deployment("second", 1, "beta", v2), // listed out of order
+		deployment("first", 4, "beta", v1),
+		deployment("same", 3, "beta", v1),
+		preview,
+		deployment("other stack", 1, "prod", v2),
+		deployment("no compose", 0, "beta", ""),
+	})
+	want := map[string]string{
+		"same":    "none",
+		"preview": "none",
+		"second":  "~api +web -worker",
+	}
+	if !maps.Equal(changes, want) {
+		t.Errorf("serviceChanges() = %v, want %v", changes, want)
+	}
+}
+
+func TestDeployedBy(t *testing.T) {
+	tests := []struct {
+		deployment *defangv1.Deployment
+		want       string
+	}{
+		{&defangv1.Deployment{DeployedBy: "user-1", OriginMetadata: map[string]string{"GITHUB_ACTOR": "octocat"}}, "user-1"},
+		{&defangv1.Deployment{OriginMetadata: map[string]string{"GITHUB_ACTOR": "octocat", "GIT_AUTHOR_EMAIL": "a@example.com"}}, "octocat"},
+		{&defangv1.Deployment{OriginMetadata: map[string]string{"GITHUB_ACTOR": "", "GIT_AUTHOR_EMAIL": "a@example.com"}}, "a@example.com"},
+		{&defangv1.Deployment{}, ""},
+	}
+	for _, tt := range tests {
+		if got := deployedBy(tt.deployment); got != tt.want {
+			t.Errorf("deployedBy() = %q, want %q", got, tt.want)
+		}
+	}
+}
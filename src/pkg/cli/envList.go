@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+const (
+	EnvSourceCompose        = "compose"
+	EnvSourceConfig         = "config"
+	EnvSourceConfigNotSet   = "config (not set)"
+	EnvSourceComposeConfigs = "compose+config"
+)
+
+const maskedValue = "********"
+
+type EnvLineItem struct {
+	Name   string
+	Value  string // empty for config values, masked for values that look like secrets
+	Source string
+}
+
+// GetDeployedEnv returns the environment variables of a service as applied in the last deployment of the project.
+func GetDeployedEnv(ctx context.Context, projectName, serviceName string, provider client.Provider) ([]EnvLineItem, error) {
+	opts := OptionsFromContext(ctx)
+	projUpdate, err := provider.GetProjectUpdate(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	if projUpdate == nil || len(projUpdate.Compose) == 0 {
+		return nil, fmt.Errorf("project %q has not been deployed", projectName)
+	}
+	project, err := compose.LoadFromContent(ctx, projUpdate.Compose, projectName)
+	if err != nil {
+		return nil, err
+	}
+	svccfg, ok := project.Services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("service %q not found in project %q", serviceName, projectName)
+	}
+
+	var configNames []string
+	if configs, err := provider.ListConfig(ctx, &defangv1.ListConfigsRequest{Project: projectName}); err != nil {
+		opts.Term.Debug("ListConfig failed:", err)
+	} else {
+		configNames = configs.Names
+	}
+	configSource := func(name string) string {
+		if configNames != nil && !slices.Contains(configNames, name) {
+			return EnvSourceConfigNotSet
+		}
+		return EnvSourceConfig
+	}
+
+	var items []EnvLineItem
+	for name, value := range svccfg.Environment {
+		item := EnvLineItem{Name: name, Source: EnvSourceCompose}
+		if value == nil {
+			item.Source = configSource(name)
+		} else if configs := compose.DetectInterpolationVariables(*value); len(configs) > 0 {
+			item.Value = *value // only the ${…} references, not the config values
+			item.Source = EnvSourceComposeConfigs
+			for _, config := range configs {
+				if configSource(config) == EnvSourceConfigNotSet {
+					item.Source = EnvSourceConfigNotSet
+				}
+			}
+		} else {
+			item.Value = *value
+			if isSecret, _, _ := compose.IsSecret(name, *value); isSecret {
+				item.Value = maskedValue
+			}
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no environment variables found for service %q", serviceName)
+	}
+	slices.SortFunc(items, func(a, b EnvLineItem) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return items, nil
+}
+
+func PrintDeployedEnv(ctx context.Context, projectName, serviceName string, provider client.Provider) error {
+	opts := OptionsFromContext(ctx)
+	items, err := GetDeployedEnv(ctx, projectName, serviceName, provider)
+	if err != nil {
+		return err
+	}
+	return opts.Term.Table(items, "Name", "Value", "Source")
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEnvProvider struct {
+	client.MockProvider
+	compose []byte
+}
+
+func (m mockEnvProvider) GetProjectUpdate(context.Context, string) (*defangv1.ProjectUpdate, error) {
+	if m.compose == nil {
+		return nil, nil
+	}
+	return &defangv1.ProjectUpdate{Compose: m.compose}, nil
+}
+
+func TestGetDeployedEnv(t *testing.T) {
+	provider := mockEnvProvider{compose: []byte(`
+services:
+  api:
+    image: myapi
+    environment:
+      CONFIG1:
+      MISSING:
+      DATABASE_URL: postgres://user:${CONFIG2}@db/app
+      REDIS_URL: redis://:${NOT_SET}@redis
+      DEBUG: "1"
+      API_KEY: 50m34p1k3y
+`)}
+
+	items, err := GetDeployedEnv(t.Context(), "app", "api", provider)
+	require.NoError(t, err)
+	expected := []EnvLineItem{
+		{Name: "API_KEY", Value: maskedValue, Source: EnvSourceCompose},
+		{Name: "CONFIG1", Source: EnvSourceConfig},
+		{Name: "DATABASE_URL", Value: "postgres://user:${CONFIG2}@db/app", Source: EnvSourceComposeConfigs},
+		{Name: "DEBUG", Value: "1", Source: EnvSourceCompose},
+		{Name: "MISSING", Source: EnvSourceConfigNotSet},
+		{Name: "REDIS_URL", Value: "redis://:${NOT_SET}@redis", Source: EnvSourceConfigNotSet},
+	}
+	assert.Equal(t, expected, items)
+
+	_, err = GetDeployedEnv(t.Context(), "app", "web", provider)
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = GetDeployedEnv(t.Context(), "app", "api", mockEnvProvider{})
+	assert.ErrorContains(t, err, "has not been deployed")
+}
@@ -21,13 +21,14 @@ import (
 )
 
 func RunEstimate(ctx context.Context, project *compose.Project, client client.FabricClient, previewProvider client.Provider, estimateProviderID client.ProviderID, region string, mode modes.Mode) (*defangv1.EstimateResponse, error) {
-	term.Debugf("Running estimate for project %s in region %s with mode %s", project.Name, region, mode)
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Running estimate for project %s in region %s with mode %s", project.Name, region, mode)
 	preview, err := GeneratePreview(ctx, project, client, previewProvider, estimateProviderID, mode, region)
 	if err != nil {
 		return nil, err
 	}
 
-	term.Info("Preparing estimate")
+	opts.Term.Info("Preparing estimate")
 
 	estimate, err := client.Estimate(ctx, &defangv1.EstimateRequest{
 		Provider:      estimateProviderID.Value(),
@@ -41,6 +42,7 @@ func RunEstimate(ctx context.Context, project *compose.Project, client client.Fa
 }
 
 func GeneratePreview(ctx context.Context, project *compose.Project, client client.FabricClient, previewProvider client.Provider, estimateProviderID client.ProviderID, mode modes.Mode, region string) (string, error) {
+	opts := OptionsFromContext(ctx)
 	os.Setenv("DEFANG_JSON", "1")             // HACK: always show JSON output for estimate
 	since := time.Now().Add(-1 * time.Minute) // fetch logs since one minute ago to account for clock drift
 
@@ -48,13 +50,16 @@ func GeneratePreview(ctx context.Context, project *compose.Project, client clien
 	if err := compose.FixupServices(ctx, previewProvider, fixedProject, compose.UploadModeEstimate); err != nil {
 		return "", err
 	}
+	if err := compose.ValidateProject(ctx, fixedProject, mode, estimateProviderID); err != nil {
+		return "", &ComposeError{err}
+	}
 
 	composeData, err := compose.MarshalYAML(fixedProject)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal compose project: %w", err)
 	}
 
-	term.Debugf("Fixedup project: %s", string(composeData))
+	opts.Term.Debugf("Fixedup project: %s", string(composeData))
 
 	resp, err := client.Preview(ctx, &defangv1.PreviewRequest{
 		Provider:    estimateProviderID.Value(),
@@ -67,7 +72,7 @@ func GeneratePreview(ctx context.Context, project *compose.Project, client clien
 		return "", err
 	}
 
-	term.Info("Generating deployment preview, this may take a few minutes...")
+	opts.Term.Info("Generating deployment preview, this may take a few minutes...")
 	var pulumiPreviewLogLines []string
 	tailOptions := TailOptions{
 		Deployment: resp.Etag,
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// execInputBufferSize is the most input that is sent in a single request.
+const execInputBufferSize = 32 * 1024
+
+type ExecOptions struct {
+	Command []string // the command to run; the default shell of the container if empty
+	Stdin   bool     // pass stdin to the command
+	TTY     bool     // allocate a pseudo-terminal for the command
+}
+
+// ExecExitError is returned by Exec when the command exits with a non-zero exit code.
+type ExecExitError struct {
+	Code int
+}
+
+func (e ExecExitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.Code)
+}
+
+// Exec runs a command in a running container of the service, like "kubectl exec", streaming the input and output of
+// the command over a single bidirectional stream. With a TTY, the local terminal is put in raw mode, so that keys like
+// Ctrl-C are passed on to the command, and changes to the size of the terminal window are passed on too.
+func Exec(ctx context.Context, fabric client.FabricClient, projectName, stack, service string, execOpts ExecOptions) error {
+	opts := OptionsFromContext(ctx)
+	if service == "" {
+		return errors.New("missing service name")
+	}
+	opts.Term.Debugf("Running %q in service %q in project %q", execOpts.Command, service, projectName)
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	stdin, ttyOut, stderr := opts.Term.Stdio()
+	tty := execOpts.TTY
+	if tty && !opts.Term.IsTerminal() {
+		opts.Term.Warn("Unable to use a TTY: input is not a terminal")
+		tty = false
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // stop sending input and resizes
+
+	session := &execSession{stream: fabric.Exec(ctx)}
+	defer session.stream.CloseResponse()
+
+	start := &defangv1.ExecRequest{
+		Project: projectName,
+		Stack:   stack,
+		Service: service,
+		Command: execOpts.Command,
+		Tty:     tty,
+		Stdin:   execOpts.Stdin,
+	}
+	if tty {
+		start.Size = getTerminalSize(ttyOut.Fd())
+	}
+	if err := session.send(start); err != nil {
+		return err
+	}
+
+	if tty {
+		restore, err := term.MakeRaw(int(stdin.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed to put the terminal in raw mode: %w", err)
+		}
+		defer restore()
+
+		resized := term.NotifyResize(ctx)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-resized:
+					if session.send(&defangv1.ExecRequest{Size: getTerminalSize(ttyOut.Fd())}) != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	if execOpts.Stdin {
+		var input io.Reader = stdin
+		if stdin == os.Stdin {
+			nonBlockingStdin := term.NewNonBlockingStdin()
+			defer nonBlockingStdin.Close() // abort the read loop
+			input = nonBlockingStdin
+		}
+		go session.sendInput(input)
+	}
+
+	return session.receive(opts.Term.Stdout(), stderr)
+}
+
+// execSession is a single run of a command over an Exec stream.
+type execSession struct {
+	stream client.ExecStream
+	mutex  sync.Mutex // input and resizes are sent from their own goroutines, but only one can send at a time
+}
+
+func (s *execSession) send(req *defangv1.ExecRequest) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stream.Send(req)
+}
+
+// sendInput sends everything that is read from input as the stdin of the command, and closes the stdin of the
+// command at the end of the input.
+func (s *execSession) sendInput(input io.Reader) error {
+	buf := make([]byte, execInputBufferSize)
+	for {
+		n, err := input.Read(buf)
+		if n > 0 {
+			if err := s.send(&defangv1.ExecRequest{Input: bytes.Clone(buf[:n])}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return s.send(&defangv1.ExecRequest{CloseInput: true})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// receive writes the output of the command to stdout and stderr until it exits, and returns an ExecExitError if it
+// exits with a non-zero exit code.
+func (s *execSession) receive(stdout, stderr io.Writer) error {
+	for {
+		resp, err := s.stream.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("the connection was closed before the command exited")
+			}
+			return err
+		}
+		if _, err := stdout.Write(resp.Stdout); err != nil {
+			return err
+		}
+		if _, err := stderr.Write(resp.Stderr); err != nil {
+			return err
+		}
+		if resp.Exited {
+			if resp.ExitCode != 0 {
+				return ExecExitError{Code: int(resp.ExitCode)}
+			}
+			return nil
+		}
+	}
+}
+
+func getTerminalSize(fd uintptr) *defangv1.TerminalSize {
+	width, height, err := term.GetSize(int(fd))
+	if err != nil {
+		return nil
+	}
+	return &defangv1.TerminalSize{Rows: uint32(height), Cols: uint32(width)}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type mockExecFabricClient struct {
+	client.MockFabricClient
+	stream client.ExecStream
+}
+
+func (m *mockExecFabricClient) Exec(ctx context.Context) client.ExecStream {
+	return m.stream
+}
+
+// echoExecStream echoes the input back on stdout once the input is closed, and then exits with the given code.
+type echoExecStream struct {
+	client.MockExecStream
+	input    []byte
+	resps    chan *defangv1.ExecResponse
+	exitCode int32
+}
+
+func (m *echoExecStream) Send(req *defangv1.ExecRequest) error {
+	m.MockExecStream.Send(req)
+	m.input = append(m.input, req.Input...)
+	if req.CloseInput {
+		m.resps <- &defangv1.ExecResponse{Stdout: m.input}
+		m.resps <- &defangv1.ExecResponse{Exited: true, ExitCode: m.exitCode}
+	}
+	return nil
+}
+
+func (m *echoExecStream) Receive() (*defangv1.ExecResponse, error) {
+	return <-m.resps, nil
+}
+
+func TestExec(t *testing.T) {
+	t.Run("output", func(t *testing.T) {
+		stdout, stderr := term.SetupTestTerm(t)
+		stream := &client.MockExecStream{Resps: []*defangv1.ExecResponse{
+			{Stdout: []byte("hello\n")},
+			{Stderr: []byte("oops\n")},
+			{Exited: true},
+		}}
+
+		err := Exec(t.Context(), &mockExecFabricClient{stream: stream}, "test", "beta", "app", ExecOptions{Command: []string{"ls", "-l"}})
+		if err != nil {
+			t.Fatalf("Exec() error = %v", err)
+		}
+		if stdout.String() != "hello\n" || stderr.String() != "oops\n" {
+			t.Errorf("unexpected output: %q, %q", stdout.String(), stderr.String())
+		}
+		reqs := stream.Requests()
+		if len(reqs) != 1 || reqs[0].Service != "app" || reqs[0].Stack != "beta" || !slices.Equal(reqs[0].Command, []string{"ls", "-l"}) || reqs[0].Tty || reqs[0].Stdin {
+			t.Errorf("unexpected requests: %v", reqs)
+		}
+	})
+
+	t.Run("stdin and exit code", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { r.Close() })
+		w.WriteString("some input")
+		w.Close()
+
+		var stdout, stderr strings.Builder
+		ctx := WithOptions(t.Context(), Options{Term: term.NewTerm(r, &stdout, &stderr)})
+		stream := &echoExecStream{resps: make(chan *defangv1.ExecResponse, 2), exitCode: 3}
+
+		err = Exec(ctx, &mockExecFabricClient{stream: stream}, "test", "", "app", ExecOptions{Stdin: true, TTY: true})
+		var exitErr ExecExitError
+		if !errors.As(err, &exitErr) || exitErr.Code != 3 {
+			t.Fatalf("Exec() error = %v, want exit code 3", err)
+		}
+		if !strings.HasSuffix(stdout.String(), "some input") {
+			t.Errorf("expected the input to be echoed, got %q", stdout.String())
+		}
+		reqs := stream.Requests()
+		if reqs[0].Tty || !reqs[0].Stdin {
+			t.Errorf("expected stdin without a TTY, since the input is not a terminal, got %v", reqs[0])
+		}
+		if last := reqs[len(reqs)-1]; !last.CloseInput {
+			t.Errorf("expected the input to be closed, got %v", last)
+		}
+	})
+
+	t.Run("connection closed", func(t *testing.T) {
+		term.SetupTestTerm(t)
+		stream := &client.MockExecStream{Resps: []*defangv1.ExecResponse{{Stdout: []byte("hello\n")}}}
+
+		err := Exec(t.Context(), &mockExecFabricClient{stream: stream}, "test", "", "app", ExecOptions{})
+		if err == nil || errors.Is(err, io.EOF) || !strings.Contains(err.Error(), "closed before the command exited") {
+			t.Errorf("Exec() error = %v, want connection closed", err)
+		}
+	})
+
+	t.Run("missing service", func(t *testing.T) {
+		err := Exec(t.Context(), client.MockFabricClient{}, "test", "", "", ExecOptions{})
+		if err == nil || !strings.Contains(err.Error(), "missing service name") {
+			t.Errorf("Exec() error = %v, want missing service name", err)
+		}
+	})
+}
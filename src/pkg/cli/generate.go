@@ -21,8 +21,9 @@ type GenerateArgs struct {
 }
 
 func GenerateWithAI(ctx context.Context, client client.FabricClient, args GenerateArgs) ([]string, error) {
-	if dryrun.DoDryRun {
-		term.Warn("Dry run, no project files will be generated")
+	opts := OptionsFromContext(ctx)
+	if opts.DryRun {
+		opts.Term.Warn("Dry run, no project files will be generated")
 		return nil, dryrun.ErrDryRun
 	}
 
@@ -36,25 +37,25 @@ func GenerateWithAI(ctx context.Context, client client.FabricClient, args Genera
 		return nil, err
 	}
 
-	if term.DoDebug() {
+	if opts.DoDebug() {
 		// Print the files that were generated
 		for _, file := range response.Files {
-			term.Printc(term.DebugColor, file.Name+"\n```")
-			term.Printc(term.DebugColor, file.Content)
-			term.Printc(term.DebugColor, "```")
-			term.Println("")
-			term.Println("")
+			opts.Term.Printc(term.DebugColor, file.Name+"\n```")
+			opts.Term.Printc(term.DebugColor, file.Content)
+			opts.Term.Printc(term.DebugColor, "```")
+			opts.Term.Println("")
+			opts.Term.Println("")
 		}
 	}
 
 	// Write each file to disk
-	term.Info("Writing files to disk...")
+	opts.Term.Info("Writing files to disk...")
 	if err := os.MkdirAll(args.Folder, 0755); err != nil {
 		return nil, err
 	}
 	for _, file := range response.Files {
 		// Print the files that were generated
-		term.Println("   -", file.Name)
+		opts.Term.Println("   -", file.Name)
 		// TODO: this will overwrite existing files
 		if err = os.WriteFile(filepath.Join(args.Folder, file.Name), []byte(file.Content), 0644); err != nil {
 			return nil, err
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
 
@@ -23,6 +22,8 @@ type ServiceLineItem struct {
 	Fqdn              string
 	AcmeCertUsed      bool
 	HealthcheckStatus string
+	Autoscaling       string
+	Access            string // "private" if only reachable by other services in the project
 }
 
 type ErrNoServices struct {
@@ -47,11 +48,12 @@ func PrintLongServices(ctx context.Context, projectName string, provider client.
 	if numServices == 0 {
 		return nil
 	}
-	return PrintObject("", servicesResponse)
+	return PrintObject(ctx, "", servicesResponse)
 }
 
 func GetServices(ctx context.Context, projectName string, provider client.Provider) ([]ServiceLineItem, error) {
-	term.Debugf("Listing services in project %q", projectName)
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Listing services in project %q", projectName)
 
 	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: projectName})
 	if err != nil {
@@ -84,12 +86,13 @@ func PrintServices(ctx context.Context, projectName string, provider client.Prov
 		return err
 	}
 
-	return PrintServiceStatesAndEndpoints(services)
+	return PrintServiceStatesAndEndpoints(ctx, services)
 }
 
 type HealthCheckResults map[string]*string
 
 func GetHealthcheckResults(ctx context.Context, serviceInfos []*defangv1.ServiceInfo) HealthCheckResults {
+	opts := OptionsFromContext(ctx)
 	// Create a context with a timeout for HTTP requests
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -112,7 +115,7 @@ func GetHealthcheckResults(ctx context.Context, serviceInfos []*defangv1.Service
 				defer wg.Done()
 				result, err := RunHealthcheck(ctx, serviceInfo.Service.Name, "https://"+endpoint, serviceInfo.HealthcheckPath)
 				if err != nil {
-					term.Debugf("Healthcheck error for service %q at endpoint %q: %s", serviceInfo.Service.Name, endpoint, err.Error())
+					opts.Term.Debugf("Healthcheck error for service %q at endpoint %q: %s", serviceInfo.Service.Name, endpoint, err.Error())
 					result = "error"
 				}
 				*results[serviceInfo.Service.Name] = result
@@ -126,6 +129,7 @@ func GetHealthcheckResults(ctx context.Context, serviceInfos []*defangv1.Service
 }
 
 func RunHealthcheck(ctx context.Context, name, endpoint, path string) (string, error) {
+	opts := OptionsFromContext(ctx)
 	url, err := url.JoinPath(endpoint, path)
 	if err != nil {
 		return "", err
@@ -135,17 +139,17 @@ func RunHealthcheck(ctx context.Context, name, endpoint, path string) (string, e
 	if err != nil {
 		return "", err
 	}
-	term.Debugf("[%s] checking health at %s", name, url)
+	opts.Term.Debugf("[%s] checking health at %s", name, url)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode >= 200 && resp.StatusCode < 400 {
-		term.Debugf("[%s] ✔ healthy", name)
+		opts.Term.Debugf("[%s] ✔ healthy", name)
 		return "healthy", nil
 	} else {
-		term.Debugf("[%s] ✘ unhealthy (%s)", name, resp.Status)
+		opts.Term.Debugf("[%s] ✘ unhealthy (%s)", name, resp.Status)
 		return "unhealthy (" + resp.Status + ")", nil
 	}
 }
@@ -170,6 +174,11 @@ func NewServiceFromServiceInfo(serviceInfos []*defangv1.ServiceInfo) ([]ServiceL
 			domainname = serviceInfo.PrivateFqdn
 		}
 
+		access := ""
+		if serviceInfo.PublicFqdn == "" && serviceInfo.Domainname == "" && serviceInfo.PrivateFqdn != "" {
+			access = "private"
+		}
+
 		ps := ServiceLineItem{
 			Deployment:   serviceInfo.Etag,
 			Service:      serviceInfo.Service.Name,
@@ -178,6 +187,8 @@ func NewServiceFromServiceInfo(serviceInfos []*defangv1.ServiceInfo) ([]ServiceL
 			Endpoint:     domainname,
 			Fqdn:         fqdn,
 			AcmeCertUsed: serviceInfo.UseAcmeCert,
+			Autoscaling:  formatAutoscaling(serviceInfo.Autoscaling),
+			Access:       access,
 		}
 		serviceTableItems = append(serviceTableItems, ps)
 	}
@@ -185,10 +196,43 @@ func NewServiceFromServiceInfo(serviceInfos []*defangv1.ServiceInfo) ([]ServiceL
 	return serviceTableItems, nil
 }
 
-func PrintServiceStatesAndEndpoints(services []ServiceLineItem) error {
+func formatAutoscaling(autoscaling *defangv1.Autoscaling) string {
+	if autoscaling == nil {
+		return ""
+	}
+	replicas := "auto"
+	if autoscaling.MinReplicas > 0 || autoscaling.MaxReplicas > 0 {
+		replicas = fmt.Sprintf("%d-%d", autoscaling.MinReplicas, autoscaling.MaxReplicas)
+		if autoscaling.MaxReplicas == 0 {
+			replicas = fmt.Sprintf("%d-", autoscaling.MinReplicas)
+		}
+	}
+	targets := []string{replicas}
+	if autoscaling.TargetCpuPercent > 0 {
+		targets = append(targets, fmt.Sprintf("cpu:%d%%", autoscaling.TargetCpuPercent))
+	}
+	if autoscaling.TargetRequestsPerSecond > 0 {
+		targets = append(targets, fmt.Sprintf("rps:%d", autoscaling.TargetRequestsPerSecond))
+	}
+	if autoscaling.TargetQueueDepth > 0 {
+		targets = append(targets, fmt.Sprintf("queue:%d", autoscaling.TargetQueueDepth))
+	}
+	return strings.Join(targets, " ")
+}
+
+func PrintServiceStatesAndEndpoints(ctx context.Context, services []ServiceLineItem) error {
+	opts := OptionsFromContext(ctx)
 	showCertGenerateHint := false
 	printHealthcheckStatus := false
+	printAutoscaling := false
+	printAccess := false
 	for _, svc := range services {
+		if svc.Access != "" {
+			printAccess = true
+		}
+		if svc.Autoscaling != "" {
+			printAutoscaling = true
+		}
 		if svc.AcmeCertUsed {
 			showCertGenerateHint = true
 		}
@@ -201,17 +245,23 @@ func PrintServiceStatesAndEndpoints(services []ServiceLineItem) error {
 	if printHealthcheckStatus {
 		attrs = append(attrs, "HealthcheckStatus")
 	}
+	if printAutoscaling {
+		attrs = append(attrs, "Autoscaling")
+	}
+	if printAccess {
+		attrs = append(attrs, "Access")
+	}
 	// if showDomainNameColumn {
 	// 	attrs = append(attrs, "DomainName")
 	// }
 
-	err := term.Table(services, attrs...)
+	err := opts.Term.Table(services, attrs...)
 	if err != nil {
 		return err
 	}
 
 	if showCertGenerateHint {
-		term.Info("Run `defang cert generate` to get a TLS certificate for your service(s)")
+		opts.Term.Info("Run `defang cert generate` to get a TLS certificate for your service(s)")
 	}
 
 	return nil
@@ -240,6 +240,53 @@ func TestGetServiceStatesAndEndpoints(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with autoscaling",
+			serviceinfos: []*defangv1.ServiceInfo{
+				{
+					Service: &defangv1.Service{
+						Name: "service1",
+					},
+					Status: "UNKNOWN",
+					Autoscaling: &defangv1.Autoscaling{
+						MinReplicas:      2,
+						MaxReplicas:      10,
+						TargetCpuPercent: 70,
+					},
+				},
+			},
+			expectedServices: []ServiceLineItem{
+				{
+					Service:     "service1",
+					Status:      "UNKNOWN",
+					Endpoint:    "N/A",
+					Autoscaling: "2-10 cpu:70%",
+				},
+			},
+		},
+		{
+			name: "private service",
+			serviceinfos: []*defangv1.ServiceInfo{
+				{
+					Service: &defangv1.Service{
+						Name: "service1",
+					},
+					Status:      "UNKNOWN",
+					PrivateFqdn: "service1.internal",
+					Endpoints: []string{
+						"service1.internal:5432",
+					},
+				},
+			},
+			expectedServices: []ServiceLineItem{
+				{
+					Service:  "service1",
+					Status:   "UNKNOWN",
+					Endpoint: "service1.internal",
+					Access:   "private",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -252,6 +299,8 @@ func TestGetServiceStatesAndEndpoints(t *testing.T) {
 				assert.Equal(t, tt.expectedServices[i].Status, svc.Status)
 				assert.Equal(t, tt.expectedServices[i].Endpoint, svc.Endpoint)
 				assert.Equal(t, tt.expectedServices[i].AcmeCertUsed, svc.AcmeCertUsed)
+				assert.Equal(t, tt.expectedServices[i].Autoscaling, svc.Autoscaling)
+				assert.Equal(t, tt.expectedServices[i].Access, svc.Access)
 			}
 		})
 	}
@@ -324,7 +373,7 @@ func TestPrintServiceStatesAndEndpointsAndDomainname(t *testing.T) {
 			// Reset stdout before each test
 			stdout.Reset()
 
-			err := PrintServiceStatesAndEndpoints(tt.services)
+			err := PrintServiceStatesAndEndpoints(t.Context(), tt.services)
 			require.NoError(t, err)
 			receivedLines := strings.Split(stdout.String(), "\n")
 
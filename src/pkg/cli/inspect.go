@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/logs"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const inspectEventLimit = 10
+
+// ServiceInspection is everything known about a deployed service.
+type ServiceInspection struct {
+	Name        string                      `json:"name"`
+	Project     string                      `json:"project"`
+	Image       string                      `json:"image,omitempty"`  // as deployed, which is only a digest if pinned
+	Digest      string                      `json:"digest,omitempty"` // only known if the image is pinned by digest
+	Environment []string                    `json:"environment"`      // names only; values may be sensitive
+	Info        json.RawMessage             `json:"info"`             // as returned by the provider, incl. state and endpoints
+	Spec        *composeTypes.ServiceConfig `json:"spec,omitempty"`   // redacted like the support bundle
+	Events      []InspectEvent              `json:"events"`
+}
+
+type InspectEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	Stderr    bool      `json:"stderr,omitempty"`
+	Host      string    `json:"host,omitempty"`
+}
+
+func InspectService(ctx context.Context, projectName, serviceName string, provider client.Provider) (*ServiceInspection, error) {
+	opts := OptionsFromContext(ctx)
+	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: projectName})
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(servicesResponse.Services, func(si *defangv1.ServiceInfo) bool {
+		return si.Service.GetName() == serviceName
+	})
+	if idx < 0 {
+		return nil, fmt.Errorf("service %q not found in project %q", serviceName, projectName)
+	}
+	serviceInfo := servicesResponse.Services[idx]
+
+	info, err := protojson.Marshal(serviceInfo)
+	if err != nil {
+		return nil, err
+	}
+	inspection := &ServiceInspection{
+		Name:        serviceName,
+		Project:     projectName,
+		Environment: []string{},
+		Info:        info,
+		Events:      []InspectEvent{},
+	}
+
+	// The converted spec is only available from the last deployment of the project
+	if projUpdate, err := provider.GetProjectUpdate(ctx, projectName); err != nil {
+		opts.Term.Debug("GetProjectUpdate failed:", err)
+	} else if projUpdate != nil && len(projUpdate.Compose) > 0 {
+		project, err := compose.LoadFromContent(ctx, projUpdate.Compose, projectName)
+		if err != nil {
+			opts.Term.Debug("failed to load deployed compose file:", err)
+		} else if svccfg, ok := project.Services[serviceName]; ok {
+			inspection.Image = svccfg.Image
+			if _, digest, ok := strings.Cut(svccfg.Image, "@"); ok {
+				inspection.Digest = digest
+			}
+			inspection.Environment = slices.Sorted(maps.Keys(svccfg.Environment))
+			spec := redactService(svccfg)
+			spec.Environment = nil // the names are listed above
+			inspection.Spec = &spec
+		}
+	}
+
+	inspection.Events = getLastEvents(ctx, provider, projectName, serviceInfo)
+	return inspection, nil
+}
+
+// getLastEvents returns the most recent log entries of the service's current deployment; best effort.
+func getLastEvents(ctx context.Context, provider client.Provider, projectName string, serviceInfo *defangv1.ServiceInfo) []InspectEvent {
+	opts := OptionsFromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	events := []InspectEvent{}
+	logSeq, err := provider.QueryLogs(ctx, &defangv1.TailRequest{
+		Project:  projectName,
+		Services: []string{serviceInfo.Service.GetName()},
+		Etag:     serviceInfo.Etag,
+		LogType:  uint32(logs.LogTypeAll),
+		Limit:    inspectEventLimit,
+	})
+	if err != nil {
+		opts.Term.Debug("QueryLogs failed:", err)
+		return events
+	}
+	for resp, err := range logSeq {
+		if err != nil {
+			opts.Term.Debug("QueryLogs failed:", err)
+			break
+		}
+		for _, entry := range resp.Entries {
+			events = append(events, InspectEvent{
+				Timestamp: entry.Timestamp.AsTime(),
+				Message:   entry.Message,
+				Stderr:    entry.Stderr,
+				Host:      valueOrDefault(entry.Host, resp.Host),
+			})
+		}
+	}
+	if len(events) > inspectEventLimit {
+		events = events[len(events)-inspectEventLimit:]
+	}
+	return events
+}
+
+func PrintServiceInspection(w io.Writer, inspection *ServiceInspection) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(inspection)
+}
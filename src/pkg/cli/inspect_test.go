@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockInspectProvider struct {
+	client.MockProvider
+}
+
+func (mockInspectProvider) GetServices(context.Context, *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return &defangv1.GetServicesResponse{Services: []*defangv1.ServiceInfo{
+		{Service: &defangv1.Service{Name: "worker"}},
+		{
+			Service:    &defangv1.Service{Name: "api"},
+			Etag:       "a1b2c3",
+			State:      defangv1.ServiceState_DEPLOYMENT_COMPLETED,
+			PublicFqdn: "api.example.com",
+		},
+	}}, nil
+}
+
+func (mockInspectProvider) GetProjectUpdate(context.Context, string) (*defangv1.ProjectUpdate, error) {
+	return &defangv1.ProjectUpdate{Compose: []byte(`
+services:
+  api:
+    image: myapi@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+    environment:
+      SECRET_KEY: hunter2
+      DEBUG: "1"
+    command: ["server", "--token=hunter2"]
+    labels:
+      auth: hunter2
+    x-defang-ingress:
+      auth:
+        client_secret: hunter2
+  worker:
+    image: myworker:latest
+`)}, nil
+}
+
+func (mockInspectProvider) QueryLogs(context.Context, *defangv1.TailRequest) (iter.Seq2[*defangv1.TailResponse, error], error) {
+	return client.MockIter([]*defangv1.TailResponse{{
+		Host: "api-1",
+		Entries: []*defangv1.LogEntry{
+			{Message: "started", Timestamp: timestamppb.New(time.Unix(1700000000, 0))},
+		},
+	}}, nil), nil
+}
+
+func TestInspectService(t *testing.T) {
+	inspection, err := InspectService(t.Context(), "app", "api", mockInspectProvider{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "myapi@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", inspection.Image)
+	assert.Equal(t, []string{"DEBUG", "SECRET_KEY"}, inspection.Environment)
+	require.NotNil(t, inspection.Spec)
+	assert.Equal(t, "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", inspection.Digest)
+	assert.Nil(t, inspection.Spec.Environment, "env values must not be included")
+	spec, err := json.Marshal(inspection.Spec)
+	require.NoError(t, err)
+	assert.NotContains(t, string(spec), "hunter2", "the spec must be redacted")
+	assert.Contains(t, string(inspection.Info), `"publicFqdn":"api.example.com"`)
+	require.Len(t, inspection.Events, 1)
+	assert.Equal(t, "api-1", inspection.Events[0].Host)
+
+	inspection, err = InspectService(t.Context(), "app", "worker", mockInspectProvider{})
+	require.NoError(t, err)
+	assert.Equal(t, "myworker:latest", inspection.Image)
+	assert.Empty(t, inspection.Digest, "the digest of a tag is unknown")
+
+	_, err = InspectService(t.Context(), "app", "web", mockInspectProvider{})
+	assert.ErrorContains(t, err, "not found")
+}
@@ -5,12 +5,12 @@ import (
 	"os"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/bufbuild/connect-go"
 )
 
 func Logout(ctx context.Context, fabricClient client.FabricClient, cluster string) error {
-	term.Debug("Logging out")
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debug("Logging out")
 	err := fabricClient.RevokeToken(ctx)
 	// Ignore unauthenticated errors, since we're logging out anyway
 	if err != nil && connect.CodeOf(err) != connect.CodeUnauthenticated {
@@ -20,19 +20,19 @@ func Logout(ctx context.Context, fabricClient client.FabricClient, cluster strin
 	// Remove the cached token file
 	tokenFile := client.GetTokenFile(cluster)
 	if err := os.Remove(tokenFile); err != nil && !os.IsNotExist(err) {
-		term.Warn("Failed to remove token file:", err)
+		opts.Term.Warn("Failed to remove token file:", err)
 		// Don't return the error - we still consider logout successful
 	} else if err == nil {
-		term.Debug("Removed token file:", tokenFile)
+		opts.Term.Debug("Removed token file:", tokenFile)
 	}
 
 	// Also remove the JWT web identity token file if it exists
 	jwtFile, err := client.GetWebIdentityTokenFile(cluster)
 	if err == nil {
 		if err := os.Remove(jwtFile); err != nil && !os.IsNotExist(err) {
-			term.Warn("Failed to remove JWT token file:", err)
+			opts.Term.Warn("Failed to remove JWT token file:", err)
 		} else if err == nil {
-			term.Debug("Removed JWT token file:", jwtFile)
+			opts.Term.Debug("Removed JWT token file:", jwtFile)
 		}
 	}
 
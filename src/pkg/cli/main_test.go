@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// Don't keep the Compose files of the test deployments in the state dir of the user
+	dir, err := os.MkdirTemp("", "defang-revisions-")
+	if err != nil {
+		panic(err)
+	}
+	revisionsDir = dir
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// MetricsEndpoint prints the Prometheus-compatible scrape URL and bearer token for the metrics of the project.
+func MetricsEndpoint(ctx context.Context, fabric client.FabricClient, projectName, stack string) (*defangv1.MetricsEndpoint, error) {
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("Getting metrics endpoint for project %q", projectName)
+
+	endpoint, err := fabric.GetMetricsEndpoint(ctx, &defangv1.GetMetricsEndpointRequest{Project: projectName, Stack: stack})
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(endpoint.Url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics endpoint %q: %w", endpoint.Url, err)
+	}
+
+	opts.Term.Println("Scrape URL:  ", endpoint.Url)
+	opts.Term.Println("Bearer token:", endpoint.Token)
+	if endpoint.ExpiresAt != nil {
+		opts.Term.Println("Expires at:  ", endpoint.ExpiresAt.AsTime().Local().Format(time.RFC3339))
+	}
+	opts.Term.Printf(`
+Add this job to the scrape_configs of your Prometheus configuration:
+
+  - job_name: defang-%s
+    scheme: %s
+    metrics_path: %s
+    authorization:
+      credentials: %s
+    static_configs:
+      - targets: [%q]
+`, projectName, u.Scheme, u.EscapedPath(), endpoint.Token, u.Host)
+	return endpoint, nil
+}
+
+type MetricsExportParams struct {
+	ProjectName    string
+	Stack          string
+	RemoteWriteURL string // empty to stop exporting
+	BearerToken    string
+}
+
+// MetricsExport forwards the platform metrics of the project to a Prometheus remote-write endpoint.
+func MetricsExport(ctx context.Context, fabric client.FabricClient, params MetricsExportParams) error {
+	opts := OptionsFromContext(ctx)
+	var target string
+	if params.RemoteWriteURL != "" {
+		u, err := url.Parse(params.RemoteWriteURL)
+		if err != nil {
+			return fmt.Errorf("invalid remote-write URL: %w", err)
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return errors.New("invalid remote-write URL; must be an absolute https:// URL")
+		}
+		target = u.Redacted() // don't print any credentials in the URL
+		opts.Term.Debugf("Exporting metrics of project %q to %s", params.ProjectName, target)
+	} else {
+		opts.Term.Debugf("Stopping the metrics export of project %q", params.ProjectName)
+	}
+
+	if opts.DryRun {
+		return dryrun.ErrDryRun
+	}
+
+	if err := fabric.PutMetricsExport(ctx, &defangv1.PutMetricsExportRequest{
+		Project:        params.ProjectName,
+		Stack:          params.Stack,
+		RemoteWriteUrl: params.RemoteWriteURL,
+		BearerToken:    params.BearerToken,
+	}); err != nil {
+		return err
+	}
+
+	if target == "" {
+		opts.Term.Info("Stopped exporting metrics")
+	} else {
+		opts.Term.Info("Exporting metrics to", target)
+	}
+	return nil
+}
@@ -13,7 +13,6 @@ import (
 	"strings"
 
 	"github.com/DefangLabs/defang/src/pkg/http"
-	"github.com/DefangLabs/defang/src/pkg/term"
 )
 
 var ErrSampleNotFound = errors.New("sample not found")
@@ -31,12 +30,13 @@ type Sample struct {
 }
 
 func FetchSamples(ctx context.Context) ([]Sample, error) {
+	opts := OptionsFromContext(ctx)
 	resp, err := http.GetWithHeader(ctx, "https://docs.defang.io/samples-v2.json", http.Header{"Accept-Encoding": []string{"gzip"}})
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
-	term.Debug(resp.Header)
+	opts.Term.Debug(resp.Header)
 	reader := resp.Body
 	if resp.Header.Get("Content-Encoding") == "gzip" {
 		reader, err = gzip.NewReader(resp.Body)
@@ -61,6 +61,7 @@ func InitFromSamples(ctx context.Context, dir string, names []string) error {
 }
 
 func copyFromSamples(ctx context.Context, dir string, names []string, skipExisting bool) error {
+	opts := OptionsFromContext(ctx)
 	const repo = "samples"
 	const branch = "main"
 
@@ -69,14 +70,14 @@ func copyFromSamples(ctx context.Context, dir string, names []string, skipExisti
 		return err
 	}
 	defer resp.Body.Close()
-	term.Debug(resp.Header)
+	opts.Term.Debug(resp.Header)
 	tarball, err := gzip.NewReader(resp.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read tarball: %w", err)
 	}
 	defer tarball.Close()
 	tarReader := tar.NewReader(tarball)
-	term.Info("Copying files to disk...")
+	opts.Term.Info("Copying files to disk...")
 
 	sampleFound := false
 
@@ -101,7 +102,7 @@ func copyFromSamples(ctx context.Context, dir string, names []string, skipExisti
 			prefix := fmt.Sprintf("%s-%s/samples/%s/", repo, branch, name)
 			if base, ok := strings.CutPrefix(h.Name, prefix); ok && len(base) > 0 {
 				sampleFound = true
-				term.Println("   -", base)
+				opts.Term.Println("   -", base)
 				path := filepath.Join(dir, subdir, base)
 				if h.FileInfo().IsDir() {
 					if err := os.MkdirAll(path, 0755); err != nil {
@@ -114,7 +115,7 @@ func copyFromSamples(ctx context.Context, dir string, names []string, skipExisti
 					if !skipExisting || !os.IsExist(err) {
 						return err
 					}
-					term.Warnf("File already exists, skipping: %q", path)
+					opts.Term.Warnf("File already exists, skipping: %q", path)
 				}
 			}
 		}
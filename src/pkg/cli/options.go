@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/surveyor"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+// Options holds the settings that affect all CLI operations. They are carried in the context so that
+// this package can be used as a library, and so tests can run in parallel without sharing globals.
+// Only the dry-run flag, the prompt settings, and the logger are carried here; the Fabric client and the
+// provider are passed as arguments. This package and the compose package log through Options.Term.
+type Options struct {
+	DryRun         bool
+	NonInteractive bool              // don't prompt; see Confirm
+	Yes            bool              // assume "yes" for all confirmations
+	Surveyor       surveyor.Surveyor // for prompts; defaults to one that uses the Term
+	Term           *term.Term        // logger; defaults to term.DefaultTerm
+}
+
+type optionsKey struct{}
+
+// WithOptions returns a copy of ctx that carries the given options.
+func WithOptions(ctx context.Context, opts Options) context.Context {
+	if opts.Term == nil {
+		opts.Term = term.DefaultTerm
+	}
+	if opts.Surveyor == nil {
+		opts.Surveyor = &surveyor.DefaultSurveyor{DefaultOpts: []survey.AskOpt{survey.WithStdio(opts.Term.Stdio())}}
+	}
+	ctx = compose.WithConfirm(ctx, confirmLargeContext)
+	ctx = compose.WithTerm(ctx, opts.Term)
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+// OptionsFromContext returns the options set with WithOptions, or the defaults for the terminal.
+func OptionsFromContext(ctx context.Context) Options {
+	if opts, ok := ctx.Value(optionsKey{}).(Options); ok {
+		return opts
+	}
+	return Options{
+		NonInteractive: !term.DefaultTerm.IsTerminal(),
+		Surveyor:       surveyor.NewDefaultSurveyor(),
+		Term:           term.DefaultTerm,
+	}
+}
+
+// DoDebug returns true if debug output is enabled for the logger.
+func (o Options) DoDebug() bool {
+	return o.Term.DoDebug()
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/dryrun"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+func TestOptionsFromContext(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		opts := OptionsFromContext(t.Context())
+		if opts.DryRun {
+			t.Error("expected DryRun to be false")
+		}
+		if opts.Term != term.DefaultTerm {
+			t.Error("expected the default term")
+		}
+	})
+
+	t.Run("default term", func(t *testing.T) {
+		opts := OptionsFromContext(WithOptions(t.Context(), Options{DryRun: true}))
+		if !opts.DryRun {
+			t.Error("expected DryRun to be true")
+		}
+		if opts.Term != term.DefaultTerm {
+			t.Error("expected the default term")
+		}
+	})
+}
+
+func TestOptionsDryRun(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	logger := term.NewTerm(nil, &stdout, &stderr)
+	logger.SetDebug(true)
+	ctx := WithOptions(context.Background(), Options{DryRun: true, Term: logger})
+
+	// With dry-run the provider is never used
+	err := ConfigDelete(ctx, "project", nil, "name")
+	if !errors.Is(err, dryrun.ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+	if !strings.Contains(stdout.String()+stderr.String(), `Deleting config [name] in project "project"`) {
+		t.Errorf("expected debug output on the given term, got %q", stdout.String()+stderr.String())
+	}
+}
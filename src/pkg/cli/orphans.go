@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+// handleOrphans finds the services of the previous deployment that are no longer in the project. Unless removeOrphans
+// is set, the orphans are carried over into the project so they keep running, like "docker compose up" does.
+func handleOrphans(ctx context.Context, prevUpdate *defangv1.ProjectUpdate, project *compose.Project, removeOrphans bool) {
+	opts := OptionsFromContext(ctx)
+	deployed := loadDeployedProject(ctx, prevUpdate, project.Name)
+	if deployed == nil {
+		return
+	}
+	orphans := compose.OrphanedServices(deployed, project)
+	if len(orphans) == 0 {
+		return
+	}
+
+	if removeOrphans {
+		opts.Term.Info("Removing orphaned service(s):", strings.Join(orphans, ", "))
+		return
+	}
+	opts.Term.Warnf("Found orphaned service(s) %s that are deployed but not in the Compose file; use --remove-orphans to remove them", strings.Join(orphans, ", "))
+	carryOverServices(deployed, project, orphans)
+}
+
+// loadDeployedProject returns the project of the previous deployment, or nil if there is none.
+func loadDeployedProject(ctx context.Context, prevUpdate *defangv1.ProjectUpdate, projectName string) *compose.Project {
+	opts := OptionsFromContext(ctx)
+	if prevUpdate == nil || len(prevUpdate.Compose) == 0 {
+		return nil
+	}
+	deployed, err := compose.LoadFromContent(ctx, prevUpdate.Compose, projectName)
+	if err != nil {
+		opts.Term.Debugf("Failed to load the deployed Compose file: %v", err)
+		return nil
+	}
+	return deployed
+}
+
+// keepDeployedServices puts the deployed version of the given services back into the project, so they keep running
+// unchanged; services that were never deployed are left out.
+func keepDeployedServices(ctx context.Context, prevUpdate *defangv1.ProjectUpdate, project *compose.Project, names []string) {
+	opts := OptionsFromContext(ctx)
+	slices.Sort(names)
+	deployed := loadDeployedProject(ctx, prevUpdate, project.Name)
+	var kept []string
+	for _, name := range names {
+		var ok bool
+		if deployed != nil {
+			_, ok = deployed.Services[name]
+		}
+		if ok {
+			kept = append(kept, name)
+		} else {
+			opts.Term.Warnf("service %q: not deployed", name)
+		}
+	}
+	if len(kept) > 0 {
+		opts.Term.Warnf("Keeping the deployed version of service(s) %s", strings.Join(kept, ", "))
+		carryOverServices(deployed, project, kept)
+	}
+}
+
+// carryOverServices copies the given services from the deployed project into the project,
+// along with the top-level networks and volumes they use.
+func carryOverServices(deployed, project *compose.Project, names []string) {
+	for _, name := range names {
+		svccfg := deployed.Services[name]
+		project.Services[name] = svccfg
+		// Keep the top-level networks and volumes used by the service
+		for network := range svccfg.Networks {
+			config, ok := deployed.Networks[network]
+			if _, exists := project.Networks[network]; ok && !exists {
+				if project.Networks == nil {
+					project.Networks = composeTypes.Networks{}
+				}
+				project.Networks[network] = config
+			}
+		}
+		for _, volume := range svccfg.Volumes {
+			config, ok := deployed.Volumes[volume.Source]
+			if _, exists := project.Volumes[volume.Source]; ok && !exists {
+				if project.Volumes == nil {
+					project.Volumes = composeTypes.Volumes{}
+				}
+				project.Volumes[volume.Source] = config
+			}
+		}
+	}
+}
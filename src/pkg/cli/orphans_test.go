@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestKeepDeployedServices(t *testing.T) {
+	prevUpdate := &defangv1.ProjectUpdate{Compose: []byte(`
+services:
+  api:
+    image: api:v1
+  worker:
+    image: worker:v1
+`)}
+	project := &compose.Project{Name: "app", Services: composeTypes.Services{
+		"web": {Name: "web", Image: "web:v2"},
+	}}
+
+	// The warnings go to the logger in the context, not the package-level term
+	var stdout, stderr bytes.Buffer
+	ctx := WithOptions(t.Context(), Options{Term: term.NewTerm(os.Stdin, &stdout, &stderr)})
+	keepDeployedServices(ctx, prevUpdate, project, []string{"worker", "new"})
+	if !strings.Contains(stdout.String(), "Keeping the deployed version of service(s) worker") {
+		t.Errorf("expected a warning in the context's term, got %q", stdout.String())
+	}
+
+	if got := project.Services["worker"].Image; got != "worker:v1" {
+		t.Errorf("expected the deployed version of worker, got %q", got)
+	}
+	if _, ok := project.Services["new"]; ok {
+		t.Error("expected the service that was never deployed to be left out")
+	}
+	if _, ok := project.Services["api"]; ok {
+		t.Error("expected only the given services to be carried over")
+	}
+
+	t.Run("no previous deployment", func(t *testing.T) {
+		project := &compose.Project{Name: "app", Services: composeTypes.Services{}}
+		keepDeployedServices(t.Context(), nil, project, []string{"worker"})
+		if len(project.Services) != 0 {
+			t.Errorf("expected no services, got %v", project.Services)
+		}
+	})
+}
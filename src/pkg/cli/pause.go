@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// PauseServices scales the given services of the deployed project to zero replicas, keeping their definition.
+func PauseServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, compose.PauseService)
+}
+
+// ResumeServices scales paused services of the deployed project back to their configured replicas.
+func ResumeServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, compose.ResumeService)
+}
+
+// EnableMaintenance makes the ingress of the given services serve a maintenance page with the message,
+// while the services keep running.
+func EnableMaintenance(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string, message string) (*defangv1.DeployResponse, error) {
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, func(svccfg *compose.ServiceConfig) error {
+		return compose.EnableMaintenance(svccfg, message)
+	})
+}
+
+// DisableMaintenance restores the traffic to services put in maintenance mode.
+func DisableMaintenance(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, compose.DisableMaintenance)
+}
+
+// RestartServices replaces the containers of the given services of the deployed project, without changing or
+// rebuilding them, so they pick up updated config values too.
+func RestartServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+	revision := time.Now().UTC().Format(time.RFC3339Nano)
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, func(svccfg *compose.ServiceConfig) error {
+		return compose.RestartService(svccfg, revision)
+	})
+}
+
+// RedeployServices is like RestartServices, for images with a mutable tag like "latest", which the new containers
+// pull again when they start.
+func RedeployServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string) (*defangv1.DeployResponse, error) {
+	revision := time.Now().UTC().Format(time.RFC3339Nano)
+	return redeployServices(ctx, fabric, provider, stack, projectName, serviceNames, func(svccfg *compose.ServiceConfig) error {
+		return compose.RedeployService(svccfg, revision)
+	})
+}
+
+// LoadDeployedProject loads the Compose file of the last deployment of the project.
+func LoadDeployedProject(ctx context.Context, provider client.Provider, projectName string) (*compose.Project, error) {
+	projUpdate, err := provider.GetProjectUpdate(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	if projUpdate == nil || len(projUpdate.Compose) == 0 {
+		return nil, fmt.Errorf("project %q has not been deployed", projectName)
+	}
+	return compose.LoadFromContent(ctx, projUpdate.Compose, projectName)
+}
+
+// redeployServices applies the update to the services of the last deployed Compose file and deploys the result.
+func redeployServices(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, serviceNames []string, update func(*compose.ServiceConfig) error) (*defangv1.DeployResponse, error) {
+	project, err := LoadDeployedProject(ctx, provider, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serviceName := range serviceNames {
+		svccfg, ok := project.Services[serviceName]
+		if !ok {
+			return nil, fmt.Errorf("service %q not found in project %q", serviceName, projectName)
+		}
+		if err := update(&svccfg); err != nil {
+			return nil, err
+		}
+		project.Services[serviceName] = svccfg
+	}
+
+	return redeployProject(ctx, fabric, provider, stack, project, false)
+}
+
+func redeployProject(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, project *compose.Project, removeOrphans bool) (*defangv1.DeployResponse, error) {
+	// Build contexts in the deployed Compose file are already uploaded, so nothing gets rebuilt
+	resp, _, err := ComposeUp(ctx, fabric, provider, stack, ComposeUpParams{
+		Project:       project,
+		UploadMode:    compose.UploadModeDefault,
+		RemoveOrphans: removeOrphans,
+	})
+	return resp, err
+}
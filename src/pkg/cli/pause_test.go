@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// deployedWithRemoteContexts is a deployed Compose file, so the build contexts are already uploaded
+const deployedWithRemoteContexts = `name: test
+services:
+  app:
+    build:
+      context: s3://cd-bucket/uploads/sha256-abc.tar.gz
+      dockerfile: Dockerfile.prod
+  worker:
+    build:
+      context: s3://cd-bucket/uploads/sha256-def.tar.gz
+      dockerfile: Dockerfile
+`
+
+func TestRedeployServicesRemoteBuildContext(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	provider := &mockDeployProvider{prevProjectUpdate: &defangv1.ProjectUpdate{Compose: []byte(deployedWithRemoteContexts)}}
+	stack := &stacks.Parameters{Provider: client.ProviderDefang}
+	if _, err := PauseServices(t.Context(), client.MockFabricClient{}, provider, stack, "test", []string{"worker"}); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := compose.LoadFromContent(t.Context(), provider.deployedCompose, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, dockerfile := range map[string]string{"app": "Dockerfile.prod", "worker": "Dockerfile"} {
+		build := project.Services[name].Build
+		if build == nil || build.Dockerfile != dockerfile {
+			t.Errorf("service %q: expected dockerfile %q, got %+v", name, dockerfile, build)
+		}
+	}
+}
+
+func TestRedeployServicesPullPolicy(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	deployed := deployedWithRemoteContexts + `  web:
+    image: nginx:latest
+`
+	provider := &mockDeployProvider{prevProjectUpdate: &defangv1.ProjectUpdate{Compose: []byte(deployed)}}
+	stack := &stacks.Parameters{Provider: client.ProviderDefang}
+	if _, err := RedeployServices(t.Context(), client.MockFabricClient{}, provider, stack, "test", []string{"web", "app"}); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := compose.LoadFromContent(t.Context(), provider.deployedCompose, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web := project.Services["web"]; web.PullPolicy != "" || web.Extensions["x-defang-config-revision"] == nil {
+		t.Errorf("expected only a new revision in the deployed Compose file, got pull policy %q and %v", web.PullPolicy, web.Extensions)
+	}
+	if build := project.Services["app"].Build; build == nil || build.Dockerfile != "Dockerfile.prod" {
+		t.Errorf("expected the dockerfile of the uploaded build context to be kept, got %+v", build)
+	}
+}
@@ -0,0 +1,265 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/muesli/termenv"
+	"go.yaml.in/yaml/v4"
+)
+
+type PlanAction string
+
+const (
+	PlanActionAdd    PlanAction = "add"
+	PlanActionChange PlanAction = "change"
+	PlanActionRemove PlanAction = "remove"
+)
+
+// DeploymentPlan is what a deployment would change compared to the deployed project; the field names are part of
+// the CLI's interface.
+type DeploymentPlan struct {
+	Project  string        `json:"project"`
+	Stack    string        `json:"stack,omitempty"`
+	Services []ServicePlan `json:"services"` // only the services that change
+}
+
+type ServicePlan struct {
+	Name    string       `json:"name"`
+	Action  PlanAction   `json:"action"`
+	Changes []PlanChange `json:"changes,omitempty"`
+}
+
+// PlanChange is a changed setting of a service; Old is empty for an added setting and New for a removed one.
+type PlanChange struct {
+	Field string `json:"field"` // eg. "image" or "environment.DEBUG"
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// NewDeploymentPlan compares the project with the deployed project, which is nil if nothing was deployed.
+func NewDeploymentPlan(deployed, project *compose.Project, stack string) DeploymentPlan {
+	plan := DeploymentPlan{
+		Project:  project.Name,
+		Stack:    stack,
+		Services: []ServicePlan{}, // never null
+	}
+	var deployedServices composeTypes.Services
+	if deployed != nil {
+		deployedServices = deployed.Services
+	}
+	names := slices.Sorted(maps.Keys(project.Services))
+	for name := range deployedServices {
+		if _, ok := project.Services[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		svccfg, ok := project.Services[name]
+		prev, deployed := deployedServices[name]
+		switch {
+		case !deployed:
+			plan.Services = append(plan.Services, ServicePlan{Name: name, Action: PlanActionAdd})
+		case !ok:
+			plan.Services = append(plan.Services, ServicePlan{Name: name, Action: PlanActionRemove})
+		default:
+			if changes := diffService(prev, svccfg); len(changes) > 0 {
+				plan.Services = append(plan.Services, ServicePlan{Name: name, Action: PlanActionChange, Changes: changes})
+			}
+		}
+	}
+	return plan
+}
+
+func diffService(prev, svccfg composeTypes.ServiceConfig) []PlanChange {
+	var changes []PlanChange
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, PlanChange{Field: field, Old: old, New: new})
+		}
+	}
+
+	add("image", prev.Image, svccfg.Image)
+	add("build context", buildContextID(prev.Build), buildContextID(svccfg.Build))
+
+	keys := slices.Collect(maps.Keys(prev.Environment))
+	for key := range svccfg.Environment {
+		if _, ok := prev.Environment[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		add("environment."+key, envValue(prev.Environment, key), envValue(svccfg.Environment, key))
+	}
+
+	add("ports", formatPorts(prev.Ports), formatPorts(svccfg.Ports))
+
+	// Any other setting, like the command or the resources, is only reported as changed
+	if otherSettings(prev) != otherSettings(svccfg) {
+		changes = append(changes, PlanChange{Field: "other settings"})
+	}
+	return changes
+}
+
+// otherSettings returns the YAML of the settings of the service that are not diffed one by one.
+func otherSettings(svccfg composeTypes.ServiceConfig) string {
+	if svccfg.Build != nil {
+		build := *svccfg.Build
+		build.Context = ""
+		svccfg.Build = &build
+	}
+	svccfg.Image, svccfg.Environment, svccfg.Ports = "", nil, nil
+	bytes, err := yaml.Marshal(svccfg)
+	if err != nil {
+		return err.Error()
+	}
+	return string(bytes)
+}
+
+// envValue returns the quoted value of the environment variable, or a placeholder for a variable that is set from
+// config, so config values are never printed.
+func envValue(env composeTypes.MappingWithEquals, key string) string {
+	value, ok := env[key]
+	if !ok {
+		return ""
+	}
+	if value == nil {
+		return "(config)"
+	}
+	return fmt.Sprintf("%q", *value)
+}
+
+func formatPorts(ports []composeTypes.ServicePortConfig) string {
+	var formatted []string
+	for _, port := range ports {
+		s := fmt.Sprintf("%d/%s", port.Target, port.Protocol)
+		if port.Mode != "" {
+			s += " (" + port.Mode + ")"
+		}
+		formatted = append(formatted, s)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+var digestRegex = regexp.MustCompile(`sha256-[A-Za-z0-9+/]+=*`)
+
+// buildContextID identifies the build context by the digest in its URL, so the same files uploaded to a different
+// URL, or only digested for a plan, are not reported as a change.
+func buildContextID(build *composeTypes.BuildConfig) string {
+	if build == nil {
+		return ""
+	}
+	context := build.Context
+	if unescaped, err := url.PathUnescape(context); err == nil {
+		context = unescaped
+	}
+	if digest := digestRegex.FindString(context); len(digest) > 19 {
+		return digest[:19] // like a short Git commit hash
+	}
+	return context
+}
+
+// ComposePlan returns what "compose up" would change, without uploading or deploying anything. Like a preview, the
+// build contexts are only digested, so a changed build context shows up as a changed build.
+func ComposePlan(ctx context.Context, provider client.Provider, stack *stacks.Parameters, params ComposeUpParams) (*DeploymentPlan, error) {
+	opts := OptionsFromContext(ctx)
+	project := params.Project
+	if err := compose.ValidateServiceDockerfiles(ctx, project); err != nil {
+		return nil, &ComposeError{err}
+	}
+
+	fixedProject := project.WithoutUnnecessaryResources()
+	prevUpdate, err := provider.GetProjectUpdate(ctx, project.Name)
+	if err != nil {
+		opts.Term.Debug("GetProjectUpdate failed:", err)
+		prevUpdate = nil
+	}
+	handleOrphans(ctx, prevUpdate, fixedProject, params.RemoveOrphans)
+
+	if params.Spot {
+		compose.UseSpotCapacity(ctx, fixedProject)
+	}
+	if err := compose.FixupServices(ctx, provider, fixedProject, compose.UploadModePreview); err != nil {
+		return nil, err
+	}
+	if err := compose.ValidateProject(ctx, fixedProject, params.Mode, stackProviderID(stack)); err != nil {
+		return nil, &ComposeError{err}
+	}
+
+	plan, err := planDeployment(ctx, prevUpdate, fixedProject, provider.GetStackName())
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// planDeployment compares the fixed up project with the project of the previous deployment. The project is loaded
+// from its YAML, like the deployed project, so both have the same defaults.
+func planDeployment(ctx context.Context, prevUpdate *defangv1.ProjectUpdate, fixedProject *compose.Project, stack string) (DeploymentPlan, error) {
+	bytes, err := compose.MarshalYAML(fixedProject)
+	if err != nil {
+		return DeploymentPlan{}, err
+	}
+	project, err := compose.LoadFromContent(ctx, bytes, fixedProject.Name)
+	if err != nil {
+		return DeploymentPlan{}, err
+	}
+	return NewDeploymentPlan(loadDeployedProject(ctx, prevUpdate, project.Name), project, stack), nil
+}
+
+// PrintDeploymentPlan prints the plan as a colored diff, or as JSON.
+func PrintDeploymentPlan(ctx context.Context, w io.Writer, plan DeploymentPlan, format OutputFormat) error {
+	if format == OutputFormatJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(plan)
+	}
+
+	t := term.NewTerm(os.Stdin, w, w)
+	t.ForceColor(OptionsFromContext(ctx).Term.StdoutCanColor())
+	if len(plan.Services) == 0 {
+		_, err := t.Printf("No changes to project %q.\n", plan.Project)
+		return err
+	}
+	t.Printf("Changes to project %q:\n", plan.Project)
+	for _, service := range plan.Services {
+		switch service.Action {
+		case PlanActionAdd:
+			t.Printc(termenv.ANSIGreen, "+ ", service.Name, " (new)\n")
+		case PlanActionRemove:
+			t.Printc(term.ErrorColor, "- ", service.Name, " (removed)\n")
+		case PlanActionChange:
+			t.Printc(term.WarnColor, "~ ", service.Name, "\n")
+			for _, change := range service.Changes {
+				switch {
+				case change.Old == "" && change.New == "":
+					t.Printf("    %s changed\n", change.Field)
+				case change.Old == "":
+					t.Printc(termenv.ANSIGreen, "    + ", change.Field, ": ", change.New, "\n")
+				case change.New == "":
+					t.Printc(term.ErrorColor, "    - ", change.Field, ": ", change.Old, "\n")
+				default:
+					t.Printc(term.WarnColor, "    ~ ", change.Field, ": ", change.Old, " → ", change.New, "\n")
+				}
+			}
+		}
+	}
+	return nil
+}
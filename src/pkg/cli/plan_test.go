@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentPlan(t *testing.T) {
+	debug, prod := "1", "0"
+	deployed := &compose.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"web": {
+				Name:        "web",
+				Image:       "nginx:1.25",
+				Environment: composeTypes.MappingWithEquals{"DEBUG": &debug, "SECRET": nil, "OLD": &debug},
+				Ports:       []composeTypes.ServicePortConfig{{Target: 80, Mode: compose.Mode_INGRESS, Protocol: "tcp"}},
+			},
+			"api": {
+				Name:  "api",
+				Build: &composeTypes.BuildConfig{Context: "s3://bucket/uploads/sha256-aaaaaaaaaaaaaaaaaaaaaaaa.tar.gz?X-Amz-Signature=1"},
+			},
+			"worker": {Name: "worker", Image: "worker"},
+			"same":   {Name: "same", Image: "redis"},
+		},
+	}
+	project := &compose.Project{
+		Name: "app",
+		Services: composeTypes.Services{
+			"web": {
+				Name:        "web",
+				Image:       "nginx:1.27",
+				Environment: composeTypes.MappingWithEquals{"DEBUG": &prod, "SECRET": nil, "NEW": &prod},
+				Ports:       []composeTypes.ServicePortConfig{{Target: 8080, Mode: compose.Mode_INGRESS, Protocol: "tcp"}},
+				Command:     composeTypes.ShellCommand{"serve"},
+			},
+			"api": {
+				Name:  "api",
+				Build: &composeTypes.BuildConfig{Context: "s3://cd-preview/sha256-aaaaaaaaaaaaaaaaaaaaaaaa.tar.gz"},
+			},
+			"db":   {Name: "db", Image: "postgres"},
+			"same": {Name: "same", Image: "redis"},
+		},
+	}
+
+	plan := NewDeploymentPlan(deployed, project, "beta")
+	assert.Equal(t, DeploymentPlan{
+		Project: "app",
+		Stack:   "beta",
+		Services: []ServicePlan{
+			{Name: "db", Action: PlanActionAdd},
+			{Name: "web", Action: PlanActionChange, Changes: []PlanChange{
+				{Field: "image", Old: "nginx:1.25", New: "nginx:1.27"},
+				{Field: "environment.DEBUG", Old: `"1"`, New: `"0"`},
+				{Field: "environment.NEW", New: `"0"`},
+				{Field: "environment.OLD", Old: `"1"`},
+				{Field: "ports", Old: "80/tcp (ingress)", New: "8080/tcp (ingress)"},
+				{Field: "other settings"},
+			}},
+			{Name: "worker", Action: PlanActionRemove},
+		},
+	}, plan)
+
+	t.Run("nothing deployed", func(t *testing.T) {
+		plan := NewDeploymentPlan(nil, project, "")
+		require.Len(t, plan.Services, 4)
+		for _, service := range plan.Services {
+			assert.Equal(t, PlanActionAdd, service.Action)
+		}
+	})
+
+	t.Run("same as deployed", func(t *testing.T) {
+		project, err := compose.LoadFromContent(t.Context(), []byte(`
+services:
+  web:
+    image: nginx
+    environment:
+      DEBUG: "1"
+      SECRET:
+    ports:
+      - "80:80"
+    deploy:
+      resources:
+        reservations:
+          memory: 256M
+`), "app")
+		require.NoError(t, err)
+		yaml, err := compose.MarshalYAML(project)
+		require.NoError(t, err)
+		plan, err := planDeployment(t.Context(), &defangv1.ProjectUpdate{Compose: yaml}, project, "")
+		require.NoError(t, err)
+		assert.Empty(t, plan.Services)
+	})
+
+	t.Run("print", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, PrintDeploymentPlan(t.Context(), &out, plan, OutputFormatText))
+		assert.Equal(t, `Changes to project "app":
++ db (new)
+~ web
+    ~ image: nginx:1.25 → nginx:1.27
+    ~ environment.DEBUG: "1" → "0"
+    + environment.NEW: "0"
+    - environment.OLD: "1"
+    ~ ports: 80/tcp (ingress) → 8080/tcp (ingress)
+    other settings changed
+- worker (removed)
+`, out.String())
+
+		out.Reset()
+		require.NoError(t, PrintDeploymentPlan(t.Context(), &out, NewDeploymentPlan(project, project, ""), OutputFormatText))
+		assert.Equal(t, "No changes to project \"app\".\n", out.String())
+	})
+}
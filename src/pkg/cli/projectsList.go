@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type ProjectLineItem struct {
+	Active       string
+	ProjectName  string
+	Services     int32
+	Stack        string
+	Provider     string
+	Region       string
+	LastDeployed string
+}
+
+func ListProjects(ctx context.Context, fabric client.FabricClient, stackName string) ([]*defangv1.Project, error) {
+	response, err := fabric.ListProjects(ctx, &defangv1.ListProjectsRequest{Stack: stackName})
+	if err != nil {
+		return nil, err
+	}
+	return response.Projects, nil
+}
+
+func ProjectsList(ctx context.Context, fabric client.FabricClient, stackName string) error {
+	opts := OptionsFromContext(ctx)
+	projects, err := ListProjects(ctx, fabric, stackName)
+	if err != nil {
+		return err
+	}
+
+	if len(projects) == 0 {
+		_, err := opts.Term.Warn("No projects found; use --workspace to specify a different workspace")
+		return err
+	}
+
+	activeProject := client.GetActiveProject()
+	items := make([]ProjectLineItem, len(projects))
+	for i, p := range projects {
+		items[i] = ProjectLineItem{
+			ProjectName: p.Name,
+			Services:    p.ServiceCount,
+			Stack:       p.Stack,
+			Provider:    getProvider(p.Provider, ""),
+			Region:      p.Region,
+		}
+		if p.LastDeployedAt != nil {
+			items[i].LastDeployed = p.LastDeployedAt.AsTime().Local().Format(time.RFC3339)
+		}
+		if p.Name == activeProject {
+			items[i].Active = "*"
+		}
+	}
+	slices.SortStableFunc(items, func(a, b ProjectLineItem) int {
+		return strings.Compare(a.ProjectName, b.ProjectName)
+	})
+
+	return opts.Term.Table(items, "Active", "ProjectName", "Services", "Stack", "Provider", "Region", "LastDeployed")
+}
+
+// UseProject sets the active project for subsequent commands, after checking that it has been deployed.
+func UseProject(ctx context.Context, fabric client.FabricClient, projectName string) error {
+	projects, err := ListProjects(ctx, fabric, "")
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(projects, func(p *defangv1.Project) bool { return p.Name == projectName }) {
+		return fmt.Errorf("project %q not found; use \"defang projects\" to list the deployed projects", projectName)
+	}
+	return client.SetActiveProject(projectName)
+}
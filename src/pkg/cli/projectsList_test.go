@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+func TestProjectsList(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	err := ProjectsList(t.Context(), client.MockFabricClient{}, "")
+	if err != nil {
+		t.Fatalf("ProjectsList() error = %v", err)
+	}
+
+	for _, expected := range []string{"PROJECTNAME", "SERVICES", "LASTDEPLOYED", "test", "beta", "aws", "us-test-2"} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, stdout.String())
+		}
+	}
+}
+
+func TestUseProjectNotFound(t *testing.T) {
+	err := UseProject(t.Context(), client.MockFabricClient{}, "unknown")
+	if err == nil || !strings.Contains(err.Error(), `project "unknown" not found`) {
+		t.Errorf("UseProject() error = %v, expected not found", err)
+	}
+}
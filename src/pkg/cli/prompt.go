@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/progress"
+	"github.com/DefangLabs/defang/src/pkg/track"
+)
+
+// ErrConfirmationRequired is returned by Confirm when it cannot ask the user, because there is no TTY or
+// prompts were disabled with --non-interactive.
+var ErrConfirmationRequired = errors.New("confirmation required; use --yes to proceed in non-interactive mode")
+
+// ErrInputRequired is returned by Ask when it cannot ask the user, because there is no TTY or prompts were
+// disabled with --non-interactive.
+var ErrInputRequired = errors.New("input required; cannot prompt in non-interactive mode")
+
+// ErrCanceled is returned by Confirm when the user declines.
+var ErrCanceled = errors.New("canceled")
+
+// promptMu serializes prompts, like those from the parallel uploads.
+var promptMu sync.Mutex
+
+type ConfirmPrompt struct {
+	Message          string
+	Event            string // tracking event for the answer, eg. "Delete Services Prompt Answered"
+	Default          bool   // pre-selected answer
+	IfNonInteractive bool   // answer assumed in non-interactive mode; false fails with ErrConfirmationRequired
+}
+
+// Confirm asks the user to confirm an action. With --yes it proceeds without asking. In non-interactive mode
+// it proceeds only if the prompt allows it, so destructive actions never happen without an explicit --yes.
+// Returns ErrCanceled if the user declines.
+func Confirm(ctx context.Context, prompt ConfirmPrompt) error {
+	opts := OptionsFromContext(ctx)
+	if opts.Yes {
+		opts.Term.Debugf("Assuming yes for %q", prompt.Message)
+		return nil
+	}
+	if opts.NonInteractive {
+		if prompt.IfNonInteractive {
+			return nil
+		}
+		return ErrConfirmationRequired
+	}
+
+	var confirm bool
+	err := askOne(ctx, opts, &survey.Confirm{
+		Message: prompt.Message,
+		Default: prompt.Default,
+	}, &confirm)
+	if prompt.Event != "" {
+		track.Evt(prompt.Event, P("confirm", confirm), P("err", err))
+	}
+	if err != nil {
+		return err
+	}
+	if !confirm {
+		return ErrCanceled
+	}
+	return nil
+}
+
+// Ask prompts the user for an answer that has no default, like a password or a choice between providers.
+// Unlike Confirm, --yes doesn't answer it; returns ErrInputRequired in non-interactive mode.
+func Ask(ctx context.Context, prompt survey.Prompt, response any) error {
+	opts := OptionsFromContext(ctx)
+	if opts.NonInteractive {
+		return ErrInputRequired
+	}
+	return askOne(ctx, opts, prompt, response)
+}
+
+func askOne(ctx context.Context, opts Options, prompt survey.Prompt, response any) error {
+	promptMu.Lock()
+	defer promptMu.Unlock()
+	if display, ok := progress.FromContext(ctx).(*progress.Display); ok {
+		display.SetInPlace(false) // or the prompt gets overwritten
+	}
+	return opts.Surveyor.AskOne(prompt, response)
+}
+
+// confirmLargeContext lets the compose package confirm uploading a large build context. Without a TTY the
+// upload goes ahead, like it did before there was a prompt.
+func confirmLargeContext(ctx context.Context, message string) error {
+	return Confirm(ctx, ConfirmPrompt{
+		Message:          message,
+		Event:            "Large Context Prompt Answered",
+		Default:          true,
+		IfNonInteractive: true,
+	})
+}
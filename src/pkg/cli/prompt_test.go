@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+type confirmSurveyor struct {
+	answer bool
+	asked  int
+}
+
+func (s *confirmSurveyor) AskOne(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	s.asked++
+	*response.(*bool) = s.answer
+	return nil
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             Options
+		answer           bool
+		ifNonInteractive bool
+		wantAsked        bool
+		wantErr          error
+	}{
+		{name: "yes", opts: Options{Yes: true, NonInteractive: true}},
+		{name: "non-interactive", opts: Options{NonInteractive: true}, wantErr: ErrConfirmationRequired},
+		{name: "non-interactive allowed", opts: Options{NonInteractive: true}, ifNonInteractive: true},
+		{name: "confirmed", answer: true, wantAsked: true},
+		{name: "declined", answer: false, wantAsked: true, wantErr: ErrCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			surveyor := &confirmSurveyor{answer: tt.answer}
+			tt.opts.Surveyor = surveyor
+			tt.opts.Term = term.NewTerm(nil, io.Discard, io.Discard)
+			ctx := WithOptions(t.Context(), tt.opts)
+
+			err := Confirm(ctx, ConfirmPrompt{Message: "Continue?", IfNonInteractive: tt.ifNonInteractive})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if asked := surveyor.asked > 0; asked != tt.wantAsked {
+				t.Errorf("expected asked to be %v, got %v", tt.wantAsked, asked)
+			}
+		})
+	}
+}
+
+func TestAsk(t *testing.T) {
+	t.Run("non-interactive", func(t *testing.T) {
+		surveyor := &confirmSurveyor{}
+		ctx := WithOptions(t.Context(), Options{NonInteractive: true, Yes: true, Surveyor: surveyor})
+		var answer bool
+		if err := Ask(ctx, &survey.Confirm{Message: "Continue?"}, &answer); !errors.Is(err, ErrInputRequired) {
+			t.Errorf("expected ErrInputRequired, got %v", err)
+		}
+		if surveyor.asked != 0 {
+			t.Error("expected no prompt in non-interactive mode")
+		}
+	})
+
+	t.Run("interactive", func(t *testing.T) {
+		surveyor := &confirmSurveyor{answer: true}
+		ctx := WithOptions(t.Context(), Options{Surveyor: surveyor})
+		var answer bool
+		if err := Ask(ctx, &survey.Confirm{Message: "Continue?"}, &answer); err != nil {
+			t.Fatal(err)
+		}
+		if !answer || surveyor.asked != 1 {
+			t.Errorf("expected the answer from the prompt, got %v after %d prompts", answer, surveyor.asked)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/DefangLabs/defang/src/pkg"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/docker/go-units"
+)
+
+// quotaWarnPercent is the usage at which a quota is considered nearly exhausted
+const quotaWarnPercent = 90
+
+// quotaBuildContext is the quota for the compressed size of a single build context, in MiB
+const quotaBuildContext = "build-context"
+
+type QuotaLineItem struct {
+	Quota string
+	Used  string
+	Limit string
+	Usage string
+}
+
+// Quotas prints the quotas of the plan of the tenant versus their current consumption.
+func Quotas(ctx context.Context, fabric client.FabricClient) error {
+	opts := OptionsFromContext(ctx)
+	resp, err := fabric.GetQuotas(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts.Term.Info("Quotas of the", pkg.SubscriptionTierToString(resp.Tier), "plan:")
+
+	items := make([]QuotaLineItem, len(resp.Quotas))
+	var exhausted []string
+	for i, quota := range resp.Quotas {
+		items[i] = QuotaLineItem{
+			Quota: quota.Name,
+			Used:  formatQuotaValue(quota.Used, quota.Unit),
+			Limit: "unlimited",
+		}
+		if quota.Limit > 0 {
+			percent := int(100 * quota.Used / quota.Limit)
+			items[i].Limit = formatQuotaValue(quota.Limit, quota.Unit)
+			items[i].Usage = strconv.Itoa(percent) + "%"
+			if percent >= quotaWarnPercent {
+				exhausted = append(exhausted, quota.Name)
+			}
+		}
+	}
+
+	if err := opts.Term.Table(items, "Quota", "Used", "Limit", "Usage"); err != nil {
+		return err
+	}
+
+	for _, name := range exhausted {
+		opts.Term.Warnf("The %s quota is nearly exhausted; deployments that need more will be rejected. Remove unused projects or services, or upgrade your plan", name)
+	}
+	return nil
+}
+
+func formatQuotaValue(value float64, unit string) string {
+	s := strconv.FormatFloat(value, 'f', -1, 64)
+	if unit == "" {
+		return s
+	}
+	return fmt.Sprintf("%s %s", s, unit)
+}
+
+// maxContextSize returns the smaller of the requested build context size limit and the limit of the plan of the
+// tenant. The quota is best effort: if it can't be fetched, the requested limit is used.
+func maxContextSize(ctx context.Context, fabric client.FabricClient, requested int64) int64 {
+	if requested <= 0 {
+		requested = compose.DefaultUploadOptions().MaxContextSize
+	}
+	resp, err := fabric.GetQuotas(ctx)
+	if err != nil {
+		OptionsFromContext(ctx).Term.Debug("GetQuotas failed:", err)
+		return requested
+	}
+	for _, quota := range resp.Quotas {
+		if quota.Name != quotaBuildContext || quota.Limit <= 0 {
+			continue
+		}
+		if allowed := int64(quota.Limit * compose.MiB); allowed < requested {
+			OptionsFromContext(ctx).Term.Debugf("Build context size is limited to %s by the %s plan", units.BytesSize(float64(allowed)), pkg.SubscriptionTierToString(resp.Tier))
+			return allowed
+		}
+	}
+	return requested
+}
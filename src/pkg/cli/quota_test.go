@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+func TestQuotas(t *testing.T) {
+	stdout, _ := term.SetupTestTerm(t)
+
+	if err := Quotas(t.Context(), client.MockFabricClient{}); err != nil {
+		t.Fatalf("Quotas() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		"Personal plan",
+		"QUOTA", "USED", "LIMIT", "USAGE",
+		"services", "90%",
+		"1.5 vCPU", "4 vCPU", "37%",
+		"2048 MiB", "8192 MiB", "25%",
+		"42 minutes", "unlimited",
+		"The services quota is nearly exhausted",
+	} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, stdout.String())
+		}
+	}
+	if strings.Contains(stdout.String(), "The cpus quota") {
+		t.Errorf("expected no warning for the cpus quota, got:\n%s", stdout.String())
+	}
+}
+
+type buildContextQuotaFabric struct {
+	client.MockFabricClient
+	limit float64
+}
+
+func (m buildContextQuotaFabric) GetQuotas(ctx context.Context) (*defangv1.GetQuotasResponse, error) {
+	return &defangv1.GetQuotasResponse{
+		Quotas: []*defangv1.Quota{{Name: quotaBuildContext, Unit: "MiB", Limit: m.limit}},
+	}, nil
+}
+
+func TestMaxContextSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     float64
+		requested int64
+		want      int64
+	}{
+		{"no quota", 0, 0, compose.DefaultUploadOptions().MaxContextSize},
+		{"requested below quota", 50, 20 * compose.MiB, 20 * compose.MiB},
+		{"requested above quota", 50, 200 * compose.MiB, 50 * compose.MiB},
+		{"default above quota", 50, 0, 50 * compose.MiB},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maxContextSize(t.Context(), buildContextQuotaFabric{limit: tt.limit}, tt.requested); got != tt.want {
+				t.Errorf("maxContextSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// RefreshConfig restarts the services of the deployed project that use any of the given configs, or any config at
+// all if none are given, so they pick up updated values. Nothing is rebuilt and the other services are left as is.
+// Returns a nil response if no service uses the configs.
+func RefreshConfig(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, configNames []string) (*defangv1.DeployResponse, []string, error) {
+	opts := OptionsFromContext(ctx)
+	project, err := LoadDeployedProject(ctx, provider, projectName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceNames := compose.ServicesUsingConfig(project, configNames...)
+	if len(serviceNames) == 0 {
+		return nil, nil, nil
+	}
+
+	revision := time.Now().UTC().Format(time.RFC3339Nano)
+	for _, name := range serviceNames {
+		svccfg := project.Services[name]
+		compose.SetConfigRevision(&svccfg, revision)
+		project.Services[name] = svccfg
+	}
+	opts.Term.Debugf("Restarting service(s) %v of project %q with config revision %s", serviceNames, projectName, revision)
+
+	resp, err := redeployProject(ctx, fabric, provider, stack, project, false)
+	return resp, serviceNames, err
+}
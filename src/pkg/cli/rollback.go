@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/types"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// maxLocalRevisions is the number of deployed Compose files that are kept locally per project and stack.
+const maxLocalRevisions = 20
+
+// revisionsDir is where the Compose files of recent deployments are kept, in case the controller doesn't have them.
+var revisionsDir = filepath.Join(client.StateDir, "revisions")
+
+func revisionPath(projectName, stackName string, etag types.ETag) string {
+	if stackName == "" {
+		stackName = "default"
+	}
+	return filepath.Join(revisionsDir, projectName, stackName, etag+".yaml")
+}
+
+// saveRevision keeps the deployed Compose file of the deployment, removing the oldest ones over maxLocalRevisions.
+func saveRevision(projectName, stackName string, etag types.ETag, composeFile []byte) error {
+	path := revisionPath(projectName, stackName, etag)
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, composeFile, 0600); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	type revision struct {
+		name    string
+		modTime int64
+	}
+	revisions := make([]revision, 0, len(entries))
+	for _, entry := range entries {
+		if info, err := entry.Info(); err == nil && strings.HasSuffix(entry.Name(), ".yaml") {
+			revisions = append(revisions, revision{entry.Name(), info.ModTime().UnixNano()})
+		}
+	}
+	slices.SortFunc(revisions, func(a, b revision) int {
+		return cmp.Compare(b.modTime, a.modTime) // newest first
+	})
+	for _, old := range revisions[min(len(revisions), maxLocalRevisions):] {
+		os.Remove(filepath.Join(dir, old.name))
+	}
+	return nil
+}
+
+// loadRevision returns the locally kept Compose file of the deployment, or nil if there is none.
+func loadRevision(projectName, stackName string, etag types.ETag) ([]byte, error) {
+	composeFile, err := os.ReadFile(revisionPath(projectName, stackName, etag))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return composeFile, err
+}
+
+// findRollbackDeployment returns the deployment with the etag, or if the etag is empty, the most recent successful
+// deployment before the current one. Returns nil if there is no such deployment in the history.
+func findRollbackDeployment(history []*defangv1.Deployment, current, etag types.ETag) *defangv1.Deployment {
+	deployments := slices.Clone(history)
+	slices.SortStableFunc(deployments, func(a, b *defangv1.Deployment) int {
+		return b.Timestamp.AsTime().Compare(a.Timestamp.AsTime()) // newest first
+	})
+
+	if etag != "" {
+		for _, d := range deployments {
+			if d.Id == etag {
+				return d
+			}
+		}
+		return nil
+	}
+
+	// Skip the current deployment and anything deployed after it, like a failed deployment
+	pastCurrent := current == "" || !slices.ContainsFunc(deployments, func(d *defangv1.Deployment) bool { return d.Id == current })
+	for _, d := range deployments {
+		if d.Id == current {
+			pastCurrent = true
+			continue
+		}
+		if pastCurrent && d.Action == defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP && d.Status == defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS {
+			return d
+		}
+	}
+	return nil
+}
+
+// Rollback redeploys the Compose file of an earlier deployment of the project, so the original Compose file is not
+// needed. Without an etag, it rolls back to the most recent successful deployment before the current one. Services
+// added after that deployment are kept. Returns the etag of the deployment that was rolled back to.
+func Rollback(ctx context.Context, fabric client.FabricClient, provider client.Provider, stack *stacks.Parameters, projectName string, etag types.ETag) (*defangv1.DeployResponse, types.ETag, error) {
+	opts := OptionsFromContext(ctx)
+
+	var current types.ETag
+	if projUpdate, err := provider.GetProjectUpdate(ctx, projectName); err != nil {
+		opts.Term.Debug("GetProjectUpdate failed:", err)
+	} else if projUpdate != nil {
+		current = projUpdate.Etag
+	}
+	if etag != "" && etag == current {
+		return nil, "", fmt.Errorf("deployment %q is the current deployment of project %q", etag, projectName)
+	}
+
+	stackName := provider.GetStackName()
+	resp, err := fabric.ListDeployments(ctx, &defangv1.ListDeploymentsRequest{
+		Type:    defangv1.DeploymentType_DEPLOYMENT_TYPE_HISTORY,
+		Project: projectName,
+		Stack:   stackName,
+		Limit:   100,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	target := findRollbackDeployment(resp.Deployments, current, etag)
+	var composeFile []byte
+	if target != nil {
+		etag = target.Id
+		composeFile = target.Compose
+	} else if etag == "" {
+		return nil, "", fmt.Errorf("no successful deployment of project %q found to roll back to", projectName)
+	}
+	if len(composeFile) == 0 {
+		if composeFile, err = loadRevision(projectName, stackName, etag); err != nil {
+			return nil, "", err
+		}
+	}
+	if len(composeFile) == 0 {
+		if target == nil {
+			return nil, "", fmt.Errorf("deployment %q not found for project %q", etag, projectName)
+		}
+		return nil, "", fmt.Errorf("the Compose file of deployment %q is not available; only deployments made with this version of the CLI can be rolled back to", etag)
+	}
+
+	project, err := compose.LoadFromContent(ctx, composeFile, projectName)
+	if err != nil {
+		return nil, "", err
+	}
+	opts.Term.Debugf("Rolling back project %q from deployment %q to %q", projectName, current, etag)
+
+	deployResp, err := redeployProject(ctx, fabric, provider, stack, project, false)
+	return deployResp, etag, err
+}
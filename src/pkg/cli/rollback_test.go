@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/stacks"
+	"github.com/DefangLabs/defang/src/pkg/term"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockRollbackFabricClient struct {
+	client.MockFabricClient
+	deployments []*defangv1.Deployment
+}
+
+func (m mockRollbackFabricClient) ListDeployments(ctx context.Context, req *defangv1.ListDeploymentsRequest) (*defangv1.ListDeploymentsResponse, error) {
+	return &defangv1.ListDeploymentsResponse{Deployments: m.deployments}, nil
+}
+
+func testDeployment(id string, minutesAgo int, status defangv1.DeploymentStatus, composeFile string) *defangv1.Deployment {
+	return &defangv1.Deployment{
+		Id:        id,
+		Project:   "test",
+		Action:    defangv1.DeploymentAction_DEPLOYMENT_ACTION_UP,
+		Status:    status,
+		Timestamp: timestamppb.New(time.Now().Add(-time.Duration(minutesAgo) * time.Minute)),
+		Compose:   []byte(composeFile),
+	}
+}
+
+func TestFindRollbackDeployment(t *testing.T) {
+	const success, failed = defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_FAILED
+	preview := testDeployment("preview", 3, success, "")
+	preview.Action = defangv1.DeploymentAction_DEPLOYMENT_ACTION_PREVIEW
+	history := []*defangv1.Deployment{
+		testDeployment("oldest", 5, success, ""),
+		testDeployment("failed", 4, failed, ""),
+		preview,
+		testDeployment("current", 2, success, ""),
+		testDeployment("newer", 1, failed, ""), // a failed deployment after the current one
+	}
+
+	tests := []struct {
+		name, current, etag, want string
+	}{
+		{"previous", "current", "", "oldest"},
+		{"current unknown", "", "", "current"},
+		{"current not in history", "unknown", "", "current"},
+		{"etag", "current", "failed", "failed"},
+		{"etag not in history", "current", "unknown", ""},
+		{"no earlier deployment", "oldest", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findRollbackDeployment(history, tt.current, tt.etag).GetId(); got != tt.want {
+				t.Errorf("findRollbackDeployment() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveRevision(t *testing.T) {
+	oldDir := revisionsDir
+	t.Cleanup(func() { revisionsDir = oldDir })
+	revisionsDir = t.TempDir()
+
+	for i := range maxLocalRevisions + 2 {
+		if err := saveRevision("test", "beta", fmt.Sprint("etag", i), []byte("name: test\n")); err != nil {
+			t.Fatal(err)
+		}
+		// Make sure the modification times are in order, even with a coarse clock
+		modTime := time.Now().Add(time.Duration(i-maxLocalRevisions-2) * time.Second)
+		os.Chtimes(revisionPath("test", "beta", fmt.Sprint("etag", i)), modTime, modTime)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(revisionsDir, "test", "beta"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != maxLocalRevisions {
+		t.Errorf("expected %d revisions, got %d", maxLocalRevisions, len(entries))
+	}
+	if composeFile, err := loadRevision("test", "beta", "etag1"); err != nil || composeFile != nil {
+		t.Errorf("expected the oldest revisions to be removed, got %q, %v", composeFile, err)
+	}
+	if composeFile, err := loadRevision("test", "beta", fmt.Sprint("etag", maxLocalRevisions+1)); err != nil || string(composeFile) != "name: test\n" {
+		t.Errorf("expected the newest revision to be kept, got %q, %v", composeFile, err)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	const composeFile = "name: test\nservices:\n  app:\n    image: nginx:1.27\n"
+	fabric := mockRollbackFabricClient{deployments: []*defangv1.Deployment{
+		testDeployment("previous", 2, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, composeFile),
+		testDeployment("current", 1, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, ""),
+		testDeployment("local", 3, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, ""),
+		testDeployment("missing", 4, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, ""),
+	}}
+	provider := &mockDeployProvider{prevProjectUpdate: &defangv1.ProjectUpdate{Etag: "current"}}
+	stack := &stacks.Parameters{Provider: client.ProviderDefang}
+	if err := saveRevision("test", provider.GetStackName(), "local", []byte(composeFile)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		etag, want, wantErr string
+	}{
+		{"", "previous", ""},
+		{"local", "local", ""}, // the controller has no Compose file, but it was kept locally
+		{"current", "", "is the current deployment"},
+		{"missing", "", "is not available"},
+		{"unknown", "", "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.etag, func(t *testing.T) {
+			resp, etag, err := Rollback(t.Context(), fabric, provider, stack, "test", tt.etag)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Rollback() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Rollback() error = %v", err)
+			}
+			if etag != tt.want {
+				t.Errorf("Rollback() etag = %q, want %q", etag, tt.want)
+			}
+			if len(resp.Services) != 1 || resp.Services[0].Service.Name != "app" {
+				t.Errorf("expected the app service to be deployed, got %v", resp.Services)
+			}
+		})
+	}
+}
+
+func TestRollbackRemoteBuildContext(t *testing.T) {
+	term.SetupTestTerm(t)
+
+	fabric := mockRollbackFabricClient{deployments: []*defangv1.Deployment{
+		testDeployment("previous", 2, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, deployedWithRemoteContexts),
+		testDeployment("current", 1, defangv1.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS, ""),
+	}}
+	provider := &mockDeployProvider{prevProjectUpdate: &defangv1.ProjectUpdate{Etag: "current"}}
+	stack := &stacks.Parameters{Provider: client.ProviderDefang}
+	if _, _, err := Rollback(t.Context(), fabric, provider, stack, "test", ""); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+
+	project, err := compose.LoadFromContent(t.Context(), provider.deployedCompose, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, dockerfile := range map[string]string{"app": "Dockerfile.prod", "worker": "Dockerfile"} {
+		build := project.Services[name].Build
+		if build == nil || !strings.HasPrefix(build.Context, "s3://") || build.Dockerfile != dockerfile {
+			t.Errorf("service %q: expected the uploaded build context with dockerfile %q, got %+v", name, dockerfile, build)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type ServiceDNSLineItem struct {
+	Service  string
+	Hostname string // the name other services in the project should use to reach this service
+	Ports    string
+	Access   string // "private" or "public"
+}
+
+// GetServiceDNS returns the service discovery name of each service that can be reached by other services.
+func GetServiceDNS(serviceInfos []*defangv1.ServiceInfo) []ServiceDNSLineItem {
+	var items []ServiceDNSLineItem
+	for _, serviceInfo := range serviceInfos {
+		hostname := serviceInfo.PrivateFqdn
+		if hostname == "" {
+			hostname = serviceInfo.PublicFqdn
+		}
+		if hostname == "" {
+			continue // no ports; not reachable
+		}
+		access := "public"
+		if serviceInfo.PublicFqdn == "" && serviceInfo.Domainname == "" {
+			access = "private"
+		}
+		var ports []string
+		for _, endpoint := range serviceInfo.Endpoints {
+			if host, port, ok := strings.Cut(endpoint, ":"); ok && host == serviceInfo.PrivateFqdn {
+				ports = append(ports, port)
+			}
+		}
+		items = append(items, ServiceDNSLineItem{
+			Service:  serviceInfo.Service.Name,
+			Hostname: hostname,
+			Ports:    strings.Join(ports, ","),
+			Access:   access,
+		})
+	}
+	slices.SortFunc(items, func(a, b ServiceDNSLineItem) int {
+		return strings.Compare(a.Service, b.Service)
+	})
+	return items
+}
+
+func PrintServiceDNS(ctx context.Context, serviceInfos []*defangv1.ServiceInfo) error {
+	items := GetServiceDNS(serviceInfos)
+	if len(items) == 0 {
+		return nil
+	}
+	return OptionsFromContext(ctx).Term.Table(items, "Service", "Hostname", "Ports", "Access")
+}
+
+// PrintProjectServiceDNS prints the service discovery names of the deployed services in the project.
+func PrintProjectServiceDNS(ctx context.Context, projectName string, provider client.Provider) error {
+	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: projectName})
+	if err != nil {
+		return err
+	}
+	if len(servicesResponse.Services) == 0 {
+		return ErrNoServices{ProjectName: projectName}
+	}
+	return PrintServiceDNS(ctx, servicesResponse.Services)
+}
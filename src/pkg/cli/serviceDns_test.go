@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceDNS(t *testing.T) {
+	serviceInfos := []*defangv1.ServiceInfo{
+		{
+			Service:     &defangv1.Service{Name: "web"},
+			PublicFqdn:  "web--80.example.com",
+			PrivateFqdn: "web.app.internal",
+			Endpoints:   []string{"web--80.example.com", "web.app.internal:9090"},
+		},
+		{
+			Service:     &defangv1.Service{Name: "db"},
+			PrivateFqdn: "db.app.internal",
+			Endpoints:   []string{"db.app.internal:5432"},
+		},
+		{
+			Service:    &defangv1.Service{Name: "api"},
+			PublicFqdn: "api.example.com",
+			Endpoints:  []string{"api--8080.example.com"},
+		},
+		{
+			Service: &defangv1.Service{Name: "worker"},
+		},
+	}
+
+	expected := []ServiceDNSLineItem{
+		{Service: "api", Hostname: "api.example.com", Access: "public"},
+		{Service: "db", Hostname: "db.app.internal", Ports: "5432", Access: "private"},
+		{Service: "web", Hostname: "web.app.internal", Ports: "9090", Access: "public"},
+	}
+	assert.Equal(t, expected, GetServiceDNS(serviceInfos))
+}
@@ -6,7 +6,7 @@ import (
 	"iter"
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/pkg/progress"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 )
@@ -23,7 +23,8 @@ func WaitServiceState(
 	etag types.ETag,
 	services []string,
 ) (ServiceStates, error) {
-	term.Debugf("waiting for services %v to reach state %s\n", services, targetState) // TODO: don't print in Go-routine
+	opts := OptionsFromContext(ctx)
+	opts.Term.Debugf("waiting for services %v to reach state %s\n", services, targetState) // TODO: don't print in Go-routine
 
 	if len(services) == 0 {
 		return nil, ErrNothingToMonitor
@@ -72,10 +73,10 @@ func WaitServiceState(
 			continue
 		}
 
-		term.Debugf("service %s with state ( %s ) and status: %s\n", msg.Name, msg.State, msg.Status) // TODO: don't print in Go-routine
+		opts.Term.Debugf("service %s with state ( %s ) and status: %s\n", msg.Name, msg.State, msg.Status) // TODO: don't print in Go-routine
 
 		if _, ok := serviceStates[msg.Name]; !ok {
-			term.Debugf("unexpected service %s update", msg.Name) // TODO: don't print in Go-routine
+			opts.Term.Debugf("unexpected service %s update", msg.Name) // TODO: don't print in Go-routine
 			continue
 		}
 		if msg.State == defangv1.ServiceState_NOT_SPECIFIED {
@@ -85,6 +86,9 @@ func WaitServiceState(
 
 		if serviceStates[msg.Name] != targetState {
 			serviceStates[msg.Name] = msg.State
+			if phase, ok := progress.FromServiceState(msg.State); ok {
+				progress.Report(ctx, msg.Name, phase)
+			}
 
 			// exit early on detecting a FAILED state
 			switch msg.State {
@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	composeTypes "github.com/compose-spec/compose-go/v2/types"
+)
+
+const (
+	supportBundleRequests = 100         // number of recent request IDs to include
+	supportBundleMaxLog   = 1024 * 1024 // only the tail of each log file is included
+	redacted              = "[REDACTED]"
+)
+
+// Patterns of secrets that might end up in logs
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\bbearer\s+[\w.~+/=-]+`),
+	regexp.MustCompile(`\beyJ[\w-]+\.[\w-]+\.[\w-]+`),   // JWT
+	regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`), // AWS access key ID
+	regexp.MustCompile(`(?i)\b((?:access_?token|api_?key|password|secret)["']?\s*[:=]\s*["']?)[^\s"',]+`),
+}
+
+type SupportBundleParams struct {
+	Output     string // path of the zip file to create
+	CliVersion string
+	Project    *compose.Project // optional; redacted like redactProject
+}
+
+// SupportBundle creates a zip file with information for troubleshooting: version info, the normalized compose
+// project, the IDs of recent calls to the Fabric controller, and the CLI logs. Secrets are redacted, but users
+// should still review the file before sharing it.
+func SupportBundle(ctx context.Context, fabric client.FabricClient, params SupportBundleParams) error {
+	opts := OptionsFromContext(ctx)
+
+	f, err := os.OpenFile(params.Output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+
+	fabricVersion, err := GetVersion(ctx, fabric)
+	if err != nil {
+		fabricVersion = "unknown: " + err.Error()
+	}
+	version := fmt.Sprintf("Defang CLI:    %s\nDefang Fabric: %s\nOS/Arch:       %s/%s\nGo:            %s\nCreated:       %s\n",
+		params.CliVersion, fabricVersion, runtime.GOOS, runtime.GOARCH, runtime.Version(), time.Now().UTC().Format(time.RFC3339))
+	if err := addBundleFile(zw, "version.txt", []byte(version)); err != nil {
+		return err
+	}
+
+	if params.Project != nil {
+		yaml, err := compose.MarshalYAML(redactProject(params.Project))
+		if err != nil {
+			return err
+		}
+		if err := addBundleFile(zw, "compose.yaml", yaml); err != nil {
+			return err
+		}
+	}
+
+	requests, err := client.RecentRequests(supportBundleRequests)
+	if err != nil {
+		opts.Term.Warn("Failed to read the recent request IDs:", err)
+	} else if err := addBundleFile(zw, "requests.log", []byte(strings.Join(requests, "\n")+"\n")); err != nil {
+		return err
+	}
+
+	logFiles, _ := filepath.Glob(filepath.Join(client.StateDir, "*.log"))
+	for _, logFile := range logFiles {
+		if logFile == client.RequestsLogPath {
+			continue
+		}
+		data, err := readTail(logFile, supportBundleMaxLog)
+		if err != nil {
+			opts.Term.Warnf("Failed to read log file %q: %v", logFile, err)
+			continue
+		}
+		if err := addBundleFile(zw, "logs/"+filepath.Base(logFile), []byte(sanitize(string(data)))); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func addBundleFile(zw *zip.Writer, name string, data []byte) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readTail returns the last limit bytes of the file.
+func readTail(path string, limit int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err == nil && info.Size() > limit {
+		if _, err := f.Seek(-limit, io.SeekEnd); err != nil {
+			return nil, err
+		}
+	}
+	return io.ReadAll(f)
+}
+
+// sanitize replaces anything that looks like a secret.
+func sanitize(s string) string {
+	for _, re := range secretPatterns {
+		if re.NumSubexp() > 0 {
+			s = re.ReplaceAllString(s, "${1}"+redacted)
+		} else {
+			s = re.ReplaceAllString(s, redacted)
+		}
+	}
+	return s
+}
+
+// redactProject returns a copy of the project with the services redacted, and without the values of the x-*
+// extensions, which might contain secrets.
+func redactProject(project *compose.Project) *compose.Project {
+	redactedProject := *project
+	redactedProject.Extensions = redactExtensions(project.Extensions)
+	redactedProject.Services = make(composeTypes.Services, len(project.Services))
+	for name, service := range project.Services {
+		redactedProject.Services[name] = redactService(service)
+	}
+	return &redactedProject
+}
+
+// redactService returns a copy of the service without the values of the environment variables, the build args, the
+// labels, and the x-* extensions, and without the arguments of its commands, which might all contain secrets.
+func redactService(service composeTypes.ServiceConfig) composeTypes.ServiceConfig {
+	service.Environment = redactMapping(service.Environment)
+	if service.Build != nil {
+		build := *service.Build
+		build.Args = redactMapping(build.Args)
+		build.Labels = redactLabels(build.Labels)
+		service.Build = &build
+	}
+	service.Command = redactCommand(service.Command, 1)
+	service.Entrypoint = redactCommand(service.Entrypoint, 1)
+	if service.HealthCheck != nil {
+		healthCheck := *service.HealthCheck
+		healthCheck.Test = redactCommand(healthCheck.Test, 2) // like ["CMD", "curl", ...]
+		service.HealthCheck = &healthCheck
+	}
+	service.Labels = redactLabels(service.Labels)
+	service.Extensions = redactExtensions(service.Extensions)
+	return service
+}
+
+// redactMapping replaces the values of the mapping; variables without a value are kept as is.
+func redactMapping(mapping composeTypes.MappingWithEquals) composeTypes.MappingWithEquals {
+	if mapping == nil {
+		return nil
+	}
+	redactedValue := redacted
+	result := make(composeTypes.MappingWithEquals, len(mapping))
+	for key, value := range mapping {
+		if value != nil {
+			value = &redactedValue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func redactLabels(labels composeTypes.Labels) composeTypes.Labels {
+	if labels == nil {
+		return nil
+	}
+	result := make(composeTypes.Labels, len(labels))
+	for key := range labels {
+		result[key] = redacted
+	}
+	return result
+}
+
+// redactCommand keeps the first keep elements of the command, like the executable, and replaces the arguments.
+func redactCommand(command []string, keep int) []string {
+	if len(command) <= keep {
+		return command
+	}
+	result := slices.Clone(command[:keep])
+	for range command[keep:] {
+		result = append(result, redacted)
+	}
+	return result
+}
+
+func redactExtensions(extensions composeTypes.Extensions) composeTypes.Extensions {
+	if extensions == nil {
+		return nil
+	}
+	result := make(composeTypes.Extensions, len(extensions))
+	for key, value := range extensions {
+		result[key] = redactValue(value)
+	}
+	return result
+}
+
+// redactValue replaces the values in the extension, keeping its structure and booleans so it can still be debugged.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case nil, bool:
+		return v
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			result[key] = redactValue(value)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, value := range v {
+			result[i] = redactValue(value)
+		}
+		return result
+	default:
+		return redacted
+	}
+}
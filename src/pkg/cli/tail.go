@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"os"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -48,15 +50,24 @@ type TailOptions struct {
 	EndEventDetectFunc TailDetectStopEventFunc // Deprecated: use Subscribe and GetDeploymentStatus instead #851
 	Filter             string
 	Follow             bool
+	Grep               string        // only show entries matching this regular expression; case-sensitive, unlike Filter
+	JSON               bool          // print each entry as a JSON object, with the timestamp in UTC
+	Level              logs.LogLevel // only show entries of at least this level
 	Limit              int32
+	Logfmt             bool // print each entry as logfmt key=value pairs, with the timestamp in UTC
 	LogType            logs.LogType
+	NoPrefix           bool // leave out the deployment, service, and host of each entry
 	Raw                bool
 	Services           []string
 	Since              time.Time
-	Stack              string // only used for display purposes
+	Stack              string         // only used for display purposes
+	Tail               int32          // only show the last entries of the window; unlike Limit, also with Since
+	TimeZone           *time.Location // nil means time.Local
+	Timestamps         logs.TimestampFormat
 	Until              time.Time
 	Verbose            bool
 	PrintBookends      bool
+	Builds             bool // show the output of image builds, even when not Verbose
 }
 
 func (to TailOptions) String() string {
@@ -76,6 +87,15 @@ func (to TailOptions) String() string {
 	if to.Raw {
 		cmd += " --raw"
 	}
+	if to.Tail > 0 {
+		cmd += fmt.Sprintf(" --tail=%d", to.Tail)
+	}
+	if to.Logfmt {
+		cmd += " --log-format=logfmt"
+	}
+	if to.NoPrefix {
+		cmd += " --no-prefix"
+	}
 	// --verbose is the default for "tail" so we test for false
 	if !to.Verbose {
 		cmd += " --verbose=0"
@@ -86,6 +106,18 @@ func (to TailOptions) String() string {
 	if to.Filter != "" {
 		cmd += fmt.Sprintf(" --filter=%q", to.Filter)
 	}
+	if to.Grep != "" {
+		cmd += fmt.Sprintf(" --grep=%q", to.Grep)
+	}
+	if to.Level != "" {
+		cmd += " --level=" + to.Level.String()
+	}
+	if to.Timestamps != "" && to.Timestamps != logs.TimestampRFC3339 {
+		cmd += " --timestamps=" + to.Timestamps.String()
+	}
+	if to.TimeZone != nil {
+		cmd += " --tz=" + to.TimeZone.String()
+	}
 	if to.Stack != "" {
 		cmd += " --stack=" + to.Stack
 	}
@@ -134,11 +166,12 @@ func (cerr CancelError) Unwrap() error {
 }
 
 func Tail(ctx context.Context, provider client.Provider, projectName string, options TailOptions) error {
+	opts := OptionsFromContext(ctx)
 	if options.LogType == logs.LogTypeUnspecified {
 		options.LogType = logs.LogTypeAll
 	}
 
-	term.Debugf("Tailing %s logs in project %q", options.LogType, projectName)
+	opts.Term.Debugf("Tailing %s logs in project %q", options.LogType, projectName)
 
 	if options.Deployment != "" {
 		_, err := types.ParseEtag(options.Deployment)
@@ -153,17 +186,17 @@ func Tail(ctx context.Context, provider client.Provider, projectName string, opt
 			if _, err := provider.GetService(ctx, &defangv1.GetRequest{Project: projectName, Name: service}); err != nil {
 				switch connect.CodeOf(err) {
 				case connect.CodeNotFound:
-					term.Warnf("Service does not exist (yet): %q", service)
+					opts.Term.Warnf("Service does not exist (yet): %q", service)
 				case connect.CodeUnknown:
 					// Ignore unknown (nil) errors
 				default:
-					term.Warn(err) // TODO: use client.PrettyError(…)
+					opts.Term.Warn(err) // TODO: use client.PrettyError(…)
 				}
 			}
 		}
 	}
 
-	if dryrun.DoDryRun {
+	if opts.DryRun {
 		return dryrun.ErrDryRun
 	}
 
@@ -231,11 +264,39 @@ func streamLogs(ctx context.Context, provider client.Provider, projectName strin
 		Until:    untilTs,
 		Follow:   options.Follow,
 		Limit:    options.Limit,
+		MinLevel: options.Level.String(),
+		Regex:    options.Grep,
 	}
 
-	term.Debug("Tail request:", tailRequest)
-
-	logSeq, err := provider.QueryLogs(ctx, tailRequest)
+	// Servers and providers that don't support the level or regex ignore them, so we filter here as well
+	if options.Level != "" || options.Grep != "" {
+		grep, err := regexp.Compile(options.Grep)
+		if err != nil {
+			return fmt.Errorf("invalid --grep: %w", err)
+		}
+		handler = filterLogEntries(handler, options.Level, grep)
+	}
+
+	t := OptionsFromContext(ctx).Term
+	t.Debug("Tail request:", tailRequest)
+
+	var logSeq iter.Seq2[*defangv1.TailResponse, error]
+	var err error
+	switch {
+	case options.Follow:
+		logSeq, err = provider.QueryLogs(ctx, tailRequest)
+	case options.Tail > 0 && sinceTs != nil:
+		// The logs since a time are returned from the start, so we get them all and keep the last ones
+		logSeq, err = queryLogPages(ctx, provider, tailRequest, 0)
+		logSeq = lastLogEntries(logSeq, int(options.Tail))
+	case options.Tail > 0:
+		tailRequest.Limit = options.Tail // without a start time, the last entries are returned
+		logSeq, err = provider.QueryLogs(ctx, tailRequest)
+	case options.Limit > logsPageSize:
+		logSeq, err = queryLogPages(ctx, provider, tailRequest, options.Limit)
+	default:
+		logSeq, err = provider.QueryLogs(ctx, tailRequest)
+	}
 	if err != nil {
 		return err
 	}
@@ -244,12 +305,12 @@ func streamLogs(ctx context.Context, provider client.Provider, projectName strin
 	defer cancel() // to ensure we clean-up this context
 
 	spin := spinner.New()
-	doSpinner := !options.Raw && term.StdoutCanColor() && term.IsTerminal()
+	doSpinner := !options.Raw && t.StdoutCanColor() && t.IsTerminal()
 
-	if term.IsTerminal() && !options.Raw {
+	if t.IsTerminal() && !options.Raw {
 		if doSpinner {
-			term.HideCursor()
-			defer term.ShowCursor()
+			t.HideCursor()
+			defer t.ShowCursor()
 
 			cancelSpinner := spin.Start(ctx)
 			defer cancelSpinner()
@@ -260,7 +321,7 @@ func streamLogs(ctx context.Context, provider client.Provider, projectName strin
 			if oldState, err := term.MakeUnbuf(int(os.Stdin.Fd())); err == nil {
 				defer term.Restore(int(os.Stdin.Fd()), oldState)
 
-				term.Info("Showing only build logs and runtime errors. Press V to toggle verbose mode.")
+				t.Info("Showing only build logs and runtime errors. Press V to toggle verbose mode.")
 				input := term.NewNonBlockingStdin()
 				defer input.Close() // abort the read loop
 				go func() {
@@ -274,7 +335,7 @@ func streamLogs(ctx context.Context, provider client.Provider, projectName strin
 						case 3: // Ctrl-C
 							cancel() // cancel the tail context
 						case 10, 13: // Enter or Return
-							term.Println(" ") // empty line, but overwrite the spinner
+							t.Println(" ") // empty line, but overwrite the spinner
 						case 'v', 'V':
 							verbose := !options.Verbose
 							options.Verbose = verbose
@@ -285,7 +346,7 @@ func streamLogs(ctx context.Context, provider client.Provider, projectName strin
 							if toggleCount++; toggleCount == 4 && !verbose {
 								modeStr += ". I like the way you work it, no verbosity."
 							}
-							term.Info("Verbose mode", modeStr)
+							t.Info("Verbose mode", modeStr)
 							track.Evt("Verbose Toggled", P("verbose", verbose), P("toggleCount", toggleCount))
 						}
 					}
@@ -308,10 +369,10 @@ func makeHeadBookendOptions(options *TailOptions, firstLogTime time.Time) *TailO
 	return &newOptions
 }
 
-func printHeadBookend(options *TailOptions, firstLogTime time.Time) {
+func printHeadBookend(t *term.Term, options *TailOptions, firstLogTime time.Time) {
 	newOptions := makeHeadBookendOptions(options, firstLogTime)
 	if !newOptions.Until.IsZero() {
-		term.Info("To view older logs, run: `defang logs" + newOptions.String() + "`")
+		t.Info("To view older logs, run: `defang logs" + newOptions.String() + "`")
 	}
 }
 
@@ -326,14 +387,15 @@ func makeTailBookendOptions(options *TailOptions, lastLogTime time.Time) *TailOp
 	return &newOptions
 }
 
-func printTailBookend(options *TailOptions, lastLogTime time.Time) {
+func printTailBookend(t *term.Term, options *TailOptions, lastLogTime time.Time) {
 	newOptions := makeTailBookendOptions(options, lastLogTime)
 	if !newOptions.Since.IsZero() {
-		term.Info("To view more recent logs, run: `defang logs" + newOptions.String() + "`")
+		t.Info("To view more recent logs, run: `defang logs" + newOptions.String() + "`")
 	}
 }
 
 func receiveLogs(ctx context.Context, provider client.Provider, projectName string, tailRequest *defangv1.TailRequest, logSeq iter.Seq2[*defangv1.TailResponse, error], options *TailOptions, doSpinner bool, handler LogEntryHandler) error {
+	t := OptionsFromContext(ctx).Term
 	next, stop := iter.Pull2(logSeq)
 	defer stop()
 
@@ -345,7 +407,7 @@ func receiveLogs(ctx context.Context, provider client.Provider, projectName stri
 		if !ok {
 			// Iterator finished normally
 			if options.PrintBookends {
-				printTailBookend(options, lastLogTime)
+				printTailBookend(t, options, lastLogTime)
 			}
 			return nil
 		}
@@ -359,10 +421,10 @@ func receiveLogs(ctx context.Context, provider client.Provider, projectName stri
 
 			// Reconnect on transient errors
 			if isTransientError(err) {
-				term.Debug("Disconnected:", err)
+				t.Debug("Disconnected:", err)
 				var spaces int
 				if !options.Raw {
-					spaces, _ = term.Warnf("Reconnecting...\r") // overwritten below
+					spaces, _ = t.Warnf("Reconnecting...\r") // overwritten below
 				}
 				if err := provider.DelayBeforeRetry(ctx); err != nil {
 					return err
@@ -371,12 +433,12 @@ func receiveLogs(ctx context.Context, provider client.Provider, projectName stri
 				stop() // stop the old iterator
 				newLogSeq, err := provider.QueryLogs(ctx, tailRequest)
 				if err != nil {
-					term.Debug("Reconnect failed:", err)
+					t.Debug("Reconnect failed:", err)
 					return err
 				}
 				next, stop = iter.Pull2(newLogSeq)
 				if !options.Raw {
-					term.Printf("%*s", spaces, "\r") // clear the "reconnecting" message
+					t.Printf("%*s", spaces, "\r") // clear the "reconnecting" message
 				}
 				skipDuplicate = true
 				continue
@@ -390,7 +452,7 @@ func receiveLogs(ctx context.Context, provider client.Provider, projectName stri
 		}
 
 		if options.PrintBookends && !headBookendPrinted && len(msg.Entries) > 0 {
-			printHeadBookend(options, msg.Entries[0].Timestamp.AsTime())
+			printHeadBookend(t, options, msg.Entries[0].Timestamp.AsTime())
 			headBookendPrinted = true
 		}
 
@@ -398,13 +460,13 @@ func receiveLogs(ctx context.Context, provider client.Provider, projectName stri
 			lastLogTime = msg.Entries[len(msg.Entries)-1].Timestamp.AsTime()
 		}
 
-		if err = handleLogEntryMsgs(msg, doSpinner, skipDuplicate, options, handler); err != nil {
+		if err = handleLogEntryMsgs(t, msg, doSpinner, skipDuplicate, options, handler); err != nil {
 			return err
 		}
 	}
 }
 
-func handleLogEntryMsgs(msg *defangv1.TailResponse, doSpinner bool, skipDuplicate bool, options *TailOptions, handler LogEntryHandler) error {
+func handleLogEntryMsgs(t *term.Term, msg *defangv1.TailResponse, doSpinner bool, skipDuplicate bool, options *TailOptions, handler LogEntryHandler) error {
 	for _, e := range msg.Entries {
 		// Replace service progress messages with our own spinner
 		if doSpinner && isProgressDot(e.Message) {
@@ -424,9 +486,9 @@ func handleLogEntryMsgs(msg *defangv1.TailResponse, doSpinner bool, skipDuplicat
 			options.Since = ts
 		}
 
-		err := handler(e, options, term.DefaultTerm)
+		err := handler(e, options, t)
 		if err != nil {
-			term.Debug("Ending tail loop", err)
+			t.Debug("Ending tail loop", err)
 			return err
 		}
 
@@ -440,21 +502,46 @@ func handleLogEntryMsgs(msg *defangv1.TailResponse, doSpinner bool, skipDuplicat
 	return nil
 }
 
+// filterLogEntries returns a handler that only passes on the entries of at least the given level that match grep.
+func filterLogEntries(handler LogEntryHandler, level logs.LogLevel, grep *regexp.Regexp) LogEntryHandler {
+	return func(e *defangv1.LogEntry, options *TailOptions, t *term.Term) error {
+		if level != "" && !level.Includes(logs.DetectLogLevel(e.Message, e.Stderr)) {
+			return nil
+		}
+		if !grep.MatchString(e.Message) {
+			return nil
+		}
+		return handler(e, options, t)
+	}
+}
+
 func logEntryPrintHandler(e *defangv1.LogEntry, options *TailOptions, t *term.Term) error {
 	// HACK: skip noisy CI/CD logs (except errors)
 	var internalServices = []string{"cd", "kaniko", "fabric", "ecs", "codebuild", "cloudbuild", "pulumi"}
 	var internalHosts = []string{"kaniko", "fabric", "ecs", "codebuild", "cloudbuild", "pulumi"}
+	var builderHosts = []string{"kaniko", "codebuild", "cloudbuild"}
 	isInternal := slices.Contains(internalServices, e.Service) || slices.Contains(internalHosts, e.Host)
+	if options.Builds && slices.Contains(builderHosts, e.Host) {
+		isInternal = false
+	}
 	onlyErrors := !options.Verbose && isInternal
 	if onlyErrors && !e.Stderr {
 		return nil
 	}
 
+	if options.JSON {
+		return printLogEntryJSON(e, options, t)
+	}
+
+	if options.Logfmt {
+		return printLogEntryLogfmt(e, options, t)
+	}
+
 	if options.Raw {
 		if e.Stderr {
-			term.Error(e.Message)
+			t.Error(e.Message)
 		} else {
-			term.Println(e.Message)
+			t.Println(e.Message)
 		}
 		return nil
 	}
@@ -463,9 +550,90 @@ func logEntryPrintHandler(e *defangv1.LogEntry, options *TailOptions, t *term.Te
 	return nil
 }
 
+// logEntryJSON is the JSON output of a log entry; the timestamp is always in UTC, regardless of the time zone
+type logEntryJSON struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Etag      string `json:"etag,omitempty"`
+	Service   string `json:"service,omitempty"`
+	Host      string `json:"host,omitempty"`
+	Stream    string `json:"stream"` // "stdout" or "stderr"
+	Stderr    bool   `json:"stderr,omitempty"`
+	Message   string `json:"message"`
+}
+
+func newLogEntryJSON(e *defangv1.LogEntry, options *TailOptions) logEntryJSON {
+	entry := logEntryJSON{
+		Stream:  string(logs.SourceStdout),
+		Stderr:  e.Stderr,
+		Message: e.Message,
+	}
+	if e.Stderr {
+		entry.Stream = string(logs.SourceStderr)
+	}
+	if options.Timestamps != logs.TimestampNone {
+		entry.Timestamp = e.Timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+	if !options.NoPrefix {
+		entry.Etag, entry.Service, entry.Host = e.Etag, e.Service, e.Host
+	}
+	return entry
+}
+
+func printLogEntryJSON(e *defangv1.LogEntry, options *TailOptions, t *term.Term) error {
+	bytes, err := json.Marshal(newLogEntryJSON(e, options))
+	if err != nil {
+		return err
+	}
+	_, err = t.Println(string(bytes))
+	return err
+}
+
+// printLogEntryLogfmt prints the entry on a single line of logfmt, with the same fields as the JSON output.
+func printLogEntryLogfmt(e *defangv1.LogEntry, options *TailOptions, t *term.Term) error {
+	entry := newLogEntryJSON(e, options)
+	var line strings.Builder
+	for _, field := range [][2]string{
+		{"ts", entry.Timestamp},
+		{"etag", entry.Etag},
+		{"service", entry.Service},
+		{"host", entry.Host},
+		{"stream", entry.Stream},
+		{"msg", strings.TrimRight(entry.Message, "\r\n")},
+	} {
+		if field[1] == "" && field[0] != "msg" {
+			continue
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(field[0] + "=" + logfmtValue(field[1]))
+	}
+	_, err := t.Println(line.String())
+	return err
+}
+
+func logfmtValue(value string) string {
+	if value == "" || strings.ContainsFunc(value, func(r rune) bool { return r <= ' ' || r == '=' || r == '"' || r == 0x7f }) {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+func formatTimestamp(ts time.Time, options *TailOptions) string {
+	switch options.Timestamps {
+	case logs.TimestampNone:
+		return ""
+	case logs.TimestampRelative:
+		return logs.FormatRelative(ts, time.Now())
+	}
+	if options.TimeZone != nil {
+		return ts.In(options.TimeZone).Format(RFC3339Milli)
+	}
+	return ts.Local().Format(RFC3339Milli)
+}
+
 func printLogEntry(e *defangv1.LogEntry, options *TailOptions, t *term.Term) {
-	ts := e.Timestamp.AsTime()
-	tsString := ts.Local().Format(RFC3339Milli)
+	tsString := formatTimestamp(e.Timestamp.AsTime(), options)
 	tsColor := termenv.ANSIBrightBlack
 	if t.HasDarkBackground() {
 		tsColor = termenv.ANSIWhite
@@ -479,16 +647,18 @@ func printLogEntry(e *defangv1.LogEntry, options *TailOptions, t *term.Term) {
 	buf := term.NewMessageBuilder(t.StdoutCanColor())
 	for i, line := range strings.Split(trimmed, "\n") {
 		if i == 0 {
-			prefixLen, _ = buf.Printc(tsColor, tsString, " ")
-			if options.Deployment == "" {
+			if tsString != "" {
+				prefixLen, _ = buf.Printc(tsColor, tsString, " ")
+			}
+			if options.Deployment == "" && !options.NoPrefix {
 				l, _ := buf.Printc(termenv.ANSIYellow, e.Etag, " ")
 				prefixLen += l
 			}
-			if len(options.Services) != 1 {
+			if len(options.Services) != 1 && !options.NoPrefix {
 				l, _ := buf.Printc(termenv.ANSIGreen, e.Service, " ")
 				prefixLen += l
 			}
-			if options.Verbose {
+			if options.Verbose && !options.NoPrefix {
 				l, _ := buf.Printc(termenv.ANSIMagenta, e.Host, " ")
 				prefixLen += l
 			}
@@ -3,14 +3,18 @@ package cli
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/DefangLabs/defang/src/pkg"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/logs"
 	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/bufbuild/connect-go"
 )
@@ -18,6 +22,7 @@ import (
 const targetServiceState = defangv1.ServiceState_DEPLOYMENT_COMPLETED
 
 func TailAndMonitor(ctx context.Context, project *compose.Project, provider client.Provider, waitTimeout time.Duration, tailOptions TailOptions) (ServiceStates, error) {
+	opts := OptionsFromContext(ctx)
 	tailOptions.Follow = true
 	if tailOptions.Deployment == "" {
 		panic("tailOptions.Deployment must be a valid deployment ID")
@@ -70,10 +75,10 @@ func TailAndMonitor(ctx context.Context, project *compose.Project, provider clie
 	// blocking call to tail
 	var tailErr error
 	if err := Tail(tailCtx, provider, project.Name, tailOptions); err != nil {
-		term.Debug("Tail stopped with", err, errors.Unwrap(err))
+		opts.Term.Debug("Tail stopped with", err, errors.Unwrap(err))
 
 		if connect.CodeOf(err) == connect.CodePermissionDenied {
-			term.Warn("Unable to tail logs. Waiting for the deployment to finish.")
+			opts.Term.Warn("Unable to tail logs. Waiting for the deployment to finish.")
 			// If tail fails because of missing permission, we wait for the deployment to finish
 			<-tailCtx.Done()
 			// Get the actual error from the context so we won't print "Error: missing tail permission"
@@ -85,14 +90,14 @@ func TailAndMonitor(ctx context.Context, project *compose.Project, provider clie
 			break // an end condition was detected; cdErr and/or svcErr might be nil
 
 		case errors.Is(context.Cause(ctx), context.Canceled):
-			term.Warn("Deployment is not finished. Service(s) might not be running.")
+			opts.Term.Warn("Deployment is not finished. Service(s) might not be running.")
 
 		case errors.Is(context.Cause(tailCtx), errMonitoringDone):
 			break // the monitoring stopped the tail; cdErr and/or svcErr will have been set
 
 		case errors.Is(context.Cause(ctx), context.DeadlineExceeded):
 			// Tail was canceled when wait-timeout is reached; show a warning and exit with an error
-			term.Warn("Wait-timeout exceeded, detaching from logs. Deployment still in progress.")
+			opts.Term.Warn("Wait-timeout exceeded, detaching from logs. Deployment still in progress.")
 			fallthrough
 
 		default:
@@ -103,6 +108,100 @@ func TailAndMonitor(ctx context.Context, project *compose.Project, provider clie
 	return serviceStates, errors.Join(cdErr, svcErr, tailErr)
 }
 
+// WaitForDeployment blocks until the CD task has finished and all compute services of the deployment have
+// reached the target state, without tailing the logs. A waitTimeout > 0 bounds the wait; exceeding it is an error.
+func WaitForDeployment(ctx context.Context, project *compose.Project, provider client.Provider, deployment types.ETag, waitTimeout time.Duration) (ServiceStates, error) {
+	if waitTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, waitTimeout)
+		defer cancelTimeout()
+	}
+
+	svcStatusCtx, cancelSvcStatus := context.WithCancelCause(ctx)
+	defer cancelSvcStatus(nil) // to cancel WaitServiceState and clean-up context
+
+	_, computeServices := splitManagedAndUnmanagedServices(project.Services)
+
+	var serviceStates ServiceStates
+	var svcErr error
+	svcDone := make(chan struct{})
+	go func() {
+		defer close(svcDone)
+		serviceStates, svcErr = WaitServiceState(svcStatusCtx, provider, targetServiceState, project.Name, deployment, computeServices)
+	}()
+
+	cdErr := WaitForCdTaskExit(ctx, provider)
+	if cdErr != nil {
+		// When CD fails, stop WaitServiceState
+		cancelSvcStatus(cdErr)
+	}
+	<-svcDone
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return serviceStates, fmt.Errorf("wait-timeout of %v exceeded; the deployment is still in progress: %w", waitTimeout, context.DeadlineExceeded)
+	}
+	if errors.Is(svcErr, ErrNothingToMonitor) {
+		svcErr = nil // only managed services; the CD task finishing is all we can wait for
+	}
+	return serviceStates, errors.Join(cdErr, svcErr)
+}
+
+// maxHeldBuildLines is the number of build lines kept per service with BuildLogs.Quiet; errors are at the end.
+const maxHeldBuildLines = 100
+
+// BuildLogs prints the image build output of a deployment while waiting for it, so build errors show up right away.
+// With Quiet, the output of each service is held back and only printed once BuildFailed reports its build failed.
+type BuildLogs struct {
+	Quiet bool
+
+	lock   sync.Mutex
+	held   map[string][]*defangv1.LogEntry
+	failed map[string]bool
+}
+
+// Stream prints the build output of the deployment until the context is canceled.
+func (b *BuildLogs) Stream(ctx context.Context, provider client.Provider, projectName string, deployment types.ETag) error {
+	options := TailOptions{
+		Deployment: deployment,
+		Follow:     true,
+		LogType:    logs.LogTypeBuild,
+		Verbose:    true, // don't hide the output of the builders, and don't grab the keyboard to toggle verbose mode
+	}
+	return streamLogs(ctx, provider, projectName, options, func(e *defangv1.LogEntry, options *TailOptions, t *term.Term) error {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		service := strings.TrimSuffix(e.Service, "-image") // the build of service "app" logs as "app-image"
+		if b.Quiet && !b.failed[service] {
+			if b.held == nil {
+				b.held = make(map[string][]*defangv1.LogEntry)
+			}
+			held := append(b.held[service], e)
+			if len(held) > maxHeldBuildLines {
+				held = held[len(held)-maxHeldBuildLines:]
+			}
+			b.held[service] = held
+			return nil
+		}
+		return logEntryPrintHandler(e, options, t)
+	})
+}
+
+// BuildFailed prints the build output that was held back for the service, and lets any further output through.
+func (b *BuildLogs) BuildFailed(ctx context.Context, service string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.failed == nil {
+		b.failed = make(map[string]bool)
+	}
+	b.failed[service] = true
+	options := TailOptions{Verbose: true}
+	t := OptionsFromContext(ctx).Term
+	for _, e := range b.held[service] {
+		_ = logEntryPrintHandler(e, &options, t)
+	}
+	delete(b.held, service)
+}
+
 func CanMonitorService(service *compose.ServiceConfig) bool {
 	// Services with "restart: no" are assumed to be one-off
 	// tasks, so they are not monitored.
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"iter"
 	"testing"
@@ -9,9 +10,12 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/logs"
+	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type mockSubscribeData struct {
@@ -151,3 +155,99 @@ func TestTailAndMonitor(t *testing.T) {
 		"hasura": defangv1.ServiceState_DEPLOYMENT_COMPLETED,
 	}, states)
 }
+
+func TestWaitForDeployment(t *testing.T) {
+	project := &compose.Project{
+		Name: "project1",
+		Services: compose.Services{
+			"db":  compose.ServiceConfig{Name: "db", Extensions: map[string]any{"x-defang-postgres": true}},
+			"web": compose.ServiceConfig{Name: "web"},
+		},
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		mockProvider := &mockTailAndMonitorProvider{
+			getDeploymentStatusErr: io.EOF, // done
+			subs: map[types.ETag]*mockSubscribeData{
+				"deployment1": {
+					resps: []*defangv1.SubscribeResponse{
+						{Name: "web", State: defangv1.ServiceState_DEPLOYMENT_PENDING},
+						{Name: "web", State: defangv1.ServiceState_DEPLOYMENT_COMPLETED},
+					},
+				},
+			},
+		}
+		states, err := WaitForDeployment(t.Context(), project, mockProvider, "deployment1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, ServiceStates{"web": defangv1.ServiceState_DEPLOYMENT_COMPLETED}, states)
+	})
+
+	t.Run("only managed services", func(t *testing.T) {
+		mockProvider := &mockTailAndMonitorProvider{getDeploymentStatusErr: io.EOF}
+		managed := &compose.Project{Name: "project1", Services: compose.Services{"db": project.Services["db"]}}
+		_, err := WaitForDeployment(t.Context(), managed, mockProvider, "deployment1", time.Minute)
+		require.NoError(t, err)
+	})
+
+	t.Run("cd failed", func(t *testing.T) {
+		mockProvider := &mockTailAndMonitorProvider{
+			getDeploymentStatusErr: client.ErrDeploymentFailed{Message: "boom"},
+			subs:                   map[types.ETag]*mockSubscribeData{"deployment1": {}},
+		}
+		_, err := WaitForDeployment(t.Context(), project, mockProvider, "deployment1", time.Minute)
+		require.ErrorAs(t, err, &client.ErrDeploymentFailed{})
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		mockProvider := &mockTailAndMonitorProvider{
+			subs: map[types.ETag]*mockSubscribeData{"deployment1": {}},
+		}
+		_, err := WaitForDeployment(t.Context(), project, mockProvider, "deployment1", 100*time.Millisecond)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "wait-timeout of 100ms exceeded")
+	})
+}
+
+func TestBuildLogs(t *testing.T) {
+	newProvider := func() *mockTailProvider {
+		return &mockTailProvider{
+			Iters: []iter.Seq2[*defangv1.TailResponse, error]{
+				client.MockIter([]*defangv1.TailResponse{
+					{Service: "app-image", Etag: "deployment1", Host: "kaniko", Entries: []*defangv1.LogEntry{
+						{Message: "Step 1/2 : FROM alpine", Timestamp: timestamppb.Now(), Stderr: true},
+						{Message: "COPY failed: file not found", Timestamp: timestamppb.Now()},
+					}},
+					{Service: "worker-image", Etag: "deployment1", Host: "kaniko", Entries: []*defangv1.LogEntry{
+						{Message: "Step 1/1 : FROM busybox", Timestamp: timestamppb.Now(), Stderr: true},
+					}},
+				}, io.EOF),
+			},
+		}
+	}
+
+	for _, quiet := range []bool{false, true} {
+		t.Run(fmt.Sprintf("quiet=%v", quiet), func(t *testing.T) {
+			stdout, _ := term.SetupTestTerm(t)
+
+			provider := newProvider()
+			buildLogs := &BuildLogs{Quiet: quiet}
+			err := buildLogs.Stream(t.Context(), provider, "project1", "deployment1")
+			require.ErrorIs(t, err, io.EOF)
+			require.Len(t, provider.Reqs, 1)
+			require.Equal(t, uint32(logs.LogTypeBuild), provider.Reqs[0].LogType)
+			require.Equal(t, "deployment1", provider.Reqs[0].Etag)
+
+			if quiet {
+				require.NotContains(t, stdout.String(), "FROM alpine", "expected no build output before the build failed")
+				buildLogs.BuildFailed(t.Context(), "app")
+			}
+			require.Contains(t, stdout.String(), "Step 1/2 : FROM alpine")
+			require.Contains(t, stdout.String(), "COPY failed: file not found")
+			if quiet {
+				require.NotContains(t, stdout.String(), "FROM busybox", "expected no build output of the other service")
+			} else {
+				require.Contains(t, stdout.String(), "Step 1/1 : FROM busybox")
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// logsPageSize is the number of log entries that are fetched at a time, when more are needed.
+const logsPageSize = 1000
+
+// queryLogPages returns the logs since the start of the request, fetched page by page, so a large history is
+// retrieved incrementally; limit is the total number of entries, or 0 for all. The next page is requested with
+// the cursor of the previous page; servers and providers without cursors get a request that starts at the
+// timestamp of the last entry instead, and the entries at that timestamp that were already seen are skipped.
+func queryLogPages(ctx context.Context, provider client.Provider, req *defangv1.TailRequest, limit int32) (iter.Seq2[*defangv1.TailResponse, error], error) {
+	remaining := limit
+	nextPage := func() *defangv1.TailRequest {
+		page, _ := proto.Clone(req).(*defangv1.TailRequest)
+		page.Limit = logsPageSize
+		if remaining > 0 {
+			page.Limit = min(remaining, logsPageSize)
+		}
+		return page
+	}
+
+	page := nextPage()
+	logSeq, err := provider.QueryLogs(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(*defangv1.TailResponse, error) bool) {
+		var last time.Time // timestamp of the last entry
+		var seenAtLast int // number of entries at the last timestamp
+		for {
+			var received, yielded int32
+			var nextCursor string
+			skip := seenAtLast
+			for msg, err := range logSeq {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if msg == nil {
+					continue
+				}
+				if msg.NextCursor != "" {
+					nextCursor = msg.NextCursor
+				}
+				received += int32(len(msg.Entries))
+
+				entries := make([]*defangv1.LogEntry, 0, len(msg.Entries))
+				for _, e := range msg.Entries {
+					ts := e.Timestamp.AsTime()
+					if skip > 0 && page.Cursor == "" && ts.Equal(last) {
+						skip-- // already seen on the previous page
+						continue
+					}
+					if remaining > 0 && yielded == remaining {
+						break
+					}
+					if ts.Equal(last) {
+						seenAtLast++
+					} else {
+						last, seenAtLast = ts, 1
+					}
+					entries = append(entries, e)
+					yielded++
+				}
+				if len(entries) == 0 {
+					continue
+				}
+				msg.Entries = entries
+				if !yield(msg, nil) {
+					return
+				}
+			}
+
+			if remaining > 0 {
+				if remaining -= yielded; remaining == 0 {
+					return
+				}
+			}
+			// Without a cursor, a page that is not full is the last one; a page without new entries means that a
+			// whole page has the same timestamp, and we can't get past it.
+			if nextCursor == "" && (received < page.Limit || yielded == 0) {
+				return
+			}
+
+			page = nextPage()
+			if nextCursor != "" {
+				page.Cursor = nextCursor
+			} else {
+				page.Since = timestamppb.New(last)
+			}
+			if logSeq, err = provider.QueryLogs(ctx, page); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}, nil
+}
+
+// lastLogEntries returns the last n entries of the logs in a single response, for showing the end of a window that
+// can only be retrieved from its start.
+func lastLogEntries(logSeq iter.Seq2[*defangv1.TailResponse, error], n int) iter.Seq2[*defangv1.TailResponse, error] {
+	return func(yield func(*defangv1.TailResponse, error) bool) {
+		entries := make([]*defangv1.LogEntry, 0, n)
+		for msg, err := range logSeq {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if msg == nil {
+				continue
+			}
+			for _, e := range msg.Entries {
+				e.Service = valueOrDefault(e.Service, msg.Service)
+				e.Host = valueOrDefault(e.Host, msg.Host)
+				e.Etag = valueOrDefault(e.Etag, msg.Etag)
+				if len(entries) == n {
+					entries = append(entries[:0], entries[1:]...)
+				}
+				entries = append(entries, e)
+			}
+		}
+		if len(entries) > 0 {
+			yield(&defangv1.TailResponse{Entries: entries}, nil)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// makeLogPage returns a page of entries with the given messages; entries with the same second share a timestamp.
+func makeLogPage(nextCursor string, messages ...string) iter.Seq2[*defangv1.TailResponse, error] {
+	start := time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)
+	var entries []*defangv1.LogEntry
+	for _, message := range messages {
+		var second int
+		fmt.Sscanf(message, "m%d", &second)
+		entries = append(entries, &defangv1.LogEntry{Message: message, Timestamp: timestamppb.New(start.Add(time.Duration(second) * time.Second))})
+	}
+	return client.MockIter([]*defangv1.TailResponse{{Service: "app", Entries: entries, NextCursor: nextCursor}}, nil)
+}
+
+func collectMessages(t *testing.T, logSeq iter.Seq2[*defangv1.TailResponse, error]) []string {
+	t.Helper()
+	var messages []string
+	for msg, err := range logSeq {
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range msg.Entries {
+			messages = append(messages, e.Message)
+		}
+	}
+	return messages
+}
+
+func TestQueryLogPages(t *testing.T) {
+	full := func(first int) []string {
+		messages := make([]string, logsPageSize)
+		for i := range messages {
+			messages[i] = fmt.Sprintf("m%d", first+i)
+		}
+		return messages
+	}
+	req := &defangv1.TailRequest{Project: "project1", Since: timestamppb.New(time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC))}
+
+	t.Run("cursor", func(t *testing.T) {
+		p := &mockTailProvider{Iters: []iter.Seq2[*defangv1.TailResponse, error]{
+			makeLogPage("page2", "m1", "m2"),
+			makeLogPage("", "m3"),
+		}}
+		logSeq, err := queryLogPages(t.Context(), p, req, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := collectMessages(t, logSeq); !slices.Equal(got, []string{"m1", "m2", "m3"}) {
+			t.Errorf("got %v", got)
+		}
+		if len(p.Reqs) != 2 || p.Reqs[1].Cursor != "page2" || p.Reqs[1].Limit != logsPageSize {
+			t.Errorf("expected the second page by cursor, got %v", p.Reqs)
+		}
+	})
+
+	t.Run("timestamps", func(t *testing.T) {
+		page1 := full(0)
+		page1[len(page1)-1] = page1[len(page1)-2] // two entries at the last timestamp
+		last := page1[len(page1)-1]
+		p := &mockTailProvider{Iters: []iter.Seq2[*defangv1.TailResponse, error]{
+			makeLogPage("", page1...),
+			makeLogPage("", last, last, "m5000"), // starts at the last timestamp again
+		}}
+		logSeq, err := queryLogPages(t.Context(), p, req, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := collectMessages(t, logSeq)
+		if want := append(page1, "m5000"); !slices.Equal(got, want) {
+			t.Errorf("expected %d entries without duplicates, got %d ending with %v", len(want), len(got), got[len(got)-3:])
+		}
+		if len(p.Reqs) != 2 || !p.Reqs[1].Since.AsTime().Equal(time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC).Add(998*time.Second)) {
+			t.Errorf("expected the second page since the last timestamp, got %v", p.Reqs[1].Since.AsTime())
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		p := &mockTailProvider{Iters: []iter.Seq2[*defangv1.TailResponse, error]{
+			makeLogPage("", full(0)...),
+			makeLogPage("", full(logsPageSize)...),
+		}}
+		logSeq, err := queryLogPages(t.Context(), p, req, logsPageSize+10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := collectMessages(t, logSeq); len(got) != logsPageSize+10 {
+			t.Errorf("expected %d entries, got %d", logsPageSize+10, len(got))
+		}
+		if p.Reqs[1].Limit != 10 {
+			t.Errorf("expected the second page to be limited to the remaining entries, got %d", p.Reqs[1].Limit)
+		}
+	})
+}
+
+func TestLastLogEntries(t *testing.T) {
+	logSeq := lastLogEntries(func(yield func(*defangv1.TailResponse, error) bool) {
+		for msg := range makeLogPage("", "m1", "m2", "m3") {
+			yield(msg, nil)
+		}
+		for msg := range makeLogPage("", "m4", "m5") {
+			yield(msg, nil)
+		}
+	}, 3)
+
+	var got []*defangv1.LogEntry
+	for msg, err := range logSeq {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, msg.Entries...)
+	}
+	if len(got) != 3 || got[0].Message != "m3" || got[2].Message != "m5" || got[0].Service != "app" {
+		t.Errorf("expected the last 3 entries with their service, got %v", got)
+	}
+}
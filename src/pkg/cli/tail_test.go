@@ -291,6 +291,44 @@ func (m mockQueryErrorProvider) QueryLogs(ctx context.Context, req *defangv1.Tai
 	return client.MockIter[defangv1.TailResponse](nil, m.TailStreamError), nil
 }
 
+func TestTailFilters(t *testing.T) {
+	stdout, stderr := term.SetupTestTerm(t)
+
+	p := &mockTailProvider{
+		Iters: []iter.Seq2[*defangv1.TailResponse, error]{
+			client.MockIter([]*defangv1.TailResponse{
+				{Service: "api", Etag: "ETAG", Host: "HOST", Entries: []*defangv1.LogEntry{
+					{Message: "INFO GET /api/1", Timestamp: timestamppb.Now()},
+					{Message: "WARN GET /api/2 slow", Timestamp: timestamppb.Now()},
+					{Message: `{"level":"error","msg":"GET /api/3 failed"}`, Timestamp: timestamppb.Now()},
+					{Message: "ERROR POST /api/4 failed", Timestamp: timestamppb.Now()},
+					{Message: "GET /api/5 panicked", Timestamp: timestamppb.Now(), Stderr: true},
+				}},
+			}, io.EOF),
+		},
+	}
+
+	err := Tail(t.Context(), p, "project1", TailOptions{Verbose: true, Raw: true, Level: logs.LogLevelWarn, Grep: `^\S*\s*GET|"msg":"GET`})
+	if err != io.EOF {
+		t.Errorf("Tail() error = %v, want io.EOF", err)
+	}
+	if req := p.Reqs[0]; req.MinLevel != "warn" || req.Regex == "" {
+		t.Errorf("expected the filters in the request, got %v", req)
+	}
+	want := "WARN GET /api/2 slow\n{\"level\":\"error\",\"msg\":\"GET /api/3 failed\"}\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("expected only the matching warnings and errors, got %q", got)
+	}
+	if got := stderr.String(); !strings.Contains(got, "GET /api/5 panicked") {
+		t.Errorf("expected the stderr entry without a level as an error, got %q", got)
+	}
+
+	err = Tail(t.Context(), p, "project1", TailOptions{Grep: "("})
+	if err == nil || !strings.Contains(err.Error(), "invalid --grep") {
+		t.Errorf("expected an invalid regular expression error, got %v", err)
+	}
+}
+
 func TestTailError(t *testing.T) {
 	const cancelError = "logs --since=2024-01-02T03:04:05Z --verbose=0 --project-name=project"
 	tailOptions := TailOptions{
@@ -486,6 +524,28 @@ func jsonFileToLogEntry(t *testing.T, fileName string) []*defangv1.LogEntry {
 	return logEntries
 }
 
+func TestPrintHandlerBuilds(t *testing.T) {
+	entries := []*defangv1.LogEntry{
+		{Message: "Step 1/2 : FROM alpine", Service: "app", Host: "codebuild", Timestamp: timestamppb.Now()},
+		{Message: "Update succeeded", Service: "cd", Host: "pulumi", Timestamp: timestamppb.Now()},
+	}
+	for _, builds := range []bool{false, true} {
+		var stdout, stderr bytes.Buffer
+		mockTerm := term.NewTerm(os.Stdin, &stdout, &stderr)
+		for _, entry := range entries {
+			if err := logEntryPrintHandler(entry, &TailOptions{Builds: builds}, mockTerm); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := strings.Contains(stdout.String(), "FROM alpine"); got != builds {
+			t.Errorf("Builds=%v: expected build output %v, got:\n%s", builds, builds, stdout.String())
+		}
+		if strings.Contains(stdout.String(), "Update succeeded") {
+			t.Errorf("Builds=%v: expected other internal logs to stay hidden, got:\n%s", builds, stdout.String())
+		}
+	}
+}
+
 func TestTailOptions_String(t *testing.T) {
 	tests := []struct {
 		name string
@@ -544,6 +604,42 @@ func TestTailOptions_String(t *testing.T) {
 			},
 			want: " --until=2024-01-02T05:04:05Z",
 		},
+		{
+			name: "with timestamps and time zone",
+			to: TailOptions{
+				Verbose:    true,
+				Timestamps: logs.TimestampRelative,
+				TimeZone:   time.UTC,
+			},
+			want: " --timestamps=relative --tz=UTC",
+		},
+		{
+			name: "with grep and level",
+			to: TailOptions{
+				Verbose: true,
+				Grep:    `GET /api/\d+`,
+				Level:   logs.LogLevelWarn,
+			},
+			want: ` --grep="GET /api/\\d+" --level=warn`,
+		},
+		{
+			name: "with logfmt and no prefix",
+			to: TailOptions{
+				Verbose:  true,
+				Logfmt:   true,
+				NoPrefix: true,
+			},
+			want: " --log-format=logfmt --no-prefix",
+		},
+		{
+			name: "with since and tail",
+			to: TailOptions{
+				Verbose: true,
+				Since:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Tail:    500,
+			},
+			want: " --since=2024-01-02T03:04:05Z --tail=500",
+		},
 	}
 
 	for _, tt := range tests {
@@ -554,3 +650,58 @@ func TestTailOptions_String(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintLogEntryLogfmt(t *testing.T) {
+	var stdout bytes.Buffer
+	mockTerm := term.NewTerm(os.Stdin, &stdout, &stdout)
+	entry := &defangv1.LogEntry{
+		Message:   `GET /api status="500" failed` + "\n",
+		Timestamp: timestamppb.New(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Service:   "app",
+		Stderr:    true,
+	}
+	if err := logEntryPrintHandler(entry, &TailOptions{Logfmt: true, Verbose: true}, mockTerm); err != nil {
+		t.Fatal(err)
+	}
+	want := `ts=2025-01-02T03:04:05Z service=app stream=stderr msg="GET /api status=\"500\" failed"` + "\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrintLogEntryTimestamps(t *testing.T) {
+	entry := &defangv1.LogEntry{
+		Message:   "hello",
+		Timestamp: timestamppb.New(time.Date(2025, 1, 2, 3, 4, 5, 6e6, time.UTC)),
+		Service:   "app",
+		Etag:      "abc123",
+		Host:      "host1",
+	}
+	tokyo := time.FixedZone("+09:00", 9*60*60)
+
+	tests := []struct {
+		name    string
+		options TailOptions
+		want    string
+	}{
+		{"time zone", TailOptions{Deployment: "abc123", Services: []string{"app"}, TimeZone: tokyo}, "2025-01-02T12:04:05.006+09:00 hello\n"},
+		{"none", TailOptions{Deployment: "abc123", Services: []string{"app"}, Timestamps: logs.TimestampNone}, "hello\n"},
+		{"json", TailOptions{JSON: true, TimeZone: tokyo}, `{"timestamp":"2025-01-02T03:04:05.006Z","etag":"abc123","service":"app","host":"host1","stream":"stdout","message":"hello"}` + "\n"},
+		{"json without prefix or timestamp", TailOptions{JSON: true, NoPrefix: true, Timestamps: logs.TimestampNone}, `{"stream":"stdout","message":"hello"}` + "\n"},
+		{"logfmt", TailOptions{Logfmt: true, TimeZone: tokyo}, "ts=2025-01-02T03:04:05.006Z etag=abc123 service=app host=host1 stream=stdout msg=hello\n"},
+		{"logfmt without prefix", TailOptions{Logfmt: true, NoPrefix: true}, "ts=2025-01-02T03:04:05.006Z stream=stdout msg=hello\n"},
+		{"no prefix", TailOptions{NoPrefix: true, Verbose: true, Timestamps: logs.TimestampNone}, "hello\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			mockTerm := term.NewTerm(os.Stdin, &stdout, &stderr)
+			if err := logEntryPrintHandler(entry, &tt.options, mockTerm); err != nil {
+				t.Fatal(err)
+			}
+			if got := term.StripAnsi(stdout.String()); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
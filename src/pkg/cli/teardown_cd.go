@@ -9,14 +9,13 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/cli/client/byoc/state"
-	"github.com/DefangLabs/defang/src/pkg/dryrun"
-	"github.com/DefangLabs/defang/src/pkg/term"
 )
 
 var ErrExistingStacks = errors.New("there are still deployed stacks")
 
 func TearDownCD(ctx context.Context, provider client.Provider, force bool) error {
-	if dryrun.DoDryRun {
+	opts := OptionsFromContext(ctx)
+	if opts.DryRun {
 		return errors.New("dry run")
 	}
 	list, err := provider.CdList(ctx, false)
@@ -34,9 +33,9 @@ func TearDownCD(ctx context.Context, provider client.Provider, force bool) error
 	})
 
 	if len(stacks) > 0 {
-		term.Info("Some stacks are currently deployed. Run the following commands to tear them down:")
+		opts.Term.Info("Some stacks are currently deployed. Run the following commands to tear them down:")
 		for _, stack := range stacks {
-			term.Infof("  `defang down --workspace %s --project-name %s --stack %s`\n", stack.Workspace, stack.Project, stack.Stack)
+			opts.Term.Infof("  `defang down --workspace %s --project-name %s --stack %s`\n", stack.Workspace, stack.Project, stack.Stack)
 		}
 		if !force {
 			return ErrExistingStacks
@@ -14,12 +14,13 @@ import (
 )
 
 func Token(ctx context.Context, client client.FabricClient, tenant types.TenantNameOrID, dur time.Duration, s scope.Scope) error {
-	if dryrun.DoDryRun {
+	opts := OptionsFromContext(ctx)
+	if opts.DryRun {
 		return dryrun.ErrDryRun
 	}
 
 	code, err := auth.StartAuthCodeFlow(ctx, false, func(token string) {
-		term.Debug("Getting access token for scope:", s)
+		opts.Term.Debug("Getting access token for scope:", s)
 	}, "token-cli")
 	if err != nil {
 		return err
@@ -36,7 +37,7 @@ func Token(ctx context.Context, client client.FabricClient, tenant types.TenantN
 		scopes = []string{string(s)}
 	}
 
-	term.Debugf("Generating token for tenant %q with scopes %v", tenant, scopes)
+	opts.Term.Debugf("Generating token for tenant %q with scopes %v", tenant, scopes)
 
 	resp, err := client.Token(ctx, &defangv1.TokenRequest{
 		Assertion: at,
@@ -48,7 +49,7 @@ func Token(ctx context.Context, client client.FabricClient, tenant types.TenantN
 		return err
 	}
 
-	term.Printc(term.BrightCyan, "Scoped access token: ")
-	term.Println(resp.AccessToken)
+	opts.Term.Printc(term.BrightCyan, "Scoped access token: ")
+	opts.Term.Println(resp.AccessToken)
 	return nil
 }
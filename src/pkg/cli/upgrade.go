@@ -13,39 +13,40 @@ import (
 )
 
 func Upgrade(ctx context.Context) error {
+	opts := OptionsFromContext(ctx)
 	// Find path to the current executable to determine how to upgrade
 	ex, err := os.Executable()
 	if err != nil {
 		return err
 	}
-	term.Debugf(" - Executable: %s\n", ex)
+	opts.Term.Debugf(" - Executable: %s\n", ex)
 
 	ex, err = filepath.EvalSymlinks(ex)
 	if err != nil {
 		return err
 	}
-	term.Debugf(" - Evaluated: %s\n", ex)
+	opts.Term.Debugf(" - Evaluated: %s\n", ex)
 
 	if strings.HasPrefix(ex, "/nix/store/") {
 		// Detect whether the user has used Flakes or nix-env
 		if strings.Contains(ex, "-defang-cli-") {
-			printInstructions("nix-env -if https://github.com/DefangLabs/defang/archive/main.tar.gz")
+			printInstructions(opts.Term, "nix-env -if https://github.com/DefangLabs/defang/archive/main.tar.gz")
 		} else {
-			printInstructions("nix profile install github:DefangLabs/defang#defang-bin --refresh")
+			printInstructions(opts.Term, "nix profile install github:DefangLabs/defang#defang-bin --refresh")
 		}
 		return nil
 	}
 
 	// Check if we're running via npx (npm_execpath is set by npx/npm/yarn/etc)
 	if _, exists := os.LookupEnv("npm_execpath"); exists {
-		printInstructions("npx defang@latest")
+		printInstructions(opts.Term, "npx defang@latest")
 		return nil
 	}
 
 	// Check if we're running on Windows
 	if runtime.GOOS == "windows" {
 		if strings.Contains(strings.ToLower(ex), "winget") {
-			printInstructions("winget upgrade defang")
+			printInstructions(opts.Term, "winget upgrade defang")
 			return nil
 		}
 		// Check if we're running in PowerShell (and not CMD or Git Bash)
@@ -53,19 +54,19 @@ func Upgrade(ctx context.Context) error {
 		_, hasPrompt := os.LookupEnv("PROMPT")             // CMD
 		_, hasPSModulePath := os.LookupEnv("PSModulePath") // CMD and Powershell
 		if !hasMSYSTEM && !hasPrompt && hasPSModulePath {
-			printInstructions(`iwr https://s.defang.io/defang_windows_` + runtime.GOARCH + `.zip -OutFile defang.zip; Expand-Archive defang.zip . -Force`)
+			printInstructions(opts.Term, `iwr https://s.defang.io/defang_windows_`+runtime.GOARCH+`.zip -OutFile defang.zip; Expand-Archive defang.zip . -Force`)
 			return nil
 		}
 	}
 
 	prefix, err := homebrewPrefix(ctx)
 	if err == nil && strings.HasPrefix(ex, prefix) {
-		printInstructions("brew upgrade -g defang")
+		printInstructions(opts.Term, "brew upgrade -g defang")
 		return nil
 	}
 
 	// Default to the shell script
-	printInstructions(`eval "$(curl -fsSL s.defang.io/install)"`)
+	printInstructions(opts.Term, `eval "$(curl -fsSL s.defang.io/install)"`)
 
 	return nil
 }
@@ -92,7 +93,7 @@ func homebrewPrefix(ctx context.Context) (string, error) {
 	return "", errors.New(HOMEBREW_PREFIX + " not found")
 }
 
-func printInstructions(cmd string) {
-	term.Info("To upgrade defang, run the following command:")
-	term.Print("\n  ", cmd, "\n\n")
+func printInstructions(t *term.Term, cmd string) {
+	t.Info("To upgrade defang, run the following command:")
+	t.Print("\n  ", cmd, "\n\n")
 }
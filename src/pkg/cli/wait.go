@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// waitStateAliases are the friendly names for the states that "defang wait --for" accepts, besides the state names.
+var waitStateAliases = map[string]defangv1.ServiceState{
+	"healthy":   defangv1.ServiceState_DEPLOYMENT_COMPLETED,
+	"completed": defangv1.ServiceState_DEPLOYMENT_COMPLETED,
+	"paused":    defangv1.ServiceState_DEPLOYMENT_SCALED_IN,
+	"scaled-in": defangv1.ServiceState_DEPLOYMENT_SCALED_IN,
+}
+
+// ParseWaitState parses the state to wait for, like "healthy" or "DEPLOYMENT_COMPLETED".
+func ParseWaitState(s string) (defangv1.ServiceState, error) {
+	if state, ok := waitStateAliases[strings.ToLower(s)]; ok {
+		return state, nil
+	}
+	if state, ok := defangv1.ServiceState_value[strings.ToUpper(s)]; ok && state != int32(defangv1.ServiceState_NOT_SPECIFIED) {
+		return defangv1.ServiceState(state), nil
+	}
+	return defangv1.ServiceState_NOT_SPECIFIED, fmt.Errorf("invalid state %q; use healthy, paused, or one of the service states", s)
+}
+
+type ErrServiceNotFound struct {
+	ProjectName string
+	Service     string
+}
+
+func (e ErrServiceNotFound) Error() string {
+	return fmt.Sprintf("service %q not found in project %q", e.Service, e.ProjectName)
+}
+
+type WaitParams struct {
+	ProjectName string
+	Services    []string // empty means all services of the project
+	State       defangv1.ServiceState
+	Timeout     time.Duration // 0 means no timeout
+}
+
+// WaitForServices blocks until the deployed services reach the requested state. Returns client.ErrDeploymentFailed
+// if a service fails, and an error wrapping context.DeadlineExceeded when the timeout is reached.
+func WaitForServices(ctx context.Context, provider client.Provider, params WaitParams) (ServiceStates, error) {
+	opts := OptionsFromContext(ctx)
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+
+	servicesResponse, err := provider.GetServices(ctx, &defangv1.GetServicesRequest{Project: params.ProjectName})
+	if err != nil {
+		return nil, err
+	}
+	deployed := make(map[string]*defangv1.ServiceInfo, len(servicesResponse.Services))
+	for _, si := range servicesResponse.Services {
+		deployed[si.Service.GetName()] = si
+	}
+
+	services := params.Services
+	if len(services) == 0 {
+		if len(deployed) == 0 {
+			return nil, ErrNoServices{ProjectName: params.ProjectName}
+		}
+		for name := range deployed {
+			services = append(services, name)
+		}
+		slices.Sort(services)
+	}
+
+	// Only subscribe for the services that are not in the requested state yet
+	states := make(ServiceStates, len(services))
+	var pending []string
+	etag := ""
+	for _, name := range services {
+		si, ok := deployed[name]
+		if !ok {
+			return nil, ErrServiceNotFound{ProjectName: params.ProjectName, Service: name}
+		}
+		states[name] = si.State
+		if si.State == params.State {
+			continue
+		}
+		if si.State == defangv1.ServiceState_BUILD_FAILED || si.State == defangv1.ServiceState_DEPLOYMENT_FAILED {
+			return states, client.ErrDeploymentFailed{Service: name, Message: si.Status}
+		}
+		pending = append(pending, name)
+		// Only filter by deployment if all services are part of the same deployment
+		if len(pending) == 1 {
+			etag = si.Etag
+		} else if etag != si.Etag {
+			etag = ""
+		}
+	}
+	if len(pending) == 0 {
+		return states, nil
+	}
+
+	opts.Term.Debugf("Waiting for services %v to reach state %s", pending, params.State)
+	pendingStates, err := WaitServiceState(ctx, provider, params.State, params.ProjectName, etag, pending)
+	for name, state := range pendingStates {
+		if state != defangv1.ServiceState_NOT_SPECIFIED {
+			states[name] = state
+		}
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return states, fmt.Errorf("timed out after %v waiting for service(s) %v to reach state %s: %w", params.Timeout, pending, params.State, context.DeadlineExceeded)
+	}
+	if err != nil {
+		return states, err
+	}
+	if !allInState(params.State, pendingStates) {
+		return states, fmt.Errorf("stopped waiting before service(s) %v reached state %s", pending, params.State)
+	}
+	return states, nil
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/types"
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWaitProvider struct {
+	mockTailAndMonitorProvider
+	services []*defangv1.ServiceInfo
+	block    bool // never send any updates
+}
+
+func (m *mockWaitProvider) Subscribe(ctx context.Context, req *defangv1.SubscribeRequest) (iter.Seq2[*defangv1.SubscribeResponse, error], error) {
+	if m.block {
+		return func(yield func(*defangv1.SubscribeResponse, error) bool) {
+			<-ctx.Done()
+			yield(nil, ctx.Err())
+		}, nil
+	}
+	return m.mockTailAndMonitorProvider.Subscribe(ctx, req)
+}
+
+func (m *mockWaitProvider) GetServices(context.Context, *defangv1.GetServicesRequest) (*defangv1.GetServicesResponse, error) {
+	return &defangv1.GetServicesResponse{Services: m.services}, nil
+}
+
+func serviceInfo(name, etag string, state defangv1.ServiceState) *defangv1.ServiceInfo {
+	return &defangv1.ServiceInfo{Service: &defangv1.Service{Name: name}, Etag: etag, State: state}
+}
+
+func TestParseWaitState(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    defangv1.ServiceState
+		wantErr bool
+	}{
+		{input: "healthy", want: defangv1.ServiceState_DEPLOYMENT_COMPLETED},
+		{input: "Healthy", want: defangv1.ServiceState_DEPLOYMENT_COMPLETED},
+		{input: "paused", want: defangv1.ServiceState_DEPLOYMENT_SCALED_IN},
+		{input: "build_running", want: defangv1.ServiceState_BUILD_RUNNING},
+		{input: "NOT_SPECIFIED", wantErr: true},
+		{input: "bogus", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseWaitState(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWaitForServices(t *testing.T) {
+	healthy := defangv1.ServiceState_DEPLOYMENT_COMPLETED
+
+	t.Run("already healthy", func(t *testing.T) {
+		provider := &mockWaitProvider{services: []*defangv1.ServiceInfo{
+			serviceInfo("api", "etag1", healthy),
+			serviceInfo("worker", "etag1", healthy),
+		}}
+		states, err := WaitForServices(t.Context(), provider, WaitParams{ProjectName: "project1", State: healthy})
+		require.NoError(t, err)
+		require.Equal(t, ServiceStates{"api": healthy, "worker": healthy}, states)
+	})
+
+	t.Run("becomes healthy", func(t *testing.T) {
+		provider := &mockWaitProvider{
+			services: []*defangv1.ServiceInfo{
+				serviceInfo("api", "etag1", healthy),
+				serviceInfo("worker", "etag1", defangv1.ServiceState_DEPLOYMENT_PENDING),
+			},
+			mockTailAndMonitorProvider: mockTailAndMonitorProvider{subs: map[types.ETag]*mockSubscribeData{
+				"etag1": {resps: []*defangv1.SubscribeResponse{
+					{Name: "worker", State: defangv1.ServiceState_DEPLOYMENT_PENDING},
+					{Name: "worker", State: healthy},
+				}},
+			}},
+		}
+		states, err := WaitForServices(t.Context(), provider, WaitParams{ProjectName: "project1", Services: []string{"api", "worker"}, State: healthy})
+		require.NoError(t, err)
+		require.Equal(t, ServiceStates{"api": healthy, "worker": healthy}, states)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		provider := &mockWaitProvider{
+			services: []*defangv1.ServiceInfo{serviceInfo("worker", "etag1", defangv1.ServiceState_BUILD_RUNNING)},
+			mockTailAndMonitorProvider: mockTailAndMonitorProvider{subs: map[types.ETag]*mockSubscribeData{
+				"etag1": {resps: []*defangv1.SubscribeResponse{
+					{Name: "worker", State: defangv1.ServiceState_BUILD_FAILED, Status: "boom"},
+				}},
+			}},
+		}
+		_, err := WaitForServices(t.Context(), provider, WaitParams{ProjectName: "project1", Services: []string{"worker"}, State: healthy})
+		require.ErrorAs(t, err, &client.ErrDeploymentFailed{})
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		provider := &mockWaitProvider{services: []*defangv1.ServiceInfo{serviceInfo("api", "etag1", healthy)}}
+		_, err := WaitForServices(t.Context(), provider, WaitParams{ProjectName: "project1", Services: []string{"web"}, State: healthy})
+		require.ErrorAs(t, err, &ErrServiceNotFound{})
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		provider := &mockWaitProvider{
+			services: []*defangv1.ServiceInfo{serviceInfo("worker", "etag1", defangv1.ServiceState_DEPLOYMENT_PENDING)},
+			block:    true,
+		}
+		_, err := WaitForServices(t.Context(), provider, WaitParams{ProjectName: "project1", State: healthy, Timeout: 100 * time.Millisecond})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
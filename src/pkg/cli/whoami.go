@@ -5,7 +5,6 @@ import (
 
 	"github.com/DefangLabs/defang/src/pkg/auth"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
-	"github.com/DefangLabs/defang/src/pkg/term"
 	"github.com/DefangLabs/defang/src/pkg/types"
 
 	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
@@ -23,6 +22,7 @@ type ShowAccountData struct {
 }
 
 func Whoami(ctx context.Context, fabric client.FabricClient, maybeProvider client.Provider, userInfo *auth.UserInfo, tenantSelection types.TenantNameOrID) (ShowAccountData, error) {
+	opts := OptionsFromContext(ctx)
 	resp, err := fabric.WhoAmI(ctx)
 	if err != nil {
 		return ShowAccountData{}, err
@@ -33,7 +33,7 @@ func Whoami(ctx context.Context, fabric client.FabricClient, maybeProvider clien
 		tenantSelection = types.TenantNameOrID(resp.TenantId)
 	}
 
-	term.Debug("User ID: " + resp.UserId)
+	opts.Term.Debug("User ID: " + resp.UserId)
 	showData := ShowAccountData{
 		Region:         resp.Region,
 		SubscriberTier: resp.Tier,
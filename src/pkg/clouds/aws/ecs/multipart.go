@@ -0,0 +1,127 @@
+package ecs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/ptr"
+)
+
+// multipartPartSize is the size of the parts of a multipart upload; S3 requires at least 5 MiB, except for the last
+// part, and allows up to 10,000 parts.
+var multipartPartSize = 8 << 20
+
+// maxPartAttempts is the number of times a part is uploaded before the upload fails; each attempt is retried by the
+// S3 client as well, but only briefly.
+const maxPartAttempts = 5
+
+// partRetryDelay is the delay before the second attempt of a part; it grows with each attempt.
+var partRetryDelay = time.Second
+
+type s3MultipartAPI interface {
+	CreateMultipartUpload(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// UploadMultipart uploads the body to the same object as a URL from CreateUploadURL, in parts that are retried on
+// their own, so a network blip doesn't restart a large upload. The retry callback, if any, is called before a part
+// is uploaded again. Returns the URL of the object, without query.
+func (a *AwsEcs) UploadMultipart(ctx context.Context, name string, body io.Reader, retry func(part int32, err error)) (string, error) {
+	cfg, err := a.LoadConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := uploadKey(name)
+	if err != nil {
+		return "", err
+	}
+
+	if err := uploadMultipart(ctx, s3.NewFromConfig(cfg), a.BucketName, key, body, retry); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", a.BucketName, cfg.Region, key), nil
+}
+
+func uploadMultipart(ctx context.Context, s3Client s3MultipartAPI, bucket, key string, body io.Reader, retry func(part int32, err error)) error {
+	out, err := s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return err
+	}
+
+	parts, err := uploadParts(ctx, s3Client, bucket, key, out.UploadId, body, retry)
+	if err != nil {
+		// Abort the upload, so the stored parts are deleted, even if the context was canceled
+		s3Client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   &bucket,
+			Key:      &key,
+			UploadId: out.UploadId,
+		})
+		return err
+	}
+
+	_, err = s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          &bucket,
+		Key:             &key,
+		UploadId:        out.UploadId,
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	})
+	return err
+}
+
+// uploadParts reads the body one part at a time, so only the current part is kept in memory for its retries.
+func uploadParts(ctx context.Context, s3Client s3MultipartAPI, bucket, key string, uploadId *string, body io.Reader, retry func(part int32, err error)) ([]s3types.CompletedPart, error) {
+	var parts []s3types.CompletedPart
+	buf := make([]byte, multipartPartSize)
+	for number := int32(1); ; number++ {
+		n, err := io.ReadFull(body, buf)
+		if errors.Is(err, io.EOF) && number > 1 {
+			break // the previous part was the last one
+		}
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+
+		for attempt := 1; ; attempt++ {
+			out, err := s3Client.UploadPart(ctx, &s3.UploadPartInput{
+				Bucket:        &bucket,
+				Key:           &key,
+				UploadId:      uploadId,
+				PartNumber:    ptr.Int32(number),
+				Body:          bytes.NewReader(buf[:n]),
+				ContentLength: ptr.Int64(int64(n)),
+			})
+			if err == nil {
+				parts = append(parts, s3types.CompletedPart{ETag: out.ETag, PartNumber: ptr.Int32(number)})
+				break
+			}
+			if ctx.Err() != nil || attempt >= maxPartAttempts {
+				return nil, fmt.Errorf("failed to upload part %d: %w", number, err)
+			}
+			if retry != nil {
+				retry(number, err)
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(attempt) * partRetryDelay):
+			}
+		}
+
+		if n < len(buf) {
+			break // short read: this was the last part
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,108 @@
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/ptr"
+)
+
+type mockS3Multipart struct {
+	parts     map[int32]string
+	failures  map[int32]int // number of times to fail each part
+	completed []int32
+	aborted   bool
+}
+
+func (m *mockS3Multipart) CreateMultipartUpload(ctx context.Context, in *s3.CreateMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	return &s3.CreateMultipartUploadOutput{UploadId: ptr.String("upload")}, nil
+}
+
+func (m *mockS3Multipart) UploadPart(ctx context.Context, in *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	number := *in.PartNumber
+	if m.failures[number] > 0 {
+		m.failures[number]--
+		io.ReadAll(in.Body) // like a dropped connection after sending the part
+		return nil, errors.New("connection reset")
+	}
+	data, _ := io.ReadAll(in.Body)
+	m.parts[number] = string(data)
+	return &s3.UploadPartOutput{ETag: ptr.String(fmt.Sprint("etag", number))}, nil
+}
+
+func (m *mockS3Multipart) CompleteMultipartUpload(ctx context.Context, in *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	for _, part := range in.MultipartUpload.Parts {
+		if *part.ETag != fmt.Sprint("etag", *part.PartNumber) {
+			return nil, fmt.Errorf("unexpected ETag %q for part %d", *part.ETag, *part.PartNumber)
+		}
+		m.completed = append(m.completed, *part.PartNumber)
+	}
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (m *mockS3Multipart) AbortMultipartUpload(ctx context.Context, in *s3.AbortMultipartUploadInput, _ ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	m.aborted = true
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func TestUploadMultipart(t *testing.T) {
+	defer func(size int, delay time.Duration) {
+		multipartPartSize, partRetryDelay = size, delay
+	}(multipartPartSize, partRetryDelay)
+	multipartPartSize, partRetryDelay = 4, 0
+
+	tests := []struct {
+		name      string
+		body      string
+		failures  map[int32]int
+		wantParts []int32
+		retries   int
+		wantErr   string
+	}{
+		{"empty", "", nil, []int32{1}, 0, ""},
+		{"single part", "012", nil, []int32{1}, 0, ""},
+		{"exact parts", "01234567", nil, []int32{1, 2}, 0, ""},
+		{"retried part", "0123456789", map[int32]int{2: 2}, []int32{1, 2, 3}, 2, ""},
+		{"failed part", "0123456789", map[int32]int{2: maxPartAttempts}, nil, maxPartAttempts - 1, "failed to upload part 2: connection reset"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockS3Multipart{parts: map[int32]string{}, failures: tt.failures}
+			var retries []int32
+			err := uploadMultipart(t.Context(), m, "bucket", "uploads/test", strings.NewReader(tt.body), func(part int32, err error) {
+				retries = append(retries, part)
+			})
+			if len(retries) != tt.retries {
+				t.Errorf("expected %d retries, got %v", tt.retries, retries)
+			}
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				if !m.aborted {
+					t.Error("expected the upload to be aborted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(m.completed) != fmt.Sprint(tt.wantParts) {
+				t.Errorf("expected parts %v, got %v", tt.wantParts, m.completed)
+			}
+			var stored string
+			for _, number := range m.completed {
+				stored += m.parts[number]
+			}
+			if stored != tt.body {
+				t.Errorf("expected %q to be stored, got %q", tt.body, stored)
+			}
+		})
+	}
+}
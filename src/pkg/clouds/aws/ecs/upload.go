@@ -3,9 +3,15 @@ package ecs
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go/ptr"
 	"github.com/google/uuid"
 )
@@ -21,6 +27,25 @@ func (a *AwsEcs) CreateUploadURL(ctx context.Context, name string) (string, erro
 		return "", err
 	}
 
+	key, err := uploadKey(name)
+	if err != nil {
+		return "", err
+	}
+
+	s3Client := s3.NewFromConfig(cfg)
+	// Use S3 SDK to create a presigned URL for uploading a file.
+	req, err := s3.NewPresignClient(s3Client).PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket: &a.BucketName,
+		Key:    &key,
+	})
+	if err != nil {
+		return "", err
+	}
+	return req.URL, nil
+}
+
+// uploadKey returns the key of the object for an upload with the name, or a random name if empty.
+func uploadKey(name string) (string, error) {
 	if name == "" {
 		name = uuid.NewString()
 	} else {
@@ -31,15 +56,97 @@ func (a *AwsEcs) CreateUploadURL(ctx context.Context, name string) (string, erro
 		name = s3InvalidCharsRegexp.ReplaceAllString(name, "_")
 		// name = path.Join(buildsPath, tenantName.String(), digest); TODO: avoid collisions between tenants
 	}
+	return prefix + name, nil
+}
 
-	s3Client := s3.NewFromConfig(cfg)
-	// Use S3 SDK to create a presigned URL for uploading a file.
-	req, err := s3.NewPresignClient(s3Client).PresignPutObject(ctx, &s3.PutObjectInput{
+// UploadExists returns whether the object at the URL of an earlier upload exists, like
+// https://bucket.s3.us-west-2.amazonaws.com/uploads/name or s3://bucket/uploads/name.
+func (a *AwsEcs) UploadExists(ctx context.Context, uploadUrl string) (bool, error) {
+	u, err := url.Parse(uploadUrl)
+	if err != nil {
+		return false, err
+	}
+	bucket, _, _ := strings.Cut(u.Host, ".s3.")
+	key := strings.TrimPrefix(u.Path, "/")
+	if bucket != a.BucketName || !strings.HasPrefix(key, prefix) {
+		return false, nil // not from our bucket
+	}
+
+	cfg, err := a.LoadConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, err = s3.NewFromConfig(cfg).HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: &a.BucketName,
-		Key:    ptr.String(prefix + name),
+		Key:    &key,
 	})
 	if err != nil {
-		return "", err
+		var notFound *s3types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
 	}
-	return req.URL, nil
+	return true, nil
+}
+
+type Upload struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// ListUploads returns the files uploaded with CreateUploadURL, like the build contexts.
+func (a *AwsEcs) ListUploads(ctx context.Context) ([]Upload, error) {
+	cfg, err := a.LoadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploads []Upload
+	paginator := s3.NewListObjectsV2Paginator(s3.NewFromConfig(cfg), &s3.ListObjectsV2Input{
+		Bucket: &a.BucketName,
+		Prefix: ptr.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			uploads = append(uploads, Upload{
+				Name:         strings.TrimPrefix(ptr.ToString(obj.Key), prefix),
+				Size:         ptr.ToInt64(obj.Size),
+				LastModified: ptr.ToTime(obj.LastModified),
+			})
+		}
+	}
+	return uploads, nil
+}
+
+// DeleteUploads deletes the files uploaded with CreateUploadURL by name.
+func (a *AwsEcs) DeleteUploads(ctx context.Context, names []string) error {
+	cfg, err := a.LoadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	s3Client := s3.NewFromConfig(cfg)
+	for batch := range slices.Chunk(names, 1000) { // DeleteObjects accepts up to 1000 keys
+		objects := make([]s3types.ObjectIdentifier, len(batch))
+		for i, name := range batch {
+			objects[i] = s3types.ObjectIdentifier{Key: ptr.String(prefix + name)}
+		}
+		out, err := s3Client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: &a.BucketName,
+			Delete: &s3types.Delete{Objects: objects, Quiet: ptr.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("failed to delete %q: %s", ptr.ToString(out.Errors[0].Key), ptr.ToString(out.Errors[0].Message))
+		}
+	}
+	return nil
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ var (
 )
 
 type Route53API interface {
+	ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
 	CreateHostedZone(ctx context.Context, params *route53.CreateHostedZoneInput, optFns ...func(*route53.Options)) (*route53.CreateHostedZoneOutput, error)
 	CreateReusableDelegationSet(ctx context.Context, params *route53.CreateReusableDelegationSetInput, optFns ...func(*route53.Options)) (*route53.CreateReusableDelegationSetOutput, error)
 	DeleteReusableDelegationSet(ctx context.Context, params *route53.DeleteReusableDelegationSetInput, optFns ...func(*route53.Options)) (*route53.DeleteReusableDelegationSetOutput, error)
@@ -181,6 +183,104 @@ func ListResourceRecords(ctx context.Context, zoneId, recordName string, recordT
 	return values, nil
 }
 
+// GetParentHostedZone returns the public hosted zone that contains the given domain, walking up the domain tree.
+func GetParentHostedZone(ctx context.Context, domain string, r53 Route53API) (*types.HostedZone, error) {
+	for name := dns.Normalize(domain); strings.Contains(name, "."); _, name, _ = strings.Cut(name, ".") {
+		zones, err := GetHostedZonesByName(ctx, name, r53)
+		if errors.Is(err, ErrZoneNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, zone := range zones {
+			if zone.Config == nil || !zone.Config.PrivateZone {
+				return zone, nil
+			}
+		}
+	}
+	return nil, ErrZoneNotFound
+}
+
+// UpsertCNAMERecord creates or updates the CNAME record for the domain in its parent hosted zone.
+func UpsertCNAMERecord(ctx context.Context, domain, target string, r53 Route53API) error {
+	zone, err := GetParentHostedZone(ctx, domain, r53)
+	if err != nil {
+		return err
+	}
+	if isSameDomain(*zone.Name, domain) {
+		return fmt.Errorf("cannot create a CNAME record at the zone apex %q; create an ALIAS record instead", domain)
+	}
+
+	_, err = r53.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zone.Id,
+		ChangeBatch: &types.ChangeBatch{
+			Comment: ptr.String("Created by Defang CLI"),
+			Changes: []types.Change{
+				{
+					Action: types.ChangeActionUpsert,
+					ResourceRecordSet: &types.ResourceRecordSet{
+						Name:            ptr.String(dns.Normalize(domain)),
+						Type:            types.RRTypeCname,
+						TTL:             ptr.Int64(300),
+						ResourceRecords: []types.ResourceRecord{{Value: ptr.String(dns.Normalize(target))}},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+// AllowCAAIssuer adds the CA to the CAA records that apply to the domain, if the closest ancestor in its parent hosted
+// zone has any CAA records that don't already authorize the CA. Without CAA records, any CA can issue certificates.
+// Returns the name of the updated record, or "" if nothing needed to change.
+func AllowCAAIssuer(ctx context.Context, domain, issuer string, r53 Route53API) (string, error) {
+	zone, err := GetParentHostedZone(ctx, domain, r53)
+	if err != nil {
+		return "", err
+	}
+	// The domain itself has the CNAME, which can't have other records
+	for name := dns.Parent(domain); name != ""; name = dns.Parent(name) {
+		resp, err := r53.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+			HostedZoneId:    zone.Id,
+			StartRecordName: ptr.String(name),
+			StartRecordType: types.RRTypeCaa,
+			MaxItems:        ptr.Int32(1),
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(resp.ResourceRecordSets) > 0 {
+			rrset := resp.ResourceRecordSets[0]
+			if rrset.Type == types.RRTypeCaa && isSameDomain(*rrset.Name, name) {
+				var values []string
+				for _, record := range rrset.ResourceRecords {
+					values = append(values, *record.Value)
+				}
+				if dns.CAAAuthorizes(values, issuer) {
+					return "", nil
+				}
+				rrset.ResourceRecords = append(rrset.ResourceRecords, types.ResourceRecord{Value: ptr.String(dns.CAAIssueRecord(issuer))})
+				_, err = r53.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: zone.Id,
+					ChangeBatch: &types.ChangeBatch{
+						Comment: ptr.String("Created by Defang CLI"),
+						Changes: []types.Change{{Action: types.ChangeActionUpsert, ResourceRecordSet: &rrset}},
+					},
+				})
+				if err != nil {
+					return "", err
+				}
+				return name, nil
+			}
+		}
+		if isSameDomain(*zone.Name, name) {
+			break
+		}
+	}
+	return "", nil
+}
+
 func GetHostedZoneTags(ctx context.Context, zoneId string, r53 Route53API) (map[string]string, error) {
 	zoneId = strings.TrimPrefix(zoneId, "/hostedzone/")
 	listResp, err := r53.ListTagsForResource(ctx, &route53.ListTagsForResourceInput{
@@ -0,0 +1,192 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/DefangLabs/defang/src/pkg/dns"
+)
+
+var ErrZoneNotFound = errors.New("the Cloudflare zone was not found")
+
+// Client is a minimal Cloudflare API client for managing DNS records
+type Client struct {
+	Token      string
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewClientFromEnv creates a Cloudflare API client using the CLOUDFLARE_API_TOKEN environment variable
+func NewClientFromEnv() (*Client, error) {
+	token := os.Getenv("CLOUDFLARE_API_TOKEN")
+	if token == "" {
+		return nil, errors.New("CLOUDFLARE_API_TOKEN must be set to create DNS records in Cloudflare")
+	}
+	return &Client{
+		Token:      token,
+		HTTPClient: http.DefaultClient,
+		BaseURL:    "https://api.cloudflare.com/client/v4",
+	}, nil
+}
+
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type apiResponse[T any] struct {
+	Success bool       `json:"success"`
+	Errors  []apiError `json:"errors"`
+	Result  T          `json:"result"`
+}
+
+type Zone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type DNSRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+	Proxied bool   `json:"proxied"`
+}
+
+// CAARecord is a DNS record of type CAA, which has structured data instead of content
+type CAARecord struct {
+	ID   string  `json:"id,omitempty"`
+	Type string  `json:"type"`
+	Name string  `json:"name"`
+	Data CAAData `json:"data"`
+	TTL  int     `json:"ttl"`
+}
+
+type CAAData struct {
+	Flags int    `json:"flags"`
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// GetParentZone returns the zone that contains the given domain, walking up the domain tree.
+func (c *Client) GetParentZone(ctx context.Context, domain string) (*Zone, error) {
+	for name := dns.Normalize(domain); strings.Contains(name, "."); _, name, _ = strings.Cut(name, ".") {
+		zones, err := call[[]Zone](ctx, c, http.MethodGet, "/zones?name="+url.QueryEscape(name), nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(zones) > 0 {
+			return &zones[0], nil
+		}
+	}
+	return nil, ErrZoneNotFound
+}
+
+// UpsertCNAMERecord creates or updates the CNAME record for the domain in its parent zone.
+// The record is not proxied, so the TLS certificate can be issued by the origin.
+func (c *Client) UpsertCNAMERecord(ctx context.Context, domain, target string) error {
+	zone, err := c.GetParentZone(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	domain = dns.Normalize(domain)
+	record := DNSRecord{Type: "CNAME", Name: domain, Content: dns.Normalize(target), TTL: 300}
+	path := "/zones/" + zone.ID + "/dns_records"
+	existing, err := call[[]DNSRecord](ctx, c, http.MethodGet, path+"?type=CNAME&name="+url.QueryEscape(domain), nil)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		_, err = call[DNSRecord](ctx, c, http.MethodPut, path+"/"+existing[0].ID, record)
+	} else {
+		_, err = call[DNSRecord](ctx, c, http.MethodPost, path, record)
+	}
+	return err
+}
+
+// AllowCAAIssuer adds the CA to the CAA records that apply to the domain, if the closest ancestor in its parent zone
+// has any CAA records that don't already authorize the CA. Without CAA records, any CA can issue certificates.
+// Returns the name of the updated record, or "" if nothing needed to change.
+func (c *Client) AllowCAAIssuer(ctx context.Context, domain, issuer string) (string, error) {
+	zone, err := c.GetParentZone(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+
+	path := "/zones/" + zone.ID + "/dns_records"
+	// The domain itself has the CNAME, which can't have other records
+	for name := dns.Parent(domain); name != ""; name = dns.Parent(name) {
+		existing, err := call[[]CAARecord](ctx, c, http.MethodGet, path+"?type=CAA&name="+url.QueryEscape(name), nil)
+		if err != nil {
+			return "", err
+		}
+		if len(existing) > 0 {
+			var records []string
+			for _, record := range existing {
+				records = append(records, fmt.Sprintf("%d %s %q", record.Data.Flags, record.Data.Tag, record.Data.Value))
+			}
+			if dns.CAAAuthorizes(records, issuer) {
+				return "", nil
+			}
+			record := CAARecord{Type: "CAA", Name: name, Data: CAAData{Tag: "issue", Value: issuer}, TTL: 300}
+			if _, err := call[CAARecord](ctx, c, http.MethodPost, path, record); err != nil {
+				return "", err
+			}
+			return name, nil
+		}
+		if name == dns.Normalize(zone.Name) {
+			break
+		}
+	}
+	return "", nil
+}
+
+func call[T any](ctx context.Context, c *Client, method, path string, body any) (T, error) {
+	var result apiResponse[T]
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return result.Result, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return result.Result, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return result.Result, err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return result.Result, fmt.Errorf("Cloudflare API call failed: %d - %w", resp.StatusCode, err)
+	}
+	if !result.Success || resp.StatusCode >= 400 {
+		var errs []error
+		for _, e := range result.Errors {
+			errs = append(errs, fmt.Errorf("%d: %s", e.Code, e.Message))
+		}
+		if len(errs) == 0 {
+			errs = append(errs, errors.New(http.StatusText(resp.StatusCode)))
+		}
+		return result.Result, fmt.Errorf("Cloudflare API call failed: %d - %w", resp.StatusCode, errors.Join(errs...))
+	}
+	return result.Result, nil
+}
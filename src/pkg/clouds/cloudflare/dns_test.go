@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpsertCNAMERecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   []DNSRecord
+		wantMethod string
+	}{
+		{name: "create", wantMethod: http.MethodPost},
+		{name: "update", existing: []DNSRecord{{ID: "rec1", Type: "CNAME", Name: "app.example.com", Content: "old.example.net"}}, wantMethod: http.MethodPut},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod string
+			var gotRecord DNSRecord
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer token" {
+					t.Errorf("unexpected authorization header %q", r.Header.Get("Authorization"))
+				}
+				var result any
+				switch {
+				case r.URL.Path == "/zones":
+					if r.URL.Query().Get("name") == "example.com" {
+						result = []Zone{{ID: "zone1", Name: "example.com"}}
+					} else {
+						result = []Zone{}
+					}
+				case r.Method == http.MethodGet && r.URL.Path == "/zones/zone1/dns_records":
+					result = tt.existing
+				case r.URL.Path == "/zones/zone1/dns_records" || r.URL.Path == "/zones/zone1/dns_records/rec1":
+					gotMethod = r.Method
+					if err := json.NewDecoder(r.Body).Decode(&gotRecord); err != nil {
+						t.Fatal(err)
+					}
+					result = gotRecord
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					json.NewEncoder(w).Encode(apiResponse[any]{Errors: []apiError{{Code: 7003, Message: "not found"}}})
+					return
+				}
+				json.NewEncoder(w).Encode(apiResponse[any]{Success: true, Result: result})
+			}))
+			t.Cleanup(server.Close)
+
+			c := &Client{Token: "token", HTTPClient: server.Client(), BaseURL: server.URL}
+			if err := c.UpsertCNAMERecord(t.Context(), "App.Example.com.", "lb.example.net."); err != nil {
+				t.Fatal(err)
+			}
+			if gotMethod != tt.wantMethod {
+				t.Errorf("expected %s, got %s", tt.wantMethod, gotMethod)
+			}
+			if gotRecord.Name != "app.example.com" || gotRecord.Content != "lb.example.net" || gotRecord.Type != "CNAME" || gotRecord.Proxied {
+				t.Errorf("unexpected record: %+v", gotRecord)
+			}
+		})
+	}
+
+	t.Run("zone not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(apiResponse[[]Zone]{Success: true})
+		}))
+		t.Cleanup(server.Close)
+
+		c := &Client{Token: "token", HTTPClient: server.Client(), BaseURL: server.URL}
+		if err := c.UpsertCNAMERecord(t.Context(), "app.example.com", "lb.example.net"); err != ErrZoneNotFound {
+			t.Errorf("expected ErrZoneNotFound, got %v", err)
+		}
+	})
+}
+
+func TestAllowCAAIssuer(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing map[string][]CAARecord // by name
+		want     string
+	}{
+		{name: "no CAA records"},
+		{name: "already authorized", existing: map[string][]CAARecord{"example.com": {{ID: "caa1", Type: "CAA", Name: "example.com", Data: CAAData{Tag: "issue", Value: "letsencrypt.org"}}}}},
+		{name: "other CA", existing: map[string][]CAARecord{"example.com": {{ID: "caa1", Type: "CAA", Name: "example.com", Data: CAAData{Tag: "issue", Value: "digicert.com"}}}}, want: "example.com"},
+		{name: "closest ancestor", existing: map[string][]CAARecord{
+			"api.example.com": {{ID: "caa1", Type: "CAA", Name: "api.example.com", Data: CAAData{Tag: "issue", Value: "digicert.com"}}},
+			"example.com":     {{ID: "caa2", Type: "CAA", Name: "example.com", Data: CAAData{Tag: "issue", Value: "letsencrypt.org"}}},
+		}, want: "api.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []CAARecord
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var result any
+				switch {
+				case r.URL.Path == "/zones":
+					if r.URL.Query().Get("name") == "example.com" {
+						result = []Zone{{ID: "zone1", Name: "example.com"}}
+					} else {
+						result = []Zone{}
+					}
+				case r.Method == http.MethodGet && r.URL.Path == "/zones/zone1/dns_records":
+					if r.URL.Query().Get("type") != "CAA" {
+						t.Errorf("unexpected record type %q", r.URL.Query().Get("type"))
+					}
+					result = append([]CAARecord{}, tt.existing[r.URL.Query().Get("name")]...)
+				case r.Method == http.MethodPost && r.URL.Path == "/zones/zone1/dns_records":
+					var record CAARecord
+					if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+						t.Fatal(err)
+					}
+					created = append(created, record)
+					result = record
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					json.NewEncoder(w).Encode(apiResponse[any]{Errors: []apiError{{Code: 7003, Message: "not found"}}})
+					return
+				}
+				json.NewEncoder(w).Encode(apiResponse[any]{Success: true, Result: result})
+			}))
+			t.Cleanup(server.Close)
+
+			c := &Client{Token: "token", HTTPClient: server.Client(), BaseURL: server.URL}
+			got, err := c.AllowCAAIssuer(t.Context(), "app.api.example.com", "letsencrypt.org")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q to be updated, got %q", tt.want, got)
+			}
+			if tt.want == "" {
+				if len(created) != 0 {
+					t.Errorf("expected no records to be created, got %+v", created)
+				}
+				return
+			}
+			if len(created) != 1 || created[0].Name != tt.want || created[0].Data != (CAAData{Tag: "issue", Value: "letsencrypt.org"}) {
+				t.Errorf("unexpected records created: %+v", created)
+			}
+		})
+	}
+}
@@ -165,7 +165,7 @@ func TestDebugComposeLoadError(t *testing.T) {
 			_, loadErr := loader.LoadProject(ctx)
 			if loadErr != nil {
 				term.Error("Cannot load project:", loadErr)
-				project, err := loader.CreateProjectForDebug()
+				project, err := loader.CreateProjectForDebug(t.Context())
 				assert.NoError(t, err, "CreateProjectForDebug should not return an error")
 
 				err = debugger.DebugComposeLoadError(ctx, DebugConfig{
@@ -1,6 +1,11 @@
 package dns
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
+
+var validDomainRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
 
 func SafeLabel(fqn string) string {
 	return strings.ReplaceAll(strings.ToLower(fqn), ".", "-")
@@ -9,3 +14,45 @@ func SafeLabel(fqn string) string {
 func Normalize(domain string) string {
 	return strings.ToLower(strings.TrimSuffix(domain, "."))
 }
+
+// IsValidDomain returns true if the domain is a fully qualified domain name, without wildcards.
+func IsValidDomain(domain string) bool {
+	domain = Normalize(domain)
+	return len(domain) <= 253 && validDomainRegex.MatchString(domain)
+}
+
+// CAAIssuer returns the CA domain of a CAA record like `0 issue "letsencrypt.org"`, if it's an "issue" record.
+func CAAIssuer(record string) (string, bool) {
+	fields := strings.SplitN(strings.TrimSpace(record), " ", 3)
+	if len(fields) != 3 || !strings.EqualFold(fields[1], "issue") {
+		return "", false
+	}
+	issuer, _, _ := strings.Cut(strings.Trim(strings.TrimSpace(fields[2]), `"`), ";") // drop any parameters
+	return strings.ToLower(strings.TrimSpace(issuer)), true
+}
+
+// CAAAuthorizes returns true if the CAA records of a domain authorize the CA to issue certificates, which is also the
+// case if none of them restrict the CAs that can issue certificates.
+func CAAAuthorizes(records []string, issuer string) bool {
+	restricted := false
+	for _, record := range records {
+		if ca, ok := CAAIssuer(record); ok {
+			if ca == issuer {
+				return true
+			}
+			restricted = true
+		}
+	}
+	return !restricted
+}
+
+// CAAIssueRecord returns the CAA record that authorizes the CA to issue certificates, like `0 issue "letsencrypt.org"`.
+func CAAIssueRecord(issuer string) string {
+	return `0 issue "` + issuer + `"`
+}
+
+// Parent returns the parent domain, or "" for a top-level domain.
+func Parent(domain string) string {
+	_, parent, _ := strings.Cut(Normalize(domain), ".")
+	return parent
+}
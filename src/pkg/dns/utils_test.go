@@ -37,3 +37,66 @@ func TestNormalize(t *testing.T) {
 		}
 	}
 }
+
+func TestIsValidDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{"example.com", true},
+		{"www.Example.com.", true},
+		{"my-app.example.co.uk", true},
+		{"xn--bcher-kva.example", true},
+		{"localhost", false},
+		{"*.example.com", false},
+		{"-app.example.com", false},
+		{"app-.example.com", false},
+		{"app..example.com", false},
+		{"example.123", false},
+		{"https://example.com", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			if got := IsValidDomain(tt.domain); got != tt.want {
+				t.Errorf("IsValidDomain(%q) = %v, want %v", tt.domain, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCAAIssuer(t *testing.T) {
+	tests := []struct {
+		record string
+		issuer string
+		ok     bool
+	}{
+		{`0 issue "letsencrypt.org"`, "letsencrypt.org", true},
+		{`0 issue "amazon.com; cansignhttpexchanges=yes"`, "amazon.com", true},
+		{`128 ISSUE "DigiCert.com"`, "digicert.com", true},
+		{`0 issue ";"`, "", true}, // no CA is authorized
+		{`0 issuewild "letsencrypt.org"`, "", false},
+		{`0 iodef "mailto:security@example.com"`, "", false},
+	}
+	for _, tt := range tests {
+		issuer, ok := CAAIssuer(tt.record)
+		if issuer != tt.issuer || ok != tt.ok {
+			t.Errorf("CAAIssuer(%q) = %q, %v; expected %q, %v", tt.record, issuer, ok, tt.issuer, tt.ok)
+		}
+	}
+}
+
+func TestCAAAuthorizes(t *testing.T) {
+	if !CAAAuthorizes(nil, "letsencrypt.org") {
+		t.Error("expected no records to authorize any CA")
+	}
+	if !CAAAuthorizes([]string{`0 iodef "mailto:security@example.com"`}, "letsencrypt.org") {
+		t.Error("expected records without issue tags to authorize any CA")
+	}
+	if CAAAuthorizes([]string{`0 issue "digicert.com"`}, "letsencrypt.org") {
+		t.Error("expected another CA to not authorize Let's Encrypt")
+	}
+	if !CAAAuthorizes([]string{`0 issue "digicert.com"`, `0 issue "letsencrypt.org"`}, "letsencrypt.org") {
+		t.Error("expected Let's Encrypt to be authorized")
+	}
+}
@@ -2,8 +2,4 @@ package dryrun
 
 import "errors"
 
-var (
-	DoDryRun = false
-
-	ErrDryRun = errors.New("dry run")
-)
+var ErrDryRun = errors.New("dry run")
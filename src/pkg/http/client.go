@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 )
 
-var DefaultClient = newClient().StandardClient()
+var retryClient = newClient()
+
+var DefaultClient = retryClient.StandardClient()
 
 type Header = http.Header
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
 )
 
 // Put issues a PUT to the specified URL.
@@ -25,3 +27,17 @@ func Put(ctx context.Context, url string, contentType string, body io.Reader) (*
 	req.Header.Set("Content-Type", contentType)
 	return DefaultClient.Do(req)
 }
+
+// PutStream issues a PUT of size bytes to the specified URL. Unlike Put, the
+// body is not buffered in memory for retries: body is called to get a new
+// reader for each attempt, so it can stream content of any size. If the
+// reader is an io.Closer, it is closed after the attempt.
+func PutStream(ctx context.Context, url string, contentType string, size int64, body func() (io.Reader, error)) (*http.Response, error) {
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPut, url, retryablehttp.ReaderFunc(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size // presigned URLs don't accept chunked transfer encoding
+	req.Header.Set("Content-Type", contentType)
+	return retryClient.Do(req)
+}
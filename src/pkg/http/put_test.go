@@ -34,3 +34,39 @@ func TestPutRetries(t *testing.T) {
 		t.Errorf("expected 3 calls, got %d", calls)
 	}
 }
+
+func TestPutStreamRetries(t *testing.T) {
+	const body = "test"
+	calls, opened := 0, 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.ContentLength != int64(len(body)) {
+			t.Errorf("expected Content-Length %d, got %d", len(body), r.ContentLength)
+		}
+		if b, err := io.ReadAll(r.Body); err != nil || string(b) != body {
+			t.Errorf("expected body %q, got %q", body, b)
+		}
+		if calls < 3 {
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	resp, err := PutStream(t.Context(), server.URL, "text/plain", int64(len(body)), func() (io.Reader, error) {
+		opened++
+		return io.MultiReader(strings.NewReader(body[:2]), strings.NewReader(body[2:])), nil // not seekable
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+	if opened < calls {
+		t.Errorf("expected the body to be opened for each of the %d calls, got %d", calls, opened)
+	}
+}
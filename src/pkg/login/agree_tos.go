@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/DefangLabs/defang/src/pkg/cli"
 	"github.com/DefangLabs/defang/src/pkg/cli/client"
 	"github.com/DefangLabs/defang/src/pkg/dryrun"
 	"github.com/DefangLabs/defang/src/pkg/term"
@@ -44,7 +45,7 @@ func InteractiveAgreeToS(ctx context.Context, fabric client.FabricClient) error
 }
 
 func NonInteractiveAgreeToS(ctx context.Context, fabric client.FabricClient) error {
-	if dryrun.DoDryRun {
+	if cli.OptionsFromContext(ctx).DryRun {
 		return dryrun.ErrDryRun
 	}
 
@@ -58,7 +58,7 @@ func interactiveLogin(ctx context.Context, cluster string, flow LoginFlow, mcpCl
 	// host := client.NormalizeHost(cluster)
 	// term.Info("Successfully logged in to", host, "("+tenant.String()+" tenant)")
 
-	if dryrun.DoDryRun {
+	if cli.OptionsFromContext(ctx).DryRun {
 		return dryrun.ErrDryRun
 	}
 
@@ -0,0 +1,43 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+)
+
+type LogFormat string
+
+const (
+	LogFormatPlain  LogFormat = "plain"
+	LogFormatRaw    LogFormat = "raw"
+	LogFormatJSON   LogFormat = "json"
+	LogFormatLogfmt LogFormat = "logfmt"
+)
+
+var AllLogFormats = []LogFormat{
+	LogFormatPlain,
+	LogFormatRaw,
+	LogFormatJSON,
+	LogFormatLogfmt,
+}
+
+func (f LogFormat) String() string {
+	if f == "" {
+		return string(LogFormatPlain)
+	}
+	return string(f)
+}
+
+func (f *LogFormat) Set(value string) error {
+	for _, format := range AllLogFormats {
+		if strings.EqualFold(value, string(format)) {
+			*f = format
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid log format: %q, must be one of %v", value, AllLogFormats)
+}
+
+func (f LogFormat) Type() string {
+	return "log-format"
+}
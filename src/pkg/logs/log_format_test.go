@@ -0,0 +1,16 @@
+package logs
+
+import "testing"
+
+func TestLogFormatSet(t *testing.T) {
+	var format LogFormat
+	if format.String() != "plain" {
+		t.Errorf("expected plain by default, got %q", format)
+	}
+	if err := format.Set("LOGFMT"); err != nil || format != LogFormatLogfmt {
+		t.Errorf("Set() = %v, %v; want logfmt", format, err)
+	}
+	if err := format.Set("yaml"); err == nil {
+		t.Error("expected an error for an invalid format")
+	}
+}
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// LogLevel is the severity of a log entry; the empty level shows all entries.
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "debug"
+	LogLevelInfo  LogLevel = "info"
+	LogLevelWarn  LogLevel = "warn"
+	LogLevelError LogLevel = "error"
+)
+
+var AllLogLevels = []LogLevel{
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelWarn,
+	LogLevelError,
+}
+
+func (l LogLevel) String() string {
+	return string(l)
+}
+
+func (l *LogLevel) Set(value string) error {
+	if level, ok := parseLevelName(value); ok {
+		*l = level
+		return nil
+	}
+	return fmt.Errorf("invalid log level: %q, must be one of %v", value, AllLogLevels)
+}
+
+func (l LogLevel) Type() string {
+	return "log-level"
+}
+
+// Includes reports whether an entry of the given level is shown when filtering by this minimum level.
+func (l LogLevel) Includes(level LogLevel) bool {
+	return slices.Index(AllLogLevels, level) >= slices.Index(AllLogLevels, l)
+}
+
+func parseLevelName(name string) (LogLevel, bool) {
+	switch strings.ToLower(name) {
+	case "trace", "debug", "dbg":
+		return LogLevelDebug, true
+	case "info", "inf", "notice":
+		return LogLevelInfo, true
+	case "warn", "warning", "wrn":
+		return LogLevelWarn, true
+	case "error", "err", "fatal", "critical", "crit", "panic", "alert", "emergency":
+		return LogLevelError, true
+	}
+	return "", false
+}
+
+// levelRegex matches a level near the start of a text log line, like "ERROR ...", "[warn] ..." or "level=info".
+var levelRegex = regexp.MustCompile(`^(?:\S+\s+){0,2}?[\[(<]?(?:level=|lvl=)?"?(?i:(trace|debug|dbg|info|inf|notice|warn|warning|wrn|error|err|fatal|critical|crit|panic))\b`)
+
+// DetectLogLevel returns the level of a log message, from the "level" or "severity" of a JSON message, or from a
+// level near the start of a text message. Messages without a level are errors on stderr and info otherwise.
+func DetectLogLevel(message string, stderr bool) LogLevel {
+	if strings.HasPrefix(message, "{") {
+		var fields map[string]any
+		if json.Unmarshal([]byte(message), &fields) == nil {
+			for _, key := range []string{"level", "severity", "lvl", "log.level"} {
+				if name, ok := fields[key].(string); ok {
+					if level, ok := parseLevelName(name); ok {
+						return level
+					}
+				}
+			}
+		}
+	} else if match := levelRegex.FindStringSubmatch(message); match != nil {
+		level, _ := parseLevelName(match[1])
+		return level
+	}
+	if stderr {
+		return LogLevelError
+	}
+	return LogLevelInfo
+}
@@ -0,0 +1,47 @@
+package logs
+
+import "testing"
+
+func TestDetectLogLevel(t *testing.T) {
+	tests := []struct {
+		message string
+		stderr  bool
+		want    LogLevel
+	}{
+		{"Server started", false, LogLevelInfo},
+		{"Traceback (most recent call last):", true, LogLevelError},
+		{"DEBUG connecting to db", false, LogLevelDebug},
+		{"[warn] disk almost full", false, LogLevelWarn},
+		{"2024-01-02T03:04:05Z WARNING: deprecated", false, LogLevelWarn},
+		{"2024-01-02 03:04:05 ERROR failed", false, LogLevelError},
+		{`time=2024-01-02T03:04:05Z level=info msg="ok"`, true, LogLevelInfo},
+		{`{"level":"warn","msg":"slow"}`, false, LogLevelWarn},
+		{`{"severity":"ERROR","message":"failed"}`, false, LogLevelError},
+		{`{"msg":"no level"}`, true, LogLevelError},
+		{"GET /errors 200", false, LogLevelInfo},
+		{"request panicked", false, LogLevelInfo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if got := DetectLogLevel(tt.message, tt.stderr); got != tt.want {
+				t.Errorf("DetectLogLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	var level LogLevel
+	if err := level.Set("WARNING"); err != nil || level != LogLevelWarn {
+		t.Errorf("Set() = %v, %v; want warn", level, err)
+	}
+	if err := level.Set("verbose"); err == nil {
+		t.Error("expected an error for an invalid level")
+	}
+	if !level.Includes(LogLevelError) || !level.Includes(LogLevelWarn) || level.Includes(LogLevelInfo) {
+		t.Error("expected warn to include only warn and error")
+	}
+	if !LogLevel("").Includes(LogLevelDebug) {
+		t.Error("expected no level to include everything")
+	}
+}
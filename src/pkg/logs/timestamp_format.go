@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type TimestampFormat string
+
+const (
+	TimestampRFC3339  TimestampFormat = "rfc3339"
+	TimestampRelative TimestampFormat = "relative"
+	TimestampNone     TimestampFormat = "none"
+)
+
+var AllTimestampFormats = []TimestampFormat{
+	TimestampRFC3339,
+	TimestampRelative,
+	TimestampNone,
+}
+
+func (f TimestampFormat) String() string {
+	if f == "" {
+		return string(TimestampRFC3339)
+	}
+	return string(f)
+}
+
+func (f *TimestampFormat) Set(value string) error {
+	for _, format := range AllTimestampFormats {
+		if strings.EqualFold(value, string(format)) {
+			*f = format
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid timestamp format: %q, must be one of %v", value, AllTimestampFormats)
+}
+
+func (f TimestampFormat) Type() string {
+	return "timestamp-format"
+}
+
+// ParseTimeZone returns the location for "local", "UTC", an IANA time zone name like "Europe/Amsterdam",
+// or a fixed offset like "+09:00".
+func ParseTimeZone(tz string) (*time.Location, error) {
+	switch strings.ToLower(tz) {
+	case "", "local":
+		return time.Local, nil
+	case "utc", "z":
+		return time.UTC, nil
+	}
+	if offset, err := time.Parse("-07:00", tz); err == nil {
+		_, seconds := offset.Zone()
+		return time.FixedZone(tz, seconds), nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: use local, UTC, a name like America/New_York, or an offset like +09:00", tz)
+	}
+	return loc, nil
+}
+
+// FormatRelative returns the age of the timestamp, like "1m30s ago", rounded to the second.
+func FormatRelative(ts, now time.Time) string {
+	age := now.Sub(ts).Round(time.Second)
+	if age < 0 {
+		return "in " + (-age).String()
+	}
+	return age.String() + " ago"
+}
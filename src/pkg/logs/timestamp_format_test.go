@@ -0,0 +1,78 @@
+package logs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimestampFormatSet(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    TimestampFormat
+		wantErr bool
+	}{
+		{"rfc3339", TimestampRFC3339, false},
+		{"RELATIVE", TimestampRelative, false},
+		{"none", TimestampNone, false},
+		{"unix", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			var got TimestampFormat
+			err := got.Set(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Set() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeZone(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		tz      string
+		want    string
+		wantErr bool
+	}{
+		{"UTC", "2025-01-02T03:04:05Z", false},
+		{"+09:00", "2025-01-02T12:04:05+09:00", false},
+		{"-03:30", "2025-01-01T23:34:05-03:30", false},
+		{"America/New_York", "2025-01-01T22:04:05-05:00", false},
+		{"Mars/Olympus_Mons", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tz, func(t *testing.T) {
+			loc, err := ParseTimeZone(tt.tz)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTimeZone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := ts.In(loc).Format(time.RFC3339); got != tt.want {
+				t.Errorf("ParseTimeZone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		ts   time.Time
+		want string
+	}{
+		{now, "0s ago"},
+		{now.Add(-1500 * time.Millisecond), "2s ago"},
+		{now.Add(-90 * time.Minute), "1h30m0s ago"},
+		{now.Add(time.Minute), "in 1m0s"},
+	}
+	for _, tt := range tests {
+		if got := FormatRelative(tt.ts, now); got != tt.want {
+			t.Errorf("FormatRelative(%v) = %q, want %q", tt.ts, got, tt.want)
+		}
+	}
+}
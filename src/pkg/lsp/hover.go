@@ -0,0 +1,87 @@
+package lsp
+
+import "go.yaml.in/yaml/v4"
+
+// hoverDocs documents the Defang extensions and the Compose directives that have Defang-specific constraints
+var hoverDocs = map[string]string{
+	// Project extensions
+	"x-defang-long-names": "How to handle project and service names that are too long for cloud resources: `warn` (default), `error`, or `truncate`, which shortens them with a hash suffix.",
+	"x-defang-network":    "Deploy into an existing network instead of a new default VPC: `{vpc: string, private_subnets: string[], security_groups: string[]}`.",
+
+	// Service extensions
+	"x-defang-autoscaling":     "Scale the service based on load. Either `true`, or `{min_replicas, max_replicas, cpu_percent, requests_per_second, queue_depth}`; omitted fields use the provider defaults.",
+	"x-defang-backup":          "Back up the volumes or managed store of the service on a schedule. Either `true`, or `{schedule, retention_days}`; the schedule is `hourly`, `daily`, `weekly`, or a cron expression.",
+	"x-defang-config-revision": "Set by `defang config set --apply` and `defang compose refresh-env` to restart the service with updated config values.",
+	"x-defang-bucket":          "Provision a managed object storage bucket instead of running a container; the service cannot have `build` or `ports`.",
+	"x-defang-dns-role":        "The IAM role to assume for managing DNS records in another account, as a string.",
+	"x-defang-gpu":             "The GPU class for the service, eg. `t4` or `a100`; requires a GPU device reservation under `deploy.resources.reservations.devices`.",
+	"x-defang-iam-role":        "An existing AWS IAM role ARN or GCP service account email for the service to run as.",
+	"x-defang-ignore":          "Set to `true` to exclude a local-only service, like a mail catcher, from deployment. Services that depend on it are warned about.",
+	"x-defang-ingress":         "Ingress options for services with public ports, eg. `https_redirect`, `hsts`, `paths`, `domains`, `sticky`, `idle_timeout`, `request_timeout`, `cors`, `auth`, `allow_ips`, `deny_ips` and `rate_limit`.",
+	"x-defang-instance-type":   "The instance type to run the service on, eg. `m5.large`; must be available in the provider's catalog.",
+	"x-defang-llm":             "Mark the service as an LLM, so it is granted access to the provider's managed models.",
+	"x-defang-maintenance":     "Set by `defang maintenance on` to serve a maintenance page at the ingress instead of the service: `true`, or `{message}`; remove it with `defang maintenance off`.",
+	"x-defang-mongodb":         "Use a managed MongoDB-compatible database instead of running the image.",
+	"x-defang-paused":          "Set by `defang pause` to remember the scaling settings of a paused service; remove it with `defang resume`.",
+	"x-defang-postgres":        "Use a managed Postgres database instead of running the image.",
+	"x-defang-redis":           "Use a managed Redis-compatible cache instead of running the image.",
+	"x-defang-spot":            "Set to `true` to run on spot/preemptible capacity, or `false` to opt out; not allowed for stateful services with a single replica.",
+	"x-defang-static-files":    "Serve static files from a folder instead of running a container: a folder, or `{folder, build, spa, redirects}`.",
+
+	// Compose directives
+	"cap_add":          "Only the default capabilities and `SYS_PTRACE` can be added; `ALL` is not supported.",
+	"memswap_limit":    "Swap is not supported; this is ignored unless equal to the memory limit.",
+	"oom_kill_disable": "Not supported; the service is restarted when it runs out of memory.",
+	"oom_score_adj":    "Must be between -1000 and 1000; negative values are ignored.",
+	"pids_limit":       "The maximum number of processes in the container; -1 for unlimited. Must match `deploy.resources.limits.pids` if both are set.",
+	"privileged":       "Not supported; privileged containers cannot be deployed.",
+	"security_opt":     "Only `no-new-privileges` is supported.",
+	"shm_size":         "The size of `/dev/shm`; counts against the memory reservation of the service.",
+	"tmpfs":            "In-memory mounts; the sizes count against the memory reservation of the service. Targets must be absolute paths.",
+}
+
+// keyAt returns the mapping key at the given position in the YAML document, or nil if there is none.
+func keyAt(content []byte, pos Position) *yaml.Node {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+	return findKey(&doc, pos.Line+1, pos.Character+1)
+}
+
+func findKey(node *yaml.Node, line, column int) *yaml.Node {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Line == line && column >= key.Column && column < key.Column+len(key.Value) {
+				return key
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if key := findKey(child, line, column); key != nil {
+			return key
+		}
+	}
+	return nil
+}
+
+func (s *Server) hover(params *TextDocumentPositionParams) *Hover {
+	content, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	key := keyAt(content, params.Position)
+	if key == nil {
+		return nil
+	}
+	doc, ok := hoverDocs[key.Value]
+	if !ok {
+		return nil
+	}
+	start := Position{Line: key.Line - 1, Character: key.Column - 1}
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: "**" + key.Value + "**\n\n" + doc},
+		Range:    &Range{Start: start, End: Position{Line: start.Line, Character: start.Character + len(key.Value)}},
+	}
+}
@@ -0,0 +1,255 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DefangLabs/defang/src/pkg/cli"
+	"github.com/DefangLabs/defang/src/pkg/cli/client"
+	"github.com/DefangLabs/defang/src/pkg/cli/compose"
+	"github.com/DefangLabs/defang/src/pkg/modes"
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+var ErrExitWithoutShutdown = errors.New("exit notification received before shutdown request")
+
+// Server is a language server for Compose files. It loads and validates each open document the same way as
+// "defang compose config" and publishes the warnings and errors as diagnostics. Messages are handled one at a time.
+type Server struct {
+	version   string
+	in        *bufio.Reader
+	out       io.Writer
+	documents map[string][]byte // by URI
+
+	initialized bool
+	shutdown    bool
+}
+
+func NewServer(in io.Reader, out io.Writer, version string) *Server {
+	return &Server{
+		version:   version,
+		in:        bufio.NewReader(in),
+		out:       out,
+		documents: make(map[string][]byte),
+	}
+}
+
+// Serve handles messages until the client sends the exit notification or closes the input.
+func (s *Server) Serve(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := readMessage(s.in)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if rerr := new(responseError); errors.As(err, &rerr) {
+			if err := writeMessage(s.out, &message{Error: rerr}); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return ErrExitWithoutShutdown
+			}
+			return nil
+		}
+
+		result, err := s.handle(ctx, msg)
+		if msg.ID == nil {
+			if err != nil {
+				cli.OptionsFromContext(ctx).Term.Debugf("lsp: %s: %v", msg.Method, err)
+			}
+			continue // notifications don't get a response
+		}
+		response := &message{ID: msg.ID, Result: result}
+		if err != nil {
+			if rerr := new(responseError); errors.As(err, &rerr) {
+				response.Error = rerr
+			} else {
+				response.Error = &responseError{Code: codeInvalidRequest, Message: err.Error()}
+			}
+			response.Result = nil
+		} else if result == nil {
+			response.Result = json.RawMessage("null") // a response must have either a result or an error
+		}
+		if err := writeMessage(s.out, response); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, msg *message) (any, error) {
+	if !s.initialized && msg.Method != "initialize" {
+		return nil, &responseError{Code: codeServerNotInitialized, Message: "server not initialized"}
+	}
+
+	switch msg.Method {
+	case "initialize":
+		s.initialized = true
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: TextDocumentSyncOptions{OpenClose: true, Change: 1, Save: true},
+				HoverProvider:    true,
+			},
+			ServerInfo: ServerInfo{Name: "defang", Version: s.version},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(ctx, params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// With full sync, the last change has the complete text of the document
+		return nil, s.update(ctx, params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case "textDocument/didSave":
+		var params DidSaveTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		text := string(s.documents[params.TextDocument.URI])
+		if params.Text != nil {
+			text = *params.Text
+		}
+		// Validate again, since other files like the Dockerfile or .env might have changed too
+		return nil, s.update(ctx, params.TextDocument.URI, text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		delete(s.documents, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		if hover := s.hover(&params); hover != nil {
+			return hover, nil
+		}
+		return nil, nil
+	default:
+		if msg.ID == nil {
+			return nil, nil // notifications like "$/cancelRequest" can be ignored
+		}
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+}
+
+func unmarshalParams(msg *message, params any) error {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) notify(method string, params any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.out, &message{Method: method, Params: body})
+}
+
+func (s *Server) update(ctx context.Context, uri, text string) error {
+	content := []byte(text)
+	s.documents[uri] = content
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: uri, Diagnostics: s.diagnose(ctx, uri, content)})
+}
+
+// diagnose loads and validates the document, and returns the warnings and errors as diagnostics.
+func (s *Server) diagnose(ctx context.Context, uri string, content []byte) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	add := func(severity compose.DiagnosticSeverity, msg string) {
+		diagnostics = append(diagnostics, toDiagnostic(compose.DiagnoseContent(severity, msg, content), content))
+	}
+
+	path, err := uriToPath(uri)
+	if err != nil {
+		add(compose.DiagnosticError, err.Error())
+		return diagnostics
+	}
+
+	// Capture the warnings instead of printing them; they would accumulate in the server's terminal otherwise
+	captureTerm := term.NewTerm(os.Stdin, io.Discard, io.Discard)
+	captureTerm.SetWarningHook(func(msg string) { add(compose.DiagnosticWarning, msg) })
+	ctx = compose.WithTerm(ctx, captureTerm)
+
+	project, err := compose.LoadFromBuffer(ctx, path, content)
+	if err == nil {
+		err = compose.ValidateProject(ctx, project, modes.ModeUnspecified, client.ProviderAuto)
+	}
+	if err != nil {
+		for line := range strings.Lines(err.Error()) {
+			if line = strings.TrimSpace(line); line != "" {
+				add(compose.DiagnosticError, line)
+			}
+		}
+	}
+	return diagnostics
+}
+
+// toDiagnostic converts the diagnostic, highlighting the whole line it refers to, or the first line if unknown.
+func toDiagnostic(diag compose.Diagnostic, content []byte) Diagnostic {
+	line := max(diag.Line-1, 0)
+	var text string
+	for i, l := range strings.Split(string(content), "\n") {
+		if i == line {
+			text = strings.TrimRight(l, "\r")
+			break
+		}
+	}
+	indent := len(text) - len(strings.TrimLeft(text, " \t"))
+	severity := SeverityWarning
+	if diag.Severity == compose.DiagnosticError {
+		severity = SeverityError
+	}
+	return Diagnostic{
+		Range: Range{
+			Start: Position{Line: line, Character: indent},
+			End:   Position{Line: line, Character: utf8.RuneCountInString(text)},
+		},
+		Severity: severity,
+		Code:     diag.Code,
+		Source:   "defang",
+		Message:  diag.Message,
+	}
+}
+
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", errors.New("only file:// documents are supported, got " + uri)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
@@ -0,0 +1,151 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCompose = `services:
+  app:
+    image: nginx
+    privileged: true
+  worker:
+    image: alpine
+    x-defang-unknown: true
+`
+
+func request(t *testing.T, w io.Writer, id int, method string, params any) {
+	t.Helper()
+	msg := &message{Method: method}
+	if id != 0 {
+		rawID := json.RawMessage(strings.TrimSpace(string(mustMarshal(t, id))))
+		msg.ID = &rawID
+	}
+	if params != nil {
+		msg.Params = mustMarshal(t, params)
+	}
+	if err := writeMessage(w, msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func serve(t *testing.T, input *bytes.Buffer) []*message {
+	t.Helper()
+	var output bytes.Buffer
+	if err := NewServer(input, &output, "test").Serve(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	var messages []*message
+	r := bufio.NewReader(&output)
+	for {
+		msg, err := readMessage(r)
+		if err == io.EOF {
+			return messages
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages = append(messages, msg)
+	}
+}
+
+func TestServer(t *testing.T) {
+	uri := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "compose.yaml"))
+
+	var input bytes.Buffer
+	request(t, &input, 1, "initialize", map[string]any{})
+	request(t, &input, 0, "initialized", map[string]any{})
+	request(t, &input, 0, "textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: uri, LanguageID: "yaml", Text: testCompose}})
+	request(t, &input, 2, "textDocument/hover", TextDocumentPositionParams{TextDocument: TextDocumentIdentifier{URI: uri}, Position: Position{Line: 3, Character: 6}})
+	request(t, &input, 3, "textDocument/hover", TextDocumentPositionParams{TextDocument: TextDocumentIdentifier{URI: uri}, Position: Position{Line: 2, Character: 6}})
+	request(t, &input, 4, "textDocument/unknown", map[string]any{})
+	request(t, &input, 5, "shutdown", nil)
+	request(t, &input, 0, "exit", nil)
+
+	messages := serve(t, &input)
+	if len(messages) != 6 {
+		t.Fatalf("expected 6 messages, got %d", len(messages))
+	}
+
+	t.Run("initialize", func(t *testing.T) {
+		var result InitializeResult
+		if err := json.Unmarshal(mustMarshal(t, messages[0].Result), &result); err != nil {
+			t.Fatal(err)
+		}
+		if !result.Capabilities.HoverProvider || result.Capabilities.TextDocumentSync.Change != 1 {
+			t.Errorf("unexpected capabilities: %+v", result.Capabilities)
+		}
+	})
+
+	t.Run("diagnostics", func(t *testing.T) {
+		if messages[1].Method != "textDocument/publishDiagnostics" {
+			t.Fatalf("expected publishDiagnostics, got %q", messages[1].Method)
+		}
+		var params PublishDiagnosticsParams
+		if err := json.Unmarshal(messages[1].Params, &params); err != nil {
+			t.Fatal(err)
+		}
+		if params.URI != uri {
+			t.Errorf("expected URI %q, got %q", uri, params.URI)
+		}
+		var foundError, foundWarning bool
+		for _, diag := range params.Diagnostics {
+			switch {
+			case diag.Severity == SeverityError && strings.Contains(diag.Message, "privileged"):
+				foundError = true
+				if diag.Range.Start.Line != 1 {
+					t.Errorf("expected error on line 1 (service app), got %d", diag.Range.Start.Line)
+				}
+			case diag.Severity == SeverityWarning && diag.Code == "DFG1004":
+				foundWarning = true
+				if diag.Range.Start.Line != 4 {
+					t.Errorf("expected warning on line 4 (service worker), got %d", diag.Range.Start.Line)
+				}
+			}
+		}
+		if !foundError || !foundWarning {
+			t.Errorf("expected an error for privileged and a warning for x-defang-unknown, got %+v", params.Diagnostics)
+		}
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		var hover Hover
+		if err := json.Unmarshal(mustMarshal(t, messages[2].Result), &hover); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(hover.Contents.Value, "privileged") || hover.Range == nil || hover.Range.Start.Character != 4 {
+			t.Errorf("unexpected hover: %+v", hover)
+		}
+		if string(mustMarshal(t, messages[3].Result)) != "null" {
+			t.Errorf("expected no hover for image, got %v", messages[3].Result)
+		}
+	})
+
+	t.Run("method not found", func(t *testing.T) {
+		if messages[4].Error == nil || messages[4].Error.Code != codeMethodNotFound {
+			t.Errorf("expected method not found, got %+v", messages[4])
+		}
+	})
+}
+
+func TestServerExitWithoutShutdown(t *testing.T) {
+	var input, output bytes.Buffer
+	request(t, &input, 0, "exit", nil)
+	if err := NewServer(&input, &output, "test").Serve(t.Context()); err != ErrExitWithoutShutdown {
+		t.Errorf("expected ErrExitWithoutShutdown, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package progress
+
+import (
+	"slices"
+	"sync"
+)
+
+// dependencyReporter holds back the healthy phase of a service until the services it depends on are healthy too.
+type dependencyReporter struct {
+	reporter     Reporter
+	dependencies map[string][]string
+
+	mu      sync.Mutex
+	healthy map[string]bool
+	held    []string
+}
+
+// WithDependencies returns a reporter that only reports a service as healthy once all its dependencies, like the
+// depends_on with condition service_healthy, are healthy; until then the service stays in the deploying phase.
+func WithDependencies(reporter Reporter, dependencies map[string][]string) Reporter {
+	if len(dependencies) == 0 {
+		return reporter
+	}
+	return &dependencyReporter{
+		reporter:     reporter,
+		dependencies: dependencies,
+		healthy:      make(map[string]bool),
+	}
+}
+
+func (r *dependencyReporter) SetPhase(service string, phase Phase) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.healthy[service] = phase == PhaseHealthy
+	if phase != PhaseHealthy {
+		r.held = slices.DeleteFunc(r.held, func(s string) bool { return s == service })
+		r.reporter.SetPhase(service, phase)
+		return
+	}
+	if !r.ready(service) {
+		if !slices.Contains(r.held, service) {
+			r.held = append(r.held, service)
+		}
+		return
+	}
+	r.reporter.SetPhase(service, phase)
+
+	// Release the services that were only waiting for this one; repeat for dependency chains
+	for released := true; released; {
+		released = false
+		for i, held := range r.held {
+			if r.ready(held) {
+				r.held = slices.Delete(r.held, i, i+1)
+				r.reporter.SetPhase(held, PhaseHealthy)
+				released = true
+				break
+			}
+		}
+	}
+}
+
+func (r *dependencyReporter) ready(service string) bool {
+	for _, dep := range r.dependencies[service] {
+		if !r.healthy[dep] || slices.Contains(r.held, dep) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package progress
+
+import "testing"
+
+func TestWithDependencies(t *testing.T) {
+	r := &recorder{phases: map[string]Phase{}}
+	// app needs api, which needs db
+	reporter := WithDependencies(r, map[string][]string{"app": {"api"}, "api": {"db"}})
+
+	reporter.SetPhase("app", PhaseDeploying)
+	reporter.SetPhase("app", PhaseHealthy)
+	if r.phases["app"] != PhaseDeploying {
+		t.Errorf("expected app to be held in %v, got %v", PhaseDeploying, r.phases["app"])
+	}
+	reporter.SetPhase("api", PhaseHealthy)
+	if _, ok := r.phases["api"]; ok {
+		t.Errorf("expected api to be held, got %v", r.phases["api"])
+	}
+
+	reporter.SetPhase("db", PhaseHealthy)
+	for _, service := range []string{"db", "api", "app"} {
+		if r.phases[service] != PhaseHealthy {
+			t.Errorf("expected %s to be %v, got %v", service, PhaseHealthy, r.phases[service])
+		}
+	}
+
+	reporter.SetPhase("db", PhaseFailed)
+	if r.phases["db"] != PhaseFailed {
+		t.Errorf("expected db to be %v, got %v", PhaseFailed, r.phases["db"])
+	}
+}
+
+func TestWithoutDependencies(t *testing.T) {
+	r := &recorder{phases: map[string]Phase{}}
+	if reporter := WithDependencies(r, nil); reporter != r {
+		t.Error("expected the reporter itself without dependencies")
+	}
+}
@@ -0,0 +1,126 @@
+package progress
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/muesli/termenv"
+)
+
+// Display shows the phase of each service. On a terminal it keeps a block of lines that is updated in place;
+// otherwise, like in CI logs, it prints a timestamped line for each transition.
+type Display struct {
+	mu       sync.Mutex
+	term     *term.Term
+	inPlace  bool
+	services []string
+	phases   map[string]Phase
+	uploads  map[string]*transfer
+	rendered int // number of lines of the block that was last printed
+	closed   bool
+	now      func() time.Time
+}
+
+func NewDisplay(t *term.Term, services []string, inPlace bool) *Display {
+	services = slices.Clone(services)
+	slices.Sort(services)
+	phases := make(map[string]Phase, len(services))
+	for _, service := range services {
+		phases[service] = PhasePending
+	}
+	return &Display{
+		term:     t,
+		inPlace:  inPlace,
+		services: services,
+		phases:   phases,
+		uploads:  make(map[string]*transfer),
+		now:      time.Now,
+	}
+}
+
+// SetInPlace switches between in-place updates and one line per transition. Use the latter when other output,
+// like logs, is printed at the same time, because that would get overwritten.
+func (d *Display) SetInPlace(inPlace bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inPlace = inPlace
+	d.rendered = 0 // the next block is printed below whatever was printed in between
+}
+
+func (d *Display) SetPhase(service string, phase Phase) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	prev, ok := d.phases[service]
+	if !ok {
+		d.services = append(d.services, service)
+		slices.Sort(d.services)
+	} else if prev == phase || prev == PhaseFailed || (phase < prev && phase != PhaseFailed) {
+		return // no change, or an out-of-order update from the subscription
+	}
+	d.phases[service] = phase
+
+	if d.inPlace {
+		d.render()
+	} else {
+		d.term.Infof("%s %s %s", d.now().UTC().Format(time.RFC3339), service, phase)
+	}
+}
+
+// SetTransfer shows the progress of the upload of the service: as part of the block on a terminal, or as a line
+// now and then otherwise.
+func (d *Display) SetTransfer(service string, sent, total int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	t, ok := d.uploads[service]
+	if !ok {
+		t = &transfer{}
+		d.uploads[service] = t
+	}
+	now := d.now()
+	if d.inPlace {
+		if t.update(sent, total, now, transferRenderInterval) {
+			d.render()
+		}
+	} else if t.update(sent, total, now, transferLogInterval) {
+		d.term.Infof("%s %s %s %s", now.UTC().Format(time.RFC3339), service, PhaseUploading, t.format(now))
+	}
+}
+
+// Close stops the display; later transitions are ignored.
+func (d *Display) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closed = true
+}
+
+func (d *Display) render() {
+	width := 0
+	for _, service := range d.services {
+		width = max(width, len(service))
+	}
+
+	var buf strings.Builder
+	if d.rendered > 0 {
+		fmt.Fprintf(&buf, termenv.CSI+termenv.CursorUpSeq, d.rendered)
+	}
+	for _, service := range d.services {
+		buf.WriteString("\r" + termenv.CSI + termenv.EraseEntireLineSeq)
+		fmt.Fprintf(&buf, " * %-*s  %s", width, service, d.phases[service])
+		if t, ok := d.uploads[service]; ok && d.phases[service] == PhaseUploading {
+			buf.WriteString(" " + t.format(d.now()))
+		}
+		buf.WriteString("\n")
+	}
+	d.term.Print(buf.String())
+	d.rendered = len(d.services)
+}
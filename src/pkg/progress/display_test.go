@@ -0,0 +1,109 @@
+package progress
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+)
+
+func newTestDisplay(services []string, inPlace bool) (*Display, *bytes.Buffer) {
+	var stdout, stderr bytes.Buffer
+	d := NewDisplay(term.NewTerm(os.Stdin, &stdout, &stderr), services, inPlace)
+	d.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return d, &stdout
+}
+
+func TestDisplayTransitions(t *testing.T) {
+	d, stdout := newTestDisplay([]string{"web", "api"}, false)
+
+	d.SetPhase("api", PhaseCompressing)
+	d.SetPhase("api", PhaseCompressing) // no change
+	d.SetPhase("api", PhaseUploading)
+	d.SetPhase("web", PhaseDeploying)
+	d.SetPhase("api", PhaseBuilding)
+	d.SetPhase("web", PhaseBuilding) // out of order
+	d.SetPhase("web", PhaseHealthy)
+	d.SetPhase("api", PhaseFailed)
+	d.SetPhase("api", PhaseHealthy) // stays failed
+	d.Close()
+	d.SetPhase("web", PhaseFailed) // ignored after Close
+
+	expected := []string{
+		" * 2025-01-02T03:04:05Z api compressing",
+		" * 2025-01-02T03:04:05Z api uploading",
+		" * 2025-01-02T03:04:05Z web deploying",
+		" * 2025-01-02T03:04:05Z api building",
+		" * 2025-01-02T03:04:05Z web healthy",
+		" * 2025-01-02T03:04:05Z api failed",
+	}
+	actual := strings.Split(strings.TrimSuffix(term.StripAnsi(stdout.String()), "\n"), "\n")
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+}
+
+func TestDisplayInPlace(t *testing.T) {
+	d, stdout := newTestDisplay([]string{"web", "api"}, true)
+
+	d.SetPhase("api", PhaseUploading)
+	first := stdout.String()
+	if strings.Contains(first, "\033[2A") {
+		t.Error("first render should not move the cursor up")
+	}
+	if !strings.Contains(first, " * api  uploading\n") || !strings.Contains(first, " * web  pending\n") {
+		t.Errorf("unexpected first render: %q", first)
+	}
+
+	stdout.Reset()
+	d.SetPhase("web", PhaseDeploying)
+	if second := stdout.String(); !strings.HasPrefix(second, "\033[2A") || !strings.Contains(second, " * web  deploying\n") {
+		t.Errorf("expected the block to be redrawn in place, got %q", second)
+	}
+
+	stdout.Reset()
+	d.SetInPlace(true) // something else was printed; start a new block
+	d.SetPhase("worker", PhaseBuilding)
+	if third := stdout.String(); strings.Contains(third, "\033[2A") || strings.Contains(third, "\033[3A") {
+		t.Errorf("expected a new block after SetInPlace, got %q", third)
+	} else if !strings.Contains(third, " * worker  building\n") {
+		t.Errorf("expected the new service in the block, got %q", third)
+	}
+}
+
+func TestDisplayTransfer(t *testing.T) {
+	d, stdout := newTestDisplay([]string{"api"}, false)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	d.SetTransfer("api", 0, 40<<20)
+	now = now.Add(time.Second)
+	d.SetTransfer("api", 5<<20, 40<<20) // too soon for another line
+	now = now.Add(9 * time.Second)
+	d.SetTransfer("api", 10<<20, 40<<20)
+	d.SetTransfer("api", 40<<20, 40<<20) // done
+
+	expected := []string{
+		" * 2025-01-02T03:04:05Z api uploading 0B / 40MiB (0%)",
+		" * 2025-01-02T03:04:15Z api uploading 10MiB / 40MiB (25%), 1MiB/s, ETA 30s",
+		" * 2025-01-02T03:04:15Z api uploading 40MiB / 40MiB (100%)",
+	}
+	actual := strings.Split(strings.TrimSuffix(term.StripAnsi(stdout.String()), "\n"), "\n")
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+}
+
+func TestDisplayTransferInPlace(t *testing.T) {
+	d, stdout := newTestDisplay([]string{"api"}, true)
+
+	d.SetPhase("api", PhaseUploading)
+	stdout.Reset()
+	d.SetTransfer("api", 1<<20, 4<<20)
+	if out := stdout.String(); !strings.Contains(out, " * api  uploading 1MiB / 4MiB (25%)\n") {
+		t.Errorf("expected the upload progress in the block, got %q", out)
+	}
+}
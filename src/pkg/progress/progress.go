@@ -0,0 +1,94 @@
+package progress
+
+import (
+	"context"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+// Phase is the step a service is at during "compose up".
+type Phase int
+
+const (
+	PhasePending Phase = iota
+	PhaseCompressing
+	PhaseUploading
+	PhaseBuilding
+	PhasePushing
+	PhaseDeploying
+	PhaseHealthy
+	PhaseFailed
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhasePending:
+		return "pending"
+	case PhaseCompressing:
+		return "compressing"
+	case PhaseUploading:
+		return "uploading"
+	case PhaseBuilding:
+		return "building"
+	case PhasePushing:
+		return "pushing"
+	case PhaseDeploying:
+		return "deploying"
+	case PhaseHealthy:
+		return "healthy"
+	case PhaseFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// FromServiceState maps a state from the status subscription to a phase; returns false for states without a phase.
+func FromServiceState(state defangv1.ServiceState) (Phase, bool) {
+	switch state {
+	case defangv1.ServiceState_BUILD_QUEUED,
+		defangv1.ServiceState_BUILD_PROVISIONING,
+		defangv1.ServiceState_BUILD_PENDING,
+		defangv1.ServiceState_BUILD_ACTIVATING,
+		defangv1.ServiceState_BUILD_RUNNING,
+		defangv1.ServiceState_BUILD_STOPPING:
+		return PhaseBuilding, true
+	case defangv1.ServiceState_UPDATE_QUEUED, defangv1.ServiceState_DEPLOYMENT_PENDING:
+		return PhaseDeploying, true
+	case defangv1.ServiceState_DEPLOYMENT_COMPLETED:
+		return PhaseHealthy, true
+	case defangv1.ServiceState_BUILD_FAILED, defangv1.ServiceState_DEPLOYMENT_FAILED:
+		return PhaseFailed, true
+	default:
+		return PhasePending, false
+	}
+}
+
+// Reporter receives the phase transitions of the services; it must be safe for concurrent use.
+type Reporter interface {
+	SetPhase(service string, phase Phase)
+}
+
+type reporterKey struct{}
+
+// WithReporter returns a context that carries the reporter, so deep callers like the uploads can report phases.
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, reporter)
+}
+
+// FromContext returns the reporter of the context, or nil if there is none.
+func FromContext(ctx context.Context) Reporter {
+	reporter, _ := ctx.Value(reporterKey{}).(Reporter)
+	return reporter
+}
+
+// Report sends the phase to the reporter of the context; returns false if there is no reporter, in which case
+// the caller should print its own message.
+func Report(ctx context.Context, service string, phase Phase) bool {
+	reporter := FromContext(ctx)
+	if reporter == nil {
+		return false
+	}
+	reporter.SetPhase(service, phase)
+	return true
+}
@@ -0,0 +1,58 @@
+package progress
+
+import (
+	"context"
+	"testing"
+
+	defangv1 "github.com/DefangLabs/defang/src/protos/io/defang/v1"
+)
+
+type recorder struct {
+	phases map[string]Phase
+}
+
+func (r *recorder) SetPhase(service string, phase Phase) {
+	r.phases[service] = phase
+}
+
+func TestReport(t *testing.T) {
+	if Report(t.Context(), "app", PhaseBuilding) {
+		t.Error("expected Report to return false without a reporter")
+	}
+
+	r := &recorder{phases: map[string]Phase{}}
+	ctx := WithReporter(context.Background(), r)
+	if !Report(ctx, "app", PhaseBuilding) {
+		t.Error("expected Report to return true with a reporter")
+	}
+	if r.phases["app"] != PhaseBuilding {
+		t.Errorf("expected phase %v, got %v", PhaseBuilding, r.phases["app"])
+	}
+}
+
+func TestFromServiceState(t *testing.T) {
+	tests := []struct {
+		state defangv1.ServiceState
+		want  Phase
+		ok    bool
+	}{
+		{defangv1.ServiceState_NOT_SPECIFIED, PhasePending, false},
+		{defangv1.ServiceState_BUILD_QUEUED, PhaseBuilding, true},
+		{defangv1.ServiceState_BUILD_RUNNING, PhaseBuilding, true},
+		{defangv1.ServiceState_BUILD_STOPPING, PhaseBuilding, true}, // the remote builder pushes as part of the build
+		{defangv1.ServiceState_UPDATE_QUEUED, PhaseDeploying, true},
+		{defangv1.ServiceState_DEPLOYMENT_PENDING, PhaseDeploying, true},
+		{defangv1.ServiceState_DEPLOYMENT_COMPLETED, PhaseHealthy, true},
+		{defangv1.ServiceState_BUILD_FAILED, PhaseFailed, true},
+		{defangv1.ServiceState_DEPLOYMENT_FAILED, PhaseFailed, true},
+		{defangv1.ServiceState_DEPLOYMENT_SCALED_IN, PhasePending, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state.String(), func(t *testing.T) {
+			got, ok := FromServiceState(tt.state)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("FromServiceState(%v) = %v, %v; want %v, %v", tt.state, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/DefangLabs/defang/src/pkg/term"
+	"github.com/docker/go-units"
+)
+
+// transferLogInterval is the time between the progress lines of a transfer when it's not updated in place.
+const transferLogInterval = 10 * time.Second
+
+// transferRenderInterval limits how often an in-place display is redrawn for a transfer.
+const transferRenderInterval = 100 * time.Millisecond
+
+// TransferReporter is implemented by reporters that show the progress of uploads.
+type TransferReporter interface {
+	SetTransfer(service string, sent, total int64)
+}
+
+// transfer is the progress of an upload, with the times to derive the speed from.
+type transfer struct {
+	sent, total int64
+	start       time.Time
+	reported    time.Time
+}
+
+// update records the progress and returns whether it is due to be shown again after the interval.
+func (t *transfer) update(sent, total int64, now time.Time, interval time.Duration) bool {
+	if t.start.IsZero() || sent < t.sent {
+		t.start = now // a retry starts over
+	}
+	t.sent, t.total = sent, total
+	if sent < total && now.Sub(t.reported) < interval {
+		return false
+	}
+	t.reported = now
+	return true
+}
+
+// format returns the progress like "12.5MiB / 50MiB (25%), 2.5MiB/s, ETA 15s".
+func (t *transfer) format(now time.Time) string {
+	percent := 100
+	if t.total > 0 {
+		percent = int(t.sent * 100 / t.total)
+	}
+	s := fmt.Sprintf("%s / %s (%d%%)", units.BytesSize(float64(t.sent)), units.BytesSize(float64(t.total)), percent)
+	if elapsed := now.Sub(t.start).Seconds(); elapsed > 0 && t.sent > 0 && t.sent < t.total {
+		rate := float64(t.sent) / elapsed
+		eta := time.Duration(float64(t.total-t.sent) / rate * float64(time.Second)).Round(time.Second)
+		s += fmt.Sprintf(", %s/s, ETA %v", units.BytesSize(rate), eta)
+	}
+	return s
+}
+
+// transferLog prints a line with the progress of each transfer now and then; it is used when the context has no
+// TransferReporter, like when uploading outside of "compose up".
+type transferLog struct {
+	mu        sync.Mutex
+	term      *term.Term
+	transfers map[string]*transfer
+	now       func() time.Time
+}
+
+func (l *transferLog) SetTransfer(service string, sent, total int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.transfers[service]
+	if !ok {
+		t = &transfer{}
+		l.transfers[service] = t
+	}
+	now := l.now()
+	if t.update(sent, total, now, transferLogInterval) {
+		l.term.Infof("Uploading %s: %s", service, t.format(now))
+	}
+}
+
+var defaultTransferLog = &transferLog{term: term.DefaultTerm, transfers: make(map[string]*transfer), now: time.Now}
+
+// Reader reports the number of bytes read as the progress of an upload.
+type Reader struct {
+	r        io.Reader
+	service  string
+	total    int64
+	sent     int64
+	reporter TransferReporter
+}
+
+// NewReader returns a reader that reports the bytes read from r, of total bytes, as the upload of the service. The
+// reporter of the context shows the progress if it is a TransferReporter; otherwise a line is printed now and then.
+func NewReader(ctx context.Context, service string, r io.Reader, total int64) *Reader {
+	reporter, ok := FromContext(ctx).(TransferReporter)
+	if !ok {
+		reporter = defaultTransferLog
+	}
+	reporter.SetTransfer(service, 0, total)
+	return &Reader{r: r, service: service, total: total, reporter: reporter}
+}
+
+func (pr *Reader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	if n > 0 {
+		pr.sent += int64(n)
+		pr.reporter.SetTransfer(pr.service, pr.sent, pr.total)
+	}
+	return n, err
+}
+
+// Close closes the underlying reader, if it is an io.Closer.
+func (pr *Reader) Close() error {
+	if c, ok := pr.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
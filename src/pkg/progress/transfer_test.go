@@ -0,0 +1,36 @@
+package progress
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+type transferRecorder struct {
+	recorder
+	sent []int64
+}
+
+func (r *transferRecorder) SetTransfer(service string, sent, total int64) {
+	r.sent = append(r.sent, sent)
+}
+
+func TestReader(t *testing.T) {
+	r := &transferRecorder{recorder: recorder{phases: map[string]Phase{}}}
+	ctx := WithReporter(context.Background(), r)
+
+	reader := NewReader(ctx, "api", io.NopCloser(strings.NewReader("hello world")), 11)
+	buf := make([]byte, 6)
+	for {
+		if _, err := reader.Read(buf); err != nil {
+			break
+		}
+	}
+	if err := reader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{0, 6, 11}; len(r.sent) != len(want) || r.sent[1] != want[1] || r.sent[2] != want[2] {
+		t.Errorf("expected progress %v, got %v", want, r.sent)
+	}
+}
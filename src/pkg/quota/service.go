@@ -23,6 +23,15 @@ func (q ServiceQuotas) ValidateQuotas(service *types.ServiceConfig) error {
 		}
 	}
 
+	if shmSizeMiB := float32(service.ShmSize) / compose.MiB; shmSizeMiB > q.ShmSizeMiB || service.ShmSize < 0 {
+		return fmt.Errorf("shm_size %v MiB exceeds quota %v MiB", shmSizeMiB, q.ShmSizeMiB) // CodeInvalidArgument
+	}
+	if inMemoryBytes, err := compose.GetInMemoryBytes(service); err != nil {
+		return err // CodeInvalidArgument
+	} else if inMemoryMiB := float32(inMemoryBytes) / compose.MiB; inMemoryMiB > q.MemoryMiB {
+		return fmt.Errorf("shm_size and tmpfs %v MiB exceed memory quota %v MiB", inMemoryMiB, q.MemoryMiB) // CodeInvalidArgument
+	}
+
 	if service.Deploy != nil {
 		if service.Deploy.Replicas != nil && *service.Deploy.Replicas > q.Replicas {
 			return fmt.Errorf("replicas exceeds quota (max %d)", q.Replicas) // CodeInvalidArgument
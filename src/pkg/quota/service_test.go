@@ -19,6 +19,16 @@ func TestValidateQuotas(t *testing.T) {
 			service: &types.ServiceConfig{Name: "test", Build: &types.BuildConfig{Context: ".", ShmSize: 30721 * compose.MiB}},
 			wantErr: "build.shm_size 30721 MiB exceeds quota 30720 MiB",
 		},
+		{
+			name:    "service shm size exceeds quota",
+			service: &types.ServiceConfig{Name: "test", Image: "chrome", ShmSize: 30721 * compose.MiB},
+			wantErr: "shm_size 30721 MiB exceeds quota 30720 MiB",
+		},
+		{
+			name:    "tmpfs exceeds memory quota",
+			service: &types.ServiceConfig{Name: "test", Image: "postgres", ShmSize: 1024 * compose.MiB, Tmpfs: types.StringList{"/tmp:size=64g"}},
+			wantErr: "shm_size and tmpfs 66560 MiB exceed memory quota 65536 MiB",
+		},
 		{
 			name: "too many replicas",
 			service: &types.ServiceConfig{
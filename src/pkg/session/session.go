@@ -11,7 +11,6 @@ import (
 	"github.com/DefangLabs/defang/src/pkg/clouds/aws"
 	"github.com/DefangLabs/defang/src/pkg/modes"
 	"github.com/DefangLabs/defang/src/pkg/stacks"
-	"github.com/DefangLabs/defang/src/pkg/term"
 )
 
 type StacksManager interface {
@@ -28,9 +27,23 @@ type Session struct {
 type SessionLoaderOptions struct {
 	ProjectName      string
 	ComposeFilePaths []string
+	EnvFiles         []string
+	OsEnv            bool
+	NoInterpolate    bool
 	stacks.GetStackOpts
 }
 
+// LoaderOptions returns the options for the compose loader of the session.
+func (o SessionLoaderOptions) LoaderOptions() []compose.LoaderOption {
+	return []compose.LoaderOption{
+		compose.WithProjectName(o.ProjectName),
+		compose.WithPath(o.ComposeFilePaths...),
+		compose.WithEnvFiles(o.EnvFiles...),
+		compose.WithOsEnv(o.OsEnv),
+		compose.WithNoInterpolate(o.NoInterpolate),
+	}
+}
+
 type SessionLoader struct {
 	client client.FabricClient
 	sm     StacksManager
@@ -62,7 +75,7 @@ func (sl *SessionLoader) LoadSession(ctx context.Context) (*Session, error) {
 	if stack.Provider == client.ProviderDefang {
 		extraMsg = "; consider using BYOC (https://s.defang.io/byoc)"
 	}
-	term.Infof("Using the %q stack on %s from %s%s", stack.Name, stack.Provider, whence, extraMsg)
+	cli.OptionsFromContext(ctx).Term.Infof("Using the %q stack on %s from %s%s", stack.Name, stack.Provider, whence, extraMsg)
 
 	printProviderMismatchWarnings(ctx, stack.Provider)
 	return session, nil
@@ -86,39 +99,37 @@ func (sl *SessionLoader) loadStack(ctx context.Context) (*stacks.Parameters, str
 }
 
 func (sl *SessionLoader) newLoader() client.Loader {
-	return compose.NewLoader(
-		compose.WithProjectName(sl.opts.ProjectName),
-		compose.WithPath(sl.opts.ComposeFilePaths...),
-	)
+	return compose.NewLoader(sl.opts.LoaderOptions()...)
 }
 
 func printProviderMismatchWarnings(ctx context.Context, provider client.ProviderID) {
+	t := cli.OptionsFromContext(ctx).Term
 	if provider == client.ProviderDefang {
 		// Ignore any env vars when explicitly using the Defang playground provider
 		// Defaults to defang provider in non-interactive mode
 		if env := pkg.AwsInEnv(); env != "" {
-			term.Warnf("AWS environment variables were detected (%v); did you forget --provider=aws or DEFANG_PROVIDER=aws?", env)
+			t.Warnf("AWS environment variables were detected (%v); did you forget --provider=aws or DEFANG_PROVIDER=aws?", env)
 		}
 		if env := pkg.DoInEnv(); env != "" {
-			term.Warnf("DigitalOcean environment variable was detected (%v); did you forget --provider=digitalocean or DEFANG_PROVIDER=digitalocean?", env)
+			t.Warnf("DigitalOcean environment variable was detected (%v); did you forget --provider=digitalocean or DEFANG_PROVIDER=digitalocean?", env)
 		}
 		if env := pkg.GcpInEnv(); env != "" {
-			term.Warnf("GCP project environment variable was detected (%v); did you forget --provider=gcp or DEFANG_PROVIDER=gcp?", env)
+			t.Warnf("GCP project environment variable was detected (%v); did you forget --provider=gcp or DEFANG_PROVIDER=gcp?", env)
 		}
 	}
 
 	switch provider {
 	case client.ProviderAWS:
 		if !awsInConfig(ctx) {
-			term.Warn("AWS provider was selected, but AWS environment is not set")
+			t.Warn("AWS provider was selected, but AWS environment is not set")
 		}
 	case client.ProviderDO:
 		if env := pkg.DoInEnv(); env == "" {
-			term.Warn("DigitalOcean provider was selected, but DIGITALOCEAN_TOKEN environment variable is not set")
+			t.Warn("DigitalOcean provider was selected, but DIGITALOCEAN_TOKEN environment variable is not set")
 		}
 	case client.ProviderGCP:
 		if env := pkg.GcpInEnv(); env == "" {
-			term.Warnf("GCP provider was selected, but no GCP project environment variable is set (%v)", pkg.GCPProjectEnvVars)
+			t.Warnf("GCP provider was selected, but no GCP project environment variable is set (%v)", pkg.GCPProjectEnvVars)
 		}
 	}
 }
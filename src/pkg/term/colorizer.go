@@ -22,7 +22,9 @@ type Term struct {
 	isTerminal bool
 	hasDarkBg  bool
 
-	warnings []string
+	warnings    []string
+	warningHook func(msg string)
+	log         io.Writer // gets a copy of all output, incl. debug messages, without colors
 }
 
 var DefaultTerm = NewTerm(os.Stdin, os.Stdout, os.Stderr)
@@ -71,6 +73,25 @@ func (t Term) Stdio() (FileReader, termenv.File, io.Writer) {
 	return t.stdin, t.out.TTY(), t.err
 }
 
+// Stdout returns the writer for the regular output of the terminal.
+func (t *Term) Stdout() io.Writer {
+	return t.stdout
+}
+
+// WithStdoutToStderr returns a copy of the terminal that writes all output to stderr,
+// so that stdout can be used for machine-readable output.
+func (t *Term) WithStdoutToStderr() *Term {
+	e := NewTerm(t.stdin, t.stderr, t.stderr)
+	e.debug = t.debug
+	e.json = t.json
+	e.warningHook = t.warningHook
+	e.log = t.log
+	if doColor(t.err) && !t.json {
+		e.ForceColor(true)
+	}
+	return e
+}
+
 func (t *Term) ForceColor(color bool) {
 	if color {
 		t.out = termenv.NewOutput(t.stdout, termenv.WithProfile(termenv.ANSI))
@@ -96,6 +117,11 @@ func (t *Term) DoDebug() bool {
 	return t.debug
 }
 
+// DoJSON reports whether output should be machine-readable JSON instead of text.
+func (t *Term) DoJSON() bool {
+	return t.json
+}
+
 func (t *Term) HasDarkBackground() bool {
 	return t.hasDarkBg
 }
@@ -194,34 +220,64 @@ func ensurePrefix(prefix prefixChars, s string) string {
 	return string(prefix) + s
 }
 
+// SetLog makes the terminal write a copy of all output to w, eg. a log file, incl. the debug messages that are not
+// printed without --debug.
+func (t *Term) SetLog(w io.Writer) {
+	t.log = w
+}
+
+func (t *Term) writeLog(msg string) {
+	if t.log != nil {
+		io.WriteString(t.log, StripAnsi(msg))
+	}
+}
+
 func (t *Term) Printc(c Color, v ...any) (int, error) {
-	return output(t.out, c, fmt.Sprint(v...))
+	msg := fmt.Sprint(v...)
+	t.writeLog(msg)
+	return output(t.out, c, msg)
 }
 
 func (t *Term) Print(v ...any) (int, error) {
-	return fmt.Fprint(t.out, v...)
+	msg := fmt.Sprint(v...)
+	t.writeLog(msg)
+	return io.WriteString(t.out, msg)
 }
 
 func (t *Term) Println(v ...any) (int, error) {
-	return fmt.Fprintln(t.out, v...)
+	msg := fmt.Sprintln(v...)
+	t.writeLog(msg)
+	return io.WriteString(t.out, msg)
 }
 
 func (t *Term) Printf(format string, v ...any) (int, error) {
-	return fmt.Fprintf(t.out, format, v...)
+	msg := fmt.Sprintf(format, v...)
+	t.writeLog(msg)
+	return io.WriteString(t.out, msg)
 }
 
 func (t *Term) Debug(v ...any) (int, error) {
+	if !t.debug && t.log == nil {
+		return 0, nil
+	}
+	msg := ensurePrefix(debugPrefix, fmt.Sprintln(v...))
+	t.writeLog(msg)
 	if !t.debug {
 		return 0, nil
 	}
-	return output(t.err, DebugColor, ensurePrefix(debugPrefix, fmt.Sprintln(v...)))
+	return output(t.err, DebugColor, msg)
 }
 
 func (t *Term) Debugf(format string, v ...any) (int, error) {
+	if !t.debug && t.log == nil {
+		return 0, nil
+	}
+	msg := ensureNewline(ensurePrefix(debugPrefix, fmt.Sprintf(format, v...)))
+	t.writeLog(msg)
 	if !t.debug {
 		return 0, nil
 	}
-	return output(t.err, DebugColor, ensureNewline(ensurePrefix(debugPrefix, fmt.Sprintf(format, v...))))
+	return output(t.err, DebugColor, msg)
 }
 
 func (t *Term) outOrErr() *termenv.Output {
@@ -232,31 +288,53 @@ func (t *Term) outOrErr() *termenv.Output {
 }
 
 func (t *Term) Info(v ...any) (int, error) {
-	return output(t.outOrErr(), InfoColor, ensurePrefix(infoPrefix, fmt.Sprintln(v...)))
+	msg := ensurePrefix(infoPrefix, fmt.Sprintln(v...))
+	t.writeLog(msg)
+	return output(t.outOrErr(), InfoColor, msg)
 }
 
 func (t *Term) Infof(format string, v ...any) (int, error) {
-	return output(t.outOrErr(), InfoColor, ensureNewline(ensurePrefix(infoPrefix, fmt.Sprintf(format, v...))))
+	msg := ensureNewline(ensurePrefix(infoPrefix, fmt.Sprintf(format, v...)))
+	t.writeLog(msg)
+	return output(t.outOrErr(), InfoColor, msg)
 }
 
 func (t *Term) Warn(v ...any) (int, error) {
+	t.callWarningHook(fmt.Sprintln(v...))
 	msg := ensurePrefix(warnPrefix, fmt.Sprintln(v...))
 	t.warnings = append(t.warnings, msg)
+	t.writeLog(msg)
 	return output(t.outOrErr(), WarnColor, msg)
 }
 
 func (t *Term) Warnf(format string, v ...any) (int, error) {
+	t.callWarningHook(fmt.Sprintf(format, v...))
 	msg := ensureNewline(ensurePrefix(warnPrefix, fmt.Sprintf(format, v...)))
 	t.warnings = append(t.warnings, msg)
+	t.writeLog(msg)
 	return output(t.outOrErr(), WarnColor, msg)
 }
 
+// SetWarningHook registers a function that is called with the message of every warning, without prefix or newline.
+func (t *Term) SetWarningHook(hook func(msg string)) {
+	t.warningHook = hook
+}
+
+func (t *Term) callWarningHook(msg string) {
+	if t.warningHook != nil {
+		t.warningHook(strings.TrimSpace(msg))
+	}
+}
+
 func (t *Term) Error(v ...any) (int, error) {
-	return output(t.err, ErrorColor, fmt.Sprintln(v...))
+	msg := fmt.Sprintln(v...)
+	t.writeLog(msg)
+	return output(t.err, ErrorColor, msg)
 }
 
 func (t *Term) Errorf(format string, v ...any) (int, error) {
 	line := ensureNewline(fmt.Sprintf(format, v...))
+	t.writeLog(line)
 	return output(t.err, ErrorColor, line)
 }
 
@@ -376,10 +454,22 @@ func SetJSON(json bool) {
 	DefaultTerm.SetJSON(json)
 }
 
+func SetLog(w io.Writer) {
+	DefaultTerm.SetLog(w)
+}
+
+func SetWarningHook(hook func(msg string)) {
+	DefaultTerm.SetWarningHook(hook)
+}
+
 func DoDebug() bool {
 	return DefaultTerm.DoDebug()
 }
 
+func DoJSON() bool {
+	return DefaultTerm.DoJSON()
+}
+
 func HasDarkBackground() bool {
 	return DefaultTerm.HasDarkBackground()
 }
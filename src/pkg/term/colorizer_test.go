@@ -248,3 +248,59 @@ func TestFlushWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestWarningHook(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	term := NewTerm(os.Stdin, &stdout, &stderr)
+
+	var got []string
+	term.SetWarningHook(func(msg string) { got = append(got, msg) })
+	term.Warn("Warning", 1)
+	term.Warnf("Warning %d\n", 2)
+
+	if len(got) != 2 || got[0] != "Warning 1" || got[1] != "Warning 2" {
+		t.Errorf("expected hook to get [Warning 1 Warning 2], got %q", got)
+	}
+}
+
+func TestSetLog(t *testing.T) {
+	var stdout, stderr, log bytes.Buffer
+	term := NewTerm(os.Stdin, &stdout, &stderr)
+	term.ForceColor(true)
+	term.SetLog(&log)
+
+	term.Println("output")
+	term.Info("info")
+	term.Debug("debug")
+	term.Error("error")
+
+	if strings.Contains(stdout.String()+stderr.String(), "debug") {
+		t.Errorf("expected no debug output without debug, got %q, %q", stdout.String(), stderr.String())
+	}
+	if expected := "output\n * info\n - debug\nerror\n"; log.String() != expected {
+		t.Errorf("expected the log to have all output without colors, got %q", log.String())
+	}
+}
+
+func TestWithStdoutToStderr(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	term := NewTerm(os.Stdin, &stdout, &stderr)
+	term.ForceColor(true)
+	term.json = true
+
+	var got []string
+	term.SetWarningHook(func(msg string) { got = append(got, msg) })
+	e := term.WithStdoutToStderr()
+	e.Warn("Warning")
+	e.Println("Output")
+
+	if stdout.Len() != 0 {
+		t.Errorf("expected no output on stdout, got %q", stdout.String())
+	}
+	if strings.Contains(stderr.String(), "\x1b[") {
+		t.Errorf("expected no colors in JSON mode, got %q", stderr.String())
+	}
+	if len(got) != 1 || got[0] != "Warning" {
+		t.Errorf("expected the hook to get the warning, got %q", got)
+	}
+}
@@ -0,0 +1,20 @@
+package term
+
+import (
+	"golang.org/x/term"
+)
+
+// MakeRaw puts the terminal connected to the given file descriptor into raw mode, so that all input, including
+// Ctrl-C, is passed on as is, and returns a function that restores the previous state of the terminal.
+func MakeRaw(fd int) (func() error, error) {
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+	return func() error { return term.Restore(fd, oldState) }, nil
+}
+
+// GetSize returns the dimensions of the terminal connected to the given file descriptor.
+func GetSize(fd int) (width, height int, err error) {
+	return term.GetSize(fd)
+}
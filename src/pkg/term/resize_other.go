@@ -0,0 +1,20 @@
+//go:build !windows
+// +build !windows
+
+package term
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"golang.org/x/sys/unix"
+)
+
+// NotifyResize returns a channel that receives a value whenever the terminal window is resized, until ctx is done.
+func NotifyResize(ctx context.Context) <-chan os.Signal {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, unix.SIGWINCH)
+	context.AfterFunc(ctx, func() { signal.Stop(ch) })
+	return ch
+}
@@ -0,0 +1,15 @@
+//go:build windows
+// +build windows
+
+package term
+
+import (
+	"context"
+	"os"
+)
+
+// NotifyResize returns a channel that receives a value whenever the terminal window is resized, until ctx is done.
+// Windows has no signal for this, so the returned channel never receives.
+func NotifyResize(ctx context.Context) <-chan os.Signal {
+	return nil
+}
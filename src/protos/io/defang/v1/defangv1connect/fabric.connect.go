@@ -111,6 +111,9 @@ const (
 	// FabricControllerListDeploymentsProcedure is the fully-qualified name of the FabricController's
 	// ListDeployments RPC.
 	FabricControllerListDeploymentsProcedure = "/io.defang.v1.FabricController/ListDeployments"
+	// FabricControllerListProjectsProcedure is the fully-qualified name of the FabricController's
+	// ListProjects RPC.
+	FabricControllerListProjectsProcedure = "/io.defang.v1.FabricController/ListProjects"
 	// FabricControllerCreateUploadURLProcedure is the fully-qualified name of the FabricController's
 	// CreateUploadURL RPC.
 	FabricControllerCreateUploadURLProcedure = "/io.defang.v1.FabricController/CreateUploadURL"
@@ -128,6 +131,9 @@ const (
 	FabricControllerSetOptionsProcedure = "/io.defang.v1.FabricController/SetOptions"
 	// FabricControllerWhoAmIProcedure is the fully-qualified name of the FabricController's WhoAmI RPC.
 	FabricControllerWhoAmIProcedure = "/io.defang.v1.FabricController/WhoAmI"
+	// FabricControllerGetQuotasProcedure is the fully-qualified name of the FabricController's
+	// GetQuotas RPC.
+	FabricControllerGetQuotasProcedure = "/io.defang.v1.FabricController/GetQuotas"
 	// FabricControllerTrackProcedure is the fully-qualified name of the FabricController's Track RPC.
 	FabricControllerTrackProcedure = "/io.defang.v1.FabricController/Track"
 	// FabricControllerDeleteMeProcedure is the fully-qualified name of the FabricController's DeleteMe
@@ -169,6 +175,35 @@ const (
 	// FabricControllerGetDefaultStackProcedure is the fully-qualified name of the FabricController's
 	// GetDefaultStack RPC.
 	FabricControllerGetDefaultStackProcedure = "/io.defang.v1.FabricController/GetDefaultStack"
+	// FabricControllerPutCertificateProcedure is the fully-qualified name of the FabricController's
+	// PutCertificate RPC.
+	FabricControllerPutCertificateProcedure = "/io.defang.v1.FabricController/PutCertificate"
+	// FabricControllerCreateAlertRuleProcedure is the fully-qualified name of the FabricController's
+	// CreateAlertRule RPC.
+	FabricControllerCreateAlertRuleProcedure = "/io.defang.v1.FabricController/CreateAlertRule"
+	// FabricControllerListAlertRulesProcedure is the fully-qualified name of the FabricController's
+	// ListAlertRules RPC.
+	FabricControllerListAlertRulesProcedure = "/io.defang.v1.FabricController/ListAlertRules"
+	// FabricControllerDeleteAlertRulesProcedure is the fully-qualified name of the FabricController's
+	// DeleteAlertRules RPC.
+	FabricControllerDeleteAlertRulesProcedure = "/io.defang.v1.FabricController/DeleteAlertRules"
+	// FabricControllerGetMetricsEndpointProcedure is the fully-qualified name of the FabricController's
+	// GetMetricsEndpoint RPC.
+	FabricControllerGetMetricsEndpointProcedure = "/io.defang.v1.FabricController/GetMetricsEndpoint"
+	// FabricControllerPutMetricsExportProcedure is the fully-qualified name of the FabricController's
+	// PutMetricsExport RPC.
+	FabricControllerPutMetricsExportProcedure = "/io.defang.v1.FabricController/PutMetricsExport"
+	// FabricControllerCreateBackupProcedure is the fully-qualified name of the FabricController's
+	// CreateBackup RPC.
+	FabricControllerCreateBackupProcedure = "/io.defang.v1.FabricController/CreateBackup"
+	// FabricControllerListBackupsProcedure is the fully-qualified name of the FabricController's
+	// ListBackups RPC.
+	FabricControllerListBackupsProcedure = "/io.defang.v1.FabricController/ListBackups"
+	// FabricControllerRestoreBackupProcedure is the fully-qualified name of the FabricController's
+	// RestoreBackup RPC.
+	FabricControllerRestoreBackupProcedure = "/io.defang.v1.FabricController/RestoreBackup"
+	// FabricControllerExecProcedure is the fully-qualified name of the FabricController's Exec RPC.
+	FabricControllerExecProcedure = "/io.defang.v1.FabricController/Exec"
 )
 
 // FabricControllerClient is a client for the io.defang.v1.FabricController service.
@@ -207,12 +242,14 @@ type FabricControllerClient interface {
 	ListConfigs(context.Context, *connect_go.Request[v1.ListConfigsRequest]) (*connect_go.Response[v1.ListConfigsResponse], error)
 	PutDeployment(context.Context, *connect_go.Request[v1.PutDeploymentRequest]) (*connect_go.Response[emptypb.Empty], error)
 	ListDeployments(context.Context, *connect_go.Request[v1.ListDeploymentsRequest]) (*connect_go.Response[v1.ListDeploymentsResponse], error)
+	ListProjects(context.Context, *connect_go.Request[v1.ListProjectsRequest]) (*connect_go.Response[v1.ListProjectsResponse], error)
 	CreateUploadURL(context.Context, *connect_go.Request[v1.UploadURLRequest]) (*connect_go.Response[v1.UploadURLResponse], error)
 	DelegateSubdomainZone(context.Context, *connect_go.Request[v1.DelegateSubdomainZoneRequest]) (*connect_go.Response[v1.DelegateSubdomainZoneResponse], error)
 	DeleteSubdomainZone(context.Context, *connect_go.Request[v1.DeleteSubdomainZoneRequest]) (*connect_go.Response[emptypb.Empty], error)
 	GetDelegateSubdomainZone(context.Context, *connect_go.Request[v1.GetDelegateSubdomainZoneRequest]) (*connect_go.Response[v1.DelegateSubdomainZoneResponse], error)
 	SetOptions(context.Context, *connect_go.Request[v1.SetOptionsRequest]) (*connect_go.Response[emptypb.Empty], error)
 	WhoAmI(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.WhoAmIResponse], error)
+	GetQuotas(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.GetQuotasResponse], error)
 	Track(context.Context, *connect_go.Request[v1.TrackRequest]) (*connect_go.Response[emptypb.Empty], error)
 	// Endpoint for GDPR compliance
 	DeleteMe(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[emptypb.Empty], error)
@@ -230,6 +267,16 @@ type FabricControllerClient interface {
 	ListStacks(context.Context, *connect_go.Request[v1.ListStacksRequest]) (*connect_go.Response[v1.ListStacksResponse], error)
 	DeleteStack(context.Context, *connect_go.Request[v1.DeleteStackRequest]) (*connect_go.Response[emptypb.Empty], error)
 	GetDefaultStack(context.Context, *connect_go.Request[v1.GetDefaultStackRequest]) (*connect_go.Response[v1.GetStackResponse], error)
+	PutCertificate(context.Context, *connect_go.Request[v1.PutCertificateRequest]) (*connect_go.Response[emptypb.Empty], error)
+	CreateAlertRule(context.Context, *connect_go.Request[v1.CreateAlertRuleRequest]) (*connect_go.Response[v1.AlertRule], error)
+	ListAlertRules(context.Context, *connect_go.Request[v1.ListAlertRulesRequest]) (*connect_go.Response[v1.ListAlertRulesResponse], error)
+	DeleteAlertRules(context.Context, *connect_go.Request[v1.DeleteAlertRulesRequest]) (*connect_go.Response[emptypb.Empty], error)
+	GetMetricsEndpoint(context.Context, *connect_go.Request[v1.GetMetricsEndpointRequest]) (*connect_go.Response[v1.MetricsEndpoint], error)
+	PutMetricsExport(context.Context, *connect_go.Request[v1.PutMetricsExportRequest]) (*connect_go.Response[emptypb.Empty], error)
+	CreateBackup(context.Context, *connect_go.Request[v1.CreateBackupRequest]) (*connect_go.Response[v1.Backup], error)
+	ListBackups(context.Context, *connect_go.Request[v1.ListBackupsRequest]) (*connect_go.Response[v1.ListBackupsResponse], error)
+	RestoreBackup(context.Context, *connect_go.Request[v1.RestoreBackupRequest]) (*connect_go.Response[emptypb.Empty], error)
+	Exec(context.Context) *connect_go.BidiStreamForClient[v1.ExecRequest, v1.ExecResponse]
 }
 
 // NewFabricControllerClient constructs a client for the io.defang.v1.FabricController service. By
@@ -395,6 +442,12 @@ func NewFabricControllerClient(httpClient connect_go.HTTPClient, baseURL string,
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		listProjects: connect_go.NewClient[v1.ListProjectsRequest, v1.ListProjectsResponse](
+			httpClient,
+			baseURL+FabricControllerListProjectsProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
 		createUploadURL: connect_go.NewClient[v1.UploadURLRequest, v1.UploadURLResponse](
 			httpClient,
 			baseURL+FabricControllerCreateUploadURLProcedure,
@@ -429,6 +482,12 @@ func NewFabricControllerClient(httpClient connect_go.HTTPClient, baseURL string,
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		getQuotas: connect_go.NewClient[emptypb.Empty, v1.GetQuotasResponse](
+			httpClient,
+			baseURL+FabricControllerGetQuotasProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
 		track: connect_go.NewClient[v1.TrackRequest, emptypb.Empty](
 			httpClient,
 			baseURL+FabricControllerTrackProcedure,
@@ -511,6 +570,62 @@ func NewFabricControllerClient(httpClient connect_go.HTTPClient, baseURL string,
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		putCertificate: connect_go.NewClient[v1.PutCertificateRequest, emptypb.Empty](
+			httpClient,
+			baseURL+FabricControllerPutCertificateProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+			connect_go.WithClientOptions(opts...),
+		),
+		createAlertRule: connect_go.NewClient[v1.CreateAlertRuleRequest, v1.AlertRule](
+			httpClient,
+			baseURL+FabricControllerCreateAlertRuleProcedure,
+			opts...,
+		),
+		listAlertRules: connect_go.NewClient[v1.ListAlertRulesRequest, v1.ListAlertRulesResponse](
+			httpClient,
+			baseURL+FabricControllerListAlertRulesProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
+		deleteAlertRules: connect_go.NewClient[v1.DeleteAlertRulesRequest, emptypb.Empty](
+			httpClient,
+			baseURL+FabricControllerDeleteAlertRulesProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+			connect_go.WithClientOptions(opts...),
+		),
+		getMetricsEndpoint: connect_go.NewClient[v1.GetMetricsEndpointRequest, v1.MetricsEndpoint](
+			httpClient,
+			baseURL+FabricControllerGetMetricsEndpointProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
+		putMetricsExport: connect_go.NewClient[v1.PutMetricsExportRequest, emptypb.Empty](
+			httpClient,
+			baseURL+FabricControllerPutMetricsExportProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+			connect_go.WithClientOptions(opts...),
+		),
+		createBackup: connect_go.NewClient[v1.CreateBackupRequest, v1.Backup](
+			httpClient,
+			baseURL+FabricControllerCreateBackupProcedure,
+			opts...,
+		),
+		listBackups: connect_go.NewClient[v1.ListBackupsRequest, v1.ListBackupsResponse](
+			httpClient,
+			baseURL+FabricControllerListBackupsProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
+		restoreBackup: connect_go.NewClient[v1.RestoreBackupRequest, emptypb.Empty](
+			httpClient,
+			baseURL+FabricControllerRestoreBackupProcedure,
+			opts...,
+		),
+		exec: connect_go.NewClient[v1.ExecRequest, v1.ExecResponse](
+			httpClient,
+			baseURL+FabricControllerExecProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -543,12 +658,14 @@ type fabricControllerClient struct {
 	listConfigs                *connect_go.Client[v1.ListConfigsRequest, v1.ListConfigsResponse]
 	putDeployment              *connect_go.Client[v1.PutDeploymentRequest, emptypb.Empty]
 	listDeployments            *connect_go.Client[v1.ListDeploymentsRequest, v1.ListDeploymentsResponse]
+	listProjects               *connect_go.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
 	createUploadURL            *connect_go.Client[v1.UploadURLRequest, v1.UploadURLResponse]
 	delegateSubdomainZone      *connect_go.Client[v1.DelegateSubdomainZoneRequest, v1.DelegateSubdomainZoneResponse]
 	deleteSubdomainZone        *connect_go.Client[v1.DeleteSubdomainZoneRequest, emptypb.Empty]
 	getDelegateSubdomainZone   *connect_go.Client[v1.GetDelegateSubdomainZoneRequest, v1.DelegateSubdomainZoneResponse]
 	setOptions                 *connect_go.Client[v1.SetOptionsRequest, emptypb.Empty]
 	whoAmI                     *connect_go.Client[emptypb.Empty, v1.WhoAmIResponse]
+	getQuotas                  *connect_go.Client[emptypb.Empty, v1.GetQuotasResponse]
 	track                      *connect_go.Client[v1.TrackRequest, emptypb.Empty]
 	deleteMe                   *connect_go.Client[emptypb.Empty, emptypb.Empty]
 	verifyDNSSetup             *connect_go.Client[v1.VerifyDNSSetupRequest, emptypb.Empty]
@@ -563,6 +680,16 @@ type fabricControllerClient struct {
 	listStacks                 *connect_go.Client[v1.ListStacksRequest, v1.ListStacksResponse]
 	deleteStack                *connect_go.Client[v1.DeleteStackRequest, emptypb.Empty]
 	getDefaultStack            *connect_go.Client[v1.GetDefaultStackRequest, v1.GetStackResponse]
+	putCertificate             *connect_go.Client[v1.PutCertificateRequest, emptypb.Empty]
+	createAlertRule            *connect_go.Client[v1.CreateAlertRuleRequest, v1.AlertRule]
+	listAlertRules             *connect_go.Client[v1.ListAlertRulesRequest, v1.ListAlertRulesResponse]
+	deleteAlertRules           *connect_go.Client[v1.DeleteAlertRulesRequest, emptypb.Empty]
+	getMetricsEndpoint         *connect_go.Client[v1.GetMetricsEndpointRequest, v1.MetricsEndpoint]
+	putMetricsExport           *connect_go.Client[v1.PutMetricsExportRequest, emptypb.Empty]
+	createBackup               *connect_go.Client[v1.CreateBackupRequest, v1.Backup]
+	listBackups                *connect_go.Client[v1.ListBackupsRequest, v1.ListBackupsResponse]
+	restoreBackup              *connect_go.Client[v1.RestoreBackupRequest, emptypb.Empty]
+	exec                       *connect_go.Client[v1.ExecRequest, v1.ExecResponse]
 }
 
 // GetStatus calls io.defang.v1.FabricController.GetStatus.
@@ -708,6 +835,11 @@ func (c *fabricControllerClient) ListDeployments(ctx context.Context, req *conne
 	return c.listDeployments.CallUnary(ctx, req)
 }
 
+// ListProjects calls io.defang.v1.FabricController.ListProjects.
+func (c *fabricControllerClient) ListProjects(ctx context.Context, req *connect_go.Request[v1.ListProjectsRequest]) (*connect_go.Response[v1.ListProjectsResponse], error) {
+	return c.listProjects.CallUnary(ctx, req)
+}
+
 // CreateUploadURL calls io.defang.v1.FabricController.CreateUploadURL.
 func (c *fabricControllerClient) CreateUploadURL(ctx context.Context, req *connect_go.Request[v1.UploadURLRequest]) (*connect_go.Response[v1.UploadURLResponse], error) {
 	return c.createUploadURL.CallUnary(ctx, req)
@@ -738,6 +870,11 @@ func (c *fabricControllerClient) WhoAmI(ctx context.Context, req *connect_go.Req
 	return c.whoAmI.CallUnary(ctx, req)
 }
 
+// GetQuotas calls io.defang.v1.FabricController.GetQuotas.
+func (c *fabricControllerClient) GetQuotas(ctx context.Context, req *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.GetQuotasResponse], error) {
+	return c.getQuotas.CallUnary(ctx, req)
+}
+
 // Track calls io.defang.v1.FabricController.Track.
 func (c *fabricControllerClient) Track(ctx context.Context, req *connect_go.Request[v1.TrackRequest]) (*connect_go.Response[emptypb.Empty], error) {
 	return c.track.CallUnary(ctx, req)
@@ -812,6 +949,56 @@ func (c *fabricControllerClient) GetDefaultStack(ctx context.Context, req *conne
 	return c.getDefaultStack.CallUnary(ctx, req)
 }
 
+// PutCertificate calls io.defang.v1.FabricController.PutCertificate.
+func (c *fabricControllerClient) PutCertificate(ctx context.Context, req *connect_go.Request[v1.PutCertificateRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.putCertificate.CallUnary(ctx, req)
+}
+
+// CreateAlertRule calls io.defang.v1.FabricController.CreateAlertRule.
+func (c *fabricControllerClient) CreateAlertRule(ctx context.Context, req *connect_go.Request[v1.CreateAlertRuleRequest]) (*connect_go.Response[v1.AlertRule], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// ListAlertRules calls io.defang.v1.FabricController.ListAlertRules.
+func (c *fabricControllerClient) ListAlertRules(ctx context.Context, req *connect_go.Request[v1.ListAlertRulesRequest]) (*connect_go.Response[v1.ListAlertRulesResponse], error) {
+	return c.listAlertRules.CallUnary(ctx, req)
+}
+
+// DeleteAlertRules calls io.defang.v1.FabricController.DeleteAlertRules.
+func (c *fabricControllerClient) DeleteAlertRules(ctx context.Context, req *connect_go.Request[v1.DeleteAlertRulesRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.deleteAlertRules.CallUnary(ctx, req)
+}
+
+// GetMetricsEndpoint calls io.defang.v1.FabricController.GetMetricsEndpoint.
+func (c *fabricControllerClient) GetMetricsEndpoint(ctx context.Context, req *connect_go.Request[v1.GetMetricsEndpointRequest]) (*connect_go.Response[v1.MetricsEndpoint], error) {
+	return c.getMetricsEndpoint.CallUnary(ctx, req)
+}
+
+// PutMetricsExport calls io.defang.v1.FabricController.PutMetricsExport.
+func (c *fabricControllerClient) PutMetricsExport(ctx context.Context, req *connect_go.Request[v1.PutMetricsExportRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.putMetricsExport.CallUnary(ctx, req)
+}
+
+// CreateBackup calls io.defang.v1.FabricController.CreateBackup.
+func (c *fabricControllerClient) CreateBackup(ctx context.Context, req *connect_go.Request[v1.CreateBackupRequest]) (*connect_go.Response[v1.Backup], error) {
+	return c.createBackup.CallUnary(ctx, req)
+}
+
+// ListBackups calls io.defang.v1.FabricController.ListBackups.
+func (c *fabricControllerClient) ListBackups(ctx context.Context, req *connect_go.Request[v1.ListBackupsRequest]) (*connect_go.Response[v1.ListBackupsResponse], error) {
+	return c.listBackups.CallUnary(ctx, req)
+}
+
+// RestoreBackup calls io.defang.v1.FabricController.RestoreBackup.
+func (c *fabricControllerClient) RestoreBackup(ctx context.Context, req *connect_go.Request[v1.RestoreBackupRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.restoreBackup.CallUnary(ctx, req)
+}
+
+// Exec calls io.defang.v1.FabricController.Exec.
+func (c *fabricControllerClient) Exec(ctx context.Context) *connect_go.BidiStreamForClient[v1.ExecRequest, v1.ExecResponse] {
+	return c.exec.CallBidiStream(ctx)
+}
+
 // FabricControllerHandler is an implementation of the io.defang.v1.FabricController service.
 type FabricControllerHandler interface {
 	GetStatus(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.Status], error)
@@ -848,12 +1035,14 @@ type FabricControllerHandler interface {
 	ListConfigs(context.Context, *connect_go.Request[v1.ListConfigsRequest]) (*connect_go.Response[v1.ListConfigsResponse], error)
 	PutDeployment(context.Context, *connect_go.Request[v1.PutDeploymentRequest]) (*connect_go.Response[emptypb.Empty], error)
 	ListDeployments(context.Context, *connect_go.Request[v1.ListDeploymentsRequest]) (*connect_go.Response[v1.ListDeploymentsResponse], error)
+	ListProjects(context.Context, *connect_go.Request[v1.ListProjectsRequest]) (*connect_go.Response[v1.ListProjectsResponse], error)
 	CreateUploadURL(context.Context, *connect_go.Request[v1.UploadURLRequest]) (*connect_go.Response[v1.UploadURLResponse], error)
 	DelegateSubdomainZone(context.Context, *connect_go.Request[v1.DelegateSubdomainZoneRequest]) (*connect_go.Response[v1.DelegateSubdomainZoneResponse], error)
 	DeleteSubdomainZone(context.Context, *connect_go.Request[v1.DeleteSubdomainZoneRequest]) (*connect_go.Response[emptypb.Empty], error)
 	GetDelegateSubdomainZone(context.Context, *connect_go.Request[v1.GetDelegateSubdomainZoneRequest]) (*connect_go.Response[v1.DelegateSubdomainZoneResponse], error)
 	SetOptions(context.Context, *connect_go.Request[v1.SetOptionsRequest]) (*connect_go.Response[emptypb.Empty], error)
 	WhoAmI(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.WhoAmIResponse], error)
+	GetQuotas(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.GetQuotasResponse], error)
 	Track(context.Context, *connect_go.Request[v1.TrackRequest]) (*connect_go.Response[emptypb.Empty], error)
 	// Endpoint for GDPR compliance
 	DeleteMe(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[emptypb.Empty], error)
@@ -871,6 +1060,16 @@ type FabricControllerHandler interface {
 	ListStacks(context.Context, *connect_go.Request[v1.ListStacksRequest]) (*connect_go.Response[v1.ListStacksResponse], error)
 	DeleteStack(context.Context, *connect_go.Request[v1.DeleteStackRequest]) (*connect_go.Response[emptypb.Empty], error)
 	GetDefaultStack(context.Context, *connect_go.Request[v1.GetDefaultStackRequest]) (*connect_go.Response[v1.GetStackResponse], error)
+	PutCertificate(context.Context, *connect_go.Request[v1.PutCertificateRequest]) (*connect_go.Response[emptypb.Empty], error)
+	CreateAlertRule(context.Context, *connect_go.Request[v1.CreateAlertRuleRequest]) (*connect_go.Response[v1.AlertRule], error)
+	ListAlertRules(context.Context, *connect_go.Request[v1.ListAlertRulesRequest]) (*connect_go.Response[v1.ListAlertRulesResponse], error)
+	DeleteAlertRules(context.Context, *connect_go.Request[v1.DeleteAlertRulesRequest]) (*connect_go.Response[emptypb.Empty], error)
+	GetMetricsEndpoint(context.Context, *connect_go.Request[v1.GetMetricsEndpointRequest]) (*connect_go.Response[v1.MetricsEndpoint], error)
+	PutMetricsExport(context.Context, *connect_go.Request[v1.PutMetricsExportRequest]) (*connect_go.Response[emptypb.Empty], error)
+	CreateBackup(context.Context, *connect_go.Request[v1.CreateBackupRequest]) (*connect_go.Response[v1.Backup], error)
+	ListBackups(context.Context, *connect_go.Request[v1.ListBackupsRequest]) (*connect_go.Response[v1.ListBackupsResponse], error)
+	RestoreBackup(context.Context, *connect_go.Request[v1.RestoreBackupRequest]) (*connect_go.Response[emptypb.Empty], error)
+	Exec(context.Context, *connect_go.BidiStream[v1.ExecRequest, v1.ExecResponse]) error
 }
 
 // NewFabricControllerHandler builds an HTTP handler from the service implementation. It returns the
@@ -1032,6 +1231,12 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	)
+	fabricControllerListProjectsHandler := connect_go.NewUnaryHandler(
+		FabricControllerListProjectsProcedure,
+		svc.ListProjects,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	)
 	fabricControllerCreateUploadURLHandler := connect_go.NewUnaryHandler(
 		FabricControllerCreateUploadURLProcedure,
 		svc.CreateUploadURL,
@@ -1066,6 +1271,12 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	)
+	fabricControllerGetQuotasHandler := connect_go.NewUnaryHandler(
+		FabricControllerGetQuotasProcedure,
+		svc.GetQuotas,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	)
 	fabricControllerTrackHandler := connect_go.NewUnaryHandler(
 		FabricControllerTrackProcedure,
 		svc.Track,
@@ -1148,6 +1359,62 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	)
+	fabricControllerPutCertificateHandler := connect_go.NewUnaryHandler(
+		FabricControllerPutCertificateProcedure,
+		svc.PutCertificate,
+		connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerCreateAlertRuleHandler := connect_go.NewUnaryHandler(
+		FabricControllerCreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		opts...,
+	)
+	fabricControllerListAlertRulesHandler := connect_go.NewUnaryHandler(
+		FabricControllerListAlertRulesProcedure,
+		svc.ListAlertRules,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerDeleteAlertRulesHandler := connect_go.NewUnaryHandler(
+		FabricControllerDeleteAlertRulesProcedure,
+		svc.DeleteAlertRules,
+		connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerGetMetricsEndpointHandler := connect_go.NewUnaryHandler(
+		FabricControllerGetMetricsEndpointProcedure,
+		svc.GetMetricsEndpoint,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerPutMetricsExportHandler := connect_go.NewUnaryHandler(
+		FabricControllerPutMetricsExportProcedure,
+		svc.PutMetricsExport,
+		connect_go.WithIdempotency(connect_go.IdempotencyIdempotent),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerCreateBackupHandler := connect_go.NewUnaryHandler(
+		FabricControllerCreateBackupProcedure,
+		svc.CreateBackup,
+		opts...,
+	)
+	fabricControllerListBackupsHandler := connect_go.NewUnaryHandler(
+		FabricControllerListBackupsProcedure,
+		svc.ListBackups,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	)
+	fabricControllerRestoreBackupHandler := connect_go.NewUnaryHandler(
+		FabricControllerRestoreBackupProcedure,
+		svc.RestoreBackup,
+		opts...,
+	)
+	fabricControllerExecHandler := connect_go.NewBidiStreamHandler(
+		FabricControllerExecProcedure,
+		svc.Exec,
+		opts...,
+	)
 	return "/io.defang.v1.FabricController/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FabricControllerGetStatusProcedure:
@@ -1204,6 +1471,8 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 			fabricControllerPutDeploymentHandler.ServeHTTP(w, r)
 		case FabricControllerListDeploymentsProcedure:
 			fabricControllerListDeploymentsHandler.ServeHTTP(w, r)
+		case FabricControllerListProjectsProcedure:
+			fabricControllerListProjectsHandler.ServeHTTP(w, r)
 		case FabricControllerCreateUploadURLProcedure:
 			fabricControllerCreateUploadURLHandler.ServeHTTP(w, r)
 		case FabricControllerDelegateSubdomainZoneProcedure:
@@ -1216,6 +1485,8 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 			fabricControllerSetOptionsHandler.ServeHTTP(w, r)
 		case FabricControllerWhoAmIProcedure:
 			fabricControllerWhoAmIHandler.ServeHTTP(w, r)
+		case FabricControllerGetQuotasProcedure:
+			fabricControllerGetQuotasHandler.ServeHTTP(w, r)
 		case FabricControllerTrackProcedure:
 			fabricControllerTrackHandler.ServeHTTP(w, r)
 		case FabricControllerDeleteMeProcedure:
@@ -1244,6 +1515,26 @@ func NewFabricControllerHandler(svc FabricControllerHandler, opts ...connect_go.
 			fabricControllerDeleteStackHandler.ServeHTTP(w, r)
 		case FabricControllerGetDefaultStackProcedure:
 			fabricControllerGetDefaultStackHandler.ServeHTTP(w, r)
+		case FabricControllerPutCertificateProcedure:
+			fabricControllerPutCertificateHandler.ServeHTTP(w, r)
+		case FabricControllerCreateAlertRuleProcedure:
+			fabricControllerCreateAlertRuleHandler.ServeHTTP(w, r)
+		case FabricControllerListAlertRulesProcedure:
+			fabricControllerListAlertRulesHandler.ServeHTTP(w, r)
+		case FabricControllerDeleteAlertRulesProcedure:
+			fabricControllerDeleteAlertRulesHandler.ServeHTTP(w, r)
+		case FabricControllerGetMetricsEndpointProcedure:
+			fabricControllerGetMetricsEndpointHandler.ServeHTTP(w, r)
+		case FabricControllerPutMetricsExportProcedure:
+			fabricControllerPutMetricsExportHandler.ServeHTTP(w, r)
+		case FabricControllerCreateBackupProcedure:
+			fabricControllerCreateBackupHandler.ServeHTTP(w, r)
+		case FabricControllerListBackupsProcedure:
+			fabricControllerListBackupsHandler.ServeHTTP(w, r)
+		case FabricControllerRestoreBackupProcedure:
+			fabricControllerRestoreBackupHandler.ServeHTTP(w, r)
+		case FabricControllerExecProcedure:
+			fabricControllerExecHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1361,6 +1652,10 @@ func (UnimplementedFabricControllerHandler) ListDeployments(context.Context, *co
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.ListDeployments is not implemented"))
 }
 
+func (UnimplementedFabricControllerHandler) ListProjects(context.Context, *connect_go.Request[v1.ListProjectsRequest]) (*connect_go.Response[v1.ListProjectsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.ListProjects is not implemented"))
+}
+
 func (UnimplementedFabricControllerHandler) CreateUploadURL(context.Context, *connect_go.Request[v1.UploadURLRequest]) (*connect_go.Response[v1.UploadURLResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.CreateUploadURL is not implemented"))
 }
@@ -1385,6 +1680,10 @@ func (UnimplementedFabricControllerHandler) WhoAmI(context.Context, *connect_go.
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.WhoAmI is not implemented"))
 }
 
+func (UnimplementedFabricControllerHandler) GetQuotas(context.Context, *connect_go.Request[emptypb.Empty]) (*connect_go.Response[v1.GetQuotasResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.GetQuotas is not implemented"))
+}
+
 func (UnimplementedFabricControllerHandler) Track(context.Context, *connect_go.Request[v1.TrackRequest]) (*connect_go.Response[emptypb.Empty], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.Track is not implemented"))
 }
@@ -1440,3 +1739,43 @@ func (UnimplementedFabricControllerHandler) DeleteStack(context.Context, *connec
 func (UnimplementedFabricControllerHandler) GetDefaultStack(context.Context, *connect_go.Request[v1.GetDefaultStackRequest]) (*connect_go.Response[v1.GetStackResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.GetDefaultStack is not implemented"))
 }
+
+func (UnimplementedFabricControllerHandler) PutCertificate(context.Context, *connect_go.Request[v1.PutCertificateRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.PutCertificate is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) CreateAlertRule(context.Context, *connect_go.Request[v1.CreateAlertRuleRequest]) (*connect_go.Response[v1.AlertRule], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) ListAlertRules(context.Context, *connect_go.Request[v1.ListAlertRulesRequest]) (*connect_go.Response[v1.ListAlertRulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.ListAlertRules is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) DeleteAlertRules(context.Context, *connect_go.Request[v1.DeleteAlertRulesRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.DeleteAlertRules is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) GetMetricsEndpoint(context.Context, *connect_go.Request[v1.GetMetricsEndpointRequest]) (*connect_go.Response[v1.MetricsEndpoint], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.GetMetricsEndpoint is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) PutMetricsExport(context.Context, *connect_go.Request[v1.PutMetricsExportRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.PutMetricsExport is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) CreateBackup(context.Context, *connect_go.Request[v1.CreateBackupRequest]) (*connect_go.Response[v1.Backup], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.CreateBackup is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) ListBackups(context.Context, *connect_go.Request[v1.ListBackupsRequest]) (*connect_go.Response[v1.ListBackupsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.ListBackups is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) RestoreBackup(context.Context, *connect_go.Request[v1.RestoreBackupRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.RestoreBackup is not implemented"))
+}
+
+func (UnimplementedFabricControllerHandler) Exec(context.Context, *connect_go.BidiStream[v1.ExecRequest, v1.ExecResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("io.defang.v1.FabricController.Exec is not implemented"))
+}